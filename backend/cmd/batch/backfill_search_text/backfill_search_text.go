@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/search"
+)
+
+// 一度に取得するイベント数
+const eventBatchSize = 500
+
+// search_text 未設定のイベント（012_add_search_text_to_events より前に取り込んだもの）に
+// 取り込み時と同じ search.Normalize で検索用テキストを設定する
+// SearchTimeline は search_text 未設定の行を検索しないため、マイグレーション後に一度実行する
+func main() {
+	log.Println("🔄 Starting search text backfill batch...")
+
+	// 環境変数読み込み
+	if err := godotenv.Load(".env.dev"); err != nil {
+		log.Printf("Warning: .env.dev not loaded (%v)", err)
+	}
+
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		log.Fatal("❌ DATABASE_URL not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	queries := db.New(pool)
+
+	// id順に進めるため、更新に失敗した行があっても同じ行を繰り返し取得しない
+	afterID := pgtype.UUID{Valid: true}
+	updated, failed := 0, 0
+	for {
+		events, err := queries.ListEventsWithoutSearchText(ctx, db.ListEventsWithoutSearchTextParams{
+			AfterID:   afterID,
+			BatchSize: eventBatchSize,
+		})
+		if err != nil {
+			log.Fatalf("❌ Failed to list events: %v", err)
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		for _, event := range events {
+			// ingest の saveEvent と同じ正規化
+			err := queries.UpdateEventSearchText(ctx, db.UpdateEventSearchTextParams{
+				SearchText: pgtype.Text{String: search.Normalize(event.Title + "\n" + event.Description.String), Valid: true},
+				ID:         event.ID,
+			})
+			if err != nil {
+				log.Printf("⚠️  Failed to update search text of event %s: %v", event.ID.String(), err)
+				failed++
+				continue
+			}
+			updated++
+		}
+		afterID = events[len(events)-1].ID
+		log.Printf("📊 Backfilled %d events so far", updated)
+	}
+
+	if failed > 0 {
+		log.Fatalf("❌ Backfilled %d events, %d failed (run again to retry)", updated, failed)
+	}
+	log.Printf("✅ Backfilled search text of %d events", updated)
+}
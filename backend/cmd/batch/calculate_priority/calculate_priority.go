@@ -8,6 +8,7 @@ import (
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/scheduler"
 )
 
 func main() {
@@ -50,6 +51,13 @@ func main() {
 		}
 	}
 
+	// 投稿パターンを学習し、次に取得する時刻を予測
+	learned, err := scheduler.LearnPatterns(ctx, queries, time.Now())
+	if err != nil {
+		log.Fatalf("Failed to learn posting patterns: %v", err)
+	}
+	log.Printf("📊 Learned posting patterns of %d sources", learned)
+
 	elapsed := time.Since(start)
 	log.Printf("✅ Priority calculation completed in %v", elapsed)
 }
@@ -104,12 +104,7 @@ func main() {
 		log.Printf("📻 Fetching programs for: %s (%s)", displayName, source.ExternalID)
 
 		// 前回取得時刻以降、または初回は1週間前から
-		var since string
-		if source.LastFetchedAt.Valid {
-			since = source.LastFetchedAt.Time.Add(-5 * time.Minute).Format(time.RFC3339)
-		} else {
-			since = time.Now().AddDate(0, 0, -7).Format(time.RFC3339) // 1週間前
-		}
+		since := ingest.RefreshSince(source.PlatformID, source.LastFetchedAt, time.Now())
 
 		err := ingest.FetchAndSaveRadikoPrograms(
 			ctx,
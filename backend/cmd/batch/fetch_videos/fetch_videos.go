@@ -41,12 +41,13 @@ func main() {
 	queries := db.New(pool)
 
 	// YouTube クライアント
+	// カンマ区切りで複数のAPIキーを指定できる（キーごとに1日10,000 units）
 	youtubeAPIKey := os.Getenv("YOUTUBE_API_KEY")
 	if youtubeAPIKey == "" {
 		log.Fatal("YOUTUBE_API_KEY environment variable is not set")
 	}
 
-	youtubeClient, err := youtube.NewClient(youtubeAPIKey)
+	youtubeClient, err := youtube.NewClient(youtube.ParseAPIKeys(youtubeAPIKey)...)
 	if err != nil {
 		log.Fatalf("Failed to create YouTube client: %v", err)
 	}
@@ -60,6 +61,10 @@ func main() {
 	// Podcast クライアント
 	podcastClient := podcast.NewClient()
 
+	// YouTube API Quota管理（優先度の分からないバッチの更新は低優先度の枠を使う）
+	budget := youtube.NewBudgetPlanner(queries, youtube.NewQuotaTracker(queries, youtubeClient.DailyQuota()), youtube.BudgetConfig{DailyLimit: int(youtubeClient.DailyQuota())})
+	youtubeClient.SetTracker(budget)
+
 	// すべてのソース（チャンネル）を取得
 	sources, err := queries.ListSources(ctx, 1000) // 最大1000チャンネル
 	if err != nil {
@@ -69,7 +74,7 @@ func main() {
 	log.Printf("📺 Found %d sources to fetch", len(sources))
 
 	// 並列処理用のカウンター
-	var totalSuccess, totalFailed atomic.Int32
+	var totalSuccess, totalFailed, totalDeferred atomic.Int32
 	
 	// ワーカープール（最大10並行）
 	maxWorkers := 10
@@ -97,16 +102,19 @@ func main() {
 
 			switch src.PlatformID {
 			case "youtube":
+				// 低優先度の枠の予算を使い切ったら見送る（検索・購読時の取り込みの予算を残す）
+				if ok, reason := budget.Check(youtube.BucketLow, youtube.RefreshCost); !ok {
+					log.Printf("⚠️ [YouTube] Deferred %s: %s", displayName, reason)
+					totalDeferred.Add(1)
+					return
+				}
+
 				// YouTube: 増分更新（前回取得時刻以降のみ）
 				// 初回は過去3ヶ月分
-				if src.LastFetchedAt.Valid {
-					publishedAfter = src.LastFetchedAt.Time.Add(-5 * time.Minute).Format(time.RFC3339)
-				} else {
-					publishedAfter = time.Now().AddDate(0, -3, 0).Format(time.RFC3339) // 3ヶ月前
-				}
+				publishedAfter = ingest.RefreshSince(src.PlatformID, src.LastFetchedAt, time.Now())
 				log.Printf("📺 [YouTube] %s (since %s)", displayName, publishedAfter)
 				err = ingest.FetchAndSaveChannelVideosSince(
-			ctx,
+			youtube.WithBucket(ctx, youtube.BucketLow),
 			queries,
 			youtubeClient,
 					src.ID,
@@ -118,17 +126,7 @@ func main() {
 			case "twitch":
 				// Twitch: ライブは常時チェック、VODは直近1週間のみ
 				// 前回取得時刻と1週間前の新しい方を使う
-				oneWeekAgo := time.Now().AddDate(0, 0, -7).Format(time.RFC3339)
-				if src.LastFetchedAt.Valid {
-					lastFetched := src.LastFetchedAt.Time.Add(-5 * time.Minute).Format(time.RFC3339)
-					if lastFetched > oneWeekAgo {
-						publishedAfter = lastFetched
-					} else {
-						publishedAfter = oneWeekAgo
-					}
-				} else {
-					publishedAfter = oneWeekAgo
-				}
+				publishedAfter = ingest.RefreshSince(src.PlatformID, src.LastFetchedAt, time.Now())
 				log.Printf("🎮 [Twitch] %s (🔴LIVE + VOD since %s)", displayName, publishedAfter)
 				err = ingest.FetchAndSaveTwitchVideosSince(
 					ctx,
@@ -141,17 +139,7 @@ func main() {
 
 			case "podcast":
 				// Podcast: 直近1週間は常にチェック（放送日から遅れて配信される場合がある）
-				oneWeekAgo := time.Now().AddDate(0, 0, -7).Format(time.RFC3339)
-				if src.LastFetchedAt.Valid {
-					lastFetched := src.LastFetchedAt.Time.Add(-5 * time.Minute).Format(time.RFC3339)
-					if lastFetched > oneWeekAgo {
-						publishedAfter = lastFetched
-					} else {
-						publishedAfter = oneWeekAgo
-					}
-				} else {
-					publishedAfter = time.Now().AddDate(0, -3, 0).Format(time.RFC3339) // 初回は3ヶ月前
-				}
+				publishedAfter = ingest.RefreshSince(src.PlatformID, src.LastFetchedAt, time.Now())
 				log.Printf("🎙️ [Podcast] %s (since %s)", displayName, publishedAfter)
 				err = ingest.FetchAndSavePodcastEpisodesSince(
 					ctx,
@@ -189,6 +177,6 @@ func main() {
 	// すべてのgoroutineの完了を待つ
 	wg.Wait()
 
-	log.Printf("🎉 Batch job completed! Success: %d, Failed: %d", totalSuccess.Load(), totalFailed.Load())
+	log.Printf("🎉 Batch job completed! Success: %d, Failed: %d, Deferred (YouTube quota): %d", totalSuccess.Load(), totalFailed.Load(), totalDeferred.Load())
 }
 
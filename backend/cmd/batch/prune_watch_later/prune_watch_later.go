@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/youtube"
+)
+
+// YouTube APIで一度に確認する動画数
+const videoBatchSize = 50
+
+// 「あとで見る」キューから見られなくなった番組を除く
+//   - 終了したTwitchの配信・タイムフリーの期間を過ぎたラジオ番組はキューから削除する（イベントは残す）
+//   - 配信元で削除・非公開になったYouTube動画はイベントごと削除する
+//     （watch_later・user_event_statesは外部キーのON DELETE CASCADEで一緒に削除される）
+func main() {
+	log.Println("🔄 Starting watch later prune batch...")
+
+	// 環境変数読み込み
+	if err := godotenv.Load(".env.dev"); err != nil {
+		log.Printf("Warning: .env.dev not loaded (%v)", err)
+	}
+
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		log.Fatal("❌ DATABASE_URL not set")
+	}
+
+	// カンマ区切りで複数のAPIキーを指定できる（キーごとに1日10,000 units）
+	youtubeAPIKey := os.Getenv("YOUTUBE_API_KEY")
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	queries := db.New(pool)
+
+	// 終了したTwitchの配信・ラジオ番組はAPIを呼ばずに判定できる
+	ended, err := queries.DeleteEndedWatchLater(ctx)
+	if err != nil {
+		log.Fatalf("❌ Failed to delete ended programs from watch later: %v", err)
+	}
+	log.Printf("✅ Removed %d ended Twitch/radio programs from watch later queues", ended)
+
+	if youtubeAPIKey == "" {
+		log.Fatal("❌ YOUTUBE_API_KEY not set")
+	}
+
+	youtubeClient, err := youtube.NewClient(youtube.ParseAPIKeys(youtubeAPIKey)...)
+	if err != nil {
+		log.Fatalf("❌ Failed to create YouTube client: %v", err)
+	}
+	// YouTube API Quota管理（Quotaを使い切ったら残りの動画の確認をスキップする）
+	youtubeClient.SetTracker(youtube.NewQuotaTracker(queries, youtubeClient.DailyQuota()))
+
+	events, err := queries.ListWatchLaterYouTubeEvents(ctx)
+	if err != nil {
+		log.Fatalf("❌ Failed to list watch later events: %v", err)
+	}
+	log.Printf("📋 Checking %d YouTube videos in watch later queues", len(events))
+
+	var missing []pgtype.UUID
+	for i := 0; i < len(events); i += videoBatchSize {
+		end := min(i+videoBatchSize, len(events))
+		batch := events[i:end]
+
+		videoIDs := make([]string, 0, len(batch))
+		for _, event := range batch {
+			videoIDs = append(videoIDs, event.ExternalEventID)
+		}
+
+		videos, err := youtubeClient.GetVideosDetails(ctx, videoIDs)
+		if err != nil {
+			// APIエラー時は削除と判定せずスキップ
+			log.Printf("⚠️  Failed to get videos details (skipping %d videos): %v", len(batch), err)
+			continue
+		}
+
+		found := make(map[string]bool, len(videos))
+		for _, video := range videos {
+			found[video.Id] = true
+		}
+		for _, event := range batch {
+			if !found[event.ExternalEventID] {
+				log.Printf("🗑️  Video no longer available: %s", event.ExternalEventID)
+				missing = append(missing, event.ID)
+			}
+		}
+	}
+
+	if len(missing) == 0 {
+		log.Println("✅ No unavailable videos found")
+		return
+	}
+
+	deleted, err := queries.DeleteEventsByIDs(ctx, missing)
+	if err != nil {
+		log.Fatalf("❌ Failed to delete events: %v", err)
+	}
+	log.Printf("✅ Deleted %d unavailable events", deleted)
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/ingest"
 	"github.com/kinchoKayaba/pixicast/backend/internal/twitch"
 )
 
@@ -32,6 +34,19 @@ func updateLiveStatus() {
 	defer pool.Close()
 
 	ctx := context.Background()
+	queries := db.New(pool)
+
+	// 開始・終了時刻を過ぎた番組（配信予定→配信中など）を WatchTimeline の購読者へ通知
+	if n, err := queries.RecordEventTransitions(ctx); err != nil {
+		log.Printf("⚠️ Failed to record event transitions: %v", err)
+	} else if n > 0 {
+		log.Printf("📡 Recorded %d started/ended event changes", n)
+	}
+
+	// 古い変更履歴を削除（配信中のライブがなくても実行する）
+	if err := queries.DeleteOldEventChanges(ctx); err != nil {
+		log.Printf("⚠️ Failed to delete old event changes: %v", err)
+	}
 
 	// Twitch クライアント初期化
 	twitchClientID := os.Getenv("TWITCH_CLIENT_ID")
@@ -132,6 +147,15 @@ func updateLiveStatus() {
 				continue
 			}
 
+			// WatchTimeline の購読者へ配信終了を通知
+			if err := queries.InsertEventChange(ctx, db.InsertEventChangeParams{
+				EventID:    event.ID,
+				SourceID:   event.SourceID,
+				ChangeType: ingest.ChangeUpdated,
+			}); err != nil {
+				log.Printf("⚠️ Failed to record event change %s: %v", event.Title, err)
+			}
+
 			updatedCount++
 			log.Printf("✅ Updated: %s (live -> video)", event.Title)
 		}
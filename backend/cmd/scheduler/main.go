@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/ingest"
+	"github.com/kinchoKayaba/pixicast/backend/internal/podcast"
+	"github.com/kinchoKayaba/pixicast/backend/internal/radiko"
+	"github.com/kinchoKayaba/pixicast/backend/internal/scheduler"
+	"github.com/kinchoKayaba/pixicast/backend/internal/twitch"
+	"github.com/kinchoKayaba/pixicast/backend/internal/youtube"
+)
+
+// ソースの優先度（source_priority）に従って定期更新を実行し続けるデーモン
+// cronで起動するバッチ（fetch_videos・fetch_radiko）の代わりに常駐させる
+func main() {
+	pollInterval := flag.Duration("poll", time.Minute, "更新間隔を過ぎたソースを確認する間隔")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 30*time.Second, "停止時に実行中の更新の完了を待つ時間（過ぎたらキャンセル）")
+	youtubeWorkers := flag.Int("youtube-workers", scheduler.DefaultPools["youtube"], "YouTubeのワーカー数（0で更新しない）")
+	twitchWorkers := flag.Int("twitch-workers", scheduler.DefaultPools["twitch"], "Twitchのワーカー数（0で更新しない）")
+	podcastWorkers := flag.Int("podcast-workers", scheduler.DefaultPools["podcast"], "Podcastのワーカー数（0で更新しない）")
+	radikoWorkers := flag.Int("radiko-workers", scheduler.DefaultPools["radiko"], "Radikoのワーカー数（0で更新しない）")
+	flag.Parse()
+
+	// .env.dev ファイルを読み込み
+	if err := godotenv.Load(".env.dev"); err != nil {
+		log.Printf("Warning: .env.dev file not found: %v", err)
+	}
+
+	// データベース接続
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		log.Fatal("DATABASE_URL environment variable is not set")
+	}
+
+	// SIGTERM（Cloud Runなど）・Ctrl+Cで実行中の更新の完了を待って終了
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := pgxpool.New(ctx, databaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	queries := db.New(pool)
+
+	// YouTube クライアント
+	// カンマ区切りで複数のAPIキーを指定できる（キーごとに1日10,000 units）
+	youtubeAPIKey := os.Getenv("YOUTUBE_API_KEY")
+	if youtubeAPIKey == "" {
+		log.Fatal("YOUTUBE_API_KEY environment variable is not set")
+	}
+	youtubeClient, err := youtube.NewClient(youtube.ParseAPIKeys(youtubeAPIKey)...)
+	if err != nil {
+		log.Fatalf("Failed to create YouTube client: %v", err)
+	}
+
+	// YouTube API Quota管理（1日のQuotaを優先度・検索・過去分の取り込みの枠に割り当てる）
+	quotaTracker := youtube.NewQuotaTracker(queries, youtubeClient.DailyQuota())
+	budget := youtube.NewBudgetPlanner(queries, quotaTracker, youtube.BudgetConfig{DailyLimit: int(youtubeClient.DailyQuota())})
+	youtubeClient.SetTracker(budget)
+
+	refresher := ingest.NewRefresher(queries, youtubeClient, twitch.NewClient(), podcast.NewClient(), radiko.NewClient(""))
+	s := scheduler.New(queries, refresher, budget, scheduler.Config{
+		Pools: map[string]int{
+			"youtube": *youtubeWorkers,
+			"twitch":  *twitchWorkers,
+			"podcast": *podcastWorkers,
+			"radiko":  *radikoWorkers,
+		},
+		PollInterval:    *pollInterval,
+		ShutdownTimeout: *shutdownTimeout,
+	})
+
+	if err := s.Run(ctx); err != nil {
+		log.Fatalf("❌ Scheduler failed: %v", err)
+	}
+}
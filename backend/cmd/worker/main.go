@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/ingest"
+	"github.com/kinchoKayaba/pixicast/backend/internal/jobqueue"
+	"github.com/kinchoKayaba/pixicast/backend/internal/podcast"
+	"github.com/kinchoKayaba/pixicast/backend/internal/radiko"
+	"github.com/kinchoKayaba/pixicast/backend/internal/twitch"
+	"github.com/kinchoKayaba/pixicast/backend/internal/youtube"
+)
+
+// ジョブキュー（update_schedule）のジョブを実行するワーカー
+// サーバーを JOB_WORKER_ENABLED=false で起動した場合に別プロセスとして実行する
+func main() {
+	once := flag.Bool("once", false, "実行できるジョブを1回だけ取得して実行し、終了する")
+	concurrency := flag.Int("concurrency", 4, "同時に実行するジョブ数")
+	deadLetters := flag.Int("dead-letters", 0, "失敗（デッドレター）したジョブを指定件数表示して終了する")
+	flag.Parse()
+
+	// .env.dev ファイルを読み込み
+	if err := godotenv.Load(".env.dev"); err != nil {
+		log.Printf("Warning: .env.dev file not found: %v", err)
+	}
+
+	// データベース接続
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		log.Fatal("DATABASE_URL environment variable is not set")
+	}
+
+	// SIGTERM（Cloud Runなど）・Ctrl+Cで実行中のジョブを実行待ちに戻して終了
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := pgxpool.New(ctx, databaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	queries := db.New(pool)
+
+	if *deadLetters > 0 {
+		printDeadLetters(ctx, queries, int32(*deadLetters))
+		return
+	}
+
+	// YouTube クライアント
+	// カンマ区切りで複数のAPIキーを指定できる（キーごとに1日10,000 units）
+	youtubeAPIKey := os.Getenv("YOUTUBE_API_KEY")
+	if youtubeAPIKey == "" {
+		log.Fatal("YOUTUBE_API_KEY environment variable is not set")
+	}
+	youtubeClient, err := youtube.NewClient(youtube.ParseAPIKeys(youtubeAPIKey)...)
+	if err != nil {
+		log.Fatalf("Failed to create YouTube client: %v", err)
+	}
+
+	// YouTube API Quota管理（過去分の取り込みの枠を使い切ったらQuotaがリセットされるまで見送る）
+	budget := youtube.NewBudgetPlanner(queries, youtube.NewQuotaTracker(queries, youtubeClient.DailyQuota()), youtube.BudgetConfig{DailyLimit: int(youtubeClient.DailyQuota())})
+	youtubeClient.SetTracker(budget)
+
+	worker := jobqueue.NewWorker(queries, jobqueue.WorkerConfig{Concurrency: *concurrency})
+	backfill := ingest.NewBackfill(queries, youtubeClient, twitch.NewClient(), podcast.NewClient(), radiko.NewClient(""), budget)
+	worker.Handle(jobqueue.JobBackfill, backfill.Run)
+
+	if *once {
+		n, err := worker.RunOnce(ctx)
+		if err != nil {
+			log.Fatalf("❌ Job worker failed: %v", err)
+		}
+		log.Printf("✅ Processed %d jobs", n)
+		return
+	}
+
+	if err := worker.Run(ctx); err != nil {
+		log.Fatalf("❌ Job worker failed: %v", err)
+	}
+}
+
+// printDeadLetters は失敗（デッドレター）したジョブを新しい順に表示
+func printDeadLetters(ctx context.Context, queries *db.Queries, limit int32) {
+	jobs, err := queries.ListFailedSchedules(ctx, limit)
+	if err != nil {
+		log.Fatalf("Failed to list failed jobs: %v", err)
+	}
+	if len(jobs) == 0 {
+		fmt.Println("No failed jobs")
+		return
+	}
+	for _, job := range jobs {
+		fmt.Printf("%s\t%s\t%s:%s\tattempts=%d/%d\t%s\t%s\n",
+			job.CompletedAt.Time.Format(time.RFC3339),
+			job.JobType,
+			job.PlatformID,
+			job.ExternalID,
+			job.Attempts,
+			job.MaxAttempts,
+			job.ID.String(),
+			job.ErrorMessage.String,
+		)
+	}
+}
@@ -17,6 +17,10 @@ type ApiQuotaUsage struct {
 	QuotaCost    int32              `json:"quota_cost"`
 	RequestCount int32              `json:"request_count"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	// 予算の枠（high / medium / low=優先度ごとの定期更新, search=検索, backfill=購読時の取り込み, 空文字=枠外）
+	Bucket string `json:"bucket"`
+	// APIキーの識別子（キーのSHA-256の先頭8文字、空文字=不明）
+	KeyID string `json:"key_id"`
 }
 
 // タイムライン項目（動画/配信/予定等）
@@ -40,6 +44,55 @@ type Event struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	// Video/stream duration in HH:MM:SS or MM:SS format
 	Duration pgtype.Text `json:"duration"`
+	// Normalized title and description for search (NFKC, lowercase, katakana to hiragana)
+	SearchText pgtype.Text `json:"search_text"`
+	// Audio file URL of podcast episodes (RSS enclosure)
+	EnclosureUrl pgtype.Text `json:"enclosure_url"`
+	// MIME type of the enclosure (e.g. audio/mpeg)
+	EnclosureType pgtype.Text `json:"enclosure_type"`
+	// Size of the enclosure in bytes
+	EnclosureLength pgtype.Int8 `json:"enclosure_length"`
+}
+
+// タイムライン変更履歴（WatchTimeline配信用）
+type EventChange struct {
+	// 同じ作成日時の変更履歴の順序（ストリーム再開用のカーソルは作成日時とidの組）
+	ID       int64       `json:"id"`
+	EventID  pgtype.UUID `json:"event_id"`
+	SourceID pgtype.UUID `json:"source_id"`
+	// inserted=新規追加, updated=更新, removed=削除
+	ChangeType string             `json:"change_type"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+// カレンダー購読用のシークレットトークン
+type FeedToken struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID int64       `json:"user_id"`
+	// シークレットのSHA-256ハッシュ（16進数）
+	TokenHash  string             `json:"token_hash"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	LastUsedAt pgtype.Timestamptz `json:"last_used_at"`
+	// 無効化日時（NULLの場合は有効）
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+}
+
+// タイムラインのミュートルール（ユーザーごと）
+type MuteRule struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID int64       `json:"user_id"`
+	// 対象のソース（NULLの場合はすべての購読チャンネル）
+	SourceID pgtype.UUID `json:"source_id"`
+	// keyword=キーワード, regex=正規表現, game_name=Twitchのカテゴリ, min_duration=指定秒数より短い, max_duration=指定秒数より長い, event_type=イベント種別
+	Kind string `json:"kind"`
+	// キーワード・正規表現・ゲーム名・イベント種別
+	Pattern string `json:"pattern"`
+	// キーワード・正規表現の対象（all=タイトルと説明文, title, description）
+	Field string `json:"field"`
+	// min_duration・max_durationの秒数
+	DurationSeconds int32              `json:"duration_seconds"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
 }
 
 type PlanLimit struct {
@@ -51,6 +104,8 @@ type PlanLimit struct {
 	HasDeviceSync bool               `json:"has_device_sync"`
 	Description   pgtype.Text        `json:"description"`
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	// 「あとで見る」に追加できる最大件数
+	MaxWatchLater int32 `json:"max_watch_later"`
 }
 
 // 配信プラットフォーム（YouTube, Twitch等）
@@ -78,6 +133,14 @@ type Source struct {
 	ApplePodcastUrl pgtype.Text `json:"apple_podcast_url"`
 }
 
+// 同じクリエイターとしてリンクしたソースの組（ユーザーごと）
+type SourceLink struct {
+	UserID         int64              `json:"user_id"`
+	SourceID       pgtype.UUID        `json:"source_id"`
+	LinkedSourceID pgtype.UUID        `json:"linked_source_id"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
 // チャンネル優先度管理（バッチ処理最適化用）
 type SourcePriority struct {
 	SourceID        pgtype.UUID    `json:"source_id"`
@@ -90,6 +153,50 @@ type SourcePriority struct {
 	LastCalculatedAt      pgtype.Timestamptz `json:"last_calculated_at"`
 	CreatedAt             pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	// 曜日×時刻（日本時間）ごとの投稿・配信数（168要素、日曜0時=0、新しいものほど重い）
+	PostingHistogram []float64 `json:"posting_histogram"`
+	// ライブ・予定・プレミア・ラジオの割合（0.5以上は開始前に取得）
+	PostingLiveRatio float64 `json:"posting_live_ratio"`
+	// 学習に使ったイベント数
+	PostingSampleCount int32 `json:"posting_sample_count"`
+	// 投稿パターンの確度（0=ばらばら〜1=毎回同じ時間帯）
+	PostingConfidence float64 `json:"posting_confidence"`
+	// 投稿パターンを学習した日時
+	PatternLearnedAt pgtype.Timestamptz `json:"pattern_learned_at"`
+	// 次に投稿されそうな時間帯の開始日時
+	NextExpectedAt pgtype.Timestamptz `json:"next_expected_at"`
+	// 次に取得する時刻（NULLの場合は last_fetched_at + update_interval_minutes）
+	NextFetchAt pgtype.Timestamptz `json:"next_fetch_at"`
+}
+
+// 購読チャンネルのタグ・フォルダ（ユーザーごと）
+type SubscriptionTag struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID int64       `json:"user_id"`
+	// タグの名前（ユーザーごとに一意）
+	Name      string             `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+// 購読へのタグの割り当て
+type SubscriptionTagAssignment struct {
+	TagID     pgtype.UUID        `json:"tag_id"`
+	UserID    int64              `json:"user_id"`
+	SourceID  pgtype.UUID        `json:"source_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+// 保存したタイムラインのビュー（ユーザーごと）
+type TimelineView struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID int64       `json:"user_id"`
+	// ビューの名前（ユーザーごとに一意）
+	Name string `json:"name"`
+	// 絞り込みの式
+	Expression string             `json:"expression"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
 }
 
 // 更新スケジュール管理
@@ -98,13 +205,27 @@ type UpdateSchedule struct {
 	SourceID      pgtype.UUID        `json:"source_id"`
 	ScheduledAt   pgtype.Timestamptz `json:"scheduled_at"`
 	PriorityLevel string             `json:"priority_level"`
-	// pending=待機中, running=実行中, completed=完了, failed=失敗
+	// pending=待機中, running=実行中, completed=完了, failed=失敗（リトライ上限に達したデッドレター）
 	Status       string             `json:"status"`
 	StartedAt    pgtype.Timestamptz `json:"started_at"`
 	CompletedAt  pgtype.Timestamptz `json:"completed_at"`
 	ErrorMessage pgtype.Text        `json:"error_message"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	// ジョブの種類（backfill=購読時の過去分の取り込み）
+	JobType string `json:"job_type"`
+	// ジョブの内容（job_typeごとのJSON）
+	Payload []byte `json:"payload"`
+	// 冪等キー（同じキーの実行待ち・実行中のジョブは作成しない）
+	DedupeKey pgtype.Text `json:"dedupe_key"`
+	// 実行回数（リースを取得するたびに増える）
+	Attempts int32 `json:"attempts"`
+	// failedにするまでの最大実行回数
+	MaxAttempts int32 `json:"max_attempts"`
+	// ジョブを実行中のワーカー
+	LeaseOwner pgtype.Text `json:"lease_owner"`
+	// リースの期限（過ぎると他のワーカーが取得できる）
+	LeaseExpiresAt pgtype.Timestamptz `json:"lease_expires_at"`
 }
 
 type User struct {
@@ -120,6 +241,19 @@ type User struct {
 	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
 }
 
+// ユーザーごとのイベントの状態（視聴済み・非表示・却下）
+type UserEventState struct {
+	UserID  int64       `json:"user_id"`
+	EventID pgtype.UUID `json:"event_id"`
+	// 視聴済みにした日時
+	WatchedAt pgtype.Timestamptz `json:"watched_at"`
+	// タイムラインから非表示にした日時
+	HiddenAt pgtype.Timestamptz `json:"hidden_at"`
+	// 却下（通知・おすすめ対象外）にした日時
+	DismissedAt pgtype.Timestamptz `json:"dismissed_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
 // ユーザーの購読情報
 type UserSubscription struct {
 	UserID         int64              `json:"user_id"`
@@ -131,3 +265,12 @@ type UserSubscription struct {
 	IsFavorite     bool               `json:"is_favorite"`
 	LastAccessedAt pgtype.Timestamptz `json:"last_accessed_at"`
 }
+
+// ユーザーの「あとで見る」キュー
+type WatchLater struct {
+	UserID  int64       `json:"user_id"`
+	EventID pgtype.UUID `json:"event_id"`
+	// 並び順（小さいほど先頭）
+	Position int32              `json:"position"`
+	AddedAt  pgtype.Timestamptz `json:"added_at"`
+}
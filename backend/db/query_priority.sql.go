@@ -49,8 +49,7 @@ calculated_priority AS (
     FROM sources s
     CROSS JOIN total_users tu
     LEFT JOIN channel_popularity cp ON s.id = cp.source_id
-    WHERE s.platform_id = 'youtube'
-        AND s.fetch_status = 'ok'
+    WHERE s.fetch_status = 'ok'
 )
 INSERT INTO source_priority (
     source_id,
@@ -79,34 +78,160 @@ ON CONFLICT (source_id) DO UPDATE SET
     updated_at = now()
 `
 
-// 全チャンネルの優先度を計算してsource_priorityテーブルを更新
+// 全ソース（全プラットフォーム）の優先度を計算してsource_priorityテーブルを更新
 func (q *Queries) CalculateSourcePriority(ctx context.Context) error {
 	_, err := q.db.Exec(ctx, calculateSourcePriority)
 	return err
 }
 
+const claimPendingSchedules = `-- name: ClaimPendingSchedules :many
+WITH claimed AS (
+    UPDATE update_schedule
+    SET
+        status = 'running',
+        lease_owner = $1,
+        lease_expires_at = now() + $2::int * INTERVAL '1 second',
+        attempts = attempts + 1,
+        started_at = now(),
+        updated_at = now()
+    WHERE
+        id IN (
+            SELECT id FROM update_schedule
+            WHERE
+                (status = 'pending' AND scheduled_at <= now())
+                OR (status = 'running' AND lease_expires_at < now())
+            ORDER BY
+                CASE priority_level WHEN 'high' THEN 1 WHEN 'medium' THEN 2 ELSE 3 END,
+                scheduled_at ASC
+            LIMIT $3
+        )
+        AND (
+            (status = 'pending' AND scheduled_at <= now())
+            OR (status = 'running' AND lease_expires_at < now())
+        )
+    RETURNING id, source_id, scheduled_at, priority_level, status, started_at, completed_at, error_message, created_at, updated_at, job_type, payload, dedupe_key, attempts, max_attempts, lease_owner, lease_expires_at
+)
+SELECT
+    c.id, c.source_id, c.scheduled_at, c.priority_level, c.status, c.started_at, c.completed_at, c.error_message, c.created_at, c.updated_at, c.job_type, c.payload, c.dedupe_key, c.attempts, c.max_attempts, c.lease_owner, c.lease_expires_at,
+    s.external_id,
+    s.platform_id
+FROM claimed c
+JOIN sources s ON c.source_id = s.id
+ORDER BY
+    CASE c.priority_level WHEN 'high' THEN 1 WHEN 'medium' THEN 2 ELSE 3 END,
+    c.scheduled_at ASC
+`
+
+type ClaimPendingSchedulesParams struct {
+	LeaseOwner   pgtype.Text `json:"lease_owner"`
+	LeaseSeconds int32       `json:"lease_seconds"`
+	BatchSize    int32       `json:"batch_size"`
+}
+
+type ClaimPendingSchedulesRow struct {
+	ID             pgtype.UUID        `json:"id"`
+	SourceID       pgtype.UUID        `json:"source_id"`
+	ScheduledAt    pgtype.Timestamptz `json:"scheduled_at"`
+	PriorityLevel  string             `json:"priority_level"`
+	Status         string             `json:"status"`
+	StartedAt      pgtype.Timestamptz `json:"started_at"`
+	CompletedAt    pgtype.Timestamptz `json:"completed_at"`
+	ErrorMessage   pgtype.Text        `json:"error_message"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	JobType        string             `json:"job_type"`
+	Payload        []byte             `json:"payload"`
+	DedupeKey      pgtype.Text        `json:"dedupe_key"`
+	Attempts       int32              `json:"attempts"`
+	MaxAttempts    int32              `json:"max_attempts"`
+	LeaseOwner     pgtype.Text        `json:"lease_owner"`
+	LeaseExpiresAt pgtype.Timestamptz `json:"lease_expires_at"`
+	ExternalID     string             `json:"external_id"`
+	PlatformID     string             `json:"platform_id"`
+}
+
+// 実行時刻を過ぎた実行待ちのジョブと、リースが切れた実行中のジョブをリースして取得
+// 複数のワーカーが同時に実行しても、UPDATEの条件で再チェックするため同じジョブを二重に取得しない
+func (q *Queries) ClaimPendingSchedules(ctx context.Context, arg ClaimPendingSchedulesParams) ([]ClaimPendingSchedulesRow, error) {
+	rows, err := q.db.Query(ctx, claimPendingSchedules, arg.LeaseOwner, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClaimPendingSchedulesRow{}
+	for rows.Next() {
+		var i ClaimPendingSchedulesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SourceID,
+			&i.ScheduledAt,
+			&i.PriorityLevel,
+			&i.Status,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.ErrorMessage,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.JobType,
+			&i.Payload,
+			&i.DedupeKey,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.LeaseOwner,
+			&i.LeaseExpiresAt,
+			&i.ExternalID,
+			&i.PlatformID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createUpdateSchedule = `-- name: CreateUpdateSchedule :one
 INSERT INTO update_schedule (
     source_id,
     scheduled_at,
     priority_level,
     status,
+    job_type,
+    payload,
+    dedupe_key,
+    max_attempts,
     created_at,
     updated_at
 )
-VALUES ($1, $2, $3, 'pending', now(), now())
-RETURNING id, source_id, scheduled_at, priority_level, status, started_at, completed_at, error_message, created_at, updated_at
+VALUES ($1, $2, $3, 'pending', $4, $5, $6, $7, now(), now())
+ON CONFLICT DO NOTHING
+RETURNING id, source_id, scheduled_at, priority_level, status, started_at, completed_at, error_message, created_at, updated_at, job_type, payload, dedupe_key, attempts, max_attempts, lease_owner, lease_expires_at
 `
 
 type CreateUpdateScheduleParams struct {
 	SourceID      pgtype.UUID        `json:"source_id"`
 	ScheduledAt   pgtype.Timestamptz `json:"scheduled_at"`
 	PriorityLevel string             `json:"priority_level"`
+	JobType       string             `json:"job_type"`
+	Payload       []byte             `json:"payload"`
+	DedupeKey     pgtype.Text        `json:"dedupe_key"`
+	MaxAttempts   int32              `json:"max_attempts"`
 }
 
-// 更新スケジュールを作成
+// 更新スケジュール（ジョブ）を作成
+// dedupe_key が同じ実行待ち・実行中のジョブがある場合は作成しない（行を返さない）
 func (q *Queries) CreateUpdateSchedule(ctx context.Context, arg CreateUpdateScheduleParams) (UpdateSchedule, error) {
-	row := q.db.QueryRow(ctx, createUpdateSchedule, arg.SourceID, arg.ScheduledAt, arg.PriorityLevel)
+	row := q.db.QueryRow(ctx, createUpdateSchedule,
+		arg.SourceID,
+		arg.ScheduledAt,
+		arg.PriorityLevel,
+		arg.JobType,
+		arg.Payload,
+		arg.DedupeKey,
+		arg.MaxAttempts,
+	)
 	var i UpdateSchedule
 	err := row.Scan(
 		&i.ID,
@@ -119,10 +244,80 @@ func (q *Queries) CreateUpdateSchedule(ctx context.Context, arg CreateUpdateSche
 		&i.ErrorMessage,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.JobType,
+		&i.Payload,
+		&i.DedupeKey,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LeaseOwner,
+		&i.LeaseExpiresAt,
 	)
 	return i, err
 }
 
+const deferSchedule = `-- name: DeferSchedule :execrows
+UPDATE update_schedule
+SET
+    status = 'pending',
+    attempts = GREATEST(attempts - 1, 0),
+    error_message = $1,
+    scheduled_at = $2::timestamptz,
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = now()
+WHERE
+    id = $3
+    AND lease_owner = $4
+`
+
+type DeferScheduleParams struct {
+	ErrorMessage pgtype.Text        `json:"error_message"`
+	RetryAt      pgtype.Timestamptz `json:"retry_at"`
+	ID           pgtype.UUID        `json:"id"`
+	LeaseOwner   pgtype.Text        `json:"lease_owner"`
+}
+
+// 実行中のジョブを実行回数に数えずに実行待ちに戻し、retry_at に再実行する（Quotaの予算が足りないなど、ジョブの失敗ではない場合）
+// リースを持っているワーカーのみ更新できる（他のワーカーに取得された場合は0行）
+func (q *Queries) DeferSchedule(ctx context.Context, arg DeferScheduleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deferSchedule,
+		arg.ErrorMessage,
+		arg.RetryAt,
+		arg.ID,
+		arg.LeaseOwner,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const extendScheduleLease = `-- name: ExtendScheduleLease :execrows
+UPDATE update_schedule
+SET
+    lease_expires_at = now() + $1::int * INTERVAL '1 second',
+    updated_at = now()
+WHERE
+    id = $2
+    AND status = 'running'
+    AND lease_owner = $3
+`
+
+type ExtendScheduleLeaseParams struct {
+	LeaseSeconds int32       `json:"lease_seconds"`
+	ID           pgtype.UUID `json:"id"`
+	LeaseOwner   pgtype.Text `json:"lease_owner"`
+}
+
+// 実行中のジョブのリースを延長（リースを持っているワーカーのみ。他のワーカーに取得された場合は0行）
+func (q *Queries) ExtendScheduleLease(ctx context.Context, arg ExtendScheduleLeaseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, extendScheduleLease, arg.LeaseSeconds, arg.ID, arg.LeaseOwner)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getAPIQuotaUsageByEndpoint = `-- name: GetAPIQuotaUsageByEndpoint :many
 SELECT
     endpoint,
@@ -167,6 +362,39 @@ func (q *Queries) GetAPIQuotaUsageByEndpoint(ctx context.Context, arg GetAPIQuot
 	return items, nil
 }
 
+const getActiveScheduleByDedupeKey = `-- name: GetActiveScheduleByDedupeKey :one
+SELECT id, source_id, scheduled_at, priority_level, status, started_at, completed_at, error_message, created_at, updated_at, job_type, payload, dedupe_key, attempts, max_attempts, lease_owner, lease_expires_at FROM update_schedule
+WHERE dedupe_key = $1
+    AND status IN ('pending', 'running')
+LIMIT 1
+`
+
+// 冪等キーが同じ実行待ち・実行中のジョブを取得
+func (q *Queries) GetActiveScheduleByDedupeKey(ctx context.Context, dedupeKey pgtype.Text) (UpdateSchedule, error) {
+	row := q.db.QueryRow(ctx, getActiveScheduleByDedupeKey, dedupeKey)
+	var i UpdateSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.SourceID,
+		&i.ScheduledAt,
+		&i.PriorityLevel,
+		&i.Status,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.ErrorMessage,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.JobType,
+		&i.Payload,
+		&i.DedupeKey,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LeaseOwner,
+		&i.LeaseExpiresAt,
+	)
+	return i, err
+}
+
 const getDailyAPIQuotaUsage = `-- name: GetDailyAPIQuotaUsage :one
 SELECT
     COALESCE(SUM(quota_cost), 0) as total_quota_used,
@@ -194,6 +422,91 @@ func (q *Queries) GetDailyAPIQuotaUsage(ctx context.Context, arg GetDailyAPIQuot
 	return i, err
 }
 
+const getDailyAPIQuotaUsageByBucket = `-- name: GetDailyAPIQuotaUsageByBucket :many
+SELECT
+    bucket,
+    COALESCE(SUM(quota_cost), 0)::int AS total_quota_used
+FROM api_quota_usage
+WHERE date = $1
+    AND platform_id = $2
+GROUP BY bucket
+ORDER BY bucket
+`
+
+type GetDailyAPIQuotaUsageByBucketParams struct {
+	Date       pgtype.Date `json:"date"`
+	PlatformID string      `json:"platform_id"`
+}
+
+type GetDailyAPIQuotaUsageByBucketRow struct {
+	Bucket         string `json:"bucket"`
+	TotalQuotaUsed int32  `json:"total_quota_used"`
+}
+
+// 予算の枠ごとの日次API使用量を取得
+func (q *Queries) GetDailyAPIQuotaUsageByBucket(ctx context.Context, arg GetDailyAPIQuotaUsageByBucketParams) ([]GetDailyAPIQuotaUsageByBucketRow, error) {
+	rows, err := q.db.Query(ctx, getDailyAPIQuotaUsageByBucket, arg.Date, arg.PlatformID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDailyAPIQuotaUsageByBucketRow{}
+	for rows.Next() {
+		var i GetDailyAPIQuotaUsageByBucketRow
+		if err := rows.Scan(&i.Bucket, &i.TotalQuotaUsed); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDailyAPIQuotaUsageByKey = `-- name: GetDailyAPIQuotaUsageByKey :many
+SELECT
+    key_id,
+    COALESCE(SUM(quota_cost), 0)::int AS total_quota_used
+FROM api_quota_usage
+WHERE date = $1
+    AND platform_id = $2
+    AND key_id <> ''
+GROUP BY key_id
+ORDER BY key_id
+`
+
+type GetDailyAPIQuotaUsageByKeyParams struct {
+	Date       pgtype.Date `json:"date"`
+	PlatformID string      `json:"platform_id"`
+}
+
+type GetDailyAPIQuotaUsageByKeyRow struct {
+	KeyID          string `json:"key_id"`
+	TotalQuotaUsed int32  `json:"total_quota_used"`
+}
+
+// APIキーごとの日次API使用量を取得（キーが不明な行は除く）
+func (q *Queries) GetDailyAPIQuotaUsageByKey(ctx context.Context, arg GetDailyAPIQuotaUsageByKeyParams) ([]GetDailyAPIQuotaUsageByKeyRow, error) {
+	rows, err := q.db.Query(ctx, getDailyAPIQuotaUsageByKey, arg.Date, arg.PlatformID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDailyAPIQuotaUsageByKeyRow{}
+	for rows.Next() {
+		var i GetDailyAPIQuotaUsageByKeyRow
+		if err := rows.Scan(&i.KeyID, &i.TotalQuotaUsed); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getHighPrioritySources = `-- name: GetHighPrioritySources :many
 SELECT
     s.id,
@@ -251,9 +564,48 @@ func (q *Queries) GetHighPrioritySources(ctx context.Context, limit int32) ([]Ge
 	return items, nil
 }
 
+const getLatestScheduleBySource = `-- name: GetLatestScheduleBySource :one
+SELECT id, source_id, scheduled_at, priority_level, status, started_at, completed_at, error_message, created_at, updated_at, job_type, payload, dedupe_key, attempts, max_attempts, lease_owner, lease_expires_at FROM update_schedule
+WHERE source_id = $1
+    AND job_type = $2
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetLatestScheduleBySourceParams struct {
+	SourceID pgtype.UUID `json:"source_id"`
+	JobType  string      `json:"job_type"`
+}
+
+// ソースの最新のジョブを取得（取り込み状況の表示用）
+func (q *Queries) GetLatestScheduleBySource(ctx context.Context, arg GetLatestScheduleBySourceParams) (UpdateSchedule, error) {
+	row := q.db.QueryRow(ctx, getLatestScheduleBySource, arg.SourceID, arg.JobType)
+	var i UpdateSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.SourceID,
+		&i.ScheduledAt,
+		&i.PriorityLevel,
+		&i.Status,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.ErrorMessage,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.JobType,
+		&i.Payload,
+		&i.DedupeKey,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LeaseOwner,
+		&i.LeaseExpiresAt,
+	)
+	return i, err
+}
+
 const getPendingSchedules = `-- name: GetPendingSchedules :many
 SELECT
-    us.id, us.source_id, us.scheduled_at, us.priority_level, us.status, us.started_at, us.completed_at, us.error_message, us.created_at, us.updated_at,
+    us.id, us.source_id, us.scheduled_at, us.priority_level, us.status, us.started_at, us.completed_at, us.error_message, us.created_at, us.updated_at, us.job_type, us.payload, us.dedupe_key, us.attempts, us.max_attempts, us.lease_owner, us.lease_expires_at,
     s.external_id,
     s.platform_id
 FROM update_schedule us
@@ -265,18 +617,25 @@ LIMIT $1
 `
 
 type GetPendingSchedulesRow struct {
-	ID            pgtype.UUID        `json:"id"`
-	SourceID      pgtype.UUID        `json:"source_id"`
-	ScheduledAt   pgtype.Timestamptz `json:"scheduled_at"`
-	PriorityLevel string             `json:"priority_level"`
-	Status        string             `json:"status"`
-	StartedAt     pgtype.Timestamptz `json:"started_at"`
-	CompletedAt   pgtype.Timestamptz `json:"completed_at"`
-	ErrorMessage  pgtype.Text        `json:"error_message"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
-	ExternalID    string             `json:"external_id"`
-	PlatformID    string             `json:"platform_id"`
+	ID             pgtype.UUID        `json:"id"`
+	SourceID       pgtype.UUID        `json:"source_id"`
+	ScheduledAt    pgtype.Timestamptz `json:"scheduled_at"`
+	PriorityLevel  string             `json:"priority_level"`
+	Status         string             `json:"status"`
+	StartedAt      pgtype.Timestamptz `json:"started_at"`
+	CompletedAt    pgtype.Timestamptz `json:"completed_at"`
+	ErrorMessage   pgtype.Text        `json:"error_message"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	JobType        string             `json:"job_type"`
+	Payload        []byte             `json:"payload"`
+	DedupeKey      pgtype.Text        `json:"dedupe_key"`
+	Attempts       int32              `json:"attempts"`
+	MaxAttempts    int32              `json:"max_attempts"`
+	LeaseOwner     pgtype.Text        `json:"lease_owner"`
+	LeaseExpiresAt pgtype.Timestamptz `json:"lease_expires_at"`
+	ExternalID     string             `json:"external_id"`
+	PlatformID     string             `json:"platform_id"`
 }
 
 // 実行待ちのスケジュールを取得
@@ -300,6 +659,13 @@ func (q *Queries) GetPendingSchedules(ctx context.Context, limit int32) ([]GetPe
 			&i.ErrorMessage,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.JobType,
+			&i.Payload,
+			&i.DedupeKey,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.LeaseOwner,
+			&i.LeaseExpiresAt,
 			&i.ExternalID,
 			&i.PlatformID,
 		); err != nil {
@@ -373,20 +739,30 @@ SELECT
     s.display_name,
     s.uploads_playlist_id,
     s.last_fetched_at,
-    sp.priority_level,
-    sp.subscriber_count,
+    COALESCE(sp.priority_level, 'low')::text AS priority_level,
+    COALESCE(sp.subscriber_count, 0)::int AS subscriber_count,
     sp.popularity_ratio,
-    sp.update_interval_minutes
+    COALESCE(sp.update_interval_minutes, 360)::int AS update_interval_minutes,
+    sp.posting_histogram,
+    COALESCE(sp.posting_live_ratio, 0)::float8 AS posting_live_ratio,
+    COALESCE(sp.posting_sample_count, 0)::int AS posting_sample_count
 FROM sources s
-JOIN source_priority sp ON s.id = sp.source_id
+LEFT JOIN source_priority sp ON s.id = sp.source_id
 WHERE s.platform_id = $1
     AND s.fetch_status = 'ok'
+    AND EXISTS (
+        SELECT 1 FROM user_subscriptions us
+        WHERE us.source_id = s.id AND us.enabled = true
+    )
     AND (
         s.last_fetched_at IS NULL
-        OR s.last_fetched_at < now() - (sp.update_interval_minutes || ' minutes')::interval
+        OR COALESCE(
+            sp.next_fetch_at,
+            s.last_fetched_at + (COALESCE(sp.update_interval_minutes, 360) || ' minutes')::interval
+        ) <= now()
     )
 ORDER BY
-    sp.priority_level DESC,
+    CASE COALESCE(sp.priority_level, 'low') WHEN 'high' THEN 1 WHEN 'medium' THEN 2 ELSE 3 END,
     s.last_fetched_at ASC NULLS FIRST
 LIMIT $2
 `
@@ -407,9 +783,14 @@ type GetSourcesByPriorityRow struct {
 	SubscriberCount       int32              `json:"subscriber_count"`
 	PopularityRatio       pgtype.Numeric     `json:"popularity_ratio"`
 	UpdateIntervalMinutes int32              `json:"update_interval_minutes"`
+	PostingHistogram      []float64          `json:"posting_histogram"`
+	PostingLiveRatio      float64            `json:"posting_live_ratio"`
+	PostingSampleCount    int32              `json:"posting_sample_count"`
 }
 
-// 優先度別にソースを取得
+// 更新間隔を過ぎたソースを優先度の高い順に取得（スケジューラ用）
+// 優先度が未計算のソース（calculate_priority の実行前に購読されたソースなど）は low（6時間ごと）として扱い、有効な購読がないソースは取得しない
+// 投稿パターンから次に取得する時刻（next_fetch_at）を予測済みのソースは、更新間隔の代わりにその時刻を過ぎたら取得する
 func (q *Queries) GetSourcesByPriority(ctx context.Context, arg GetSourcesByPriorityParams) ([]GetSourcesByPriorityRow, error) {
 	rows, err := q.db.Query(ctx, getSourcesByPriority, arg.PlatformID, arg.Limit)
 	if err != nil {
@@ -430,6 +811,178 @@ func (q *Queries) GetSourcesByPriority(ctx context.Context, arg GetSourcesByPrio
 			&i.SubscriberCount,
 			&i.PopularityRatio,
 			&i.UpdateIntervalMinutes,
+			&i.PostingHistogram,
+			&i.PostingLiveRatio,
+			&i.PostingSampleCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventTimesBySource = `-- name: ListEventTimesBySource :many
+SELECT
+    type,
+    COALESCE(start_at, published_at)::timestamptz AS occurred_at
+FROM events
+WHERE source_id = $1
+    AND COALESCE(start_at, published_at) >= $2::timestamptz
+    AND COALESCE(start_at, published_at) <= now()
+ORDER BY occurred_at DESC
+`
+
+type ListEventTimesBySourceParams struct {
+	SourceID pgtype.UUID        `json:"source_id"`
+	Since    pgtype.Timestamptz `json:"since"`
+}
+
+type ListEventTimesBySourceRow struct {
+	Type       string             `json:"type"`
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+}
+
+// ソースの指定日時以降のイベントの配信開始・公開日時を取得（投稿パターンの学習用、予定は開始日時を過ぎたもののみ）
+func (q *Queries) ListEventTimesBySource(ctx context.Context, arg ListEventTimesBySourceParams) ([]ListEventTimesBySourceRow, error) {
+	rows, err := q.db.Query(ctx, listEventTimesBySource, arg.SourceID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEventTimesBySourceRow{}
+	for rows.Next() {
+		var i ListEventTimesBySourceRow
+		if err := rows.Scan(&i.Type, &i.OccurredAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFailedSchedules = `-- name: ListFailedSchedules :many
+SELECT
+    us.id, us.source_id, us.scheduled_at, us.priority_level, us.status, us.started_at, us.completed_at, us.error_message, us.created_at, us.updated_at, us.job_type, us.payload, us.dedupe_key, us.attempts, us.max_attempts, us.lease_owner, us.lease_expires_at,
+    s.external_id,
+    s.platform_id
+FROM update_schedule us
+JOIN sources s ON us.source_id = s.id
+WHERE us.status = 'failed'
+ORDER BY us.completed_at DESC
+LIMIT $1
+`
+
+type ListFailedSchedulesRow struct {
+	ID             pgtype.UUID        `json:"id"`
+	SourceID       pgtype.UUID        `json:"source_id"`
+	ScheduledAt    pgtype.Timestamptz `json:"scheduled_at"`
+	PriorityLevel  string             `json:"priority_level"`
+	Status         string             `json:"status"`
+	StartedAt      pgtype.Timestamptz `json:"started_at"`
+	CompletedAt    pgtype.Timestamptz `json:"completed_at"`
+	ErrorMessage   pgtype.Text        `json:"error_message"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	JobType        string             `json:"job_type"`
+	Payload        []byte             `json:"payload"`
+	DedupeKey      pgtype.Text        `json:"dedupe_key"`
+	Attempts       int32              `json:"attempts"`
+	MaxAttempts    int32              `json:"max_attempts"`
+	LeaseOwner     pgtype.Text        `json:"lease_owner"`
+	LeaseExpiresAt pgtype.Timestamptz `json:"lease_expires_at"`
+	ExternalID     string             `json:"external_id"`
+	PlatformID     string             `json:"platform_id"`
+}
+
+// 失敗（デッドレター）したジョブを新しい順に取得
+func (q *Queries) ListFailedSchedules(ctx context.Context, limit int32) ([]ListFailedSchedulesRow, error) {
+	rows, err := q.db.Query(ctx, listFailedSchedules, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListFailedSchedulesRow{}
+	for rows.Next() {
+		var i ListFailedSchedulesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SourceID,
+			&i.ScheduledAt,
+			&i.PriorityLevel,
+			&i.Status,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.ErrorMessage,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.JobType,
+			&i.Payload,
+			&i.DedupeKey,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.LeaseOwner,
+			&i.LeaseExpiresAt,
+			&i.ExternalID,
+			&i.PlatformID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSourcesForPatternLearning = `-- name: ListSourcesForPatternLearning :many
+SELECT
+    s.id,
+    s.platform_id,
+    s.external_id,
+    s.last_fetched_at,
+    COALESCE(sp.update_interval_minutes, 360)::int AS update_interval_minutes
+FROM sources s
+LEFT JOIN source_priority sp ON s.id = sp.source_id
+WHERE s.fetch_status = 'ok'
+    AND EXISTS (
+        SELECT 1 FROM user_subscriptions us
+        WHERE us.source_id = s.id AND us.enabled = true
+    )
+ORDER BY s.id
+`
+
+type ListSourcesForPatternLearningRow struct {
+	ID                    pgtype.UUID        `json:"id"`
+	PlatformID            string             `json:"platform_id"`
+	ExternalID            string             `json:"external_id"`
+	LastFetchedAt         pgtype.Timestamptz `json:"last_fetched_at"`
+	UpdateIntervalMinutes int32              `json:"update_interval_minutes"`
+}
+
+// 投稿パターンを学習するソース（有効な購読があるソース）を取得
+func (q *Queries) ListSourcesForPatternLearning(ctx context.Context) ([]ListSourcesForPatternLearningRow, error) {
+	rows, err := q.db.Query(ctx, listSourcesForPatternLearning)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSourcesForPatternLearningRow{}
+	for rows.Next() {
+		var i ListSourcesForPatternLearningRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlatformID,
+			&i.ExternalID,
+			&i.LastFetchedAt,
+			&i.UpdateIntervalMinutes,
 		); err != nil {
 			return nil, err
 		}
@@ -447,11 +1000,13 @@ INSERT INTO api_quota_usage (
     platform_id,
     endpoint,
     quota_cost,
+    bucket,
+    key_id,
     request_count,
     created_at
 )
-VALUES ($1, $2, $3, $4, 1, now())
-ON CONFLICT (date, platform_id, endpoint) DO UPDATE SET
+VALUES ($1, $2, $3, $4, $5, $6, 1, now())
+ON CONFLICT (date, platform_id, endpoint, bucket, key_id) DO UPDATE SET
     quota_cost = api_quota_usage.quota_cost + EXCLUDED.quota_cost,
     request_count = api_quota_usage.request_count + 1
 `
@@ -461,38 +1016,129 @@ type RecordAPIQuotaUsageParams struct {
 	PlatformID string      `json:"platform_id"`
 	Endpoint   string      `json:"endpoint"`
 	QuotaCost  int32       `json:"quota_cost"`
+	Bucket     string      `json:"bucket"`
+	KeyID      string      `json:"key_id"`
 }
 
-// API使用量を記録
+// API使用量を記録（予算の枠・APIキーごと）
 func (q *Queries) RecordAPIQuotaUsage(ctx context.Context, arg RecordAPIQuotaUsageParams) error {
 	_, err := q.db.Exec(ctx, recordAPIQuotaUsage,
 		arg.Date,
 		arg.PlatformID,
 		arg.Endpoint,
 		arg.QuotaCost,
+		arg.Bucket,
+		arg.KeyID,
 	)
 	return err
 }
 
-const updateScheduleStatus = `-- name: UpdateScheduleStatus :exec
+const updateScheduleStatus = `-- name: UpdateScheduleStatus :execrows
 UPDATE update_schedule
 SET
-    status = $2,
-    started_at = CASE WHEN $2 = 'running' THEN now() ELSE started_at END,
-    completed_at = CASE WHEN $2 IN ('completed', 'failed') THEN now() ELSE completed_at END,
-    error_message = $3,
+    status = $1::text,
+    started_at = CASE WHEN $1::text = 'running' THEN now() ELSE started_at END,
+    completed_at = CASE WHEN $1::text IN ('completed', 'failed') THEN now() ELSE completed_at END,
+    error_message = $2,
+    scheduled_at = COALESCE($3::timestamptz, scheduled_at),
+    lease_owner = NULL,
+    lease_expires_at = NULL,
     updated_at = now()
-WHERE id = $1
+WHERE
+    id = $4
+    AND ($5::text IS NULL OR lease_owner = $5::text)
 `
 
 type UpdateScheduleStatusParams struct {
-	ID           pgtype.UUID `json:"id"`
-	Status       string      `json:"status"`
-	ErrorMessage pgtype.Text `json:"error_message"`
+	Status       string             `json:"status"`
+	ErrorMessage pgtype.Text        `json:"error_message"`
+	RetryAt      pgtype.Timestamptz `json:"retry_at"`
+	ID           pgtype.UUID        `json:"id"`
+	LeaseOwner   pgtype.Text        `json:"lease_owner"`
 }
 
-// スケジュールステータスを更新
-func (q *Queries) UpdateScheduleStatus(ctx context.Context, arg UpdateScheduleStatusParams) error {
-	_, err := q.db.Exec(ctx, updateScheduleStatus, arg.ID, arg.Status, arg.ErrorMessage)
+// スケジュールステータスを更新し、リースを解放
+// lease_owner を指定した場合はリースを持っているワーカーのみ更新できる（他のワーカーに取得された場合は0行）
+// retry_at を指定した場合は次の実行時刻（実行待ちに戻してリトライする場合）
+func (q *Queries) UpdateScheduleStatus(ctx context.Context, arg UpdateScheduleStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateScheduleStatus,
+		arg.Status,
+		arg.ErrorMessage,
+		arg.RetryAt,
+		arg.ID,
+		arg.LeaseOwner,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateSourceNextFetch = `-- name: UpdateSourceNextFetch :exec
+UPDATE source_priority
+SET
+    next_expected_at = $2,
+    next_fetch_at = $3,
+    updated_at = now()
+WHERE source_id = $1
+`
+
+type UpdateSourceNextFetchParams struct {
+	SourceID       pgtype.UUID        `json:"source_id"`
+	NextExpectedAt pgtype.Timestamptz `json:"next_expected_at"`
+	NextFetchAt    pgtype.Timestamptz `json:"next_fetch_at"`
+}
+
+// 取得後に次に投稿されそうな時間帯と次に取得する時刻を更新
+func (q *Queries) UpdateSourceNextFetch(ctx context.Context, arg UpdateSourceNextFetchParams) error {
+	_, err := q.db.Exec(ctx, updateSourceNextFetch, arg.SourceID, arg.NextExpectedAt, arg.NextFetchAt)
+	return err
+}
+
+const upsertSourcePostingPattern = `-- name: UpsertSourcePostingPattern :exec
+INSERT INTO source_priority (
+    source_id,
+    posting_histogram,
+    posting_live_ratio,
+    posting_sample_count,
+    posting_confidence,
+    next_expected_at,
+    next_fetch_at,
+    pattern_learned_at,
+    updated_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, now(), now())
+ON CONFLICT (source_id) DO UPDATE SET
+    posting_histogram = EXCLUDED.posting_histogram,
+    posting_live_ratio = EXCLUDED.posting_live_ratio,
+    posting_sample_count = EXCLUDED.posting_sample_count,
+    posting_confidence = EXCLUDED.posting_confidence,
+    next_expected_at = EXCLUDED.next_expected_at,
+    next_fetch_at = EXCLUDED.next_fetch_at,
+    pattern_learned_at = now(),
+    updated_at = now()
+`
+
+type UpsertSourcePostingPatternParams struct {
+	SourceID           pgtype.UUID        `json:"source_id"`
+	PostingHistogram   []float64          `json:"posting_histogram"`
+	PostingLiveRatio   float64            `json:"posting_live_ratio"`
+	PostingSampleCount int32              `json:"posting_sample_count"`
+	PostingConfidence  float64            `json:"posting_confidence"`
+	NextExpectedAt     pgtype.Timestamptz `json:"next_expected_at"`
+	NextFetchAt        pgtype.Timestamptz `json:"next_fetch_at"`
+}
+
+// 学習した投稿パターンと予測をsource_priorityテーブルに保存（優先度の列は変更しない）
+func (q *Queries) UpsertSourcePostingPattern(ctx context.Context, arg UpsertSourcePostingPatternParams) error {
+	_, err := q.db.Exec(ctx, upsertSourcePostingPattern,
+		arg.SourceID,
+		arg.PostingHistogram,
+		arg.PostingLiveRatio,
+		arg.PostingSampleCount,
+		arg.PostingConfidence,
+		arg.NextExpectedAt,
+		arg.NextFetchAt,
+	)
 	return err
 }
@@ -276,6 +276,7 @@ SELECT
     s.created_at,
     s.updated_at,
     us.enabled,
+    us.is_favorite,
     us.priority,
     us.created_at as subscribed_at
 FROM user_subscriptions us
@@ -297,6 +298,7 @@ type ListUserSubscriptionsRow struct {
 	CreatedAt         pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
 	Enabled           bool               `json:"enabled"`
+	IsFavorite        bool               `json:"is_favorite"`
 	Priority          int32              `json:"priority"`
 	SubscribedAt      pgtype.Timestamptz `json:"subscribed_at"`
 }
@@ -326,6 +328,7 @@ func (q *Queries) ListUserSubscriptions(ctx context.Context, userID int64) ([]Li
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Enabled,
+			&i.IsFavorite,
 			&i.Priority,
 			&i.SubscribedAt,
 		); err != nil {
@@ -339,6 +342,31 @@ func (q *Queries) ListUserSubscriptions(ctx context.Context, userID int64) ([]Li
 	return items, nil
 }
 
+const reorderSubscriptions = `-- name: ReorderSubscriptions :exec
+UPDATE user_subscriptions AS us
+SET
+    priority = (cardinality($1::uuid[]) - o.ord + 1)::int,
+    updated_at = now()
+FROM unnest($1::uuid[]) WITH ORDINALITY AS o(source_id, ord)
+WHERE
+    us.user_id = $2
+    AND us.source_id = o.source_id
+`
+
+type ReorderSubscriptionsParams struct {
+	SourceIds []pgtype.UUID `json:"source_ids"`
+	UserID    int64         `json:"user_id"`
+}
+
+// ============================================================================
+// ReorderSubscriptions: 指定した順序（source_idsの並び）で優先度を振り直す
+// 先頭が最も高い優先度（件数）、末尾が1
+// ============================================================================
+func (q *Queries) ReorderSubscriptions(ctx context.Context, arg ReorderSubscriptionsParams) error {
+	_, err := q.db.Exec(ctx, reorderSubscriptions, arg.SourceIds, arg.UserID)
+	return err
+}
+
 const toggleSubscriptionFavorite = `-- name: ToggleSubscriptionFavorite :one
 UPDATE user_subscriptions
 SET is_favorite = $3, updated_at = now()
@@ -452,8 +480,6 @@ INSERT INTO user_subscriptions (
 )
 ON CONFLICT (user_id, source_id)
 DO UPDATE SET
-    enabled = EXCLUDED.enabled,
-    priority = EXCLUDED.priority,
     updated_at = now()
 RETURNING user_id, source_id, enabled, priority, created_at, updated_at, is_favorite, last_accessed_at
 `
@@ -469,6 +495,7 @@ type UpsertUserSubscriptionParams struct {
 // User subscriptions（ユーザーの購読情報）に関するクエリ
 // ============================================================================
 // UpsertUserSubscription: 購読情報のupsert
+// 既に購読している場合は有効・無効（一時停止）と優先度を変更せず、既存の購読を返す
 // ============================================================================
 func (q *Queries) UpsertUserSubscription(ctx context.Context, arg UpsertUserSubscriptionParams) (UserSubscription, error) {
 	row := q.db.QueryRow(ctx, upsertUserSubscription,
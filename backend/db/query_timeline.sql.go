@@ -26,15 +26,41 @@ func (q *Queries) CountEventsBySource(ctx context.Context, sourceID pgtype.UUID)
 	return count, err
 }
 
+const deleteEventsByIDs = `-- name: DeleteEventsByIDs :execrows
+WITH deleted AS (
+    DELETE FROM events
+    WHERE id = ANY($1::uuid[])
+    RETURNING id, source_id
+)
+INSERT INTO event_changes (event_id, source_id, change_type)
+SELECT id, source_id, 'removed' FROM deleted
+`
+
+// ============================================================================
+// DeleteEventsByIDs: 配信元で削除されたイベントを削除し、削除を変更履歴に記録
+// ============================================================================
+func (q *Queries) DeleteEventsByIDs(ctx context.Context, ids []pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEventsByIDs, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteOldEvents = `-- name: DeleteOldEvents :exec
-DELETE FROM events
-WHERE 
-    type = 'video'
-    AND published_at < now() - INTERVAL '90 days'
+WITH deleted AS (
+    DELETE FROM events
+    WHERE 
+        type = 'video'
+        AND published_at < now() - INTERVAL '90 days'
+    RETURNING id, source_id
+)
+INSERT INTO event_changes (event_id, source_id, change_type)
+SELECT id, source_id, 'removed' FROM deleted
 `
 
 // ============================================================================
-// DeleteOldEvents: 古いイベントを削除（アーカイブ）
+// DeleteOldEvents: 古いイベントを削除（アーカイブ）し、削除を変更履歴に記録
 // ============================================================================
 func (q *Queries) DeleteOldEvents(ctx context.Context) error {
 	_, err := q.db.Exec(ctx, deleteOldEvents)
@@ -42,7 +68,7 @@ func (q *Queries) DeleteOldEvents(ctx context.Context) error {
 }
 
 const getEventByExternalID = `-- name: GetEventByExternalID :one
-SELECT id, platform_id, source_id, external_event_id, type, title, description, start_at, end_at, published_at, url, image_url, metrics, created_at, updated_at, duration FROM events
+SELECT id, platform_id, source_id, external_event_id, type, title, description, start_at, end_at, published_at, url, image_url, metrics, created_at, updated_at, duration, search_text, enclosure_url, enclosure_type, enclosure_length FROM events
 WHERE platform_id = $1 AND external_event_id = $2
 `
 
@@ -74,12 +100,16 @@ func (q *Queries) GetEventByExternalID(ctx context.Context, arg GetEventByExtern
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Duration,
+		&i.SearchText,
+		&i.EnclosureUrl,
+		&i.EnclosureType,
+		&i.EnclosureLength,
 	)
 	return i, err
 }
 
 const getEventByID = `-- name: GetEventByID :one
-SELECT id, platform_id, source_id, external_event_id, type, title, description, start_at, end_at, published_at, url, image_url, metrics, created_at, updated_at, duration FROM events
+SELECT id, platform_id, source_id, external_event_id, type, title, description, start_at, end_at, published_at, url, image_url, metrics, created_at, updated_at, duration, search_text, enclosure_url, enclosure_type, enclosure_length FROM events
 WHERE id = $1
 `
 
@@ -106,6 +136,10 @@ func (q *Queries) GetEventByID(ctx context.Context, id pgtype.UUID) (Event, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Duration,
+		&i.SearchText,
+		&i.EnclosureUrl,
+		&i.EnclosureType,
+		&i.EnclosureLength,
 	)
 	return i, err
 }
@@ -210,6 +244,48 @@ func (q *Queries) ListEventsByType(ctx context.Context, arg ListEventsByTypePara
 	return items, nil
 }
 
+const listEventsWithoutSearchText = `-- name: ListEventsWithoutSearchText :many
+SELECT id, title, description
+FROM events
+WHERE search_text IS NULL AND id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListEventsWithoutSearchTextParams struct {
+	AfterID   pgtype.UUID `json:"after_id"`
+	BatchSize int32       `json:"batch_size"`
+}
+
+type ListEventsWithoutSearchTextRow struct {
+	ID          pgtype.UUID `json:"id"`
+	Title       string      `json:"title"`
+	Description pgtype.Text `json:"description"`
+}
+
+// ============================================================================
+// ListEventsWithoutSearchText: search_text 未設定のイベントをid順に取得（バックフィル用）
+// ============================================================================
+func (q *Queries) ListEventsWithoutSearchText(ctx context.Context, arg ListEventsWithoutSearchTextParams) ([]ListEventsWithoutSearchTextRow, error) {
+	rows, err := q.db.Query(ctx, listEventsWithoutSearchText, arg.AfterID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEventsWithoutSearchTextRow{}
+	for rows.Next() {
+		var i ListEventsWithoutSearchTextRow
+		if err := rows.Scan(&i.ID, &i.Title, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listLiveEvents = `-- name: ListLiveEvents :many
 SELECT 
     e.id,
@@ -225,28 +301,38 @@ SELECT
     e.url,
     e.image_url,
     e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
     e.created_at,
     e.updated_at,
     s.display_name as source_display_name,
     s.thumbnail_url as source_thumbnail_url,
-    s.handle as source_handle
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
 FROM events e
 JOIN sources s ON e.source_id = s.id
 JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
 WHERE 
     us.user_id = $1
     AND us.enabled = true
-    AND e.type = 'live'
+    AND e.type = ANY($2::text[])
     AND e.start_at IS NOT NULL
     AND e.start_at <= now()
     AND (e.end_at IS NULL OR e.end_at > now())
 ORDER BY e.start_at DESC
-LIMIT $2
+LIMIT $3
 `
 
 type ListLiveEventsParams struct {
-	UserID int64 `json:"user_id"`
-	Limit  int32 `json:"limit"`
+	UserID     int64    `json:"user_id"`
+	EventTypes []string `json:"event_types"`
+	Limit      int32    `json:"limit"`
 }
 
 type ListLiveEventsRow struct {
@@ -263,18 +349,27 @@ type ListLiveEventsRow struct {
 	Url                string             `json:"url"`
 	ImageUrl           pgtype.Text        `json:"image_url"`
 	Metrics            []byte             `json:"metrics"`
+	Duration           pgtype.Text        `json:"duration"`
+	EnclosureUrl       pgtype.Text        `json:"enclosure_url"`
+	EnclosureType      pgtype.Text        `json:"enclosure_type"`
+	EnclosureLength    pgtype.Int8        `json:"enclosure_length"`
 	CreatedAt          pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
 	SourceDisplayName  pgtype.Text        `json:"source_display_name"`
 	SourceThumbnailUrl pgtype.Text        `json:"source_thumbnail_url"`
 	SourceHandle       pgtype.Text        `json:"source_handle"`
+	SourceExternalID   string             `json:"source_external_id"`
+	WatchedAt          pgtype.Timestamptz `json:"watched_at"`
+	HiddenAt           pgtype.Timestamptz `json:"hidden_at"`
+	DismissedAt        pgtype.Timestamptz `json:"dismissed_at"`
 }
 
 // ============================================================================
-// ListLiveEvents: 配信中のイベントを取得
+// ListLiveEvents: 配信中・放送中のイベントを取得
+// event_types は timeline.OnAirTypes（ライブ配信・プレミア公開・ラジオ番組）
 // ============================================================================
 func (q *Queries) ListLiveEvents(ctx context.Context, arg ListLiveEventsParams) ([]ListLiveEventsRow, error) {
-	rows, err := q.db.Query(ctx, listLiveEvents, arg.UserID, arg.Limit)
+	rows, err := q.db.Query(ctx, listLiveEvents, arg.UserID, arg.EventTypes, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -296,11 +391,19 @@ func (q *Queries) ListLiveEvents(ctx context.Context, arg ListLiveEventsParams)
 			&i.Url,
 			&i.ImageUrl,
 			&i.Metrics,
+			&i.Duration,
+			&i.EnclosureUrl,
+			&i.EnclosureType,
+			&i.EnclosureLength,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SourceDisplayName,
 			&i.SourceThumbnailUrl,
 			&i.SourceHandle,
+			&i.SourceExternalID,
+			&i.WatchedAt,
+			&i.HiddenAt,
+			&i.DismissedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -328,37 +431,104 @@ SELECT
     e.image_url,
     e.metrics,
     e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
     e.created_at,
     e.updated_at,
     s.display_name as source_display_name,
     s.thumbnail_url as source_thumbnail_url,
     s.handle as source_handle,
-    s.external_id as source_external_id
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
 FROM events e
 JOIN sources s ON e.source_id = s.id
 JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
 WHERE 
     us.user_id = $1
     AND us.enabled = true
     AND (
         $2::timestamptz IS NULL
-        OR COALESCE(e.start_at, e.published_at) < $2
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < $2::timestamptz
     )
     AND (
-        $4::text[] IS NULL
-        OR s.external_id = ANY($4::text[])
+        $3::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) >= $3::timestamptz
+        OR e.end_at > $3::timestamptz
+    )
+    AND (
+        $4::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < $4::timestamptz
+    )
+    AND (
+        $5::text[] IS NULL
+        OR s.external_id = ANY($5::text[])
+    )
+    AND (
+        $6::text[] IS NULL
+        OR e.platform_id = ANY($6::text[])
+    )
+    AND (
+        $7::text[] IS NULL
+        OR e.type = ANY($7::text[])
+    )
+    AND (
+        $8::uuid[] IS NULL
+        OR s.id = ANY($8::uuid[])
+    )
+    AND (
+        $9::uuid[] IS NULL
+        OR EXISTS (
+            SELECT 1 FROM subscription_tag_assignments sta
+            WHERE
+                sta.user_id = us.user_id
+                AND sta.source_id = us.source_id
+                AND sta.tag_id = ANY($9::uuid[])
+        )
+    )
+    AND (
+        NOT $10::bool
+        OR us.is_favorite = true
+    )
+    AND (
+        NOT $11::bool
+        OR ues.watched_at IS NULL
+    )
+    AND (
+        NOT $12::bool
+        OR ues.hidden_at IS NULL
+    )
+    AND (
+        $13::timestamptz IS NULL
+        OR (COALESCE(e.start_at, e.published_at, e.created_at), (e.type = 'live'), e.id) < ($13::timestamptz, $14::bool, $15::uuid)
     )
 ORDER BY 
-    COALESCE(e.start_at, e.published_at) DESC NULLS LAST,
-    CASE WHEN e.type = 'live' THEN 0 ELSE 1 END ASC
-LIMIT $3
+    COALESCE(e.start_at, e.published_at, e.created_at) DESC,
+    (e.type = 'live') DESC,
+    e.id DESC
+LIMIT $16
 `
 
 type ListTimelineParams struct {
-	UserID     int64              `json:"user_id"`
-	Column2    pgtype.Timestamptz `json:"column_2"`
-	Limit      int32              `json:"limit"`
-	ChannelIds []string           `json:"channel_ids"`
+	UserID         int64              `json:"user_id"`
+	BeforeTime     pgtype.Timestamptz `json:"before_time"`
+	DayStart       pgtype.Timestamptz `json:"day_start"`
+	DayEnd         pgtype.Timestamptz `json:"day_end"`
+	ChannelIds     []string           `json:"channel_ids"`
+	PlatformIds    []string           `json:"platform_ids"`
+	EventTypes     []string           `json:"event_types"`
+	SourceIds      []pgtype.UUID      `json:"source_ids"`
+	TagIds         []pgtype.UUID      `json:"tag_ids"`
+	FavoritesOnly  bool               `json:"favorites_only"`
+	ExcludeWatched bool               `json:"exclude_watched"`
+	ExcludeHidden  bool               `json:"exclude_hidden"`
+	CursorTime     pgtype.Timestamptz `json:"cursor_time"`
+	CursorLive     pgtype.Bool        `json:"cursor_live"`
+	CursorID       pgtype.UUID        `json:"cursor_id"`
+	PageLimit      int32              `json:"page_limit"`
 }
 
 type ListTimelineRow struct {
@@ -376,23 +546,47 @@ type ListTimelineRow struct {
 	ImageUrl           pgtype.Text        `json:"image_url"`
 	Metrics            []byte             `json:"metrics"`
 	Duration           pgtype.Text        `json:"duration"`
+	EnclosureUrl       pgtype.Text        `json:"enclosure_url"`
+	EnclosureType      pgtype.Text        `json:"enclosure_type"`
+	EnclosureLength    pgtype.Int8        `json:"enclosure_length"`
 	CreatedAt          pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
 	SourceDisplayName  pgtype.Text        `json:"source_display_name"`
 	SourceThumbnailUrl pgtype.Text        `json:"source_thumbnail_url"`
 	SourceHandle       pgtype.Text        `json:"source_handle"`
 	SourceExternalID   string             `json:"source_external_id"`
+	WatchedAt          pgtype.Timestamptz `json:"watched_at"`
+	HiddenAt           pgtype.Timestamptz `json:"hidden_at"`
+	DismissedAt        pgtype.Timestamptz `json:"dismissed_at"`
 }
 
 // ============================================================================
-// ListTimeline: ユーザーのタイムラインを取得
+// ListTimeline: ユーザーのタイムラインを新着順に取得
+// 並び順は (COALESCE(start_at, published_at, created_at), type = 'live', id) の降順のキーセット
+// （同じ時刻なら配信中を先にする。idx_events_timeline_sort と同じ式でインデックスを使う）
+// before_time・day_start/day_end も同じ並び順の時刻で絞り込む
+// day_start/day_end 指定時はその範囲にかかる番組のみ（日付をまたぐ番組を含む）
+// channel_ids/platform_ids/event_types/source_ids/tag_ids はNULLの場合は絞り込まない
+// exclude_watched/exclude_hidden で視聴済み・非表示のイベントを除外
 // ============================================================================
 func (q *Queries) ListTimeline(ctx context.Context, arg ListTimelineParams) ([]ListTimelineRow, error) {
 	rows, err := q.db.Query(ctx, listTimeline,
 		arg.UserID,
-		arg.Column2,
-		arg.Limit,
+		arg.BeforeTime,
+		arg.DayStart,
+		arg.DayEnd,
 		arg.ChannelIds,
+		arg.PlatformIds,
+		arg.EventTypes,
+		arg.SourceIds,
+		arg.TagIds,
+		arg.FavoritesOnly,
+		arg.ExcludeWatched,
+		arg.ExcludeHidden,
+		arg.CursorTime,
+		arg.CursorLive,
+		arg.CursorID,
+		arg.PageLimit,
 	)
 	if err != nil {
 		return nil, err
@@ -416,12 +610,462 @@ func (q *Queries) ListTimeline(ctx context.Context, arg ListTimelineParams) ([]L
 			&i.ImageUrl,
 			&i.Metrics,
 			&i.Duration,
+			&i.EnclosureUrl,
+			&i.EnclosureType,
+			&i.EnclosureLength,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SourceDisplayName,
+			&i.SourceThumbnailUrl,
+			&i.SourceHandle,
+			&i.SourceExternalID,
+			&i.WatchedAt,
+			&i.HiddenAt,
+			&i.DismissedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTimelineAscending = `-- name: ListTimelineAscending :many
+SELECT 
+    e.id,
+    e.platform_id,
+    e.source_id,
+    e.external_event_id,
+    e.type,
+    e.title,
+    e.description,
+    e.start_at,
+    e.end_at,
+    e.published_at,
+    e.url,
+    e.image_url,
+    e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
+    e.created_at,
+    e.updated_at,
+    s.display_name as source_display_name,
+    s.thumbnail_url as source_thumbnail_url,
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
+FROM events e
+JOIN sources s ON e.source_id = s.id
+JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
+WHERE 
+    us.user_id = $1
+    AND us.enabled = true
+    AND (
+        $2::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < $2::timestamptz
+    )
+    AND (
+        $3::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) >= $3::timestamptz
+        OR e.end_at > $3::timestamptz
+    )
+    AND (
+        $4::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < $4::timestamptz
+    )
+    AND (
+        $5::text[] IS NULL
+        OR s.external_id = ANY($5::text[])
+    )
+    AND (
+        $6::text[] IS NULL
+        OR e.platform_id = ANY($6::text[])
+    )
+    AND (
+        $7::text[] IS NULL
+        OR e.type = ANY($7::text[])
+    )
+    AND (
+        $8::uuid[] IS NULL
+        OR s.id = ANY($8::uuid[])
+    )
+    AND (
+        $9::uuid[] IS NULL
+        OR EXISTS (
+            SELECT 1 FROM subscription_tag_assignments sta
+            WHERE
+                sta.user_id = us.user_id
+                AND sta.source_id = us.source_id
+                AND sta.tag_id = ANY($9::uuid[])
+        )
+    )
+    AND (
+        NOT $10::bool
+        OR us.is_favorite = true
+    )
+    AND (
+        NOT $11::bool
+        OR ues.watched_at IS NULL
+    )
+    AND (
+        NOT $12::bool
+        OR ues.hidden_at IS NULL
+    )
+    AND (
+        $13::timestamptz IS NULL
+        OR (COALESCE(e.start_at, e.published_at, e.created_at), (e.type = 'live'), e.id) > ($13::timestamptz, $14::bool, $15::uuid)
+    )
+ORDER BY 
+    COALESCE(e.start_at, e.published_at, e.created_at) ASC,
+    (e.type = 'live') ASC,
+    e.id ASC
+LIMIT $16
+`
+
+type ListTimelineAscendingParams struct {
+	UserID         int64              `json:"user_id"`
+	BeforeTime     pgtype.Timestamptz `json:"before_time"`
+	DayStart       pgtype.Timestamptz `json:"day_start"`
+	DayEnd         pgtype.Timestamptz `json:"day_end"`
+	ChannelIds     []string           `json:"channel_ids"`
+	PlatformIds    []string           `json:"platform_ids"`
+	EventTypes     []string           `json:"event_types"`
+	SourceIds      []pgtype.UUID      `json:"source_ids"`
+	TagIds         []pgtype.UUID      `json:"tag_ids"`
+	FavoritesOnly  bool               `json:"favorites_only"`
+	ExcludeWatched bool               `json:"exclude_watched"`
+	ExcludeHidden  bool               `json:"exclude_hidden"`
+	CursorTime     pgtype.Timestamptz `json:"cursor_time"`
+	CursorLive     pgtype.Bool        `json:"cursor_live"`
+	CursorID       pgtype.UUID        `json:"cursor_id"`
+	PageLimit      int32              `json:"page_limit"`
+}
+
+type ListTimelineAscendingRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	PlatformID         string             `json:"platform_id"`
+	SourceID           pgtype.UUID        `json:"source_id"`
+	ExternalEventID    string             `json:"external_event_id"`
+	Type               string             `json:"type"`
+	Title              string             `json:"title"`
+	Description        pgtype.Text        `json:"description"`
+	StartAt            pgtype.Timestamptz `json:"start_at"`
+	EndAt              pgtype.Timestamptz `json:"end_at"`
+	PublishedAt        pgtype.Timestamptz `json:"published_at"`
+	Url                string             `json:"url"`
+	ImageUrl           pgtype.Text        `json:"image_url"`
+	Metrics            []byte             `json:"metrics"`
+	Duration           pgtype.Text        `json:"duration"`
+	EnclosureUrl       pgtype.Text        `json:"enclosure_url"`
+	EnclosureType      pgtype.Text        `json:"enclosure_type"`
+	EnclosureLength    pgtype.Int8        `json:"enclosure_length"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	SourceDisplayName  pgtype.Text        `json:"source_display_name"`
+	SourceThumbnailUrl pgtype.Text        `json:"source_thumbnail_url"`
+	SourceHandle       pgtype.Text        `json:"source_handle"`
+	SourceExternalID   string             `json:"source_external_id"`
+	WatchedAt          pgtype.Timestamptz `json:"watched_at"`
+	HiddenAt           pgtype.Timestamptz `json:"hidden_at"`
+	DismissedAt        pgtype.Timestamptz `json:"dismissed_at"`
+}
+
+// ============================================================================
+// ListTimelineAscending: ユーザーのタイムラインを開始時刻順に取得（番組表・新着方向のページ）
+// ListTimeline と同じ条件で、並び順はそのちょうど逆順（昇順のキーセット）
+// ============================================================================
+func (q *Queries) ListTimelineAscending(ctx context.Context, arg ListTimelineAscendingParams) ([]ListTimelineAscendingRow, error) {
+	rows, err := q.db.Query(ctx, listTimelineAscending,
+		arg.UserID,
+		arg.BeforeTime,
+		arg.DayStart,
+		arg.DayEnd,
+		arg.ChannelIds,
+		arg.PlatformIds,
+		arg.EventTypes,
+		arg.SourceIds,
+		arg.TagIds,
+		arg.FavoritesOnly,
+		arg.ExcludeWatched,
+		arg.ExcludeHidden,
+		arg.CursorTime,
+		arg.CursorLive,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTimelineAscendingRow{}
+	for rows.Next() {
+		var i ListTimelineAscendingRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlatformID,
+			&i.SourceID,
+			&i.ExternalEventID,
+			&i.Type,
+			&i.Title,
+			&i.Description,
+			&i.StartAt,
+			&i.EndAt,
+			&i.PublishedAt,
+			&i.Url,
+			&i.ImageUrl,
+			&i.Metrics,
+			&i.Duration,
+			&i.EnclosureUrl,
+			&i.EnclosureType,
+			&i.EnclosureLength,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SourceDisplayName,
+			&i.SourceThumbnailUrl,
+			&i.SourceHandle,
+			&i.SourceExternalID,
+			&i.WatchedAt,
+			&i.HiddenAt,
+			&i.DismissedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTimelineByPriority = `-- name: ListTimelineByPriority :many
+SELECT 
+    e.id,
+    e.platform_id,
+    e.source_id,
+    e.external_event_id,
+    e.type,
+    e.title,
+    e.description,
+    e.start_at,
+    e.end_at,
+    e.published_at,
+    e.url,
+    e.image_url,
+    e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
+    e.created_at,
+    e.updated_at,
+    s.display_name as source_display_name,
+    s.thumbnail_url as source_thumbnail_url,
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
+FROM events e
+JOIN sources s ON e.source_id = s.id
+JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
+WHERE 
+    us.user_id = $1
+    AND us.enabled = true
+    AND (
+        $2::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < $2::timestamptz
+    )
+    AND (
+        $3::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) >= $3::timestamptz
+        OR e.end_at > $3::timestamptz
+    )
+    AND (
+        $4::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < $4::timestamptz
+    )
+    AND (
+        $5::text[] IS NULL
+        OR s.external_id = ANY($5::text[])
+    )
+    AND (
+        $6::text[] IS NULL
+        OR e.platform_id = ANY($6::text[])
+    )
+    AND (
+        $7::text[] IS NULL
+        OR e.type = ANY($7::text[])
+    )
+    AND (
+        $8::uuid[] IS NULL
+        OR s.id = ANY($8::uuid[])
+    )
+    AND (
+        $9::uuid[] IS NULL
+        OR EXISTS (
+            SELECT 1 FROM subscription_tag_assignments sta
+            WHERE
+                sta.user_id = us.user_id
+                AND sta.source_id = us.source_id
+                AND sta.tag_id = ANY($9::uuid[])
+        )
+    )
+    AND (
+        NOT $10::bool
+        OR us.is_favorite = true
+    )
+    AND (
+        NOT $11::bool
+        OR ues.watched_at IS NULL
+    )
+    AND (
+        NOT $12::bool
+        OR ues.hidden_at IS NULL
+    )
+    AND (
+        $13::timestamptz IS NULL
+        OR (
+            $14::bool
+            AND (us.priority, COALESCE(e.start_at, e.published_at, e.created_at), (e.type = 'live'), e.id) > ($15::int, $13::timestamptz, $16::bool, $17::uuid)
+        )
+        OR (
+            NOT $14::bool
+            AND (us.priority, COALESCE(e.start_at, e.published_at, e.created_at), (e.type = 'live'), e.id) < ($15::int, $13::timestamptz, $16::bool, $17::uuid)
+        )
+    )
+ORDER BY 
+    CASE WHEN $14::bool THEN us.priority END ASC,
+    CASE WHEN $14::bool THEN COALESCE(e.start_at, e.published_at, e.created_at) END ASC,
+    CASE WHEN $14::bool THEN (e.type = 'live') END ASC,
+    CASE WHEN $14::bool THEN e.id END ASC,
+    CASE WHEN NOT $14::bool THEN us.priority END DESC,
+    CASE WHEN NOT $14::bool THEN COALESCE(e.start_at, e.published_at, e.created_at) END DESC,
+    CASE WHEN NOT $14::bool THEN (e.type = 'live') END DESC,
+    CASE WHEN NOT $14::bool THEN e.id END DESC
+LIMIT $18
+`
+
+type ListTimelineByPriorityParams struct {
+	UserID         int64              `json:"user_id"`
+	BeforeTime     pgtype.Timestamptz `json:"before_time"`
+	DayStart       pgtype.Timestamptz `json:"day_start"`
+	DayEnd         pgtype.Timestamptz `json:"day_end"`
+	ChannelIds     []string           `json:"channel_ids"`
+	PlatformIds    []string           `json:"platform_ids"`
+	EventTypes     []string           `json:"event_types"`
+	SourceIds      []pgtype.UUID      `json:"source_ids"`
+	TagIds         []pgtype.UUID      `json:"tag_ids"`
+	FavoritesOnly  bool               `json:"favorites_only"`
+	ExcludeWatched bool               `json:"exclude_watched"`
+	ExcludeHidden  bool               `json:"exclude_hidden"`
+	CursorTime     pgtype.Timestamptz `json:"cursor_time"`
+	Ascending      bool               `json:"ascending"`
+	CursorPriority pgtype.Int4        `json:"cursor_priority"`
+	CursorLive     pgtype.Bool        `json:"cursor_live"`
+	CursorID       pgtype.UUID        `json:"cursor_id"`
+	PageLimit      int32              `json:"page_limit"`
+}
+
+type ListTimelineByPriorityRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	PlatformID         string             `json:"platform_id"`
+	SourceID           pgtype.UUID        `json:"source_id"`
+	ExternalEventID    string             `json:"external_event_id"`
+	Type               string             `json:"type"`
+	Title              string             `json:"title"`
+	Description        pgtype.Text        `json:"description"`
+	StartAt            pgtype.Timestamptz `json:"start_at"`
+	EndAt              pgtype.Timestamptz `json:"end_at"`
+	PublishedAt        pgtype.Timestamptz `json:"published_at"`
+	Url                string             `json:"url"`
+	ImageUrl           pgtype.Text        `json:"image_url"`
+	Metrics            []byte             `json:"metrics"`
+	Duration           pgtype.Text        `json:"duration"`
+	EnclosureUrl       pgtype.Text        `json:"enclosure_url"`
+	EnclosureType      pgtype.Text        `json:"enclosure_type"`
+	EnclosureLength    pgtype.Int8        `json:"enclosure_length"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	SourceDisplayName  pgtype.Text        `json:"source_display_name"`
+	SourceThumbnailUrl pgtype.Text        `json:"source_thumbnail_url"`
+	SourceHandle       pgtype.Text        `json:"source_handle"`
+	SourceExternalID   string             `json:"source_external_id"`
+	WatchedAt          pgtype.Timestamptz `json:"watched_at"`
+	HiddenAt           pgtype.Timestamptz `json:"hidden_at"`
+	DismissedAt        pgtype.Timestamptz `json:"dismissed_at"`
+}
+
+// ============================================================================
+// ListTimelineByPriority: ユーザーのタイムラインを購読の優先度順に取得
+// 並び順は (us.priority, ListTimeline の並び順) のキーセット、cursor_priority を併用
+// ascending=false: 優先度の高い購読から新着順, ascending=true: その逆順（手前のページ）
+// 条件は ListTimeline と同じ
+// ============================================================================
+func (q *Queries) ListTimelineByPriority(ctx context.Context, arg ListTimelineByPriorityParams) ([]ListTimelineByPriorityRow, error) {
+	rows, err := q.db.Query(ctx, listTimelineByPriority,
+		arg.UserID,
+		arg.BeforeTime,
+		arg.DayStart,
+		arg.DayEnd,
+		arg.ChannelIds,
+		arg.PlatformIds,
+		arg.EventTypes,
+		arg.SourceIds,
+		arg.TagIds,
+		arg.FavoritesOnly,
+		arg.ExcludeWatched,
+		arg.ExcludeHidden,
+		arg.CursorTime,
+		arg.Ascending,
+		arg.CursorPriority,
+		arg.CursorLive,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTimelineByPriorityRow{}
+	for rows.Next() {
+		var i ListTimelineByPriorityRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlatformID,
+			&i.SourceID,
+			&i.ExternalEventID,
+			&i.Type,
+			&i.Title,
+			&i.Description,
+			&i.StartAt,
+			&i.EndAt,
+			&i.PublishedAt,
+			&i.Url,
+			&i.ImageUrl,
+			&i.Metrics,
+			&i.Duration,
+			&i.EnclosureUrl,
+			&i.EnclosureType,
+			&i.EnclosureLength,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SourceDisplayName,
 			&i.SourceThumbnailUrl,
 			&i.SourceHandle,
 			&i.SourceExternalID,
+			&i.WatchedAt,
+			&i.HiddenAt,
+			&i.DismissedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -434,9 +1078,9 @@ func (q *Queries) ListTimeline(ctx context.Context, arg ListTimelineParams) ([]L
 }
 
 const listTimelineBySource = `-- name: ListTimelineBySource :many
-SELECT id, platform_id, source_id, external_event_id, type, title, description, start_at, end_at, published_at, url, image_url, metrics, created_at, updated_at, duration FROM events
+SELECT id, platform_id, source_id, external_event_id, type, title, description, start_at, end_at, published_at, url, image_url, metrics, created_at, updated_at, duration, search_text, enclosure_url, enclosure_type, enclosure_length FROM events
 WHERE source_id = $1
-ORDER BY COALESCE(start_at, published_at) DESC NULLS LAST
+ORDER BY COALESCE(start_at, published_at, created_at) DESC, (type = 'live') DESC, id DESC
 LIMIT $2
 `
 
@@ -474,6 +1118,10 @@ func (q *Queries) ListTimelineBySource(ctx context.Context, arg ListTimelineBySo
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Duration,
+			&i.SearchText,
+			&i.EnclosureUrl,
+			&i.EnclosureType,
+			&i.EnclosureLength,
 		); err != nil {
 			return nil, err
 		}
@@ -500,27 +1148,37 @@ SELECT
     e.url,
     e.image_url,
     e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
     e.created_at,
     e.updated_at,
     s.display_name as source_display_name,
     s.thumbnail_url as source_thumbnail_url,
-    s.handle as source_handle
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
 FROM events e
 JOIN sources s ON e.source_id = s.id
 JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
 WHERE 
     us.user_id = $1
     AND us.enabled = true
-    AND e.type IN ('scheduled', 'premiere')
+    AND e.type = ANY($2::text[])
     AND e.start_at IS NOT NULL
     AND e.start_at > now()
 ORDER BY e.start_at ASC
-LIMIT $2
+LIMIT $3
 `
 
 type ListUpcomingEventsParams struct {
-	UserID int64 `json:"user_id"`
-	Limit  int32 `json:"limit"`
+	UserID     int64    `json:"user_id"`
+	EventTypes []string `json:"event_types"`
+	Limit      int32    `json:"limit"`
 }
 
 type ListUpcomingEventsRow struct {
@@ -537,18 +1195,27 @@ type ListUpcomingEventsRow struct {
 	Url                string             `json:"url"`
 	ImageUrl           pgtype.Text        `json:"image_url"`
 	Metrics            []byte             `json:"metrics"`
+	Duration           pgtype.Text        `json:"duration"`
+	EnclosureUrl       pgtype.Text        `json:"enclosure_url"`
+	EnclosureType      pgtype.Text        `json:"enclosure_type"`
+	EnclosureLength    pgtype.Int8        `json:"enclosure_length"`
 	CreatedAt          pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
 	SourceDisplayName  pgtype.Text        `json:"source_display_name"`
 	SourceThumbnailUrl pgtype.Text        `json:"source_thumbnail_url"`
 	SourceHandle       pgtype.Text        `json:"source_handle"`
+	SourceExternalID   string             `json:"source_external_id"`
+	WatchedAt          pgtype.Timestamptz `json:"watched_at"`
+	HiddenAt           pgtype.Timestamptz `json:"hidden_at"`
+	DismissedAt        pgtype.Timestamptz `json:"dismissed_at"`
 }
 
 // ============================================================================
 // ListUpcomingEvents: 今後予定されているイベントを取得
+// event_types は timeline.UpcomingTypes（配信予定・プレミア公開・ラジオ番組など）
 // ============================================================================
 func (q *Queries) ListUpcomingEvents(ctx context.Context, arg ListUpcomingEventsParams) ([]ListUpcomingEventsRow, error) {
-	rows, err := q.db.Query(ctx, listUpcomingEvents, arg.UserID, arg.Limit)
+	rows, err := q.db.Query(ctx, listUpcomingEvents, arg.UserID, arg.EventTypes, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -570,11 +1237,19 @@ func (q *Queries) ListUpcomingEvents(ctx context.Context, arg ListUpcomingEvents
 			&i.Url,
 			&i.ImageUrl,
 			&i.Metrics,
+			&i.Duration,
+			&i.EnclosureUrl,
+			&i.EnclosureType,
+			&i.EnclosureLength,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SourceDisplayName,
 			&i.SourceThumbnailUrl,
 			&i.SourceHandle,
+			&i.SourceExternalID,
+			&i.WatchedAt,
+			&i.HiddenAt,
+			&i.DismissedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -586,6 +1261,197 @@ func (q *Queries) ListUpcomingEvents(ctx context.Context, arg ListUpcomingEvents
 	return items, nil
 }
 
+const searchTimeline = `-- name: SearchTimeline :many
+SELECT 
+    e.id,
+    e.platform_id,
+    e.source_id,
+    e.external_event_id,
+    e.type,
+    e.title,
+    e.description,
+    e.start_at,
+    e.end_at,
+    e.published_at,
+    e.url,
+    e.image_url,
+    e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
+    e.created_at,
+    e.updated_at,
+    s.display_name as source_display_name,
+    s.thumbnail_url as source_thumbnail_url,
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
+FROM events e
+JOIN sources s ON e.source_id = s.id
+JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
+WHERE 
+    us.user_id = $1
+    AND us.enabled = true
+    AND e.search_text IS NOT NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM unnest($2::text[]) AS term
+        WHERE strpos(e.search_text, term) = 0
+    )
+    AND (
+        $3::text[] IS NULL
+        OR e.platform_id = ANY($3::text[])
+    )
+    AND (
+        $4::text[] IS NULL
+        OR e.type = ANY($4::text[])
+    )
+    AND (
+        $5::uuid[] IS NULL
+        OR s.id = ANY($5::uuid[])
+    )
+    AND (
+        NOT $6::bool
+        OR us.is_favorite = true
+    )
+    AND (
+        $7::timestamptz IS NULL
+        OR (COALESCE(e.start_at, e.published_at, e.created_at), (e.type = 'live'), e.id) < ($7::timestamptz, $8::bool, $9::uuid)
+    )
+ORDER BY 
+    COALESCE(e.start_at, e.published_at, e.created_at) DESC,
+    (e.type = 'live') DESC,
+    e.id DESC
+LIMIT $10
+`
+
+type SearchTimelineParams struct {
+	UserID        int64              `json:"user_id"`
+	Terms         []string           `json:"terms"`
+	PlatformIds   []string           `json:"platform_ids"`
+	EventTypes    []string           `json:"event_types"`
+	SourceIds     []pgtype.UUID      `json:"source_ids"`
+	FavoritesOnly bool               `json:"favorites_only"`
+	CursorTime    pgtype.Timestamptz `json:"cursor_time"`
+	CursorLive    pgtype.Bool        `json:"cursor_live"`
+	CursorID      pgtype.UUID        `json:"cursor_id"`
+	PageLimit     int32              `json:"page_limit"`
+}
+
+type SearchTimelineRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	PlatformID         string             `json:"platform_id"`
+	SourceID           pgtype.UUID        `json:"source_id"`
+	ExternalEventID    string             `json:"external_event_id"`
+	Type               string             `json:"type"`
+	Title              string             `json:"title"`
+	Description        pgtype.Text        `json:"description"`
+	StartAt            pgtype.Timestamptz `json:"start_at"`
+	EndAt              pgtype.Timestamptz `json:"end_at"`
+	PublishedAt        pgtype.Timestamptz `json:"published_at"`
+	Url                string             `json:"url"`
+	ImageUrl           pgtype.Text        `json:"image_url"`
+	Metrics            []byte             `json:"metrics"`
+	Duration           pgtype.Text        `json:"duration"`
+	EnclosureUrl       pgtype.Text        `json:"enclosure_url"`
+	EnclosureType      pgtype.Text        `json:"enclosure_type"`
+	EnclosureLength    pgtype.Int8        `json:"enclosure_length"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	SourceDisplayName  pgtype.Text        `json:"source_display_name"`
+	SourceThumbnailUrl pgtype.Text        `json:"source_thumbnail_url"`
+	SourceHandle       pgtype.Text        `json:"source_handle"`
+	SourceExternalID   string             `json:"source_external_id"`
+	WatchedAt          pgtype.Timestamptz `json:"watched_at"`
+	HiddenAt           pgtype.Timestamptz `json:"hidden_at"`
+	DismissedAt        pgtype.Timestamptz `json:"dismissed_at"`
+}
+
+// ============================================================================
+// SearchTimeline: 購読ソースのイベントをタイトル・説明文で検索
+// terms は正規化済みの検索語（すべてを含むイベントを新着順で返す）
+// search_text は取り込み時と backfill_search_text バッチで search.Normalize により設定する
+// ============================================================================
+func (q *Queries) SearchTimeline(ctx context.Context, arg SearchTimelineParams) ([]SearchTimelineRow, error) {
+	rows, err := q.db.Query(ctx, searchTimeline,
+		arg.UserID,
+		arg.Terms,
+		arg.PlatformIds,
+		arg.EventTypes,
+		arg.SourceIds,
+		arg.FavoritesOnly,
+		arg.CursorTime,
+		arg.CursorLive,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchTimelineRow{}
+	for rows.Next() {
+		var i SearchTimelineRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlatformID,
+			&i.SourceID,
+			&i.ExternalEventID,
+			&i.Type,
+			&i.Title,
+			&i.Description,
+			&i.StartAt,
+			&i.EndAt,
+			&i.PublishedAt,
+			&i.Url,
+			&i.ImageUrl,
+			&i.Metrics,
+			&i.Duration,
+			&i.EnclosureUrl,
+			&i.EnclosureType,
+			&i.EnclosureLength,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SourceDisplayName,
+			&i.SourceThumbnailUrl,
+			&i.SourceHandle,
+			&i.SourceExternalID,
+			&i.WatchedAt,
+			&i.HiddenAt,
+			&i.DismissedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateEventSearchText = `-- name: UpdateEventSearchText :exec
+UPDATE events
+SET search_text = $1
+WHERE id = $2
+`
+
+type UpdateEventSearchTextParams struct {
+	SearchText pgtype.Text `json:"search_text"`
+	ID         pgtype.UUID `json:"id"`
+}
+
+// ============================================================================
+// UpdateEventSearchText: イベントの検索用テキストを設定（updated_at は変更しない）
+// ============================================================================
+func (q *Queries) UpdateEventSearchText(ctx context.Context, arg UpdateEventSearchTextParams) error {
+	_, err := q.db.Exec(ctx, updateEventSearchText, arg.SearchText, arg.ID)
+	return err
+}
+
 const upsertEvent = `-- name: UpsertEvent :one
 
 INSERT INTO events (
@@ -602,9 +1468,13 @@ INSERT INTO events (
     image_url,
     metrics,
     duration,
+    search_text,
+    enclosure_url,
+    enclosure_type,
+    enclosure_length,
     updated_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, now()
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, now()
 )
 ON CONFLICT (platform_id, external_event_id)
 DO UPDATE SET
@@ -618,8 +1488,12 @@ DO UPDATE SET
     image_url = EXCLUDED.image_url,
     metrics = EXCLUDED.metrics,
     duration = EXCLUDED.duration,
+    search_text = EXCLUDED.search_text,
+    enclosure_url = EXCLUDED.enclosure_url,
+    enclosure_type = EXCLUDED.enclosure_type,
+    enclosure_length = EXCLUDED.enclosure_length,
     updated_at = now()
-RETURNING id, platform_id, source_id, external_event_id, type, title, description, start_at, end_at, published_at, url, image_url, metrics, created_at, updated_at, duration
+RETURNING id, platform_id, source_id, external_event_id, type, title, description, start_at, end_at, published_at, url, image_url, metrics, created_at, updated_at, duration, search_text, enclosure_url, enclosure_type, enclosure_length
 `
 
 type UpsertEventParams struct {
@@ -636,6 +1510,10 @@ type UpsertEventParams struct {
 	ImageUrl        pgtype.Text        `json:"image_url"`
 	Metrics         []byte             `json:"metrics"`
 	Duration        pgtype.Text        `json:"duration"`
+	SearchText      pgtype.Text        `json:"search_text"`
+	EnclosureUrl    pgtype.Text        `json:"enclosure_url"`
+	EnclosureType   pgtype.Text        `json:"enclosure_type"`
+	EnclosureLength pgtype.Int8        `json:"enclosure_length"`
 }
 
 // query_timeline.sql
@@ -658,6 +1536,10 @@ func (q *Queries) UpsertEvent(ctx context.Context, arg UpsertEventParams) (Event
 		arg.ImageUrl,
 		arg.Metrics,
 		arg.Duration,
+		arg.SearchText,
+		arg.EnclosureUrl,
+		arg.EnclosureType,
+		arg.EnclosureLength,
 	)
 	var i Event
 	err := row.Scan(
@@ -677,6 +1559,10 @@ func (q *Queries) UpsertEvent(ctx context.Context, arg UpsertEventParams) (Event
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Duration,
+		&i.SearchText,
+		&i.EnclosureUrl,
+		&i.EnclosureType,
+		&i.EnclosureLength,
 	)
 	return i, err
 }
@@ -21,7 +21,7 @@ func (q *Queries) DeleteInactiveAnonymousUsers(ctx context.Context) error {
 }
 
 const getPlanLimit = `-- name: GetPlanLimit :one
-SELECT plan_type, max_channels, display_name, price_monthly, has_favorites, has_device_sync, description, created_at FROM plan_limits WHERE plan_type = $1
+SELECT plan_type, max_channels, display_name, price_monthly, has_favorites, has_device_sync, description, created_at, max_watch_later FROM plan_limits WHERE plan_type = $1
 `
 
 func (q *Queries) GetPlanLimit(ctx context.Context, planType string) (PlanLimit, error) {
@@ -36,6 +36,7 @@ func (q *Queries) GetPlanLimit(ctx context.Context, planType string) (PlanLimit,
 		&i.HasDeviceSync,
 		&i.Description,
 		&i.CreatedAt,
+		&i.MaxWatchLater,
 	)
 	return i, err
 }
@@ -151,7 +152,7 @@ func (q *Queries) GetUserWithPlanInfo(ctx context.Context, id int64) (GetUserWit
 }
 
 const listAllPlanLimits = `-- name: ListAllPlanLimits :many
-SELECT plan_type, max_channels, display_name, price_monthly, has_favorites, has_device_sync, description, created_at FROM plan_limits ORDER BY price_monthly NULLS FIRST
+SELECT plan_type, max_channels, display_name, price_monthly, has_favorites, has_device_sync, description, created_at, max_watch_later FROM plan_limits ORDER BY price_monthly NULLS FIRST
 `
 
 func (q *Queries) ListAllPlanLimits(ctx context.Context) ([]PlanLimit, error) {
@@ -172,6 +173,7 @@ func (q *Queries) ListAllPlanLimits(ctx context.Context) ([]PlanLimit, error) {
 			&i.HasDeviceSync,
 			&i.Description,
 			&i.CreatedAt,
+			&i.MaxWatchLater,
 		); err != nil {
 			return nil, err
 		}
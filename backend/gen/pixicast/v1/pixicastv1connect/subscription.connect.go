@@ -0,0 +1,576 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/pixicast/v1/subscription.proto
+
+package pixicastv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SubscriptionServiceName is the fully-qualified name of the SubscriptionService service.
+	SubscriptionServiceName = "pixicast.v1.SubscriptionService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SubscriptionServiceCreateSubscriptionProcedure is the fully-qualified name of the
+	// SubscriptionService's CreateSubscription RPC.
+	SubscriptionServiceCreateSubscriptionProcedure = "/pixicast.v1.SubscriptionService/CreateSubscription"
+	// SubscriptionServiceListSubscriptionsProcedure is the fully-qualified name of the
+	// SubscriptionService's ListSubscriptions RPC.
+	SubscriptionServiceListSubscriptionsProcedure = "/pixicast.v1.SubscriptionService/ListSubscriptions"
+	// SubscriptionServiceDeleteSubscriptionProcedure is the fully-qualified name of the
+	// SubscriptionService's DeleteSubscription RPC.
+	SubscriptionServiceDeleteSubscriptionProcedure = "/pixicast.v1.SubscriptionService/DeleteSubscription"
+	// SubscriptionServiceToggleFavoriteProcedure is the fully-qualified name of the
+	// SubscriptionService's ToggleFavorite RPC.
+	SubscriptionServiceToggleFavoriteProcedure = "/pixicast.v1.SubscriptionService/ToggleFavorite"
+	// SubscriptionServiceSetEnabledProcedure is the fully-qualified name of the SubscriptionService's
+	// SetEnabled RPC.
+	SubscriptionServiceSetEnabledProcedure = "/pixicast.v1.SubscriptionService/SetEnabled"
+	// SubscriptionServiceSetPriorityProcedure is the fully-qualified name of the SubscriptionService's
+	// SetPriority RPC.
+	SubscriptionServiceSetPriorityProcedure = "/pixicast.v1.SubscriptionService/SetPriority"
+	// SubscriptionServiceReorderSubscriptionsProcedure is the fully-qualified name of the
+	// SubscriptionService's ReorderSubscriptions RPC.
+	SubscriptionServiceReorderSubscriptionsProcedure = "/pixicast.v1.SubscriptionService/ReorderSubscriptions"
+	// SubscriptionServiceListTagsProcedure is the fully-qualified name of the SubscriptionService's
+	// ListTags RPC.
+	SubscriptionServiceListTagsProcedure = "/pixicast.v1.SubscriptionService/ListTags"
+	// SubscriptionServiceCreateTagProcedure is the fully-qualified name of the SubscriptionService's
+	// CreateTag RPC.
+	SubscriptionServiceCreateTagProcedure = "/pixicast.v1.SubscriptionService/CreateTag"
+	// SubscriptionServiceUpdateTagProcedure is the fully-qualified name of the SubscriptionService's
+	// UpdateTag RPC.
+	SubscriptionServiceUpdateTagProcedure = "/pixicast.v1.SubscriptionService/UpdateTag"
+	// SubscriptionServiceDeleteTagProcedure is the fully-qualified name of the SubscriptionService's
+	// DeleteTag RPC.
+	SubscriptionServiceDeleteTagProcedure = "/pixicast.v1.SubscriptionService/DeleteTag"
+	// SubscriptionServiceSetSubscriptionTagsProcedure is the fully-qualified name of the
+	// SubscriptionService's SetSubscriptionTags RPC.
+	SubscriptionServiceSetSubscriptionTagsProcedure = "/pixicast.v1.SubscriptionService/SetSubscriptionTags"
+	// SubscriptionServiceImportSubscriptionsProcedure is the fully-qualified name of the
+	// SubscriptionService's ImportSubscriptions RPC.
+	SubscriptionServiceImportSubscriptionsProcedure = "/pixicast.v1.SubscriptionService/ImportSubscriptions"
+	// SubscriptionServiceExportSubscriptionsProcedure is the fully-qualified name of the
+	// SubscriptionService's ExportSubscriptions RPC.
+	SubscriptionServiceExportSubscriptionsProcedure = "/pixicast.v1.SubscriptionService/ExportSubscriptions"
+	// SubscriptionServiceGetIngestStatusProcedure is the fully-qualified name of the
+	// SubscriptionService's GetIngestStatus RPC.
+	SubscriptionServiceGetIngestStatusProcedure = "/pixicast.v1.SubscriptionService/GetIngestStatus"
+	// SubscriptionServiceGetMeProcedure is the fully-qualified name of the SubscriptionService's GetMe
+	// RPC.
+	SubscriptionServiceGetMeProcedure = "/pixicast.v1.SubscriptionService/GetMe"
+)
+
+// SubscriptionServiceClient is a client for the pixicast.v1.SubscriptionService service.
+type SubscriptionServiceClient interface {
+	// チャンネルを登録（プラン別のチャンネル数上限をチェック）
+	CreateSubscription(context.Context, *connect.Request[v1.CreateSubscriptionRequest]) (*connect.Response[v1.CreateSubscriptionResponse], error)
+	// 購読一覧を取得
+	ListSubscriptions(context.Context, *connect.Request[v1.ListSubscriptionsRequest]) (*connect.Response[v1.ListSubscriptionsResponse], error)
+	// 購読を解除
+	DeleteSubscription(context.Context, *connect.Request[v1.DeleteSubscriptionRequest]) (*connect.Response[v1.DeleteSubscriptionResponse], error)
+	// お気に入り状態を設定（Basicプラン以上）
+	ToggleFavorite(context.Context, *connect.Request[v1.ToggleFavoriteRequest]) (*connect.Response[v1.ToggleFavoriteResponse], error)
+	// 購読の一時停止・再開（一時停止中はタイムラインに表示されず、お気に入り・優先度は保持）
+	SetEnabled(context.Context, *connect.Request[v1.SetEnabledRequest]) (*connect.Response[v1.SetEnabledResponse], error)
+	// 購読の優先度を設定
+	SetPriority(context.Context, *connect.Request[v1.SetPriorityRequest]) (*connect.Response[v1.SetPriorityResponse], error)
+	// 購読を指定した順に並び替え（先頭ほど優先度が高くなる）
+	ReorderSubscriptions(context.Context, *connect.Request[v1.ReorderSubscriptionsRequest]) (*connect.Response[v1.ReorderSubscriptionsResponse], error)
+	// タグの一覧を取得（タグごとの購読数付き）
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
+	// タグを作成
+	CreateTag(context.Context, *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error)
+	// タグの名前を変更
+	UpdateTag(context.Context, *connect.Request[v1.UpdateTagRequest]) (*connect.Response[v1.UpdateTagResponse], error)
+	// タグを削除（タグの割り当てだけが削除され、購読は残る）
+	DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error)
+	// 購読に割り当てるタグを置き換え
+	SetSubscriptionTags(context.Context, *connect.Request[v1.SetSubscriptionTagsRequest]) (*connect.Response[v1.SetSubscriptionTagsResponse], error)
+	// ファイル（OPML・YouTube TakeoutのCSV・Pixicast JSON）から購読を一括登録
+	ImportSubscriptions(context.Context, *connect.Request[v1.ImportSubscriptionsRequest]) (*connect.Response[v1.ImportSubscriptionsResponse], error)
+	// 購読をファイル（OPML・YouTube TakeoutのCSV・Pixicast JSON）に書き出す
+	ExportSubscriptions(context.Context, *connect.Request[v1.ExportSubscriptionsRequest]) (*connect.Response[v1.ExportSubscriptionsResponse], error)
+	// 購読したチャンネルの過去分の取り込み状況を取得
+	GetIngestStatus(context.Context, *connect.Request[v1.GetIngestStatusRequest]) (*connect.Response[v1.GetIngestStatusResponse], error)
+	// ユーザー情報とプラン情報を取得
+	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
+}
+
+// NewSubscriptionServiceClient constructs a client for the pixicast.v1.SubscriptionService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSubscriptionServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SubscriptionServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	subscriptionServiceMethods := v1.File_proto_pixicast_v1_subscription_proto.Services().ByName("SubscriptionService").Methods()
+	return &subscriptionServiceClient{
+		createSubscription: connect.NewClient[v1.CreateSubscriptionRequest, v1.CreateSubscriptionResponse](
+			httpClient,
+			baseURL+SubscriptionServiceCreateSubscriptionProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("CreateSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		listSubscriptions: connect.NewClient[v1.ListSubscriptionsRequest, v1.ListSubscriptionsResponse](
+			httpClient,
+			baseURL+SubscriptionServiceListSubscriptionsProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("ListSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSubscription: connect.NewClient[v1.DeleteSubscriptionRequest, v1.DeleteSubscriptionResponse](
+			httpClient,
+			baseURL+SubscriptionServiceDeleteSubscriptionProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("DeleteSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		toggleFavorite: connect.NewClient[v1.ToggleFavoriteRequest, v1.ToggleFavoriteResponse](
+			httpClient,
+			baseURL+SubscriptionServiceToggleFavoriteProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("ToggleFavorite")),
+			connect.WithClientOptions(opts...),
+		),
+		setEnabled: connect.NewClient[v1.SetEnabledRequest, v1.SetEnabledResponse](
+			httpClient,
+			baseURL+SubscriptionServiceSetEnabledProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("SetEnabled")),
+			connect.WithClientOptions(opts...),
+		),
+		setPriority: connect.NewClient[v1.SetPriorityRequest, v1.SetPriorityResponse](
+			httpClient,
+			baseURL+SubscriptionServiceSetPriorityProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("SetPriority")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderSubscriptions: connect.NewClient[v1.ReorderSubscriptionsRequest, v1.ReorderSubscriptionsResponse](
+			httpClient,
+			baseURL+SubscriptionServiceReorderSubscriptionsProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("ReorderSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		listTags: connect.NewClient[v1.ListTagsRequest, v1.ListTagsResponse](
+			httpClient,
+			baseURL+SubscriptionServiceListTagsProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("ListTags")),
+			connect.WithClientOptions(opts...),
+		),
+		createTag: connect.NewClient[v1.CreateTagRequest, v1.CreateTagResponse](
+			httpClient,
+			baseURL+SubscriptionServiceCreateTagProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("CreateTag")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTag: connect.NewClient[v1.UpdateTagRequest, v1.UpdateTagResponse](
+			httpClient,
+			baseURL+SubscriptionServiceUpdateTagProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("UpdateTag")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTag: connect.NewClient[v1.DeleteTagRequest, v1.DeleteTagResponse](
+			httpClient,
+			baseURL+SubscriptionServiceDeleteTagProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("DeleteTag")),
+			connect.WithClientOptions(opts...),
+		),
+		setSubscriptionTags: connect.NewClient[v1.SetSubscriptionTagsRequest, v1.SetSubscriptionTagsResponse](
+			httpClient,
+			baseURL+SubscriptionServiceSetSubscriptionTagsProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("SetSubscriptionTags")),
+			connect.WithClientOptions(opts...),
+		),
+		importSubscriptions: connect.NewClient[v1.ImportSubscriptionsRequest, v1.ImportSubscriptionsResponse](
+			httpClient,
+			baseURL+SubscriptionServiceImportSubscriptionsProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("ImportSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		exportSubscriptions: connect.NewClient[v1.ExportSubscriptionsRequest, v1.ExportSubscriptionsResponse](
+			httpClient,
+			baseURL+SubscriptionServiceExportSubscriptionsProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("ExportSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		getIngestStatus: connect.NewClient[v1.GetIngestStatusRequest, v1.GetIngestStatusResponse](
+			httpClient,
+			baseURL+SubscriptionServiceGetIngestStatusProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("GetIngestStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		getMe: connect.NewClient[v1.GetMeRequest, v1.GetMeResponse](
+			httpClient,
+			baseURL+SubscriptionServiceGetMeProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("GetMe")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// subscriptionServiceClient implements SubscriptionServiceClient.
+type subscriptionServiceClient struct {
+	createSubscription   *connect.Client[v1.CreateSubscriptionRequest, v1.CreateSubscriptionResponse]
+	listSubscriptions    *connect.Client[v1.ListSubscriptionsRequest, v1.ListSubscriptionsResponse]
+	deleteSubscription   *connect.Client[v1.DeleteSubscriptionRequest, v1.DeleteSubscriptionResponse]
+	toggleFavorite       *connect.Client[v1.ToggleFavoriteRequest, v1.ToggleFavoriteResponse]
+	setEnabled           *connect.Client[v1.SetEnabledRequest, v1.SetEnabledResponse]
+	setPriority          *connect.Client[v1.SetPriorityRequest, v1.SetPriorityResponse]
+	reorderSubscriptions *connect.Client[v1.ReorderSubscriptionsRequest, v1.ReorderSubscriptionsResponse]
+	listTags             *connect.Client[v1.ListTagsRequest, v1.ListTagsResponse]
+	createTag            *connect.Client[v1.CreateTagRequest, v1.CreateTagResponse]
+	updateTag            *connect.Client[v1.UpdateTagRequest, v1.UpdateTagResponse]
+	deleteTag            *connect.Client[v1.DeleteTagRequest, v1.DeleteTagResponse]
+	setSubscriptionTags  *connect.Client[v1.SetSubscriptionTagsRequest, v1.SetSubscriptionTagsResponse]
+	importSubscriptions  *connect.Client[v1.ImportSubscriptionsRequest, v1.ImportSubscriptionsResponse]
+	exportSubscriptions  *connect.Client[v1.ExportSubscriptionsRequest, v1.ExportSubscriptionsResponse]
+	getIngestStatus      *connect.Client[v1.GetIngestStatusRequest, v1.GetIngestStatusResponse]
+	getMe                *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
+}
+
+// CreateSubscription calls pixicast.v1.SubscriptionService.CreateSubscription.
+func (c *subscriptionServiceClient) CreateSubscription(ctx context.Context, req *connect.Request[v1.CreateSubscriptionRequest]) (*connect.Response[v1.CreateSubscriptionResponse], error) {
+	return c.createSubscription.CallUnary(ctx, req)
+}
+
+// ListSubscriptions calls pixicast.v1.SubscriptionService.ListSubscriptions.
+func (c *subscriptionServiceClient) ListSubscriptions(ctx context.Context, req *connect.Request[v1.ListSubscriptionsRequest]) (*connect.Response[v1.ListSubscriptionsResponse], error) {
+	return c.listSubscriptions.CallUnary(ctx, req)
+}
+
+// DeleteSubscription calls pixicast.v1.SubscriptionService.DeleteSubscription.
+func (c *subscriptionServiceClient) DeleteSubscription(ctx context.Context, req *connect.Request[v1.DeleteSubscriptionRequest]) (*connect.Response[v1.DeleteSubscriptionResponse], error) {
+	return c.deleteSubscription.CallUnary(ctx, req)
+}
+
+// ToggleFavorite calls pixicast.v1.SubscriptionService.ToggleFavorite.
+func (c *subscriptionServiceClient) ToggleFavorite(ctx context.Context, req *connect.Request[v1.ToggleFavoriteRequest]) (*connect.Response[v1.ToggleFavoriteResponse], error) {
+	return c.toggleFavorite.CallUnary(ctx, req)
+}
+
+// SetEnabled calls pixicast.v1.SubscriptionService.SetEnabled.
+func (c *subscriptionServiceClient) SetEnabled(ctx context.Context, req *connect.Request[v1.SetEnabledRequest]) (*connect.Response[v1.SetEnabledResponse], error) {
+	return c.setEnabled.CallUnary(ctx, req)
+}
+
+// SetPriority calls pixicast.v1.SubscriptionService.SetPriority.
+func (c *subscriptionServiceClient) SetPriority(ctx context.Context, req *connect.Request[v1.SetPriorityRequest]) (*connect.Response[v1.SetPriorityResponse], error) {
+	return c.setPriority.CallUnary(ctx, req)
+}
+
+// ReorderSubscriptions calls pixicast.v1.SubscriptionService.ReorderSubscriptions.
+func (c *subscriptionServiceClient) ReorderSubscriptions(ctx context.Context, req *connect.Request[v1.ReorderSubscriptionsRequest]) (*connect.Response[v1.ReorderSubscriptionsResponse], error) {
+	return c.reorderSubscriptions.CallUnary(ctx, req)
+}
+
+// ListTags calls pixicast.v1.SubscriptionService.ListTags.
+func (c *subscriptionServiceClient) ListTags(ctx context.Context, req *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return c.listTags.CallUnary(ctx, req)
+}
+
+// CreateTag calls pixicast.v1.SubscriptionService.CreateTag.
+func (c *subscriptionServiceClient) CreateTag(ctx context.Context, req *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error) {
+	return c.createTag.CallUnary(ctx, req)
+}
+
+// UpdateTag calls pixicast.v1.SubscriptionService.UpdateTag.
+func (c *subscriptionServiceClient) UpdateTag(ctx context.Context, req *connect.Request[v1.UpdateTagRequest]) (*connect.Response[v1.UpdateTagResponse], error) {
+	return c.updateTag.CallUnary(ctx, req)
+}
+
+// DeleteTag calls pixicast.v1.SubscriptionService.DeleteTag.
+func (c *subscriptionServiceClient) DeleteTag(ctx context.Context, req *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error) {
+	return c.deleteTag.CallUnary(ctx, req)
+}
+
+// SetSubscriptionTags calls pixicast.v1.SubscriptionService.SetSubscriptionTags.
+func (c *subscriptionServiceClient) SetSubscriptionTags(ctx context.Context, req *connect.Request[v1.SetSubscriptionTagsRequest]) (*connect.Response[v1.SetSubscriptionTagsResponse], error) {
+	return c.setSubscriptionTags.CallUnary(ctx, req)
+}
+
+// ImportSubscriptions calls pixicast.v1.SubscriptionService.ImportSubscriptions.
+func (c *subscriptionServiceClient) ImportSubscriptions(ctx context.Context, req *connect.Request[v1.ImportSubscriptionsRequest]) (*connect.Response[v1.ImportSubscriptionsResponse], error) {
+	return c.importSubscriptions.CallUnary(ctx, req)
+}
+
+// ExportSubscriptions calls pixicast.v1.SubscriptionService.ExportSubscriptions.
+func (c *subscriptionServiceClient) ExportSubscriptions(ctx context.Context, req *connect.Request[v1.ExportSubscriptionsRequest]) (*connect.Response[v1.ExportSubscriptionsResponse], error) {
+	return c.exportSubscriptions.CallUnary(ctx, req)
+}
+
+// GetIngestStatus calls pixicast.v1.SubscriptionService.GetIngestStatus.
+func (c *subscriptionServiceClient) GetIngestStatus(ctx context.Context, req *connect.Request[v1.GetIngestStatusRequest]) (*connect.Response[v1.GetIngestStatusResponse], error) {
+	return c.getIngestStatus.CallUnary(ctx, req)
+}
+
+// GetMe calls pixicast.v1.SubscriptionService.GetMe.
+func (c *subscriptionServiceClient) GetMe(ctx context.Context, req *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error) {
+	return c.getMe.CallUnary(ctx, req)
+}
+
+// SubscriptionServiceHandler is an implementation of the pixicast.v1.SubscriptionService service.
+type SubscriptionServiceHandler interface {
+	// チャンネルを登録（プラン別のチャンネル数上限をチェック）
+	CreateSubscription(context.Context, *connect.Request[v1.CreateSubscriptionRequest]) (*connect.Response[v1.CreateSubscriptionResponse], error)
+	// 購読一覧を取得
+	ListSubscriptions(context.Context, *connect.Request[v1.ListSubscriptionsRequest]) (*connect.Response[v1.ListSubscriptionsResponse], error)
+	// 購読を解除
+	DeleteSubscription(context.Context, *connect.Request[v1.DeleteSubscriptionRequest]) (*connect.Response[v1.DeleteSubscriptionResponse], error)
+	// お気に入り状態を設定（Basicプラン以上）
+	ToggleFavorite(context.Context, *connect.Request[v1.ToggleFavoriteRequest]) (*connect.Response[v1.ToggleFavoriteResponse], error)
+	// 購読の一時停止・再開（一時停止中はタイムラインに表示されず、お気に入り・優先度は保持）
+	SetEnabled(context.Context, *connect.Request[v1.SetEnabledRequest]) (*connect.Response[v1.SetEnabledResponse], error)
+	// 購読の優先度を設定
+	SetPriority(context.Context, *connect.Request[v1.SetPriorityRequest]) (*connect.Response[v1.SetPriorityResponse], error)
+	// 購読を指定した順に並び替え（先頭ほど優先度が高くなる）
+	ReorderSubscriptions(context.Context, *connect.Request[v1.ReorderSubscriptionsRequest]) (*connect.Response[v1.ReorderSubscriptionsResponse], error)
+	// タグの一覧を取得（タグごとの購読数付き）
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
+	// タグを作成
+	CreateTag(context.Context, *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error)
+	// タグの名前を変更
+	UpdateTag(context.Context, *connect.Request[v1.UpdateTagRequest]) (*connect.Response[v1.UpdateTagResponse], error)
+	// タグを削除（タグの割り当てだけが削除され、購読は残る）
+	DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error)
+	// 購読に割り当てるタグを置き換え
+	SetSubscriptionTags(context.Context, *connect.Request[v1.SetSubscriptionTagsRequest]) (*connect.Response[v1.SetSubscriptionTagsResponse], error)
+	// ファイル（OPML・YouTube TakeoutのCSV・Pixicast JSON）から購読を一括登録
+	ImportSubscriptions(context.Context, *connect.Request[v1.ImportSubscriptionsRequest]) (*connect.Response[v1.ImportSubscriptionsResponse], error)
+	// 購読をファイル（OPML・YouTube TakeoutのCSV・Pixicast JSON）に書き出す
+	ExportSubscriptions(context.Context, *connect.Request[v1.ExportSubscriptionsRequest]) (*connect.Response[v1.ExportSubscriptionsResponse], error)
+	// 購読したチャンネルの過去分の取り込み状況を取得
+	GetIngestStatus(context.Context, *connect.Request[v1.GetIngestStatusRequest]) (*connect.Response[v1.GetIngestStatusResponse], error)
+	// ユーザー情報とプラン情報を取得
+	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
+}
+
+// NewSubscriptionServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSubscriptionServiceHandler(svc SubscriptionServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	subscriptionServiceMethods := v1.File_proto_pixicast_v1_subscription_proto.Services().ByName("SubscriptionService").Methods()
+	subscriptionServiceCreateSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionServiceCreateSubscriptionProcedure,
+		svc.CreateSubscription,
+		connect.WithSchema(subscriptionServiceMethods.ByName("CreateSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceListSubscriptionsHandler := connect.NewUnaryHandler(
+		SubscriptionServiceListSubscriptionsProcedure,
+		svc.ListSubscriptions,
+		connect.WithSchema(subscriptionServiceMethods.ByName("ListSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceDeleteSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionServiceDeleteSubscriptionProcedure,
+		svc.DeleteSubscription,
+		connect.WithSchema(subscriptionServiceMethods.ByName("DeleteSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceToggleFavoriteHandler := connect.NewUnaryHandler(
+		SubscriptionServiceToggleFavoriteProcedure,
+		svc.ToggleFavorite,
+		connect.WithSchema(subscriptionServiceMethods.ByName("ToggleFavorite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceSetEnabledHandler := connect.NewUnaryHandler(
+		SubscriptionServiceSetEnabledProcedure,
+		svc.SetEnabled,
+		connect.WithSchema(subscriptionServiceMethods.ByName("SetEnabled")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceSetPriorityHandler := connect.NewUnaryHandler(
+		SubscriptionServiceSetPriorityProcedure,
+		svc.SetPriority,
+		connect.WithSchema(subscriptionServiceMethods.ByName("SetPriority")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceReorderSubscriptionsHandler := connect.NewUnaryHandler(
+		SubscriptionServiceReorderSubscriptionsProcedure,
+		svc.ReorderSubscriptions,
+		connect.WithSchema(subscriptionServiceMethods.ByName("ReorderSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceListTagsHandler := connect.NewUnaryHandler(
+		SubscriptionServiceListTagsProcedure,
+		svc.ListTags,
+		connect.WithSchema(subscriptionServiceMethods.ByName("ListTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceCreateTagHandler := connect.NewUnaryHandler(
+		SubscriptionServiceCreateTagProcedure,
+		svc.CreateTag,
+		connect.WithSchema(subscriptionServiceMethods.ByName("CreateTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceUpdateTagHandler := connect.NewUnaryHandler(
+		SubscriptionServiceUpdateTagProcedure,
+		svc.UpdateTag,
+		connect.WithSchema(subscriptionServiceMethods.ByName("UpdateTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceDeleteTagHandler := connect.NewUnaryHandler(
+		SubscriptionServiceDeleteTagProcedure,
+		svc.DeleteTag,
+		connect.WithSchema(subscriptionServiceMethods.ByName("DeleteTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceSetSubscriptionTagsHandler := connect.NewUnaryHandler(
+		SubscriptionServiceSetSubscriptionTagsProcedure,
+		svc.SetSubscriptionTags,
+		connect.WithSchema(subscriptionServiceMethods.ByName("SetSubscriptionTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceImportSubscriptionsHandler := connect.NewUnaryHandler(
+		SubscriptionServiceImportSubscriptionsProcedure,
+		svc.ImportSubscriptions,
+		connect.WithSchema(subscriptionServiceMethods.ByName("ImportSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceExportSubscriptionsHandler := connect.NewUnaryHandler(
+		SubscriptionServiceExportSubscriptionsProcedure,
+		svc.ExportSubscriptions,
+		connect.WithSchema(subscriptionServiceMethods.ByName("ExportSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceGetIngestStatusHandler := connect.NewUnaryHandler(
+		SubscriptionServiceGetIngestStatusProcedure,
+		svc.GetIngestStatus,
+		connect.WithSchema(subscriptionServiceMethods.ByName("GetIngestStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceGetMeHandler := connect.NewUnaryHandler(
+		SubscriptionServiceGetMeProcedure,
+		svc.GetMe,
+		connect.WithSchema(subscriptionServiceMethods.ByName("GetMe")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/pixicast.v1.SubscriptionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SubscriptionServiceCreateSubscriptionProcedure:
+			subscriptionServiceCreateSubscriptionHandler.ServeHTTP(w, r)
+		case SubscriptionServiceListSubscriptionsProcedure:
+			subscriptionServiceListSubscriptionsHandler.ServeHTTP(w, r)
+		case SubscriptionServiceDeleteSubscriptionProcedure:
+			subscriptionServiceDeleteSubscriptionHandler.ServeHTTP(w, r)
+		case SubscriptionServiceToggleFavoriteProcedure:
+			subscriptionServiceToggleFavoriteHandler.ServeHTTP(w, r)
+		case SubscriptionServiceSetEnabledProcedure:
+			subscriptionServiceSetEnabledHandler.ServeHTTP(w, r)
+		case SubscriptionServiceSetPriorityProcedure:
+			subscriptionServiceSetPriorityHandler.ServeHTTP(w, r)
+		case SubscriptionServiceReorderSubscriptionsProcedure:
+			subscriptionServiceReorderSubscriptionsHandler.ServeHTTP(w, r)
+		case SubscriptionServiceListTagsProcedure:
+			subscriptionServiceListTagsHandler.ServeHTTP(w, r)
+		case SubscriptionServiceCreateTagProcedure:
+			subscriptionServiceCreateTagHandler.ServeHTTP(w, r)
+		case SubscriptionServiceUpdateTagProcedure:
+			subscriptionServiceUpdateTagHandler.ServeHTTP(w, r)
+		case SubscriptionServiceDeleteTagProcedure:
+			subscriptionServiceDeleteTagHandler.ServeHTTP(w, r)
+		case SubscriptionServiceSetSubscriptionTagsProcedure:
+			subscriptionServiceSetSubscriptionTagsHandler.ServeHTTP(w, r)
+		case SubscriptionServiceImportSubscriptionsProcedure:
+			subscriptionServiceImportSubscriptionsHandler.ServeHTTP(w, r)
+		case SubscriptionServiceExportSubscriptionsProcedure:
+			subscriptionServiceExportSubscriptionsHandler.ServeHTTP(w, r)
+		case SubscriptionServiceGetIngestStatusProcedure:
+			subscriptionServiceGetIngestStatusHandler.ServeHTTP(w, r)
+		case SubscriptionServiceGetMeProcedure:
+			subscriptionServiceGetMeHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSubscriptionServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSubscriptionServiceHandler struct{}
+
+func (UnimplementedSubscriptionServiceHandler) CreateSubscription(context.Context, *connect.Request[v1.CreateSubscriptionRequest]) (*connect.Response[v1.CreateSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.CreateSubscription is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) ListSubscriptions(context.Context, *connect.Request[v1.ListSubscriptionsRequest]) (*connect.Response[v1.ListSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.ListSubscriptions is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) DeleteSubscription(context.Context, *connect.Request[v1.DeleteSubscriptionRequest]) (*connect.Response[v1.DeleteSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.DeleteSubscription is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) ToggleFavorite(context.Context, *connect.Request[v1.ToggleFavoriteRequest]) (*connect.Response[v1.ToggleFavoriteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.ToggleFavorite is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) SetEnabled(context.Context, *connect.Request[v1.SetEnabledRequest]) (*connect.Response[v1.SetEnabledResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.SetEnabled is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) SetPriority(context.Context, *connect.Request[v1.SetPriorityRequest]) (*connect.Response[v1.SetPriorityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.SetPriority is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) ReorderSubscriptions(context.Context, *connect.Request[v1.ReorderSubscriptionsRequest]) (*connect.Response[v1.ReorderSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.ReorderSubscriptions is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.ListTags is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) CreateTag(context.Context, *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.CreateTag is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) UpdateTag(context.Context, *connect.Request[v1.UpdateTagRequest]) (*connect.Response[v1.UpdateTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.UpdateTag is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.DeleteTag is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) SetSubscriptionTags(context.Context, *connect.Request[v1.SetSubscriptionTagsRequest]) (*connect.Response[v1.SetSubscriptionTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.SetSubscriptionTags is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) ImportSubscriptions(context.Context, *connect.Request[v1.ImportSubscriptionsRequest]) (*connect.Response[v1.ImportSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.ImportSubscriptions is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) ExportSubscriptions(context.Context, *connect.Request[v1.ExportSubscriptionsRequest]) (*connect.Response[v1.ExportSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.ExportSubscriptions is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) GetIngestStatus(context.Context, *connect.Request[v1.GetIngestStatusRequest]) (*connect.Response[v1.GetIngestStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.GetIngestStatus is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.SubscriptionService.GetMe is not implemented"))
+}
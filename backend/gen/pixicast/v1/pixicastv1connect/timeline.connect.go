@@ -39,12 +39,117 @@ const (
 	// TimelineServiceSearchYouTubeLiveProcedure is the fully-qualified name of the TimelineService's
 	// SearchYouTubeLive RPC.
 	TimelineServiceSearchYouTubeLiveProcedure = "/pixicast.v1.TimelineService/SearchYouTubeLive"
+	// TimelineServiceWatchTimelineProcedure is the fully-qualified name of the TimelineService's
+	// WatchTimeline RPC.
+	TimelineServiceWatchTimelineProcedure = "/pixicast.v1.TimelineService/WatchTimeline"
+	// TimelineServiceListLiveNowProcedure is the fully-qualified name of the TimelineService's
+	// ListLiveNow RPC.
+	TimelineServiceListLiveNowProcedure = "/pixicast.v1.TimelineService/ListLiveNow"
+	// TimelineServiceListUpcomingProcedure is the fully-qualified name of the TimelineService's
+	// ListUpcoming RPC.
+	TimelineServiceListUpcomingProcedure = "/pixicast.v1.TimelineService/ListUpcoming"
+	// TimelineServiceSearchTimelineProcedure is the fully-qualified name of the TimelineService's
+	// SearchTimeline RPC.
+	TimelineServiceSearchTimelineProcedure = "/pixicast.v1.TimelineService/SearchTimeline"
+	// TimelineServiceSetEventStateProcedure is the fully-qualified name of the TimelineService's
+	// SetEventState RPC.
+	TimelineServiceSetEventStateProcedure = "/pixicast.v1.TimelineService/SetEventState"
+	// TimelineServiceMarkEventsBeforeProcedure is the fully-qualified name of the TimelineService's
+	// MarkEventsBefore RPC.
+	TimelineServiceMarkEventsBeforeProcedure = "/pixicast.v1.TimelineService/MarkEventsBefore"
+	// TimelineServiceListWatchLaterProcedure is the fully-qualified name of the TimelineService's
+	// ListWatchLater RPC.
+	TimelineServiceListWatchLaterProcedure = "/pixicast.v1.TimelineService/ListWatchLater"
+	// TimelineServiceAddWatchLaterProcedure is the fully-qualified name of the TimelineService's
+	// AddWatchLater RPC.
+	TimelineServiceAddWatchLaterProcedure = "/pixicast.v1.TimelineService/AddWatchLater"
+	// TimelineServiceReorderWatchLaterProcedure is the fully-qualified name of the TimelineService's
+	// ReorderWatchLater RPC.
+	TimelineServiceReorderWatchLaterProcedure = "/pixicast.v1.TimelineService/ReorderWatchLater"
+	// TimelineServiceRemoveWatchLaterProcedure is the fully-qualified name of the TimelineService's
+	// RemoveWatchLater RPC.
+	TimelineServiceRemoveWatchLaterProcedure = "/pixicast.v1.TimelineService/RemoveWatchLater"
+	// TimelineServiceListSourceLinksProcedure is the fully-qualified name of the TimelineService's
+	// ListSourceLinks RPC.
+	TimelineServiceListSourceLinksProcedure = "/pixicast.v1.TimelineService/ListSourceLinks"
+	// TimelineServiceLinkSourcesProcedure is the fully-qualified name of the TimelineService's
+	// LinkSources RPC.
+	TimelineServiceLinkSourcesProcedure = "/pixicast.v1.TimelineService/LinkSources"
+	// TimelineServiceUnlinkSourcesProcedure is the fully-qualified name of the TimelineService's
+	// UnlinkSources RPC.
+	TimelineServiceUnlinkSourcesProcedure = "/pixicast.v1.TimelineService/UnlinkSources"
+	// TimelineServiceListMuteRulesProcedure is the fully-qualified name of the TimelineService's
+	// ListMuteRules RPC.
+	TimelineServiceListMuteRulesProcedure = "/pixicast.v1.TimelineService/ListMuteRules"
+	// TimelineServiceCreateMuteRuleProcedure is the fully-qualified name of the TimelineService's
+	// CreateMuteRule RPC.
+	TimelineServiceCreateMuteRuleProcedure = "/pixicast.v1.TimelineService/CreateMuteRule"
+	// TimelineServiceUpdateMuteRuleProcedure is the fully-qualified name of the TimelineService's
+	// UpdateMuteRule RPC.
+	TimelineServiceUpdateMuteRuleProcedure = "/pixicast.v1.TimelineService/UpdateMuteRule"
+	// TimelineServiceDeleteMuteRuleProcedure is the fully-qualified name of the TimelineService's
+	// DeleteMuteRule RPC.
+	TimelineServiceDeleteMuteRuleProcedure = "/pixicast.v1.TimelineService/DeleteMuteRule"
+	// TimelineServiceListTimelineViewsProcedure is the fully-qualified name of the TimelineService's
+	// ListTimelineViews RPC.
+	TimelineServiceListTimelineViewsProcedure = "/pixicast.v1.TimelineService/ListTimelineViews"
+	// TimelineServiceCreateTimelineViewProcedure is the fully-qualified name of the TimelineService's
+	// CreateTimelineView RPC.
+	TimelineServiceCreateTimelineViewProcedure = "/pixicast.v1.TimelineService/CreateTimelineView"
+	// TimelineServiceUpdateTimelineViewProcedure is the fully-qualified name of the TimelineService's
+	// UpdateTimelineView RPC.
+	TimelineServiceUpdateTimelineViewProcedure = "/pixicast.v1.TimelineService/UpdateTimelineView"
+	// TimelineServiceDeleteTimelineViewProcedure is the fully-qualified name of the TimelineService's
+	// DeleteTimelineView RPC.
+	TimelineServiceDeleteTimelineViewProcedure = "/pixicast.v1.TimelineService/DeleteTimelineView"
 )
 
 // TimelineServiceClient is a client for the pixicast.v1.TimelineService service.
 type TimelineServiceClient interface {
 	GetTimeline(context.Context, *connect.Request[v1.GetTimelineRequest]) (*connect.Response[v1.GetTimelineResponse], error)
 	SearchYouTubeLive(context.Context, *connect.Request[v1.SearchYouTubeLiveRequest]) (*connect.Response[v1.SearchYouTubeLiveResponse], error)
+	// タイムラインの変更（追加・更新・削除）をストリーミング配信
+	WatchTimeline(context.Context, *connect.Request[v1.WatchTimelineRequest]) (*connect.ServerStreamForClient[v1.WatchTimelineResponse], error)
+	// 購読チャンネルの配信中・放送中の番組を取得（ライブ配信・プレミア公開・ラジオ番組）
+	ListLiveNow(context.Context, *connect.Request[v1.ListLiveNowRequest]) (*connect.Response[v1.ListLiveNowResponse], error)
+	// 購読チャンネルの配信予定・放送予定の番組を取得（ライブ配信・プレミア公開・ラジオ番組）
+	ListUpcoming(context.Context, *connect.Request[v1.ListUpcomingRequest]) (*connect.Response[v1.ListUpcomingResponse], error)
+	// 購読チャンネルの番組をタイトル・説明文で検索
+	SearchTimeline(context.Context, *connect.Request[v1.SearchTimelineRequest]) (*connect.Response[v1.SearchTimelineResponse], error)
+	// 番組の状態（視聴済み・非表示・却下）を設定・解除
+	SetEventState(context.Context, *connect.Request[v1.SetEventStateRequest]) (*connect.Response[v1.SetEventStateResponse], error)
+	// 指定日時より前の番組の状態を一括で設定・解除
+	MarkEventsBefore(context.Context, *connect.Request[v1.MarkEventsBeforeRequest]) (*connect.Response[v1.MarkEventsBeforeResponse], error)
+	// 「あとで見る」キューを並び順で取得
+	ListWatchLater(context.Context, *connect.Request[v1.ListWatchLaterRequest]) (*connect.Response[v1.ListWatchLaterResponse], error)
+	// 「あとで見る」キューの末尾に番組を追加
+	AddWatchLater(context.Context, *connect.Request[v1.AddWatchLaterRequest]) (*connect.Response[v1.AddWatchLaterResponse], error)
+	// 「あとで見る」キューを並び替え
+	ReorderWatchLater(context.Context, *connect.Request[v1.ReorderWatchLaterRequest]) (*connect.Response[v1.ReorderWatchLaterResponse], error)
+	// 「あとで見る」キューから番組を削除
+	RemoveWatchLater(context.Context, *connect.Request[v1.RemoveWatchLaterRequest]) (*connect.Response[v1.RemoveWatchLaterResponse], error)
+	// 同じクリエイターとしてリンクしたソースの一覧を取得
+	ListSourceLinks(context.Context, *connect.Request[v1.ListSourceLinksRequest]) (*connect.Response[v1.ListSourceLinksResponse], error)
+	// 2つのソースを同じクリエイターとしてリンク（同時配信をタイムラインで1つにまとめる）
+	LinkSources(context.Context, *connect.Request[v1.LinkSourcesRequest]) (*connect.Response[v1.LinkSourcesResponse], error)
+	// ソースのリンクを解除
+	UnlinkSources(context.Context, *connect.Request[v1.UnlinkSourcesRequest]) (*connect.Response[v1.UnlinkSourcesResponse], error)
+	// タイムラインのミュートルールの一覧を取得
+	ListMuteRules(context.Context, *connect.Request[v1.ListMuteRulesRequest]) (*connect.Response[v1.ListMuteRulesResponse], error)
+	// ミュートルールを作成
+	CreateMuteRule(context.Context, *connect.Request[v1.CreateMuteRuleRequest]) (*connect.Response[v1.CreateMuteRuleResponse], error)
+	// ミュートルールを更新
+	UpdateMuteRule(context.Context, *connect.Request[v1.UpdateMuteRuleRequest]) (*connect.Response[v1.UpdateMuteRuleResponse], error)
+	// ミュートルールを削除
+	DeleteMuteRule(context.Context, *connect.Request[v1.DeleteMuteRuleRequest]) (*connect.Response[v1.DeleteMuteRuleResponse], error)
+	// 保存したタイムラインのビューの一覧を取得
+	ListTimelineViews(context.Context, *connect.Request[v1.ListTimelineViewsRequest]) (*connect.Response[v1.ListTimelineViewsResponse], error)
+	// タイムラインのビューを保存
+	CreateTimelineView(context.Context, *connect.Request[v1.CreateTimelineViewRequest]) (*connect.Response[v1.CreateTimelineViewResponse], error)
+	// タイムラインのビューを更新
+	UpdateTimelineView(context.Context, *connect.Request[v1.UpdateTimelineViewRequest]) (*connect.Response[v1.UpdateTimelineViewResponse], error)
+	// タイムラインのビューを削除
+	DeleteTimelineView(context.Context, *connect.Request[v1.DeleteTimelineViewRequest]) (*connect.Response[v1.DeleteTimelineViewResponse], error)
 }
 
 // NewTimelineServiceClient constructs a client for the pixicast.v1.TimelineService service. By
@@ -70,13 +175,160 @@ func NewTimelineServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(timelineServiceMethods.ByName("SearchYouTubeLive")),
 			connect.WithClientOptions(opts...),
 		),
+		watchTimeline: connect.NewClient[v1.WatchTimelineRequest, v1.WatchTimelineResponse](
+			httpClient,
+			baseURL+TimelineServiceWatchTimelineProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("WatchTimeline")),
+			connect.WithClientOptions(opts...),
+		),
+		listLiveNow: connect.NewClient[v1.ListLiveNowRequest, v1.ListLiveNowResponse](
+			httpClient,
+			baseURL+TimelineServiceListLiveNowProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("ListLiveNow")),
+			connect.WithClientOptions(opts...),
+		),
+		listUpcoming: connect.NewClient[v1.ListUpcomingRequest, v1.ListUpcomingResponse](
+			httpClient,
+			baseURL+TimelineServiceListUpcomingProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("ListUpcoming")),
+			connect.WithClientOptions(opts...),
+		),
+		searchTimeline: connect.NewClient[v1.SearchTimelineRequest, v1.SearchTimelineResponse](
+			httpClient,
+			baseURL+TimelineServiceSearchTimelineProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("SearchTimeline")),
+			connect.WithClientOptions(opts...),
+		),
+		setEventState: connect.NewClient[v1.SetEventStateRequest, v1.SetEventStateResponse](
+			httpClient,
+			baseURL+TimelineServiceSetEventStateProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("SetEventState")),
+			connect.WithClientOptions(opts...),
+		),
+		markEventsBefore: connect.NewClient[v1.MarkEventsBeforeRequest, v1.MarkEventsBeforeResponse](
+			httpClient,
+			baseURL+TimelineServiceMarkEventsBeforeProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("MarkEventsBefore")),
+			connect.WithClientOptions(opts...),
+		),
+		listWatchLater: connect.NewClient[v1.ListWatchLaterRequest, v1.ListWatchLaterResponse](
+			httpClient,
+			baseURL+TimelineServiceListWatchLaterProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("ListWatchLater")),
+			connect.WithClientOptions(opts...),
+		),
+		addWatchLater: connect.NewClient[v1.AddWatchLaterRequest, v1.AddWatchLaterResponse](
+			httpClient,
+			baseURL+TimelineServiceAddWatchLaterProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("AddWatchLater")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderWatchLater: connect.NewClient[v1.ReorderWatchLaterRequest, v1.ReorderWatchLaterResponse](
+			httpClient,
+			baseURL+TimelineServiceReorderWatchLaterProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("ReorderWatchLater")),
+			connect.WithClientOptions(opts...),
+		),
+		removeWatchLater: connect.NewClient[v1.RemoveWatchLaterRequest, v1.RemoveWatchLaterResponse](
+			httpClient,
+			baseURL+TimelineServiceRemoveWatchLaterProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("RemoveWatchLater")),
+			connect.WithClientOptions(opts...),
+		),
+		listSourceLinks: connect.NewClient[v1.ListSourceLinksRequest, v1.ListSourceLinksResponse](
+			httpClient,
+			baseURL+TimelineServiceListSourceLinksProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("ListSourceLinks")),
+			connect.WithClientOptions(opts...),
+		),
+		linkSources: connect.NewClient[v1.LinkSourcesRequest, v1.LinkSourcesResponse](
+			httpClient,
+			baseURL+TimelineServiceLinkSourcesProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("LinkSources")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkSources: connect.NewClient[v1.UnlinkSourcesRequest, v1.UnlinkSourcesResponse](
+			httpClient,
+			baseURL+TimelineServiceUnlinkSourcesProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("UnlinkSources")),
+			connect.WithClientOptions(opts...),
+		),
+		listMuteRules: connect.NewClient[v1.ListMuteRulesRequest, v1.ListMuteRulesResponse](
+			httpClient,
+			baseURL+TimelineServiceListMuteRulesProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("ListMuteRules")),
+			connect.WithClientOptions(opts...),
+		),
+		createMuteRule: connect.NewClient[v1.CreateMuteRuleRequest, v1.CreateMuteRuleResponse](
+			httpClient,
+			baseURL+TimelineServiceCreateMuteRuleProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("CreateMuteRule")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMuteRule: connect.NewClient[v1.UpdateMuteRuleRequest, v1.UpdateMuteRuleResponse](
+			httpClient,
+			baseURL+TimelineServiceUpdateMuteRuleProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("UpdateMuteRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMuteRule: connect.NewClient[v1.DeleteMuteRuleRequest, v1.DeleteMuteRuleResponse](
+			httpClient,
+			baseURL+TimelineServiceDeleteMuteRuleProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("DeleteMuteRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listTimelineViews: connect.NewClient[v1.ListTimelineViewsRequest, v1.ListTimelineViewsResponse](
+			httpClient,
+			baseURL+TimelineServiceListTimelineViewsProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("ListTimelineViews")),
+			connect.WithClientOptions(opts...),
+		),
+		createTimelineView: connect.NewClient[v1.CreateTimelineViewRequest, v1.CreateTimelineViewResponse](
+			httpClient,
+			baseURL+TimelineServiceCreateTimelineViewProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("CreateTimelineView")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTimelineView: connect.NewClient[v1.UpdateTimelineViewRequest, v1.UpdateTimelineViewResponse](
+			httpClient,
+			baseURL+TimelineServiceUpdateTimelineViewProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("UpdateTimelineView")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTimelineView: connect.NewClient[v1.DeleteTimelineViewRequest, v1.DeleteTimelineViewResponse](
+			httpClient,
+			baseURL+TimelineServiceDeleteTimelineViewProcedure,
+			connect.WithSchema(timelineServiceMethods.ByName("DeleteTimelineView")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // timelineServiceClient implements TimelineServiceClient.
 type timelineServiceClient struct {
-	getTimeline       *connect.Client[v1.GetTimelineRequest, v1.GetTimelineResponse]
-	searchYouTubeLive *connect.Client[v1.SearchYouTubeLiveRequest, v1.SearchYouTubeLiveResponse]
+	getTimeline        *connect.Client[v1.GetTimelineRequest, v1.GetTimelineResponse]
+	searchYouTubeLive  *connect.Client[v1.SearchYouTubeLiveRequest, v1.SearchYouTubeLiveResponse]
+	watchTimeline      *connect.Client[v1.WatchTimelineRequest, v1.WatchTimelineResponse]
+	listLiveNow        *connect.Client[v1.ListLiveNowRequest, v1.ListLiveNowResponse]
+	listUpcoming       *connect.Client[v1.ListUpcomingRequest, v1.ListUpcomingResponse]
+	searchTimeline     *connect.Client[v1.SearchTimelineRequest, v1.SearchTimelineResponse]
+	setEventState      *connect.Client[v1.SetEventStateRequest, v1.SetEventStateResponse]
+	markEventsBefore   *connect.Client[v1.MarkEventsBeforeRequest, v1.MarkEventsBeforeResponse]
+	listWatchLater     *connect.Client[v1.ListWatchLaterRequest, v1.ListWatchLaterResponse]
+	addWatchLater      *connect.Client[v1.AddWatchLaterRequest, v1.AddWatchLaterResponse]
+	reorderWatchLater  *connect.Client[v1.ReorderWatchLaterRequest, v1.ReorderWatchLaterResponse]
+	removeWatchLater   *connect.Client[v1.RemoveWatchLaterRequest, v1.RemoveWatchLaterResponse]
+	listSourceLinks    *connect.Client[v1.ListSourceLinksRequest, v1.ListSourceLinksResponse]
+	linkSources        *connect.Client[v1.LinkSourcesRequest, v1.LinkSourcesResponse]
+	unlinkSources      *connect.Client[v1.UnlinkSourcesRequest, v1.UnlinkSourcesResponse]
+	listMuteRules      *connect.Client[v1.ListMuteRulesRequest, v1.ListMuteRulesResponse]
+	createMuteRule     *connect.Client[v1.CreateMuteRuleRequest, v1.CreateMuteRuleResponse]
+	updateMuteRule     *connect.Client[v1.UpdateMuteRuleRequest, v1.UpdateMuteRuleResponse]
+	deleteMuteRule     *connect.Client[v1.DeleteMuteRuleRequest, v1.DeleteMuteRuleResponse]
+	listTimelineViews  *connect.Client[v1.ListTimelineViewsRequest, v1.ListTimelineViewsResponse]
+	createTimelineView *connect.Client[v1.CreateTimelineViewRequest, v1.CreateTimelineViewResponse]
+	updateTimelineView *connect.Client[v1.UpdateTimelineViewRequest, v1.UpdateTimelineViewResponse]
+	deleteTimelineView *connect.Client[v1.DeleteTimelineViewRequest, v1.DeleteTimelineViewResponse]
 }
 
 // GetTimeline calls pixicast.v1.TimelineService.GetTimeline.
@@ -89,10 +341,157 @@ func (c *timelineServiceClient) SearchYouTubeLive(ctx context.Context, req *conn
 	return c.searchYouTubeLive.CallUnary(ctx, req)
 }
 
+// WatchTimeline calls pixicast.v1.TimelineService.WatchTimeline.
+func (c *timelineServiceClient) WatchTimeline(ctx context.Context, req *connect.Request[v1.WatchTimelineRequest]) (*connect.ServerStreamForClient[v1.WatchTimelineResponse], error) {
+	return c.watchTimeline.CallServerStream(ctx, req)
+}
+
+// ListLiveNow calls pixicast.v1.TimelineService.ListLiveNow.
+func (c *timelineServiceClient) ListLiveNow(ctx context.Context, req *connect.Request[v1.ListLiveNowRequest]) (*connect.Response[v1.ListLiveNowResponse], error) {
+	return c.listLiveNow.CallUnary(ctx, req)
+}
+
+// ListUpcoming calls pixicast.v1.TimelineService.ListUpcoming.
+func (c *timelineServiceClient) ListUpcoming(ctx context.Context, req *connect.Request[v1.ListUpcomingRequest]) (*connect.Response[v1.ListUpcomingResponse], error) {
+	return c.listUpcoming.CallUnary(ctx, req)
+}
+
+// SearchTimeline calls pixicast.v1.TimelineService.SearchTimeline.
+func (c *timelineServiceClient) SearchTimeline(ctx context.Context, req *connect.Request[v1.SearchTimelineRequest]) (*connect.Response[v1.SearchTimelineResponse], error) {
+	return c.searchTimeline.CallUnary(ctx, req)
+}
+
+// SetEventState calls pixicast.v1.TimelineService.SetEventState.
+func (c *timelineServiceClient) SetEventState(ctx context.Context, req *connect.Request[v1.SetEventStateRequest]) (*connect.Response[v1.SetEventStateResponse], error) {
+	return c.setEventState.CallUnary(ctx, req)
+}
+
+// MarkEventsBefore calls pixicast.v1.TimelineService.MarkEventsBefore.
+func (c *timelineServiceClient) MarkEventsBefore(ctx context.Context, req *connect.Request[v1.MarkEventsBeforeRequest]) (*connect.Response[v1.MarkEventsBeforeResponse], error) {
+	return c.markEventsBefore.CallUnary(ctx, req)
+}
+
+// ListWatchLater calls pixicast.v1.TimelineService.ListWatchLater.
+func (c *timelineServiceClient) ListWatchLater(ctx context.Context, req *connect.Request[v1.ListWatchLaterRequest]) (*connect.Response[v1.ListWatchLaterResponse], error) {
+	return c.listWatchLater.CallUnary(ctx, req)
+}
+
+// AddWatchLater calls pixicast.v1.TimelineService.AddWatchLater.
+func (c *timelineServiceClient) AddWatchLater(ctx context.Context, req *connect.Request[v1.AddWatchLaterRequest]) (*connect.Response[v1.AddWatchLaterResponse], error) {
+	return c.addWatchLater.CallUnary(ctx, req)
+}
+
+// ReorderWatchLater calls pixicast.v1.TimelineService.ReorderWatchLater.
+func (c *timelineServiceClient) ReorderWatchLater(ctx context.Context, req *connect.Request[v1.ReorderWatchLaterRequest]) (*connect.Response[v1.ReorderWatchLaterResponse], error) {
+	return c.reorderWatchLater.CallUnary(ctx, req)
+}
+
+// RemoveWatchLater calls pixicast.v1.TimelineService.RemoveWatchLater.
+func (c *timelineServiceClient) RemoveWatchLater(ctx context.Context, req *connect.Request[v1.RemoveWatchLaterRequest]) (*connect.Response[v1.RemoveWatchLaterResponse], error) {
+	return c.removeWatchLater.CallUnary(ctx, req)
+}
+
+// ListSourceLinks calls pixicast.v1.TimelineService.ListSourceLinks.
+func (c *timelineServiceClient) ListSourceLinks(ctx context.Context, req *connect.Request[v1.ListSourceLinksRequest]) (*connect.Response[v1.ListSourceLinksResponse], error) {
+	return c.listSourceLinks.CallUnary(ctx, req)
+}
+
+// LinkSources calls pixicast.v1.TimelineService.LinkSources.
+func (c *timelineServiceClient) LinkSources(ctx context.Context, req *connect.Request[v1.LinkSourcesRequest]) (*connect.Response[v1.LinkSourcesResponse], error) {
+	return c.linkSources.CallUnary(ctx, req)
+}
+
+// UnlinkSources calls pixicast.v1.TimelineService.UnlinkSources.
+func (c *timelineServiceClient) UnlinkSources(ctx context.Context, req *connect.Request[v1.UnlinkSourcesRequest]) (*connect.Response[v1.UnlinkSourcesResponse], error) {
+	return c.unlinkSources.CallUnary(ctx, req)
+}
+
+// ListMuteRules calls pixicast.v1.TimelineService.ListMuteRules.
+func (c *timelineServiceClient) ListMuteRules(ctx context.Context, req *connect.Request[v1.ListMuteRulesRequest]) (*connect.Response[v1.ListMuteRulesResponse], error) {
+	return c.listMuteRules.CallUnary(ctx, req)
+}
+
+// CreateMuteRule calls pixicast.v1.TimelineService.CreateMuteRule.
+func (c *timelineServiceClient) CreateMuteRule(ctx context.Context, req *connect.Request[v1.CreateMuteRuleRequest]) (*connect.Response[v1.CreateMuteRuleResponse], error) {
+	return c.createMuteRule.CallUnary(ctx, req)
+}
+
+// UpdateMuteRule calls pixicast.v1.TimelineService.UpdateMuteRule.
+func (c *timelineServiceClient) UpdateMuteRule(ctx context.Context, req *connect.Request[v1.UpdateMuteRuleRequest]) (*connect.Response[v1.UpdateMuteRuleResponse], error) {
+	return c.updateMuteRule.CallUnary(ctx, req)
+}
+
+// DeleteMuteRule calls pixicast.v1.TimelineService.DeleteMuteRule.
+func (c *timelineServiceClient) DeleteMuteRule(ctx context.Context, req *connect.Request[v1.DeleteMuteRuleRequest]) (*connect.Response[v1.DeleteMuteRuleResponse], error) {
+	return c.deleteMuteRule.CallUnary(ctx, req)
+}
+
+// ListTimelineViews calls pixicast.v1.TimelineService.ListTimelineViews.
+func (c *timelineServiceClient) ListTimelineViews(ctx context.Context, req *connect.Request[v1.ListTimelineViewsRequest]) (*connect.Response[v1.ListTimelineViewsResponse], error) {
+	return c.listTimelineViews.CallUnary(ctx, req)
+}
+
+// CreateTimelineView calls pixicast.v1.TimelineService.CreateTimelineView.
+func (c *timelineServiceClient) CreateTimelineView(ctx context.Context, req *connect.Request[v1.CreateTimelineViewRequest]) (*connect.Response[v1.CreateTimelineViewResponse], error) {
+	return c.createTimelineView.CallUnary(ctx, req)
+}
+
+// UpdateTimelineView calls pixicast.v1.TimelineService.UpdateTimelineView.
+func (c *timelineServiceClient) UpdateTimelineView(ctx context.Context, req *connect.Request[v1.UpdateTimelineViewRequest]) (*connect.Response[v1.UpdateTimelineViewResponse], error) {
+	return c.updateTimelineView.CallUnary(ctx, req)
+}
+
+// DeleteTimelineView calls pixicast.v1.TimelineService.DeleteTimelineView.
+func (c *timelineServiceClient) DeleteTimelineView(ctx context.Context, req *connect.Request[v1.DeleteTimelineViewRequest]) (*connect.Response[v1.DeleteTimelineViewResponse], error) {
+	return c.deleteTimelineView.CallUnary(ctx, req)
+}
+
 // TimelineServiceHandler is an implementation of the pixicast.v1.TimelineService service.
 type TimelineServiceHandler interface {
 	GetTimeline(context.Context, *connect.Request[v1.GetTimelineRequest]) (*connect.Response[v1.GetTimelineResponse], error)
 	SearchYouTubeLive(context.Context, *connect.Request[v1.SearchYouTubeLiveRequest]) (*connect.Response[v1.SearchYouTubeLiveResponse], error)
+	// タイムラインの変更（追加・更新・削除）をストリーミング配信
+	WatchTimeline(context.Context, *connect.Request[v1.WatchTimelineRequest], *connect.ServerStream[v1.WatchTimelineResponse]) error
+	// 購読チャンネルの配信中・放送中の番組を取得（ライブ配信・プレミア公開・ラジオ番組）
+	ListLiveNow(context.Context, *connect.Request[v1.ListLiveNowRequest]) (*connect.Response[v1.ListLiveNowResponse], error)
+	// 購読チャンネルの配信予定・放送予定の番組を取得（ライブ配信・プレミア公開・ラジオ番組）
+	ListUpcoming(context.Context, *connect.Request[v1.ListUpcomingRequest]) (*connect.Response[v1.ListUpcomingResponse], error)
+	// 購読チャンネルの番組をタイトル・説明文で検索
+	SearchTimeline(context.Context, *connect.Request[v1.SearchTimelineRequest]) (*connect.Response[v1.SearchTimelineResponse], error)
+	// 番組の状態（視聴済み・非表示・却下）を設定・解除
+	SetEventState(context.Context, *connect.Request[v1.SetEventStateRequest]) (*connect.Response[v1.SetEventStateResponse], error)
+	// 指定日時より前の番組の状態を一括で設定・解除
+	MarkEventsBefore(context.Context, *connect.Request[v1.MarkEventsBeforeRequest]) (*connect.Response[v1.MarkEventsBeforeResponse], error)
+	// 「あとで見る」キューを並び順で取得
+	ListWatchLater(context.Context, *connect.Request[v1.ListWatchLaterRequest]) (*connect.Response[v1.ListWatchLaterResponse], error)
+	// 「あとで見る」キューの末尾に番組を追加
+	AddWatchLater(context.Context, *connect.Request[v1.AddWatchLaterRequest]) (*connect.Response[v1.AddWatchLaterResponse], error)
+	// 「あとで見る」キューを並び替え
+	ReorderWatchLater(context.Context, *connect.Request[v1.ReorderWatchLaterRequest]) (*connect.Response[v1.ReorderWatchLaterResponse], error)
+	// 「あとで見る」キューから番組を削除
+	RemoveWatchLater(context.Context, *connect.Request[v1.RemoveWatchLaterRequest]) (*connect.Response[v1.RemoveWatchLaterResponse], error)
+	// 同じクリエイターとしてリンクしたソースの一覧を取得
+	ListSourceLinks(context.Context, *connect.Request[v1.ListSourceLinksRequest]) (*connect.Response[v1.ListSourceLinksResponse], error)
+	// 2つのソースを同じクリエイターとしてリンク（同時配信をタイムラインで1つにまとめる）
+	LinkSources(context.Context, *connect.Request[v1.LinkSourcesRequest]) (*connect.Response[v1.LinkSourcesResponse], error)
+	// ソースのリンクを解除
+	UnlinkSources(context.Context, *connect.Request[v1.UnlinkSourcesRequest]) (*connect.Response[v1.UnlinkSourcesResponse], error)
+	// タイムラインのミュートルールの一覧を取得
+	ListMuteRules(context.Context, *connect.Request[v1.ListMuteRulesRequest]) (*connect.Response[v1.ListMuteRulesResponse], error)
+	// ミュートルールを作成
+	CreateMuteRule(context.Context, *connect.Request[v1.CreateMuteRuleRequest]) (*connect.Response[v1.CreateMuteRuleResponse], error)
+	// ミュートルールを更新
+	UpdateMuteRule(context.Context, *connect.Request[v1.UpdateMuteRuleRequest]) (*connect.Response[v1.UpdateMuteRuleResponse], error)
+	// ミュートルールを削除
+	DeleteMuteRule(context.Context, *connect.Request[v1.DeleteMuteRuleRequest]) (*connect.Response[v1.DeleteMuteRuleResponse], error)
+	// 保存したタイムラインのビューの一覧を取得
+	ListTimelineViews(context.Context, *connect.Request[v1.ListTimelineViewsRequest]) (*connect.Response[v1.ListTimelineViewsResponse], error)
+	// タイムラインのビューを保存
+	CreateTimelineView(context.Context, *connect.Request[v1.CreateTimelineViewRequest]) (*connect.Response[v1.CreateTimelineViewResponse], error)
+	// タイムラインのビューを更新
+	UpdateTimelineView(context.Context, *connect.Request[v1.UpdateTimelineViewRequest]) (*connect.Response[v1.UpdateTimelineViewResponse], error)
+	// タイムラインのビューを削除
+	DeleteTimelineView(context.Context, *connect.Request[v1.DeleteTimelineViewRequest]) (*connect.Response[v1.DeleteTimelineViewResponse], error)
 }
 
 // NewTimelineServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -114,12 +513,180 @@ func NewTimelineServiceHandler(svc TimelineServiceHandler, opts ...connect.Handl
 		connect.WithSchema(timelineServiceMethods.ByName("SearchYouTubeLive")),
 		connect.WithHandlerOptions(opts...),
 	)
+	timelineServiceWatchTimelineHandler := connect.NewServerStreamHandler(
+		TimelineServiceWatchTimelineProcedure,
+		svc.WatchTimeline,
+		connect.WithSchema(timelineServiceMethods.ByName("WatchTimeline")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceListLiveNowHandler := connect.NewUnaryHandler(
+		TimelineServiceListLiveNowProcedure,
+		svc.ListLiveNow,
+		connect.WithSchema(timelineServiceMethods.ByName("ListLiveNow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceListUpcomingHandler := connect.NewUnaryHandler(
+		TimelineServiceListUpcomingProcedure,
+		svc.ListUpcoming,
+		connect.WithSchema(timelineServiceMethods.ByName("ListUpcoming")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceSearchTimelineHandler := connect.NewUnaryHandler(
+		TimelineServiceSearchTimelineProcedure,
+		svc.SearchTimeline,
+		connect.WithSchema(timelineServiceMethods.ByName("SearchTimeline")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceSetEventStateHandler := connect.NewUnaryHandler(
+		TimelineServiceSetEventStateProcedure,
+		svc.SetEventState,
+		connect.WithSchema(timelineServiceMethods.ByName("SetEventState")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceMarkEventsBeforeHandler := connect.NewUnaryHandler(
+		TimelineServiceMarkEventsBeforeProcedure,
+		svc.MarkEventsBefore,
+		connect.WithSchema(timelineServiceMethods.ByName("MarkEventsBefore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceListWatchLaterHandler := connect.NewUnaryHandler(
+		TimelineServiceListWatchLaterProcedure,
+		svc.ListWatchLater,
+		connect.WithSchema(timelineServiceMethods.ByName("ListWatchLater")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceAddWatchLaterHandler := connect.NewUnaryHandler(
+		TimelineServiceAddWatchLaterProcedure,
+		svc.AddWatchLater,
+		connect.WithSchema(timelineServiceMethods.ByName("AddWatchLater")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceReorderWatchLaterHandler := connect.NewUnaryHandler(
+		TimelineServiceReorderWatchLaterProcedure,
+		svc.ReorderWatchLater,
+		connect.WithSchema(timelineServiceMethods.ByName("ReorderWatchLater")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceRemoveWatchLaterHandler := connect.NewUnaryHandler(
+		TimelineServiceRemoveWatchLaterProcedure,
+		svc.RemoveWatchLater,
+		connect.WithSchema(timelineServiceMethods.ByName("RemoveWatchLater")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceListSourceLinksHandler := connect.NewUnaryHandler(
+		TimelineServiceListSourceLinksProcedure,
+		svc.ListSourceLinks,
+		connect.WithSchema(timelineServiceMethods.ByName("ListSourceLinks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceLinkSourcesHandler := connect.NewUnaryHandler(
+		TimelineServiceLinkSourcesProcedure,
+		svc.LinkSources,
+		connect.WithSchema(timelineServiceMethods.ByName("LinkSources")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceUnlinkSourcesHandler := connect.NewUnaryHandler(
+		TimelineServiceUnlinkSourcesProcedure,
+		svc.UnlinkSources,
+		connect.WithSchema(timelineServiceMethods.ByName("UnlinkSources")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceListMuteRulesHandler := connect.NewUnaryHandler(
+		TimelineServiceListMuteRulesProcedure,
+		svc.ListMuteRules,
+		connect.WithSchema(timelineServiceMethods.ByName("ListMuteRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceCreateMuteRuleHandler := connect.NewUnaryHandler(
+		TimelineServiceCreateMuteRuleProcedure,
+		svc.CreateMuteRule,
+		connect.WithSchema(timelineServiceMethods.ByName("CreateMuteRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceUpdateMuteRuleHandler := connect.NewUnaryHandler(
+		TimelineServiceUpdateMuteRuleProcedure,
+		svc.UpdateMuteRule,
+		connect.WithSchema(timelineServiceMethods.ByName("UpdateMuteRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceDeleteMuteRuleHandler := connect.NewUnaryHandler(
+		TimelineServiceDeleteMuteRuleProcedure,
+		svc.DeleteMuteRule,
+		connect.WithSchema(timelineServiceMethods.ByName("DeleteMuteRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceListTimelineViewsHandler := connect.NewUnaryHandler(
+		TimelineServiceListTimelineViewsProcedure,
+		svc.ListTimelineViews,
+		connect.WithSchema(timelineServiceMethods.ByName("ListTimelineViews")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceCreateTimelineViewHandler := connect.NewUnaryHandler(
+		TimelineServiceCreateTimelineViewProcedure,
+		svc.CreateTimelineView,
+		connect.WithSchema(timelineServiceMethods.ByName("CreateTimelineView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceUpdateTimelineViewHandler := connect.NewUnaryHandler(
+		TimelineServiceUpdateTimelineViewProcedure,
+		svc.UpdateTimelineView,
+		connect.WithSchema(timelineServiceMethods.ByName("UpdateTimelineView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	timelineServiceDeleteTimelineViewHandler := connect.NewUnaryHandler(
+		TimelineServiceDeleteTimelineViewProcedure,
+		svc.DeleteTimelineView,
+		connect.WithSchema(timelineServiceMethods.ByName("DeleteTimelineView")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/pixicast.v1.TimelineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TimelineServiceGetTimelineProcedure:
 			timelineServiceGetTimelineHandler.ServeHTTP(w, r)
 		case TimelineServiceSearchYouTubeLiveProcedure:
 			timelineServiceSearchYouTubeLiveHandler.ServeHTTP(w, r)
+		case TimelineServiceWatchTimelineProcedure:
+			timelineServiceWatchTimelineHandler.ServeHTTP(w, r)
+		case TimelineServiceListLiveNowProcedure:
+			timelineServiceListLiveNowHandler.ServeHTTP(w, r)
+		case TimelineServiceListUpcomingProcedure:
+			timelineServiceListUpcomingHandler.ServeHTTP(w, r)
+		case TimelineServiceSearchTimelineProcedure:
+			timelineServiceSearchTimelineHandler.ServeHTTP(w, r)
+		case TimelineServiceSetEventStateProcedure:
+			timelineServiceSetEventStateHandler.ServeHTTP(w, r)
+		case TimelineServiceMarkEventsBeforeProcedure:
+			timelineServiceMarkEventsBeforeHandler.ServeHTTP(w, r)
+		case TimelineServiceListWatchLaterProcedure:
+			timelineServiceListWatchLaterHandler.ServeHTTP(w, r)
+		case TimelineServiceAddWatchLaterProcedure:
+			timelineServiceAddWatchLaterHandler.ServeHTTP(w, r)
+		case TimelineServiceReorderWatchLaterProcedure:
+			timelineServiceReorderWatchLaterHandler.ServeHTTP(w, r)
+		case TimelineServiceRemoveWatchLaterProcedure:
+			timelineServiceRemoveWatchLaterHandler.ServeHTTP(w, r)
+		case TimelineServiceListSourceLinksProcedure:
+			timelineServiceListSourceLinksHandler.ServeHTTP(w, r)
+		case TimelineServiceLinkSourcesProcedure:
+			timelineServiceLinkSourcesHandler.ServeHTTP(w, r)
+		case TimelineServiceUnlinkSourcesProcedure:
+			timelineServiceUnlinkSourcesHandler.ServeHTTP(w, r)
+		case TimelineServiceListMuteRulesProcedure:
+			timelineServiceListMuteRulesHandler.ServeHTTP(w, r)
+		case TimelineServiceCreateMuteRuleProcedure:
+			timelineServiceCreateMuteRuleHandler.ServeHTTP(w, r)
+		case TimelineServiceUpdateMuteRuleProcedure:
+			timelineServiceUpdateMuteRuleHandler.ServeHTTP(w, r)
+		case TimelineServiceDeleteMuteRuleProcedure:
+			timelineServiceDeleteMuteRuleHandler.ServeHTTP(w, r)
+		case TimelineServiceListTimelineViewsProcedure:
+			timelineServiceListTimelineViewsHandler.ServeHTTP(w, r)
+		case TimelineServiceCreateTimelineViewProcedure:
+			timelineServiceCreateTimelineViewHandler.ServeHTTP(w, r)
+		case TimelineServiceUpdateTimelineViewProcedure:
+			timelineServiceUpdateTimelineViewHandler.ServeHTTP(w, r)
+		case TimelineServiceDeleteTimelineViewProcedure:
+			timelineServiceDeleteTimelineViewHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -136,3 +703,87 @@ func (UnimplementedTimelineServiceHandler) GetTimeline(context.Context, *connect
 func (UnimplementedTimelineServiceHandler) SearchYouTubeLive(context.Context, *connect.Request[v1.SearchYouTubeLiveRequest]) (*connect.Response[v1.SearchYouTubeLiveResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.SearchYouTubeLive is not implemented"))
 }
+
+func (UnimplementedTimelineServiceHandler) WatchTimeline(context.Context, *connect.Request[v1.WatchTimelineRequest], *connect.ServerStream[v1.WatchTimelineResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.WatchTimeline is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) ListLiveNow(context.Context, *connect.Request[v1.ListLiveNowRequest]) (*connect.Response[v1.ListLiveNowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.ListLiveNow is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) ListUpcoming(context.Context, *connect.Request[v1.ListUpcomingRequest]) (*connect.Response[v1.ListUpcomingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.ListUpcoming is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) SearchTimeline(context.Context, *connect.Request[v1.SearchTimelineRequest]) (*connect.Response[v1.SearchTimelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.SearchTimeline is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) SetEventState(context.Context, *connect.Request[v1.SetEventStateRequest]) (*connect.Response[v1.SetEventStateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.SetEventState is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) MarkEventsBefore(context.Context, *connect.Request[v1.MarkEventsBeforeRequest]) (*connect.Response[v1.MarkEventsBeforeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.MarkEventsBefore is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) ListWatchLater(context.Context, *connect.Request[v1.ListWatchLaterRequest]) (*connect.Response[v1.ListWatchLaterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.ListWatchLater is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) AddWatchLater(context.Context, *connect.Request[v1.AddWatchLaterRequest]) (*connect.Response[v1.AddWatchLaterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.AddWatchLater is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) ReorderWatchLater(context.Context, *connect.Request[v1.ReorderWatchLaterRequest]) (*connect.Response[v1.ReorderWatchLaterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.ReorderWatchLater is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) RemoveWatchLater(context.Context, *connect.Request[v1.RemoveWatchLaterRequest]) (*connect.Response[v1.RemoveWatchLaterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.RemoveWatchLater is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) ListSourceLinks(context.Context, *connect.Request[v1.ListSourceLinksRequest]) (*connect.Response[v1.ListSourceLinksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.ListSourceLinks is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) LinkSources(context.Context, *connect.Request[v1.LinkSourcesRequest]) (*connect.Response[v1.LinkSourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.LinkSources is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) UnlinkSources(context.Context, *connect.Request[v1.UnlinkSourcesRequest]) (*connect.Response[v1.UnlinkSourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.UnlinkSources is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) ListMuteRules(context.Context, *connect.Request[v1.ListMuteRulesRequest]) (*connect.Response[v1.ListMuteRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.ListMuteRules is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) CreateMuteRule(context.Context, *connect.Request[v1.CreateMuteRuleRequest]) (*connect.Response[v1.CreateMuteRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.CreateMuteRule is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) UpdateMuteRule(context.Context, *connect.Request[v1.UpdateMuteRuleRequest]) (*connect.Response[v1.UpdateMuteRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.UpdateMuteRule is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) DeleteMuteRule(context.Context, *connect.Request[v1.DeleteMuteRuleRequest]) (*connect.Response[v1.DeleteMuteRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.DeleteMuteRule is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) ListTimelineViews(context.Context, *connect.Request[v1.ListTimelineViewsRequest]) (*connect.Response[v1.ListTimelineViewsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.ListTimelineViews is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) CreateTimelineView(context.Context, *connect.Request[v1.CreateTimelineViewRequest]) (*connect.Response[v1.CreateTimelineViewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.CreateTimelineView is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) UpdateTimelineView(context.Context, *connect.Request[v1.UpdateTimelineViewRequest]) (*connect.Response[v1.UpdateTimelineViewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.UpdateTimelineView is not implemented"))
+}
+
+func (UnimplementedTimelineServiceHandler) DeleteTimelineView(context.Context, *connect.Request[v1.DeleteTimelineViewRequest]) (*connect.Response[v1.DeleteTimelineViewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pixicast.v1.TimelineService.DeleteTimelineView is not implemented"))
+}
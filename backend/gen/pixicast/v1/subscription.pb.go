@@ -0,0 +1,2478 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: proto/pixicast/v1/subscription.proto
+
+package pixicastv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 購読情報
+type Subscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`                    // youtube / twitch / podcast / radiko
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // プラットフォーム上のID（Podcastの場合はフィードURL）
+	Handle        string                 `protobuf:"bytes,4,opt,name=handle,proto3" json:"handle,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,6,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Enabled       bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	IsFavorite    bool                   `protobuf:"varint,8,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	Priority      int32                  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	TagIds        []string               `protobuf:"bytes,10,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"` // 割り当てたタグのID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Subscription) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *Subscription) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *Subscription) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *Subscription) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *Subscription) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Subscription) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Subscription) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Subscription) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+func (x *Subscription) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Subscription) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+// 購読のタグ・フォルダ
+type SubscriptionTag struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SubscriptionCount int64                  `protobuf:"varint,3,opt,name=subscription_count,json=subscriptionCount,proto3" json:"subscription_count,omitempty"` // タグを割り当てた購読の数（無効にした購読を含む）
+	CreatedAt         string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                          // RFC3339
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubscriptionTag) Reset() {
+	*x = SubscriptionTag{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionTag) ProtoMessage() {}
+
+func (x *SubscriptionTag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionTag.ProtoReflect.Descriptor instead.
+func (*SubscriptionTag) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubscriptionTag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubscriptionTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubscriptionTag) GetSubscriptionCount() int64 {
+	if x != nil {
+		return x.SubscriptionCount
+	}
+	return 0
+}
+
+func (x *SubscriptionTag) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 購読登録リクエスト
+type CreateSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platform      string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"` // youtube / twitch / podcast / radiko
+	Input         string                 `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`       // URL・@handle・チャンネルID・フィードURL・ステーションID（"TBS" または "TBS:JP13"）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateSubscriptionRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+// 購読登録レスポンス
+type CreateSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionResponse) Reset() {
+	*x = CreateSubscriptionResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 購読一覧取得リクエスト
+type ListSubscriptionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeDisabled bool                   `protobuf:"varint,1,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"` // 無効にした購読も含める
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSubscriptionsRequest) GetIncludeDisabled() bool {
+	if x != nil {
+		return x.IncludeDisabled
+	}
+	return false
+}
+
+// 購読一覧取得レスポンス
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"` // 優先度の高い順（同じ優先度の中は登録が新しい順）
+	Tags          []*SubscriptionTag     `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`                   // タグの一覧（作成順、タグごとの購読数付き）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *ListSubscriptionsResponse) GetTags() []*SubscriptionTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// 購読解除リクエスト
+type DeleteSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionRequest) Reset() {
+	*x = DeleteSubscriptionRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteSubscriptionRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+// 購読解除レスポンス
+type DeleteSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionResponse) Reset() {
+	*x = DeleteSubscriptionResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{7}
+}
+
+// お気に入り設定リクエスト
+type ToggleFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	IsFavorite    bool                   `protobuf:"varint,2,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleFavoriteRequest) Reset() {
+	*x = ToggleFavoriteRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleFavoriteRequest) ProtoMessage() {}
+
+func (x *ToggleFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*ToggleFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ToggleFavoriteRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ToggleFavoriteRequest) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+// お気に入り設定レスポンス
+type ToggleFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleFavoriteResponse) Reset() {
+	*x = ToggleFavoriteResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleFavoriteResponse) ProtoMessage() {}
+
+func (x *ToggleFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*ToggleFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ToggleFavoriteResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 購読の有効・無効切り替えリクエスト
+type SetEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEnabledRequest) Reset() {
+	*x = SetEnabledRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnabledRequest) ProtoMessage() {}
+
+func (x *SetEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetEnabledRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *SetEnabledRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// 購読の有効・無効切り替えレスポンス
+type SetEnabledResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEnabledResponse) Reset() {
+	*x = SetEnabledResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnabledResponse) ProtoMessage() {}
+
+func (x *SetEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetEnabledResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 優先度設定リクエスト
+type SetPriorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"` // 大きいほど上に表示
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriorityRequest) Reset() {
+	*x = SetPriorityRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriorityRequest) ProtoMessage() {}
+
+func (x *SetPriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriorityRequest.ProtoReflect.Descriptor instead.
+func (*SetPriorityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetPriorityRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *SetPriorityRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// 優先度設定レスポンス
+type SetPriorityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriorityResponse) Reset() {
+	*x = SetPriorityResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriorityResponse) ProtoMessage() {}
+
+func (x *SetPriorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriorityResponse.ProtoReflect.Descriptor instead.
+func (*SetPriorityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetPriorityResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 購読の並び替えリクエスト
+type ReorderSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceIds     []string               `protobuf:"bytes,1,rep,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"` // 無効にした購読を含むすべての購読を新しい順序で指定
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSubscriptionsRequest) Reset() {
+	*x = ReorderSubscriptionsRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ReorderSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReorderSubscriptionsRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+// 購読の並び替えレスポンス
+type ReorderSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"` // 並び替え後の購読一覧（無効にした購読を含む）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSubscriptionsResponse) Reset() {
+	*x = ReorderSubscriptionsResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ReorderSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReorderSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// タグ一覧取得リクエスト
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{16}
+}
+
+// タグ一覧取得レスポンス
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*SubscriptionTag     `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // 作成順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTagsResponse) GetTags() []*SubscriptionTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// タグ作成リクエスト
+type CreateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 最大50文字（ユーザーごとに一意）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// タグ作成レスポンス
+type CreateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *SubscriptionTag       `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagResponse) Reset() {
+	*x = CreateTagResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagResponse) ProtoMessage() {}
+
+func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateTagResponse) GetTag() *SubscriptionTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// タグ更新リクエスト
+type UpdateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagRequest) ProtoMessage() {}
+
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateTagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// タグ更新レスポンス
+type UpdateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *SubscriptionTag       `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagResponse) Reset() {
+	*x = UpdateTagResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagResponse) ProtoMessage() {}
+
+func (x *UpdateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateTagResponse) GetTag() *SubscriptionTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// タグ削除リクエスト
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteTagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// タグ削除レスポンス
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{23}
+}
+
+// 購読のタグ設定リクエスト
+type SetSubscriptionTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TagIds        []string               `protobuf:"bytes,2,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"` // 空の場合はすべてのタグを外す
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSubscriptionTagsRequest) Reset() {
+	*x = SetSubscriptionTagsRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubscriptionTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubscriptionTagsRequest) ProtoMessage() {}
+
+func (x *SetSubscriptionTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubscriptionTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetSubscriptionTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetSubscriptionTagsRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *SetSubscriptionTagsRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+// 購読のタグ設定レスポンス
+type SetSubscriptionTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSubscriptionTagsResponse) Reset() {
+	*x = SetSubscriptionTagsResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubscriptionTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubscriptionTagsResponse) ProtoMessage() {}
+
+func (x *SetSubscriptionTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubscriptionTagsResponse.ProtoReflect.Descriptor instead.
+func (*SetSubscriptionTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetSubscriptionTagsResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 一括登録リクエスト
+type ImportSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`   // opml / takeout / json（省略時は内容から推定）
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // ファイルの内容（最大5MB、500件まで。1回のリクエストで登録を試みるのは50件まで）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSubscriptionsRequest) Reset() {
+	*x = ImportSubscriptionsRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ImportSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ImportSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportSubscriptionsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportSubscriptionsRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// 一括登録の1行の結果
+type ImportResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // ファイル内の購読の番号（1始まり）
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Input         string                 `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`   // ファイルに書かれていた名前
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // created / exists / limit_exceeded / failed / pending
+	SourceId      string                 `protobuf:"bytes,6,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportResult) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ImportResult) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *ImportResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportResult) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ImportResult) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ImportResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 一括登録レスポンス
+type ImportSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Existing      int32                  `protobuf:"varint,3,opt,name=existing,proto3" json:"existing,omitempty"`
+	LimitExceeded int32                  `protobuf:"varint,4,opt,name=limit_exceeded,json=limitExceeded,proto3" json:"limit_exceeded,omitempty"` // プランのチャンネル数上限のため登録しなかった件数
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results       []*ImportResult        `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`  // ファイル内の順
+	Pending       int32                  `protobuf:"varint,7,opt,name=pending,proto3" json:"pending,omitempty"` // 1回のリクエストで登録する件数の上限のため未処理の件数（同じファイルを再送すると続きを登録する）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSubscriptionsResponse) Reset() {
+	*x = ImportSubscriptionsResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ImportSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ImportSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportSubscriptionsResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportSubscriptionsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportSubscriptionsResponse) GetExisting() int32 {
+	if x != nil {
+		return x.Existing
+	}
+	return 0
+}
+
+func (x *ImportSubscriptionsResponse) GetLimitExceeded() int32 {
+	if x != nil {
+		return x.LimitExceeded
+	}
+	return 0
+}
+
+func (x *ImportSubscriptionsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportSubscriptionsResponse) GetResults() []*ImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportSubscriptionsResponse) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+// 書き出しリクエスト
+type ExportSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // opml / takeout / json（デフォルト: json）。OPMLはYouTubeとPodcast、takeoutはYouTubeのみ
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSubscriptionsRequest) Reset() {
+	*x = ExportSubscriptionsRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ExportSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExportSubscriptionsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// 書き出しレスポンス
+type ExportSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSubscriptionsResponse) Reset() {
+	*x = ExportSubscriptionsResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ExportSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ExportSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExportSubscriptionsResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportSubscriptionsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportSubscriptionsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+// 取り込み状況取得リクエスト
+type GetIngestStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIngestStatusRequest) Reset() {
+	*x = GetIngestStatusRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIngestStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIngestStatusRequest) ProtoMessage() {}
+
+func (x *GetIngestStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIngestStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetIngestStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetIngestStatusRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+// 過去分の取り込み状況
+type IngestStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`                              // queued / running / done / failed
+	EventCount    int64                  `protobuf:"varint,3,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"` // 保存済みのイベント数
+	Attempts      int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	MaxAttempts   int32                  `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	FetchStatus   string                 `protobuf:"bytes,7,opt,name=fetch_status,json=fetchStatus,proto3" json:"fetch_status,omitempty"` // ok / not_found / suspended / error
+	QueuedAt      string                 `protobuf:"bytes,8,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   string                 `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	NextAttemptAt string                 `protobuf:"bytes,11,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"` // 取り込み待ちの場合の実行予定日時
+	LastFetchedAt string                 `protobuf:"bytes,12,opt,name=last_fetched_at,json=lastFetchedAt,proto3" json:"last_fetched_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestStatus) Reset() {
+	*x = IngestStatus{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestStatus) ProtoMessage() {}
+
+func (x *IngestStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestStatus.ProtoReflect.Descriptor instead.
+func (*IngestStatus) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *IngestStatus) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *IngestStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *IngestStatus) GetEventCount() int64 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *IngestStatus) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *IngestStatus) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *IngestStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *IngestStatus) GetFetchStatus() string {
+	if x != nil {
+		return x.FetchStatus
+	}
+	return ""
+}
+
+func (x *IngestStatus) GetQueuedAt() string {
+	if x != nil {
+		return x.QueuedAt
+	}
+	return ""
+}
+
+func (x *IngestStatus) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *IngestStatus) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *IngestStatus) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *IngestStatus) GetLastFetchedAt() string {
+	if x != nil {
+		return x.LastFetchedAt
+	}
+	return ""
+}
+
+// 取り込み状況取得レスポンス
+type GetIngestStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *IngestStatus          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIngestStatusResponse) Reset() {
+	*x = GetIngestStatusResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIngestStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIngestStatusResponse) ProtoMessage() {}
+
+func (x *GetIngestStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIngestStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetIngestStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetIngestStatusResponse) GetStatus() *IngestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// ユーザー情報取得リクエスト
+type GetMeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMeRequest) Reset() {
+	*x = GetMeRequest{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeRequest) ProtoMessage() {}
+
+func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeRequest.ProtoReflect.Descriptor instead.
+func (*GetMeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{34}
+}
+
+// ユーザー情報
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirebaseUid   string                 `protobuf:"bytes,2,opt,name=firebase_uid,json=firebaseUid,proto3" json:"firebase_uid,omitempty"`
+	PlanType      string                 `protobuf:"bytes,3,opt,name=plan_type,json=planType,proto3" json:"plan_type,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	PhotoUrl      string                 `protobuf:"bytes,6,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+	IsAnonymous   bool                   `protobuf:"varint,7,opt,name=is_anonymous,json=isAnonymous,proto3" json:"is_anonymous,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetFirebaseUid() string {
+	if x != nil {
+		return x.FirebaseUid
+	}
+	return ""
+}
+
+func (x *User) GetPlanType() string {
+	if x != nil {
+		return x.PlanType
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *User) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *User) GetIsAnonymous() bool {
+	if x != nil {
+		return x.IsAnonymous
+	}
+	return false
+}
+
+// プラン情報
+type Plan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	MaxChannels   int32                  `protobuf:"varint,3,opt,name=max_channels,json=maxChannels,proto3" json:"max_channels,omitempty"`
+	PriceMonthly  int32                  `protobuf:"varint,4,opt,name=price_monthly,json=priceMonthly,proto3" json:"price_monthly,omitempty"` // 無料プランの場合は0
+	HasFavorites  bool                   `protobuf:"varint,5,opt,name=has_favorites,json=hasFavorites,proto3" json:"has_favorites,omitempty"`
+	HasDeviceSync bool                   `protobuf:"varint,6,opt,name=has_device_sync,json=hasDeviceSync,proto3" json:"has_device_sync,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Plan) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Plan) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Plan) GetMaxChannels() int32 {
+	if x != nil {
+		return x.MaxChannels
+	}
+	return 0
+}
+
+func (x *Plan) GetPriceMonthly() int32 {
+	if x != nil {
+		return x.PriceMonthly
+	}
+	return 0
+}
+
+func (x *Plan) GetHasFavorites() bool {
+	if x != nil {
+		return x.HasFavorites
+	}
+	return false
+}
+
+func (x *Plan) GetHasDeviceSync() bool {
+	if x != nil {
+		return x.HasDeviceSync
+	}
+	return false
+}
+
+func (x *Plan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ユーザー情報取得レスポンス
+type GetMeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	User            *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Plan            *Plan                  `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	CurrentChannels int64                  `protobuf:"varint,3,opt,name=current_channels,json=currentChannels,proto3" json:"current_channels,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMeResponse) Reset() {
+	*x = GetMeResponse{}
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeResponse) ProtoMessage() {}
+
+func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_subscription_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeResponse.ProtoReflect.Descriptor instead.
+func (*GetMeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_subscription_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetMeResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetMeResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *GetMeResponse) GetCurrentChannels() int64 {
+	if x != nil {
+		return x.CurrentChannels
+	}
+	return 0
+}
+
+var File_proto_pixicast_v1_subscription_proto protoreflect.FileDescriptor
+
+const file_proto_pixicast_v1_subscription_proto_rawDesc = "" +
+	"\n" +
+	"$proto/pixicast/v1/subscription.proto\x12\vpixicast.v1\"\xb6\x02\n" +
+	"\fSubscription\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x16\n" +
+	"\x06handle\x18\x04 \x01(\tR\x06handle\x12!\n" +
+	"\fdisplay_name\x18\x05 \x01(\tR\vdisplayName\x12#\n" +
+	"\rthumbnail_url\x18\x06 \x01(\tR\fthumbnailUrl\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12\x1f\n" +
+	"\vis_favorite\x18\b \x01(\bR\n" +
+	"isFavorite\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\x05R\bpriority\x12\x17\n" +
+	"\atag_ids\x18\n" +
+	" \x03(\tR\x06tagIds\"\x83\x01\n" +
+	"\x0fSubscriptionTag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\x12subscription_count\x18\x03 \x01(\x03R\x11subscriptionCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"M\n" +
+	"\x19CreateSubscriptionRequest\x12\x1a\n" +
+	"\bplatform\x18\x01 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05input\x18\x02 \x01(\tR\x05input\"[\n" +
+	"\x1aCreateSubscriptionResponse\x12=\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x19.pixicast.v1.SubscriptionR\fsubscription\"E\n" +
+	"\x18ListSubscriptionsRequest\x12)\n" +
+	"\x10include_disabled\x18\x01 \x01(\bR\x0fincludeDisabled\"\x8e\x01\n" +
+	"\x19ListSubscriptionsResponse\x12?\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x19.pixicast.v1.SubscriptionR\rsubscriptions\x120\n" +
+	"\x04tags\x18\x02 \x03(\v2\x1c.pixicast.v1.SubscriptionTagR\x04tags\"8\n" +
+	"\x19DeleteSubscriptionRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\"\x1c\n" +
+	"\x1aDeleteSubscriptionResponse\"U\n" +
+	"\x15ToggleFavoriteRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x1f\n" +
+	"\vis_favorite\x18\x02 \x01(\bR\n" +
+	"isFavorite\"W\n" +
+	"\x16ToggleFavoriteResponse\x12=\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x19.pixicast.v1.SubscriptionR\fsubscription\"J\n" +
+	"\x11SetEnabledRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"S\n" +
+	"\x12SetEnabledResponse\x12=\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x19.pixicast.v1.SubscriptionR\fsubscription\"M\n" +
+	"\x12SetPriorityRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\"T\n" +
+	"\x13SetPriorityResponse\x12=\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x19.pixicast.v1.SubscriptionR\fsubscription\"<\n" +
+	"\x1bReorderSubscriptionsRequest\x12\x1d\n" +
+	"\n" +
+	"source_ids\x18\x01 \x03(\tR\tsourceIds\"_\n" +
+	"\x1cReorderSubscriptionsResponse\x12?\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x19.pixicast.v1.SubscriptionR\rsubscriptions\"\x11\n" +
+	"\x0fListTagsRequest\"D\n" +
+	"\x10ListTagsResponse\x120\n" +
+	"\x04tags\x18\x01 \x03(\v2\x1c.pixicast.v1.SubscriptionTagR\x04tags\"&\n" +
+	"\x10CreateTagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"C\n" +
+	"\x11CreateTagResponse\x12.\n" +
+	"\x03tag\x18\x01 \x01(\v2\x1c.pixicast.v1.SubscriptionTagR\x03tag\"6\n" +
+	"\x10UpdateTagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"C\n" +
+	"\x11UpdateTagResponse\x12.\n" +
+	"\x03tag\x18\x01 \x01(\v2\x1c.pixicast.v1.SubscriptionTagR\x03tag\"\"\n" +
+	"\x10DeleteTagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
+	"\x11DeleteTagResponse\"R\n" +
+	"\x1aSetSubscriptionTagsRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x17\n" +
+	"\atag_ids\x18\x02 \x03(\tR\x06tagIds\"\\\n" +
+	"\x1bSetSubscriptionTagsResponse\x12=\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x19.pixicast.v1.SubscriptionR\fsubscription\"N\n" +
+	"\x1aImportSubscriptionsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"\xd6\x01\n" +
+	"\fImportResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05input\x18\x03 \x01(\tR\x05input\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1b\n" +
+	"\tsource_id\x18\x06 \x01(\tR\bsourceId\x12!\n" +
+	"\fdisplay_name\x18\a \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"\xf9\x01\n" +
+	"\x1bImportSubscriptionsResponse\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x1a\n" +
+	"\bexisting\x18\x03 \x01(\x05R\bexisting\x12%\n" +
+	"\x0elimit_exceeded\x18\x04 \x01(\x05R\rlimitExceeded\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x123\n" +
+	"\aresults\x18\x06 \x03(\v2\x19.pixicast.v1.ImportResultR\aresults\x12\x18\n" +
+	"\apending\x18\a \x01(\x05R\apending\"4\n" +
+	"\x1aExportSubscriptionsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\"w\n" +
+	"\x1bExportSubscriptionsResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\"5\n" +
+	"\x16GetIngestStatusRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\"\x92\x03\n" +
+	"\fIngestStatus\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1f\n" +
+	"\vevent_count\x18\x03 \x01(\x03R\n" +
+	"eventCount\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12!\n" +
+	"\fmax_attempts\x18\x05 \x01(\x05R\vmaxAttempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12!\n" +
+	"\ffetch_status\x18\a \x01(\tR\vfetchStatus\x12\x1b\n" +
+	"\tqueued_at\x18\b \x01(\tR\bqueuedAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\t \x01(\tR\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\n" +
+	" \x01(\tR\vcompletedAt\x12&\n" +
+	"\x0fnext_attempt_at\x18\v \x01(\tR\rnextAttemptAt\x12&\n" +
+	"\x0flast_fetched_at\x18\f \x01(\tR\rlastFetchedAt\"L\n" +
+	"\x17GetIngestStatusResponse\x121\n" +
+	"\x06status\x18\x01 \x01(\v2\x19.pixicast.v1.IngestStatusR\x06status\"\x0e\n" +
+	"\fGetMeRequest\"\xcf\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\ffirebase_uid\x18\x02 \x01(\tR\vfirebaseUid\x12\x1b\n" +
+	"\tplan_type\x18\x03 \x01(\tR\bplanType\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12!\n" +
+	"\fdisplay_name\x18\x05 \x01(\tR\vdisplayName\x12\x1b\n" +
+	"\tphoto_url\x18\x06 \x01(\tR\bphotoUrl\x12!\n" +
+	"\fis_anonymous\x18\a \x01(\bR\visAnonymous\"\xf4\x01\n" +
+	"\x04Plan\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12!\n" +
+	"\fmax_channels\x18\x03 \x01(\x05R\vmaxChannels\x12#\n" +
+	"\rprice_monthly\x18\x04 \x01(\x05R\fpriceMonthly\x12#\n" +
+	"\rhas_favorites\x18\x05 \x01(\bR\fhasFavorites\x12&\n" +
+	"\x0fhas_device_sync\x18\x06 \x01(\bR\rhasDeviceSync\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\"\x88\x01\n" +
+	"\rGetMeResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.pixicast.v1.UserR\x04user\x12%\n" +
+	"\x04plan\x18\x02 \x01(\v2\x11.pixicast.v1.PlanR\x04plan\x12)\n" +
+	"\x10current_channels\x18\x03 \x01(\x03R\x0fcurrentChannels2\xb9\v\n" +
+	"\x13SubscriptionService\x12e\n" +
+	"\x12CreateSubscription\x12&.pixicast.v1.CreateSubscriptionRequest\x1a'.pixicast.v1.CreateSubscriptionResponse\x12b\n" +
+	"\x11ListSubscriptions\x12%.pixicast.v1.ListSubscriptionsRequest\x1a&.pixicast.v1.ListSubscriptionsResponse\x12e\n" +
+	"\x12DeleteSubscription\x12&.pixicast.v1.DeleteSubscriptionRequest\x1a'.pixicast.v1.DeleteSubscriptionResponse\x12Y\n" +
+	"\x0eToggleFavorite\x12\".pixicast.v1.ToggleFavoriteRequest\x1a#.pixicast.v1.ToggleFavoriteResponse\x12M\n" +
+	"\n" +
+	"SetEnabled\x12\x1e.pixicast.v1.SetEnabledRequest\x1a\x1f.pixicast.v1.SetEnabledResponse\x12P\n" +
+	"\vSetPriority\x12\x1f.pixicast.v1.SetPriorityRequest\x1a .pixicast.v1.SetPriorityResponse\x12k\n" +
+	"\x14ReorderSubscriptions\x12(.pixicast.v1.ReorderSubscriptionsRequest\x1a).pixicast.v1.ReorderSubscriptionsResponse\x12G\n" +
+	"\bListTags\x12\x1c.pixicast.v1.ListTagsRequest\x1a\x1d.pixicast.v1.ListTagsResponse\x12J\n" +
+	"\tCreateTag\x12\x1d.pixicast.v1.CreateTagRequest\x1a\x1e.pixicast.v1.CreateTagResponse\x12J\n" +
+	"\tUpdateTag\x12\x1d.pixicast.v1.UpdateTagRequest\x1a\x1e.pixicast.v1.UpdateTagResponse\x12J\n" +
+	"\tDeleteTag\x12\x1d.pixicast.v1.DeleteTagRequest\x1a\x1e.pixicast.v1.DeleteTagResponse\x12h\n" +
+	"\x13SetSubscriptionTags\x12'.pixicast.v1.SetSubscriptionTagsRequest\x1a(.pixicast.v1.SetSubscriptionTagsResponse\x12h\n" +
+	"\x13ImportSubscriptions\x12'.pixicast.v1.ImportSubscriptionsRequest\x1a(.pixicast.v1.ImportSubscriptionsResponse\x12h\n" +
+	"\x13ExportSubscriptions\x12'.pixicast.v1.ExportSubscriptionsRequest\x1a(.pixicast.v1.ExportSubscriptionsResponse\x12\\\n" +
+	"\x0fGetIngestStatus\x12#.pixicast.v1.GetIngestStatusRequest\x1a$.pixicast.v1.GetIngestStatusResponse\x12>\n" +
+	"\x05GetMe\x12\x19.pixicast.v1.GetMeRequest\x1a\x1a.pixicast.v1.GetMeResponseBEZCgithub.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1;pixicastv1b\x06proto3"
+
+var (
+	file_proto_pixicast_v1_subscription_proto_rawDescOnce sync.Once
+	file_proto_pixicast_v1_subscription_proto_rawDescData []byte
+)
+
+func file_proto_pixicast_v1_subscription_proto_rawDescGZIP() []byte {
+	file_proto_pixicast_v1_subscription_proto_rawDescOnce.Do(func() {
+		file_proto_pixicast_v1_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_pixicast_v1_subscription_proto_rawDesc), len(file_proto_pixicast_v1_subscription_proto_rawDesc)))
+	})
+	return file_proto_pixicast_v1_subscription_proto_rawDescData
+}
+
+var file_proto_pixicast_v1_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_proto_pixicast_v1_subscription_proto_goTypes = []any{
+	(*Subscription)(nil),                 // 0: pixicast.v1.Subscription
+	(*SubscriptionTag)(nil),              // 1: pixicast.v1.SubscriptionTag
+	(*CreateSubscriptionRequest)(nil),    // 2: pixicast.v1.CreateSubscriptionRequest
+	(*CreateSubscriptionResponse)(nil),   // 3: pixicast.v1.CreateSubscriptionResponse
+	(*ListSubscriptionsRequest)(nil),     // 4: pixicast.v1.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil),    // 5: pixicast.v1.ListSubscriptionsResponse
+	(*DeleteSubscriptionRequest)(nil),    // 6: pixicast.v1.DeleteSubscriptionRequest
+	(*DeleteSubscriptionResponse)(nil),   // 7: pixicast.v1.DeleteSubscriptionResponse
+	(*ToggleFavoriteRequest)(nil),        // 8: pixicast.v1.ToggleFavoriteRequest
+	(*ToggleFavoriteResponse)(nil),       // 9: pixicast.v1.ToggleFavoriteResponse
+	(*SetEnabledRequest)(nil),            // 10: pixicast.v1.SetEnabledRequest
+	(*SetEnabledResponse)(nil),           // 11: pixicast.v1.SetEnabledResponse
+	(*SetPriorityRequest)(nil),           // 12: pixicast.v1.SetPriorityRequest
+	(*SetPriorityResponse)(nil),          // 13: pixicast.v1.SetPriorityResponse
+	(*ReorderSubscriptionsRequest)(nil),  // 14: pixicast.v1.ReorderSubscriptionsRequest
+	(*ReorderSubscriptionsResponse)(nil), // 15: pixicast.v1.ReorderSubscriptionsResponse
+	(*ListTagsRequest)(nil),              // 16: pixicast.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),             // 17: pixicast.v1.ListTagsResponse
+	(*CreateTagRequest)(nil),             // 18: pixicast.v1.CreateTagRequest
+	(*CreateTagResponse)(nil),            // 19: pixicast.v1.CreateTagResponse
+	(*UpdateTagRequest)(nil),             // 20: pixicast.v1.UpdateTagRequest
+	(*UpdateTagResponse)(nil),            // 21: pixicast.v1.UpdateTagResponse
+	(*DeleteTagRequest)(nil),             // 22: pixicast.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),            // 23: pixicast.v1.DeleteTagResponse
+	(*SetSubscriptionTagsRequest)(nil),   // 24: pixicast.v1.SetSubscriptionTagsRequest
+	(*SetSubscriptionTagsResponse)(nil),  // 25: pixicast.v1.SetSubscriptionTagsResponse
+	(*ImportSubscriptionsRequest)(nil),   // 26: pixicast.v1.ImportSubscriptionsRequest
+	(*ImportResult)(nil),                 // 27: pixicast.v1.ImportResult
+	(*ImportSubscriptionsResponse)(nil),  // 28: pixicast.v1.ImportSubscriptionsResponse
+	(*ExportSubscriptionsRequest)(nil),   // 29: pixicast.v1.ExportSubscriptionsRequest
+	(*ExportSubscriptionsResponse)(nil),  // 30: pixicast.v1.ExportSubscriptionsResponse
+	(*GetIngestStatusRequest)(nil),       // 31: pixicast.v1.GetIngestStatusRequest
+	(*IngestStatus)(nil),                 // 32: pixicast.v1.IngestStatus
+	(*GetIngestStatusResponse)(nil),      // 33: pixicast.v1.GetIngestStatusResponse
+	(*GetMeRequest)(nil),                 // 34: pixicast.v1.GetMeRequest
+	(*User)(nil),                         // 35: pixicast.v1.User
+	(*Plan)(nil),                         // 36: pixicast.v1.Plan
+	(*GetMeResponse)(nil),                // 37: pixicast.v1.GetMeResponse
+}
+var file_proto_pixicast_v1_subscription_proto_depIdxs = []int32{
+	0,  // 0: pixicast.v1.CreateSubscriptionResponse.subscription:type_name -> pixicast.v1.Subscription
+	0,  // 1: pixicast.v1.ListSubscriptionsResponse.subscriptions:type_name -> pixicast.v1.Subscription
+	1,  // 2: pixicast.v1.ListSubscriptionsResponse.tags:type_name -> pixicast.v1.SubscriptionTag
+	0,  // 3: pixicast.v1.ToggleFavoriteResponse.subscription:type_name -> pixicast.v1.Subscription
+	0,  // 4: pixicast.v1.SetEnabledResponse.subscription:type_name -> pixicast.v1.Subscription
+	0,  // 5: pixicast.v1.SetPriorityResponse.subscription:type_name -> pixicast.v1.Subscription
+	0,  // 6: pixicast.v1.ReorderSubscriptionsResponse.subscriptions:type_name -> pixicast.v1.Subscription
+	1,  // 7: pixicast.v1.ListTagsResponse.tags:type_name -> pixicast.v1.SubscriptionTag
+	1,  // 8: pixicast.v1.CreateTagResponse.tag:type_name -> pixicast.v1.SubscriptionTag
+	1,  // 9: pixicast.v1.UpdateTagResponse.tag:type_name -> pixicast.v1.SubscriptionTag
+	0,  // 10: pixicast.v1.SetSubscriptionTagsResponse.subscription:type_name -> pixicast.v1.Subscription
+	27, // 11: pixicast.v1.ImportSubscriptionsResponse.results:type_name -> pixicast.v1.ImportResult
+	32, // 12: pixicast.v1.GetIngestStatusResponse.status:type_name -> pixicast.v1.IngestStatus
+	35, // 13: pixicast.v1.GetMeResponse.user:type_name -> pixicast.v1.User
+	36, // 14: pixicast.v1.GetMeResponse.plan:type_name -> pixicast.v1.Plan
+	2,  // 15: pixicast.v1.SubscriptionService.CreateSubscription:input_type -> pixicast.v1.CreateSubscriptionRequest
+	4,  // 16: pixicast.v1.SubscriptionService.ListSubscriptions:input_type -> pixicast.v1.ListSubscriptionsRequest
+	6,  // 17: pixicast.v1.SubscriptionService.DeleteSubscription:input_type -> pixicast.v1.DeleteSubscriptionRequest
+	8,  // 18: pixicast.v1.SubscriptionService.ToggleFavorite:input_type -> pixicast.v1.ToggleFavoriteRequest
+	10, // 19: pixicast.v1.SubscriptionService.SetEnabled:input_type -> pixicast.v1.SetEnabledRequest
+	12, // 20: pixicast.v1.SubscriptionService.SetPriority:input_type -> pixicast.v1.SetPriorityRequest
+	14, // 21: pixicast.v1.SubscriptionService.ReorderSubscriptions:input_type -> pixicast.v1.ReorderSubscriptionsRequest
+	16, // 22: pixicast.v1.SubscriptionService.ListTags:input_type -> pixicast.v1.ListTagsRequest
+	18, // 23: pixicast.v1.SubscriptionService.CreateTag:input_type -> pixicast.v1.CreateTagRequest
+	20, // 24: pixicast.v1.SubscriptionService.UpdateTag:input_type -> pixicast.v1.UpdateTagRequest
+	22, // 25: pixicast.v1.SubscriptionService.DeleteTag:input_type -> pixicast.v1.DeleteTagRequest
+	24, // 26: pixicast.v1.SubscriptionService.SetSubscriptionTags:input_type -> pixicast.v1.SetSubscriptionTagsRequest
+	26, // 27: pixicast.v1.SubscriptionService.ImportSubscriptions:input_type -> pixicast.v1.ImportSubscriptionsRequest
+	29, // 28: pixicast.v1.SubscriptionService.ExportSubscriptions:input_type -> pixicast.v1.ExportSubscriptionsRequest
+	31, // 29: pixicast.v1.SubscriptionService.GetIngestStatus:input_type -> pixicast.v1.GetIngestStatusRequest
+	34, // 30: pixicast.v1.SubscriptionService.GetMe:input_type -> pixicast.v1.GetMeRequest
+	3,  // 31: pixicast.v1.SubscriptionService.CreateSubscription:output_type -> pixicast.v1.CreateSubscriptionResponse
+	5,  // 32: pixicast.v1.SubscriptionService.ListSubscriptions:output_type -> pixicast.v1.ListSubscriptionsResponse
+	7,  // 33: pixicast.v1.SubscriptionService.DeleteSubscription:output_type -> pixicast.v1.DeleteSubscriptionResponse
+	9,  // 34: pixicast.v1.SubscriptionService.ToggleFavorite:output_type -> pixicast.v1.ToggleFavoriteResponse
+	11, // 35: pixicast.v1.SubscriptionService.SetEnabled:output_type -> pixicast.v1.SetEnabledResponse
+	13, // 36: pixicast.v1.SubscriptionService.SetPriority:output_type -> pixicast.v1.SetPriorityResponse
+	15, // 37: pixicast.v1.SubscriptionService.ReorderSubscriptions:output_type -> pixicast.v1.ReorderSubscriptionsResponse
+	17, // 38: pixicast.v1.SubscriptionService.ListTags:output_type -> pixicast.v1.ListTagsResponse
+	19, // 39: pixicast.v1.SubscriptionService.CreateTag:output_type -> pixicast.v1.CreateTagResponse
+	21, // 40: pixicast.v1.SubscriptionService.UpdateTag:output_type -> pixicast.v1.UpdateTagResponse
+	23, // 41: pixicast.v1.SubscriptionService.DeleteTag:output_type -> pixicast.v1.DeleteTagResponse
+	25, // 42: pixicast.v1.SubscriptionService.SetSubscriptionTags:output_type -> pixicast.v1.SetSubscriptionTagsResponse
+	28, // 43: pixicast.v1.SubscriptionService.ImportSubscriptions:output_type -> pixicast.v1.ImportSubscriptionsResponse
+	30, // 44: pixicast.v1.SubscriptionService.ExportSubscriptions:output_type -> pixicast.v1.ExportSubscriptionsResponse
+	33, // 45: pixicast.v1.SubscriptionService.GetIngestStatus:output_type -> pixicast.v1.GetIngestStatusResponse
+	37, // 46: pixicast.v1.SubscriptionService.GetMe:output_type -> pixicast.v1.GetMeResponse
+	31, // [31:47] is the sub-list for method output_type
+	15, // [15:31] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_proto_pixicast_v1_subscription_proto_init() }
+func file_proto_pixicast_v1_subscription_proto_init() {
+	if File_proto_pixicast_v1_subscription_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_pixicast_v1_subscription_proto_rawDesc), len(file_proto_pixicast_v1_subscription_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   38,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_pixicast_v1_subscription_proto_goTypes,
+		DependencyIndexes: file_proto_pixicast_v1_subscription_proto_depIdxs,
+		MessageInfos:      file_proto_pixicast_v1_subscription_proto_msgTypes,
+	}.Build()
+	File_proto_pixicast_v1_subscription_proto = out.File
+	file_proto_pixicast_v1_subscription_proto_goTypes = nil
+	file_proto_pixicast_v1_subscription_proto_depIdxs = nil
+}
@@ -21,13 +21,386 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 番組表の1日の区切り方
+type DayBoundary int32
+
+const (
+	DayBoundary_DAY_BOUNDARY_CALENDAR  DayBoundary = 0 // 暦日（0:00〜24:00）
+	DayBoundary_DAY_BOUNDARY_BROADCAST DayBoundary = 1 // 放送日（5:00〜29:00、radiko準拠）
+)
+
+// Enum value maps for DayBoundary.
+var (
+	DayBoundary_name = map[int32]string{
+		0: "DAY_BOUNDARY_CALENDAR",
+		1: "DAY_BOUNDARY_BROADCAST",
+	}
+	DayBoundary_value = map[string]int32{
+		"DAY_BOUNDARY_CALENDAR":  0,
+		"DAY_BOUNDARY_BROADCAST": 1,
+	}
+)
+
+func (x DayBoundary) Enum() *DayBoundary {
+	p := new(DayBoundary)
+	*p = x
+	return p
+}
+
+func (x DayBoundary) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DayBoundary) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pixicast_v1_timeline_proto_enumTypes[0].Descriptor()
+}
+
+func (DayBoundary) Type() protoreflect.EnumType {
+	return &file_proto_pixicast_v1_timeline_proto_enumTypes[0]
+}
+
+func (x DayBoundary) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DayBoundary.Descriptor instead.
+func (DayBoundary) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{0}
+}
+
+// ページング方向（表示順に対する向き）
+type PageDirection int32
+
+const (
+	PageDirection_PAGE_DIRECTION_FORWARD  PageDirection = 0 // 続きを取得（タイムラインは古い方向、番組表は遅い時刻方向）。next_cursorと組み合わせる
+	PageDirection_PAGE_DIRECTION_BACKWARD PageDirection = 1 // 手前を取得（タイムラインは新しい方向、番組表は早い時刻方向）。prev_cursorと組み合わせる
+)
+
+// Enum value maps for PageDirection.
+var (
+	PageDirection_name = map[int32]string{
+		0: "PAGE_DIRECTION_FORWARD",
+		1: "PAGE_DIRECTION_BACKWARD",
+	}
+	PageDirection_value = map[string]int32{
+		"PAGE_DIRECTION_FORWARD":  0,
+		"PAGE_DIRECTION_BACKWARD": 1,
+	}
+)
+
+func (x PageDirection) Enum() *PageDirection {
+	p := new(PageDirection)
+	*p = x
+	return p
+}
+
+func (x PageDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PageDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pixicast_v1_timeline_proto_enumTypes[1].Descriptor()
+}
+
+func (PageDirection) Type() protoreflect.EnumType {
+	return &file_proto_pixicast_v1_timeline_proto_enumTypes[1]
+}
+
+func (x PageDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PageDirection.Descriptor instead.
+func (PageDirection) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{1}
+}
+
+// タイムラインの並び順
+type TimelineSort int32
+
+const (
+	TimelineSort_TIMELINE_SORT_NEWEST   TimelineSort = 0 // 新着順
+	TimelineSort_TIMELINE_SORT_PRIORITY TimelineSort = 1 // 購読の優先度が高いチャンネル順（同じ優先度の中は新着順）
+)
+
+// Enum value maps for TimelineSort.
+var (
+	TimelineSort_name = map[int32]string{
+		0: "TIMELINE_SORT_NEWEST",
+		1: "TIMELINE_SORT_PRIORITY",
+	}
+	TimelineSort_value = map[string]int32{
+		"TIMELINE_SORT_NEWEST":   0,
+		"TIMELINE_SORT_PRIORITY": 1,
+	}
+)
+
+func (x TimelineSort) Enum() *TimelineSort {
+	p := new(TimelineSort)
+	*p = x
+	return p
+}
+
+func (x TimelineSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelineSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pixicast_v1_timeline_proto_enumTypes[2].Descriptor()
+}
+
+func (TimelineSort) Type() protoreflect.EnumType {
+	return &file_proto_pixicast_v1_timeline_proto_enumTypes[2]
+}
+
+func (x TimelineSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineSort.Descriptor instead.
+func (TimelineSort) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{2}
+}
+
+// タイムライン変更の種類
+type TimelineChangeType int32
+
+const (
+	TimelineChangeType_TIMELINE_CHANGE_TYPE_UNSPECIFIED TimelineChangeType = 0
+	TimelineChangeType_TIMELINE_CHANGE_TYPE_INSERTED    TimelineChangeType = 1 // 新規追加
+	TimelineChangeType_TIMELINE_CHANGE_TYPE_UPDATED     TimelineChangeType = 2 // 更新（タイトル・配信状態・再生回数など）
+	TimelineChangeType_TIMELINE_CHANGE_TYPE_REMOVED     TimelineChangeType = 3 // 削除
+)
+
+// Enum value maps for TimelineChangeType.
+var (
+	TimelineChangeType_name = map[int32]string{
+		0: "TIMELINE_CHANGE_TYPE_UNSPECIFIED",
+		1: "TIMELINE_CHANGE_TYPE_INSERTED",
+		2: "TIMELINE_CHANGE_TYPE_UPDATED",
+		3: "TIMELINE_CHANGE_TYPE_REMOVED",
+	}
+	TimelineChangeType_value = map[string]int32{
+		"TIMELINE_CHANGE_TYPE_UNSPECIFIED": 0,
+		"TIMELINE_CHANGE_TYPE_INSERTED":    1,
+		"TIMELINE_CHANGE_TYPE_UPDATED":     2,
+		"TIMELINE_CHANGE_TYPE_REMOVED":     3,
+	}
+)
+
+func (x TimelineChangeType) Enum() *TimelineChangeType {
+	p := new(TimelineChangeType)
+	*p = x
+	return p
+}
+
+func (x TimelineChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelineChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pixicast_v1_timeline_proto_enumTypes[3].Descriptor()
+}
+
+func (TimelineChangeType) Type() protoreflect.EnumType {
+	return &file_proto_pixicast_v1_timeline_proto_enumTypes[3]
+}
+
+func (x TimelineChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineChangeType.Descriptor instead.
+func (TimelineChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{3}
+}
+
+// 番組の状態（ユーザーごと）
+type EventState int32
+
+const (
+	EventState_EVENT_STATE_UNSPECIFIED EventState = 0
+	EventState_EVENT_STATE_WATCHED     EventState = 1 // 視聴済み
+	EventState_EVENT_STATE_HIDDEN      EventState = 2 // 非表示
+	EventState_EVENT_STATE_DISMISSED   EventState = 3 // 却下
+)
+
+// Enum value maps for EventState.
+var (
+	EventState_name = map[int32]string{
+		0: "EVENT_STATE_UNSPECIFIED",
+		1: "EVENT_STATE_WATCHED",
+		2: "EVENT_STATE_HIDDEN",
+		3: "EVENT_STATE_DISMISSED",
+	}
+	EventState_value = map[string]int32{
+		"EVENT_STATE_UNSPECIFIED": 0,
+		"EVENT_STATE_WATCHED":     1,
+		"EVENT_STATE_HIDDEN":      2,
+		"EVENT_STATE_DISMISSED":   3,
+	}
+)
+
+func (x EventState) Enum() *EventState {
+	p := new(EventState)
+	*p = x
+	return p
+}
+
+func (x EventState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pixicast_v1_timeline_proto_enumTypes[4].Descriptor()
+}
+
+func (EventState) Type() protoreflect.EnumType {
+	return &file_proto_pixicast_v1_timeline_proto_enumTypes[4]
+}
+
+func (x EventState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventState.Descriptor instead.
+func (EventState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{4}
+}
+
+// ミュートルールの種類
+type MuteRuleKind int32
+
+const (
+	MuteRuleKind_MUTE_RULE_KIND_UNSPECIFIED  MuteRuleKind = 0
+	MuteRuleKind_MUTE_RULE_KIND_KEYWORD      MuteRuleKind = 1 // タイトル・説明文にキーワードを含む（全角半角・ひらがなカタカナ・大文字小文字は区別しない）
+	MuteRuleKind_MUTE_RULE_KIND_REGEX        MuteRuleKind = 2 // タイトル・説明文が正規表現（RE2）にマッチする
+	MuteRuleKind_MUTE_RULE_KIND_GAME_NAME    MuteRuleKind = 3 // Twitchの配信カテゴリ（ゲーム名）が一致する
+	MuteRuleKind_MUTE_RULE_KIND_MIN_DURATION MuteRuleKind = 4 // 長さがduration_secondsより短い
+	MuteRuleKind_MUTE_RULE_KIND_MAX_DURATION MuteRuleKind = 5 // 長さがduration_secondsより長い
+	MuteRuleKind_MUTE_RULE_KIND_EVENT_TYPE   MuteRuleKind = 6 // 種別が一致する（live / scheduled / video / premiere / radio / episode）
+)
+
+// Enum value maps for MuteRuleKind.
+var (
+	MuteRuleKind_name = map[int32]string{
+		0: "MUTE_RULE_KIND_UNSPECIFIED",
+		1: "MUTE_RULE_KIND_KEYWORD",
+		2: "MUTE_RULE_KIND_REGEX",
+		3: "MUTE_RULE_KIND_GAME_NAME",
+		4: "MUTE_RULE_KIND_MIN_DURATION",
+		5: "MUTE_RULE_KIND_MAX_DURATION",
+		6: "MUTE_RULE_KIND_EVENT_TYPE",
+	}
+	MuteRuleKind_value = map[string]int32{
+		"MUTE_RULE_KIND_UNSPECIFIED":  0,
+		"MUTE_RULE_KIND_KEYWORD":      1,
+		"MUTE_RULE_KIND_REGEX":        2,
+		"MUTE_RULE_KIND_GAME_NAME":    3,
+		"MUTE_RULE_KIND_MIN_DURATION": 4,
+		"MUTE_RULE_KIND_MAX_DURATION": 5,
+		"MUTE_RULE_KIND_EVENT_TYPE":   6,
+	}
+)
+
+func (x MuteRuleKind) Enum() *MuteRuleKind {
+	p := new(MuteRuleKind)
+	*p = x
+	return p
+}
+
+func (x MuteRuleKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MuteRuleKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pixicast_v1_timeline_proto_enumTypes[5].Descriptor()
+}
+
+func (MuteRuleKind) Type() protoreflect.EnumType {
+	return &file_proto_pixicast_v1_timeline_proto_enumTypes[5]
+}
+
+func (x MuteRuleKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MuteRuleKind.Descriptor instead.
+func (MuteRuleKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{5}
+}
+
+// キーワード・正規表現の対象
+type MuteRuleField int32
+
+const (
+	MuteRuleField_MUTE_RULE_FIELD_ALL         MuteRuleField = 0 // タイトルと説明文
+	MuteRuleField_MUTE_RULE_FIELD_TITLE       MuteRuleField = 1 // タイトルのみ
+	MuteRuleField_MUTE_RULE_FIELD_DESCRIPTION MuteRuleField = 2 // 説明文のみ
+)
+
+// Enum value maps for MuteRuleField.
+var (
+	MuteRuleField_name = map[int32]string{
+		0: "MUTE_RULE_FIELD_ALL",
+		1: "MUTE_RULE_FIELD_TITLE",
+		2: "MUTE_RULE_FIELD_DESCRIPTION",
+	}
+	MuteRuleField_value = map[string]int32{
+		"MUTE_RULE_FIELD_ALL":         0,
+		"MUTE_RULE_FIELD_TITLE":       1,
+		"MUTE_RULE_FIELD_DESCRIPTION": 2,
+	}
+)
+
+func (x MuteRuleField) Enum() *MuteRuleField {
+	p := new(MuteRuleField)
+	*p = x
+	return p
+}
+
+func (x MuteRuleField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MuteRuleField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pixicast_v1_timeline_proto_enumTypes[6].Descriptor()
+}
+
+func (MuteRuleField) Type() protoreflect.EnumType {
+	return &file_proto_pixicast_v1_timeline_proto_enumTypes[6]
+}
+
+func (x MuteRuleField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MuteRuleField.Descriptor instead.
+func (MuteRuleField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{6}
+}
+
 // リクエストの定義
 type GetTimelineRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
-	Date              string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	YoutubeChannelIds []string               `protobuf:"bytes,2,rep,name=youtube_channel_ids,json=youtubeChannelIds,proto3" json:"youtube_channel_ids,omitempty"` // YouTubeチャンネルIDのリスト
-	BeforeTime        string                 `protobuf:"bytes,3,opt,name=before_time,json=beforeTime,proto3" json:"before_time,omitempty"`                        // ページング用：この時刻より前のイベントを取得（RFC3339形式）
-	Limit             int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                   // 取得件数（デフォルト50、最大100）
+	Date              string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                                                // 番組表表示用：指定日（YYYY-MM-DD）の番組を開始時刻の昇順で取得（空の場合は新着順のタイムライン）
+	YoutubeChannelIds []string               `protobuf:"bytes,2,rep,name=youtube_channel_ids,json=youtubeChannelIds,proto3" json:"youtube_channel_ids,omitempty"`           // チャンネルの外部IDのリスト（YouTube以外のプラットフォームも可）
+	BeforeTime        string                 `protobuf:"bytes,3,opt,name=before_time,json=beforeTime,proto3" json:"before_time,omitempty"`                                  // 非推奨（cursorを使用）：この時刻より前のイベントを取得（RFC3339形式）
+	Limit             int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                             // 取得件数（デフォルト50、最大100。date指定時はデフォルト500、最大1000）
+	Timezone          string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                        // date指定時の日付の区切りに使うIANAタイムゾーン（デフォルト: Asia/Tokyo）
+	DayBoundary       DayBoundary            `protobuf:"varint,6,opt,name=day_boundary,json=dayBoundary,proto3,enum=pixicast.v1.DayBoundary" json:"day_boundary,omitempty"` // date指定時の1日の区切り方
+	Cursor            string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                            // ページング用：前回レスポンスのnext_cursorまたはprev_cursor
+	Direction         PageDirection          `protobuf:"varint,8,opt,name=direction,proto3,enum=pixicast.v1.PageDirection" json:"direction,omitempty"`                      // cursorからどちら方向のページを取得するか
+	PlatformIds       []string               `protobuf:"bytes,9,rep,name=platform_ids,json=platformIds,proto3" json:"platform_ids,omitempty"`                               // プラットフォームで絞り込み（youtube / twitch / podcast / radiko）
+	EventTypes        []string               `protobuf:"bytes,10,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`                                 // 種別で絞り込み（live / scheduled / video / premiere / radio / episode）
+	FavoritesOnly     bool                   `protobuf:"varint,11,opt,name=favorites_only,json=favoritesOnly,proto3" json:"favorites_only,omitempty"`                       // お気に入りのチャンネルのみ
+	SourceIds         []string               `protobuf:"bytes,12,rep,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`                                    // ソースID（購読一覧のsource_id）で絞り込み
+	ExcludeWatched    bool                   `protobuf:"varint,13,opt,name=exclude_watched,json=excludeWatched,proto3" json:"exclude_watched,omitempty"`                    // 視聴済みの番組を除外
+	ExcludeHidden     bool                   `protobuf:"varint,14,opt,name=exclude_hidden,json=excludeHidden,proto3" json:"exclude_hidden,omitempty"`                       // 非表示にした番組を除外
+	IncludeMuted      bool                   `protobuf:"varint,15,opt,name=include_muted,json=includeMuted,proto3" json:"include_muted,omitempty"`                          // ミュートルールにマッチする番組も含める
+	ViewId            string                 `protobuf:"bytes,16,opt,name=view_id,json=viewId,proto3" json:"view_id,omitempty"`                                             // 保存したビューのIDを指定すると、ビューの式を満たす番組のみ取得
+	Sort              TimelineSort           `protobuf:"varint,17,opt,name=sort,proto3,enum=pixicast.v1.TimelineSort" json:"sort,omitempty"`                                // タイムラインの並び順（date指定時は指定不可）
+	TagIds            []string               `protobuf:"bytes,18,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`                                             // タグIDを指定すると、いずれかのタグを割り当てた購読の番組のみ取得
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -90,12 +463,111 @@ func (x *GetTimelineRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GetTimelineRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetTimelineRequest) GetDayBoundary() DayBoundary {
+	if x != nil {
+		return x.DayBoundary
+	}
+	return DayBoundary_DAY_BOUNDARY_CALENDAR
+}
+
+func (x *GetTimelineRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetTimelineRequest) GetDirection() PageDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return PageDirection_PAGE_DIRECTION_FORWARD
+}
+
+func (x *GetTimelineRequest) GetPlatformIds() []string {
+	if x != nil {
+		return x.PlatformIds
+	}
+	return nil
+}
+
+func (x *GetTimelineRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *GetTimelineRequest) GetFavoritesOnly() bool {
+	if x != nil {
+		return x.FavoritesOnly
+	}
+	return false
+}
+
+func (x *GetTimelineRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+func (x *GetTimelineRequest) GetExcludeWatched() bool {
+	if x != nil {
+		return x.ExcludeWatched
+	}
+	return false
+}
+
+func (x *GetTimelineRequest) GetExcludeHidden() bool {
+	if x != nil {
+		return x.ExcludeHidden
+	}
+	return false
+}
+
+func (x *GetTimelineRequest) GetIncludeMuted() bool {
+	if x != nil {
+		return x.IncludeMuted
+	}
+	return false
+}
+
+func (x *GetTimelineRequest) GetViewId() string {
+	if x != nil {
+		return x.ViewId
+	}
+	return ""
+}
+
+func (x *GetTimelineRequest) GetSort() TimelineSort {
+	if x != nil {
+		return x.Sort
+	}
+	return TimelineSort_TIMELINE_SORT_NEWEST
+}
+
+func (x *GetTimelineRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
 // レスポンスの定義
 type GetTimelineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Programs      []*Program             `protobuf:"bytes,1,rep,name=programs,proto3" json:"programs,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`         // 次のページがあるかどうか
-	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 次のページ取得用のカーソル（最後のイベントの時刻）
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`         // 指定方向に次のページがあるかどうか
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 続きのページ取得用のカーソル（最後のイベントの位置）
+	PrevCursor    string                 `protobuf:"bytes,4,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"` // 手前のページ取得用のカーソル（先頭のイベントの位置）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -151,6 +623,13 @@ func (x *GetTimelineResponse) GetNextCursor() string {
 	return ""
 }
 
+func (x *GetTimelineResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
 // 番組データの定義
 type Program struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
@@ -168,6 +647,11 @@ type Program struct {
 	PublishedAt         string                 `protobuf:"bytes,12,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`                           // 公開日時
 	ViewCount           int64                  `protobuf:"varint,13,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`                                // 再生回数
 	ChannelThumbnailUrl string                 `protobuf:"bytes,14,opt,name=channel_thumbnail_url,json=channelThumbnailUrl,proto3" json:"channel_thumbnail_url,omitempty"` // チャンネルアイコンURL
+	StartsInSeconds     int64                  `protobuf:"varint,15,opt,name=starts_in_seconds,json=startsInSeconds,proto3" json:"starts_in_seconds,omitempty"`            // 開始までの秒数（カウントダウン表示用。開始済みの場合は負数、start_atがない場合は0）
+	Watched             bool                   `protobuf:"varint,16,opt,name=watched,proto3" json:"watched,omitempty"`                                                     // 視聴済み
+	Hidden              bool                   `protobuf:"varint,17,opt,name=hidden,proto3" json:"hidden,omitempty"`                                                       // 非表示
+	Dismissed           bool                   `protobuf:"varint,18,opt,name=dismissed,proto3" json:"dismissed,omitempty"`                                                 // 却下
+	AlternateLinks      []*AlternateLink       `protobuf:"bytes,19,rep,name=alternate_links,json=alternateLinks,proto3" json:"alternate_links,omitempty"`                  // 同時配信している他のプラットフォームの番組（リンクしたソースのみ）
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -300,22 +784,126 @@ func (x *Program) GetChannelThumbnailUrl() string {
 	return ""
 }
 
-// YouTubeライブ配信検索リクエスト
-type SearchYouTubeLiveRequest struct {
+func (x *Program) GetStartsInSeconds() int64 {
+	if x != nil {
+		return x.StartsInSeconds
+	}
+	return 0
+}
+
+func (x *Program) GetWatched() bool {
+	if x != nil {
+		return x.Watched
+	}
+	return false
+}
+
+func (x *Program) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Program) GetDismissed() bool {
+	if x != nil {
+		return x.Dismissed
+	}
+	return false
+}
+
+func (x *Program) GetAlternateLinks() []*AlternateLink {
+	if x != nil {
+		return x.AlternateLinks
+	}
+	return nil
+}
+
+// 同時配信している他のプラットフォームの番組
+type AlternateLink struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                              // 検索キーワード
-	MaxResults    int32                  `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"` // 最大取得件数（デフォルト10、最大50）
+	ProgramId     string                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	PlatformName  string                 `protobuf:"bytes,2,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	LinkUrl       string                 `protobuf:"bytes,3,opt,name=link_url,json=linkUrl,proto3" json:"link_url,omitempty"`
+	ChannelTitle  string                 `protobuf:"bytes,4,opt,name=channel_title,json=channelTitle,proto3" json:"channel_title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchYouTubeLiveRequest) Reset() {
-	*x = SearchYouTubeLiveRequest{}
+func (x *AlternateLink) Reset() {
+	*x = AlternateLink{}
 	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
+func (x *AlternateLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlternateLink) ProtoMessage() {}
+
+func (x *AlternateLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlternateLink.ProtoReflect.Descriptor instead.
+func (*AlternateLink) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AlternateLink) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *AlternateLink) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *AlternateLink) GetLinkUrl() string {
+	if x != nil {
+		return x.LinkUrl
+	}
+	return ""
+}
+
+func (x *AlternateLink) GetChannelTitle() string {
+	if x != nil {
+		return x.ChannelTitle
+	}
+	return ""
+}
+
+// YouTubeライブ配信検索リクエスト
+type SearchYouTubeLiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                              // 検索キーワード
+	MaxResults    int32                  `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"` // 最大取得件数（デフォルト10、最大50）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchYouTubeLiveRequest) Reset() {
+	*x = SearchYouTubeLiveRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
 func (x *SearchYouTubeLiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
@@ -323,7 +911,7 @@ func (x *SearchYouTubeLiveRequest) String() string {
 func (*SearchYouTubeLiveRequest) ProtoMessage() {}
 
 func (x *SearchYouTubeLiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[3]
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +924,7 @@ func (x *SearchYouTubeLiveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchYouTubeLiveRequest.ProtoReflect.Descriptor instead.
 func (*SearchYouTubeLiveRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{3}
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SearchYouTubeLiveRequest) GetQuery() string {
@@ -363,7 +951,7 @@ type SearchYouTubeLiveResponse struct {
 
 func (x *SearchYouTubeLiveResponse) Reset() {
 	*x = SearchYouTubeLiveResponse{}
-	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[4]
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -375,7 +963,7 @@ func (x *SearchYouTubeLiveResponse) String() string {
 func (*SearchYouTubeLiveResponse) ProtoMessage() {}
 
 func (x *SearchYouTubeLiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[4]
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,7 +976,7 @@ func (x *SearchYouTubeLiveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchYouTubeLiveResponse.ProtoReflect.Descriptor instead.
 func (*SearchYouTubeLiveResponse) Descriptor() ([]byte, []int) {
-	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{4}
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SearchYouTubeLiveResponse) GetStreams() []*YouTubeLiveStream {
@@ -413,7 +1001,7 @@ type YouTubeLiveStream struct {
 
 func (x *YouTubeLiveStream) Reset() {
 	*x = YouTubeLiveStream{}
-	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[5]
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -425,7 +1013,7 @@ func (x *YouTubeLiveStream) String() string {
 func (*YouTubeLiveStream) ProtoMessage() {}
 
 func (x *YouTubeLiveStream) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[5]
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +1026,7 @@ func (x *YouTubeLiveStream) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use YouTubeLiveStream.ProtoReflect.Descriptor instead.
 func (*YouTubeLiveStream) Descriptor() ([]byte, []int) {
-	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{5}
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *YouTubeLiveStream) GetVideoId() string {
@@ -483,55 +1071,2688 @@ func (x *YouTubeLiveStream) GetPublishedAt() string {
 	return ""
 }
 
-var File_proto_pixicast_v1_timeline_proto protoreflect.FileDescriptor
+// タイムライン変更監視リクエスト
+type WatchTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // 再接続用：最後に受信したレスポンスのcursor（空の場合は接続時点以降の変更のみ。保持期間を過ぎたカーソルはFAILED_PRECONDITIONを返すため、タイムラインを再取得してcursorなしで接続し直す）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_pixicast_v1_timeline_proto_rawDesc = "" +
-	"\n" +
-	" proto/pixicast/v1/timeline.proto\x12\vpixicast.v1\"\x8f\x01\n" +
-	"\x12GetTimelineRequest\x12\x12\n" +
-	"\x04date\x18\x01 \x01(\tR\x04date\x12.\n" +
-	"\x13youtube_channel_ids\x18\x02 \x03(\tR\x11youtubeChannelIds\x12\x1f\n" +
-	"\vbefore_time\x18\x03 \x01(\tR\n" +
-	"beforeTime\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x83\x01\n" +
-	"\x13GetTimelineResponse\x120\n" +
-	"\bprograms\x18\x01 \x03(\v2\x14.pixicast.v1.ProgramR\bprograms\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
-	"\vnext_cursor\x18\x03 \x01(\tR\n" +
-	"nextCursor\"\xb0\x03\n" +
-	"\aProgram\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x19\n" +
-	"\bstart_at\x18\x03 \x01(\tR\astartAt\x12\x15\n" +
-	"\x06end_at\x18\x04 \x01(\tR\x05endAt\x12#\n" +
-	"\rplatform_name\x18\x05 \x01(\tR\fplatformName\x12\x1b\n" +
-	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x19\n" +
-	"\blink_url\x18\a \x01(\tR\alinkUrl\x12\x17\n" +
-	"\ais_live\x18\b \x01(\bR\x06isLive\x12#\n" +
-	"\rchannel_title\x18\t \x01(\tR\fchannelTitle\x12 \n" +
-	"\vdescription\x18\n" +
-	" \x01(\tR\vdescription\x12\x1a\n" +
-	"\bduration\x18\v \x01(\tR\bduration\x12!\n" +
-	"\fpublished_at\x18\f \x01(\tR\vpublishedAt\x12\x1d\n" +
-	"\n" +
-	"view_count\x18\r \x01(\x03R\tviewCount\x122\n" +
-	"\x15channel_thumbnail_url\x18\x0e \x01(\tR\x13channelThumbnailUrl\"Q\n" +
-	"\x18SearchYouTubeLiveRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1f\n" +
-	"\vmax_results\x18\x02 \x01(\x05R\n" +
-	"maxResults\"U\n" +
-	"\x19SearchYouTubeLiveResponse\x128\n" +
-	"\astreams\x18\x01 \x03(\v2\x1e.pixicast.v1.YouTubeLiveStreamR\astreams\"\xd3\x01\n" +
-	"\x11YouTubeLiveStream\x12\x19\n" +
-	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12#\n" +
-	"\rchannel_title\x18\x03 \x01(\tR\fchannelTitle\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
-	"\rthumbnail_url\x18\x05 \x01(\tR\fthumbnailUrl\x12!\n" +
-	"\fpublished_at\x18\x06 \x01(\tR\vpublishedAt2\xc7\x01\n" +
+func (x *WatchTimelineRequest) Reset() {
+	*x = WatchTimelineRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTimelineRequest) ProtoMessage() {}
+
+func (x *WatchTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTimelineRequest.ProtoReflect.Descriptor instead.
+func (*WatchTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WatchTimelineRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// タイムライン変更監視レスポンス
+type WatchTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          TimelineChangeType     `protobuf:"varint,1,opt,name=type,proto3,enum=pixicast.v1.TimelineChangeType" json:"type,omitempty"`
+	ProgramId     string                 `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"` // 変更された番組ID
+	Program       *Program               `protobuf:"bytes,3,opt,name=program,proto3" json:"program,omitempty"`                      // 変更後の番組データ（削除時は空）
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                        // 再接続時に指定するカーソル
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTimelineResponse) Reset() {
+	*x = WatchTimelineResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTimelineResponse) ProtoMessage() {}
+
+func (x *WatchTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTimelineResponse.ProtoReflect.Descriptor instead.
+func (*WatchTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchTimelineResponse) GetType() TimelineChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return TimelineChangeType_TIMELINE_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *WatchTimelineResponse) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *WatchTimelineResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *WatchTimelineResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// 配信中番組取得リクエスト
+type ListLiveNowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 取得件数（デフォルト20、最大100）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLiveNowRequest) Reset() {
+	*x = ListLiveNowRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLiveNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLiveNowRequest) ProtoMessage() {}
+
+func (x *ListLiveNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLiveNowRequest.ProtoReflect.Descriptor instead.
+func (*ListLiveNowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListLiveNowRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 配信中番組取得レスポンス
+type ListLiveNowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Programs      []*Program             `protobuf:"bytes,1,rep,name=programs,proto3" json:"programs,omitempty"` // 開始時刻の新しい順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLiveNowResponse) Reset() {
+	*x = ListLiveNowResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLiveNowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLiveNowResponse) ProtoMessage() {}
+
+func (x *ListLiveNowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLiveNowResponse.ProtoReflect.Descriptor instead.
+func (*ListLiveNowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListLiveNowResponse) GetPrograms() []*Program {
+	if x != nil {
+		return x.Programs
+	}
+	return nil
+}
+
+// 配信予定番組取得リクエスト
+type ListUpcomingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 取得件数（デフォルト20、最大100）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingRequest) Reset() {
+	*x = ListUpcomingRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingRequest) ProtoMessage() {}
+
+func (x *ListUpcomingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingRequest.ProtoReflect.Descriptor instead.
+func (*ListUpcomingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListUpcomingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 配信予定番組取得レスポンス
+type ListUpcomingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Programs      []*Program             `protobuf:"bytes,1,rep,name=programs,proto3" json:"programs,omitempty"` // 開始時刻の早い順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingResponse) Reset() {
+	*x = ListUpcomingResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingResponse) ProtoMessage() {}
+
+func (x *ListUpcomingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingResponse.ProtoReflect.Descriptor instead.
+func (*ListUpcomingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListUpcomingResponse) GetPrograms() []*Program {
+	if x != nil {
+		return x.Programs
+	}
+	return nil
+}
+
+// タイムライン検索リクエスト
+type SearchTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                       // 検索キーワード（空白区切りですべてを含む番組を検索。全角半角・ひらがなカタカナ・大文字小文字は区別しない）
+	PlatformIds   []string               `protobuf:"bytes,2,rep,name=platform_ids,json=platformIds,proto3" json:"platform_ids,omitempty"`        // プラットフォームで絞り込み（youtube / twitch / podcast / radiko）
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`           // 種別で絞り込み（live / scheduled / video / premiere / radio / episode）
+	FavoritesOnly bool                   `protobuf:"varint,4,opt,name=favorites_only,json=favoritesOnly,proto3" json:"favorites_only,omitempty"` // お気に入りのチャンネルのみ
+	SourceIds     []string               `protobuf:"bytes,5,rep,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`              // ソースID（購読一覧のsource_id）で絞り込み
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`                                     // ページング用：前回レスポンスのnext_cursor
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                      // 取得件数（デフォルト20、最大50）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTimelineRequest) Reset() {
+	*x = SearchTimelineRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTimelineRequest) ProtoMessage() {}
+
+func (x *SearchTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTimelineRequest.ProtoReflect.Descriptor instead.
+func (*SearchTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchTimelineRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTimelineRequest) GetPlatformIds() []string {
+	if x != nil {
+		return x.PlatformIds
+	}
+	return nil
+}
+
+func (x *SearchTimelineRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *SearchTimelineRequest) GetFavoritesOnly() bool {
+	if x != nil {
+		return x.FavoritesOnly
+	}
+	return false
+}
+
+func (x *SearchTimelineRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+func (x *SearchTimelineRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchTimelineRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// タイムライン検索レスポンス
+type SearchTimelineResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*SearchTimelineResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`                         // 新着順
+	HasMore       bool                    `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`         // 次のページがあるかどうか
+	NextCursor    string                  `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 次のページ取得用のカーソル
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTimelineResponse) Reset() {
+	*x = SearchTimelineResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTimelineResponse) ProtoMessage() {}
+
+func (x *SearchTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTimelineResponse.ProtoReflect.Descriptor instead.
+func (*SearchTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchTimelineResponse) GetResults() []*SearchTimelineResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchTimelineResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *SearchTimelineResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// タイムライン検索結果
+type SearchTimelineResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Program            *Program               `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
+	TitleSnippet       []*SnippetSegment      `protobuf:"bytes,2,rep,name=title_snippet,json=titleSnippet,proto3" json:"title_snippet,omitempty"`                   // ハイライト付きのタイトル
+	DescriptionSnippet []*SnippetSegment      `protobuf:"bytes,3,rep,name=description_snippet,json=descriptionSnippet,proto3" json:"description_snippet,omitempty"` // マッチ箇所周辺の説明文の抜粋
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SearchTimelineResult) Reset() {
+	*x = SearchTimelineResult{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTimelineResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTimelineResult) ProtoMessage() {}
+
+func (x *SearchTimelineResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTimelineResult.ProtoReflect.Descriptor instead.
+func (*SearchTimelineResult) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchTimelineResult) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *SearchTimelineResult) GetTitleSnippet() []*SnippetSegment {
+	if x != nil {
+		return x.TitleSnippet
+	}
+	return nil
+}
+
+func (x *SearchTimelineResult) GetDescriptionSnippet() []*SnippetSegment {
+	if x != nil {
+		return x.DescriptionSnippet
+	}
+	return nil
+}
+
+// スニペットの一部分
+type SnippetSegment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Highlighted   bool                   `protobuf:"varint,2,opt,name=highlighted,proto3" json:"highlighted,omitempty"` // 検索語にマッチした部分かどうか
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnippetSegment) Reset() {
+	*x = SnippetSegment{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnippetSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnippetSegment) ProtoMessage() {}
+
+func (x *SnippetSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnippetSegment.ProtoReflect.Descriptor instead.
+func (*SnippetSegment) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SnippetSegment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SnippetSegment) GetHighlighted() bool {
+	if x != nil {
+		return x.Highlighted
+	}
+	return false
+}
+
+// 番組状態の設定リクエスト
+type SetEventStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramIds    []string               `protobuf:"bytes,1,rep,name=program_ids,json=programIds,proto3" json:"program_ids,omitempty"` // 対象の番組ID（最大500件）
+	State         EventState             `protobuf:"varint,2,opt,name=state,proto3,enum=pixicast.v1.EventState" json:"state,omitempty"`
+	Value         bool                   `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"` // trueで設定、falseで解除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEventStateRequest) Reset() {
+	*x = SetEventStateRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEventStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventStateRequest) ProtoMessage() {}
+
+func (x *SetEventStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventStateRequest.ProtoReflect.Descriptor instead.
+func (*SetEventStateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetEventStateRequest) GetProgramIds() []string {
+	if x != nil {
+		return x.ProgramIds
+	}
+	return nil
+}
+
+func (x *SetEventStateRequest) GetState() EventState {
+	if x != nil {
+		return x.State
+	}
+	return EventState_EVENT_STATE_UNSPECIFIED
+}
+
+func (x *SetEventStateRequest) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+// 番組状態の設定レスポンス
+type SetEventStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedCount  int64                  `protobuf:"varint,1,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"` // 更新した番組数（購読していないチャンネルの番組は対象外）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEventStateResponse) Reset() {
+	*x = SetEventStateResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEventStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventStateResponse) ProtoMessage() {}
+
+func (x *SetEventStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventStateResponse.ProtoReflect.Descriptor instead.
+func (*SetEventStateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetEventStateResponse) GetUpdatedCount() int64 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+// 番組状態の一括設定リクエスト
+type MarkEventsBeforeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BeforeTime    string                 `protobuf:"bytes,1,opt,name=before_time,json=beforeTime,proto3" json:"before_time,omitempty"` // この日時より前の番組が対象（RFC3339形式、番組の開始・公開日時で判定）
+	State         EventState             `protobuf:"varint,2,opt,name=state,proto3,enum=pixicast.v1.EventState" json:"state,omitempty"`
+	Value         bool                   `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`                               // trueで設定、falseで解除
+	PlatformIds   []string               `protobuf:"bytes,4,rep,name=platform_ids,json=platformIds,proto3" json:"platform_ids,omitempty"` // プラットフォームで絞り込み（youtube / twitch / podcast / radiko）
+	EventTypes    []string               `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`    // 種別で絞り込み（live / scheduled / video / premiere / radio / episode）
+	SourceIds     []string               `protobuf:"bytes,6,rep,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`       // ソースID（購読一覧のsource_id）で絞り込み
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkEventsBeforeRequest) Reset() {
+	*x = MarkEventsBeforeRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkEventsBeforeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkEventsBeforeRequest) ProtoMessage() {}
+
+func (x *MarkEventsBeforeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkEventsBeforeRequest.ProtoReflect.Descriptor instead.
+func (*MarkEventsBeforeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MarkEventsBeforeRequest) GetBeforeTime() string {
+	if x != nil {
+		return x.BeforeTime
+	}
+	return ""
+}
+
+func (x *MarkEventsBeforeRequest) GetState() EventState {
+	if x != nil {
+		return x.State
+	}
+	return EventState_EVENT_STATE_UNSPECIFIED
+}
+
+func (x *MarkEventsBeforeRequest) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *MarkEventsBeforeRequest) GetPlatformIds() []string {
+	if x != nil {
+		return x.PlatformIds
+	}
+	return nil
+}
+
+func (x *MarkEventsBeforeRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *MarkEventsBeforeRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+// 番組状態の一括設定レスポンス
+type MarkEventsBeforeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedCount  int64                  `protobuf:"varint,1,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"` // 更新した番組数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkEventsBeforeResponse) Reset() {
+	*x = MarkEventsBeforeResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkEventsBeforeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkEventsBeforeResponse) ProtoMessage() {}
+
+func (x *MarkEventsBeforeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkEventsBeforeResponse.ProtoReflect.Descriptor instead.
+func (*MarkEventsBeforeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MarkEventsBeforeResponse) GetUpdatedCount() int64 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+// 「あとで見る」キュー取得リクエスト
+type ListWatchLaterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchLaterRequest) Reset() {
+	*x = ListWatchLaterRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchLaterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchLaterRequest) ProtoMessage() {}
+
+func (x *ListWatchLaterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchLaterRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchLaterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{21}
+}
+
+// 「あとで見る」キュー取得レスポンス
+type ListWatchLaterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Programs      []*Program             `protobuf:"bytes,1,rep,name=programs,proto3" json:"programs,omitempty"`                  // キューの並び順
+	MaxItems      int32                  `protobuf:"varint,2,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"` // プランごとの最大件数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchLaterResponse) Reset() {
+	*x = ListWatchLaterResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchLaterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchLaterResponse) ProtoMessage() {}
+
+func (x *ListWatchLaterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchLaterResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchLaterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListWatchLaterResponse) GetPrograms() []*Program {
+	if x != nil {
+		return x.Programs
+	}
+	return nil
+}
+
+func (x *ListWatchLaterResponse) GetMaxItems() int32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+// 「あとで見る」追加リクエスト
+type AddWatchLaterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     string                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWatchLaterRequest) Reset() {
+	*x = AddWatchLaterRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWatchLaterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatchLaterRequest) ProtoMessage() {}
+
+func (x *AddWatchLaterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatchLaterRequest.ProtoReflect.Descriptor instead.
+func (*AddWatchLaterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddWatchLaterRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+// 「あとで見る」追加レスポンス
+type AddWatchLaterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         bool                   `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"` // 新しく追加したかどうか（追加済みの場合はfalse）
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // 追加後のキューの件数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWatchLaterResponse) Reset() {
+	*x = AddWatchLaterResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWatchLaterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatchLaterResponse) ProtoMessage() {}
+
+func (x *AddWatchLaterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatchLaterResponse.ProtoReflect.Descriptor instead.
+func (*AddWatchLaterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddWatchLaterResponse) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+func (x *AddWatchLaterResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 「あとで見る」並び替えリクエスト
+type ReorderWatchLaterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramIds    []string               `protobuf:"bytes,1,rep,name=program_ids,json=programIds,proto3" json:"program_ids,omitempty"` // 並び替え後の番組IDの順序（キューの全件を指定）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderWatchLaterRequest) Reset() {
+	*x = ReorderWatchLaterRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderWatchLaterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderWatchLaterRequest) ProtoMessage() {}
+
+func (x *ReorderWatchLaterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderWatchLaterRequest.ProtoReflect.Descriptor instead.
+func (*ReorderWatchLaterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReorderWatchLaterRequest) GetProgramIds() []string {
+	if x != nil {
+		return x.ProgramIds
+	}
+	return nil
+}
+
+// 「あとで見る」並び替えレスポンス
+type ReorderWatchLaterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderWatchLaterResponse) Reset() {
+	*x = ReorderWatchLaterResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderWatchLaterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderWatchLaterResponse) ProtoMessage() {}
+
+func (x *ReorderWatchLaterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderWatchLaterResponse.ProtoReflect.Descriptor instead.
+func (*ReorderWatchLaterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{26}
+}
+
+// 「あとで見る」削除リクエスト
+type RemoveWatchLaterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramIds    []string               `protobuf:"bytes,1,rep,name=program_ids,json=programIds,proto3" json:"program_ids,omitempty"` // 削除する番組ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWatchLaterRequest) Reset() {
+	*x = RemoveWatchLaterRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWatchLaterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatchLaterRequest) ProtoMessage() {}
+
+func (x *RemoveWatchLaterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatchLaterRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWatchLaterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveWatchLaterRequest) GetProgramIds() []string {
+	if x != nil {
+		return x.ProgramIds
+	}
+	return nil
+}
+
+// 「あとで見る」削除レスポンス
+type RemoveWatchLaterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RemovedCount  int64                  `protobuf:"varint,1,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"` // 削除した件数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWatchLaterResponse) Reset() {
+	*x = RemoveWatchLaterResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWatchLaterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatchLaterResponse) ProtoMessage() {}
+
+func (x *RemoveWatchLaterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatchLaterResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWatchLaterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RemoveWatchLaterResponse) GetRemovedCount() int64 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+// ソースのリンク
+type SourceLink struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SourceId          string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	SourcePlatformId  string                 `protobuf:"bytes,2,opt,name=source_platform_id,json=sourcePlatformId,proto3" json:"source_platform_id,omitempty"`
+	SourceDisplayName string                 `protobuf:"bytes,3,opt,name=source_display_name,json=sourceDisplayName,proto3" json:"source_display_name,omitempty"`
+	LinkedSourceId    string                 `protobuf:"bytes,4,opt,name=linked_source_id,json=linkedSourceId,proto3" json:"linked_source_id,omitempty"`
+	LinkedPlatformId  string                 `protobuf:"bytes,5,opt,name=linked_platform_id,json=linkedPlatformId,proto3" json:"linked_platform_id,omitempty"`
+	LinkedDisplayName string                 `protobuf:"bytes,6,opt,name=linked_display_name,json=linkedDisplayName,proto3" json:"linked_display_name,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SourceLink) Reset() {
+	*x = SourceLink{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceLink) ProtoMessage() {}
+
+func (x *SourceLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceLink.ProtoReflect.Descriptor instead.
+func (*SourceLink) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SourceLink) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *SourceLink) GetSourcePlatformId() string {
+	if x != nil {
+		return x.SourcePlatformId
+	}
+	return ""
+}
+
+func (x *SourceLink) GetSourceDisplayName() string {
+	if x != nil {
+		return x.SourceDisplayName
+	}
+	return ""
+}
+
+func (x *SourceLink) GetLinkedSourceId() string {
+	if x != nil {
+		return x.LinkedSourceId
+	}
+	return ""
+}
+
+func (x *SourceLink) GetLinkedPlatformId() string {
+	if x != nil {
+		return x.LinkedPlatformId
+	}
+	return ""
+}
+
+func (x *SourceLink) GetLinkedDisplayName() string {
+	if x != nil {
+		return x.LinkedDisplayName
+	}
+	return ""
+}
+
+func (x *SourceLink) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// ソースのリンク一覧取得リクエスト
+type ListSourceLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSourceLinksRequest) Reset() {
+	*x = ListSourceLinksRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSourceLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSourceLinksRequest) ProtoMessage() {}
+
+func (x *ListSourceLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSourceLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListSourceLinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{30}
+}
+
+// ソースのリンク一覧取得レスポンス
+type ListSourceLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*SourceLink          `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"` // リンクした順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSourceLinksResponse) Reset() {
+	*x = ListSourceLinksResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSourceLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSourceLinksResponse) ProtoMessage() {}
+
+func (x *ListSourceLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSourceLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListSourceLinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListSourceLinksResponse) GetLinks() []*SourceLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// ソースのリンクリクエスト
+type LinkSourcesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SourceId       string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`                     // ソースID（購読一覧のsource_id）
+	LinkedSourceId string                 `protobuf:"bytes,2,opt,name=linked_source_id,json=linkedSourceId,proto3" json:"linked_source_id,omitempty"` // 同じクリエイターとしてリンクするソースID
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LinkSourcesRequest) Reset() {
+	*x = LinkSourcesRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkSourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSourcesRequest) ProtoMessage() {}
+
+func (x *LinkSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkSourcesRequest.ProtoReflect.Descriptor instead.
+func (*LinkSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LinkSourcesRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *LinkSourcesRequest) GetLinkedSourceId() string {
+	if x != nil {
+		return x.LinkedSourceId
+	}
+	return ""
+}
+
+// ソースのリンクレスポンス
+type LinkSourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkSourcesResponse) Reset() {
+	*x = LinkSourcesResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkSourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSourcesResponse) ProtoMessage() {}
+
+func (x *LinkSourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkSourcesResponse.ProtoReflect.Descriptor instead.
+func (*LinkSourcesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{33}
+}
+
+// ソースのリンク解除リクエスト
+type UnlinkSourcesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SourceId       string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	LinkedSourceId string                 `protobuf:"bytes,2,opt,name=linked_source_id,json=linkedSourceId,proto3" json:"linked_source_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnlinkSourcesRequest) Reset() {
+	*x = UnlinkSourcesRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkSourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkSourcesRequest) ProtoMessage() {}
+
+func (x *UnlinkSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkSourcesRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UnlinkSourcesRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *UnlinkSourcesRequest) GetLinkedSourceId() string {
+	if x != nil {
+		return x.LinkedSourceId
+	}
+	return ""
+}
+
+// ソースのリンク解除レスポンス
+type UnlinkSourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkSourcesResponse) Reset() {
+	*x = UnlinkSourcesResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkSourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkSourcesResponse) ProtoMessage() {}
+
+func (x *UnlinkSourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkSourcesResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkSourcesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{35}
+}
+
+// ミュートルール
+type MuteRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind            MuteRuleKind           `protobuf:"varint,2,opt,name=kind,proto3,enum=pixicast.v1.MuteRuleKind" json:"kind,omitempty"`
+	Pattern         string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`                                         // キーワード・正規表現・ゲーム名・種別
+	Field           MuteRuleField          `protobuf:"varint,4,opt,name=field,proto3,enum=pixicast.v1.MuteRuleField" json:"field,omitempty"`             // キーワード・正規表現の対象
+	DurationSeconds int32                  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // MIN_DURATION・MAX_DURATIONの秒数
+	SourceId        string                 `protobuf:"bytes,6,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`                       // 対象のソースID（空の場合はすべての購読チャンネル）
+	CreatedAt       string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MuteRule) Reset() {
+	*x = MuteRule{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteRule) ProtoMessage() {}
+
+func (x *MuteRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteRule.ProtoReflect.Descriptor instead.
+func (*MuteRule) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MuteRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MuteRule) GetKind() MuteRuleKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MuteRuleKind_MUTE_RULE_KIND_UNSPECIFIED
+}
+
+func (x *MuteRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *MuteRule) GetField() MuteRuleField {
+	if x != nil {
+		return x.Field
+	}
+	return MuteRuleField_MUTE_RULE_FIELD_ALL
+}
+
+func (x *MuteRule) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *MuteRule) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *MuteRule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// ミュートルール一覧取得リクエスト
+type ListMuteRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMuteRulesRequest) Reset() {
+	*x = ListMuteRulesRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMuteRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMuteRulesRequest) ProtoMessage() {}
+
+func (x *ListMuteRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMuteRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListMuteRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{37}
+}
+
+// ミュートルール一覧取得レスポンス
+type ListMuteRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*MuteRule            `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"` // 作成順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMuteRulesResponse) Reset() {
+	*x = ListMuteRulesResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMuteRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMuteRulesResponse) ProtoMessage() {}
+
+func (x *ListMuteRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMuteRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListMuteRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMuteRulesResponse) GetRules() []*MuteRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// ミュートルール作成リクエスト（idとcreated_atは無視）
+type CreateMuteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *MuteRule              `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMuteRuleRequest) Reset() {
+	*x = CreateMuteRuleRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMuteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMuteRuleRequest) ProtoMessage() {}
+
+func (x *CreateMuteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMuteRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateMuteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateMuteRuleRequest) GetRule() *MuteRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// ミュートルール作成レスポンス
+type CreateMuteRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *MuteRule              `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMuteRuleResponse) Reset() {
+	*x = CreateMuteRuleResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMuteRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMuteRuleResponse) ProtoMessage() {}
+
+func (x *CreateMuteRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMuteRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateMuteRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateMuteRuleResponse) GetRule() *MuteRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// ミュートルール更新リクエスト（idで指定したルールを置き換える）
+type UpdateMuteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *MuteRule              `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMuteRuleRequest) Reset() {
+	*x = UpdateMuteRuleRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMuteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMuteRuleRequest) ProtoMessage() {}
+
+func (x *UpdateMuteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMuteRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMuteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateMuteRuleRequest) GetRule() *MuteRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// ミュートルール更新レスポンス
+type UpdateMuteRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *MuteRule              `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMuteRuleResponse) Reset() {
+	*x = UpdateMuteRuleResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMuteRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMuteRuleResponse) ProtoMessage() {}
+
+func (x *UpdateMuteRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMuteRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMuteRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateMuteRuleResponse) GetRule() *MuteRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// ミュートルール削除リクエスト
+type DeleteMuteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMuteRuleRequest) Reset() {
+	*x = DeleteMuteRuleRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMuteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMuteRuleRequest) ProtoMessage() {}
+
+func (x *DeleteMuteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMuteRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMuteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteMuteRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ミュートルール削除レスポンス
+type DeleteMuteRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMuteRuleResponse) Reset() {
+	*x = DeleteMuteRuleResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMuteRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMuteRuleResponse) ProtoMessage() {}
+
+func (x *DeleteMuteRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMuteRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMuteRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{44}
+}
+
+// 保存したタイムラインのビュー
+// expressionの例: platform == "twitch" && is_live / duration > "01:00:00" && source.is_favorite
+// 使えるフィールド: platform, type, title, description, is_live, watched, hidden, duration, view_count, start_at,
+// source.id, source.name, source.handle, source.is_favorite
+// 使える演算子: && || ! == != < <= > >= =~（正規表現） contains（部分一致） in [...]
+type TimelineView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Expression    string                 `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineView) Reset() {
+	*x = TimelineView{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineView) ProtoMessage() {}
+
+func (x *TimelineView) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineView.ProtoReflect.Descriptor instead.
+func (*TimelineView) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TimelineView) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TimelineView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TimelineView) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *TimelineView) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TimelineView) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// ビュー一覧取得リクエスト
+type ListTimelineViewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimelineViewsRequest) Reset() {
+	*x = ListTimelineViewsRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimelineViewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimelineViewsRequest) ProtoMessage() {}
+
+func (x *ListTimelineViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimelineViewsRequest.ProtoReflect.Descriptor instead.
+func (*ListTimelineViewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{46}
+}
+
+// ビュー一覧取得レスポンス
+type ListTimelineViewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Views         []*TimelineView        `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"` // 作成順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimelineViewsResponse) Reset() {
+	*x = ListTimelineViewsResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimelineViewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimelineViewsResponse) ProtoMessage() {}
+
+func (x *ListTimelineViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimelineViewsResponse.ProtoReflect.Descriptor instead.
+func (*ListTimelineViewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListTimelineViewsResponse) GetViews() []*TimelineView {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+// ビュー保存リクエスト（式が不正な場合はINVALID_ARGUMENTで位置を返す）
+type CreateTimelineViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Expression    string                 `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTimelineViewRequest) Reset() {
+	*x = CreateTimelineViewRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTimelineViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimelineViewRequest) ProtoMessage() {}
+
+func (x *CreateTimelineViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimelineViewRequest.ProtoReflect.Descriptor instead.
+func (*CreateTimelineViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateTimelineViewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTimelineViewRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// ビュー保存レスポンス
+type CreateTimelineViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *TimelineView          `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTimelineViewResponse) Reset() {
+	*x = CreateTimelineViewResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTimelineViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimelineViewResponse) ProtoMessage() {}
+
+func (x *CreateTimelineViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimelineViewResponse.ProtoReflect.Descriptor instead.
+func (*CreateTimelineViewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateTimelineViewResponse) GetView() *TimelineView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+// ビュー更新リクエスト
+type UpdateTimelineViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Expression    string                 `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimelineViewRequest) Reset() {
+	*x = UpdateTimelineViewRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimelineViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimelineViewRequest) ProtoMessage() {}
+
+func (x *UpdateTimelineViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimelineViewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTimelineViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateTimelineViewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTimelineViewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTimelineViewRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// ビュー更新レスポンス
+type UpdateTimelineViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *TimelineView          `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimelineViewResponse) Reset() {
+	*x = UpdateTimelineViewResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimelineViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimelineViewResponse) ProtoMessage() {}
+
+func (x *UpdateTimelineViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimelineViewResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTimelineViewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateTimelineViewResponse) GetView() *TimelineView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+// ビュー削除リクエスト
+type DeleteTimelineViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTimelineViewRequest) Reset() {
+	*x = DeleteTimelineViewRequest{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTimelineViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTimelineViewRequest) ProtoMessage() {}
+
+func (x *DeleteTimelineViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTimelineViewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTimelineViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteTimelineViewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ビュー削除レスポンス
+type DeleteTimelineViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTimelineViewResponse) Reset() {
+	*x = DeleteTimelineViewResponse{}
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTimelineViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTimelineViewResponse) ProtoMessage() {}
+
+func (x *DeleteTimelineViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pixicast_v1_timeline_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTimelineViewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTimelineViewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pixicast_v1_timeline_proto_rawDescGZIP(), []int{53}
+}
+
+var File_proto_pixicast_v1_timeline_proto protoreflect.FileDescriptor
+
+const file_proto_pixicast_v1_timeline_proto_rawDesc = "" +
+	"\n" +
+	" proto/pixicast/v1/timeline.proto\x12\vpixicast.v1\"\x9a\x05\n" +
+	"\x12GetTimelineRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12.\n" +
+	"\x13youtube_channel_ids\x18\x02 \x03(\tR\x11youtubeChannelIds\x12\x1f\n" +
+	"\vbefore_time\x18\x03 \x01(\tR\n" +
+	"beforeTime\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12;\n" +
+	"\fday_boundary\x18\x06 \x01(\x0e2\x18.pixicast.v1.DayBoundaryR\vdayBoundary\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x128\n" +
+	"\tdirection\x18\b \x01(\x0e2\x1a.pixicast.v1.PageDirectionR\tdirection\x12!\n" +
+	"\fplatform_ids\x18\t \x03(\tR\vplatformIds\x12\x1f\n" +
+	"\vevent_types\x18\n" +
+	" \x03(\tR\n" +
+	"eventTypes\x12%\n" +
+	"\x0efavorites_only\x18\v \x01(\bR\rfavoritesOnly\x12\x1d\n" +
+	"\n" +
+	"source_ids\x18\f \x03(\tR\tsourceIds\x12'\n" +
+	"\x0fexclude_watched\x18\r \x01(\bR\x0eexcludeWatched\x12%\n" +
+	"\x0eexclude_hidden\x18\x0e \x01(\bR\rexcludeHidden\x12#\n" +
+	"\rinclude_muted\x18\x0f \x01(\bR\fincludeMuted\x12\x17\n" +
+	"\aview_id\x18\x10 \x01(\tR\x06viewId\x12-\n" +
+	"\x04sort\x18\x11 \x01(\x0e2\x19.pixicast.v1.TimelineSortR\x04sort\x12\x17\n" +
+	"\atag_ids\x18\x12 \x03(\tR\x06tagIds\"\xa4\x01\n" +
+	"\x13GetTimelineResponse\x120\n" +
+	"\bprograms\x18\x01 \x03(\v2\x14.pixicast.v1.ProgramR\bprograms\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vprev_cursor\x18\x04 \x01(\tR\n" +
+	"prevCursor\"\xf1\x04\n" +
+	"\aProgram\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x19\n" +
+	"\bstart_at\x18\x03 \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x04 \x01(\tR\x05endAt\x12#\n" +
+	"\rplatform_name\x18\x05 \x01(\tR\fplatformName\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x19\n" +
+	"\blink_url\x18\a \x01(\tR\alinkUrl\x12\x17\n" +
+	"\ais_live\x18\b \x01(\bR\x06isLive\x12#\n" +
+	"\rchannel_title\x18\t \x01(\tR\fchannelTitle\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12\x1a\n" +
+	"\bduration\x18\v \x01(\tR\bduration\x12!\n" +
+	"\fpublished_at\x18\f \x01(\tR\vpublishedAt\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\r \x01(\x03R\tviewCount\x122\n" +
+	"\x15channel_thumbnail_url\x18\x0e \x01(\tR\x13channelThumbnailUrl\x12*\n" +
+	"\x11starts_in_seconds\x18\x0f \x01(\x03R\x0fstartsInSeconds\x12\x18\n" +
+	"\awatched\x18\x10 \x01(\bR\awatched\x12\x16\n" +
+	"\x06hidden\x18\x11 \x01(\bR\x06hidden\x12\x1c\n" +
+	"\tdismissed\x18\x12 \x01(\bR\tdismissed\x12C\n" +
+	"\x0falternate_links\x18\x13 \x03(\v2\x1a.pixicast.v1.AlternateLinkR\x0ealternateLinks\"\x93\x01\n" +
+	"\rAlternateLink\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\tR\tprogramId\x12#\n" +
+	"\rplatform_name\x18\x02 \x01(\tR\fplatformName\x12\x19\n" +
+	"\blink_url\x18\x03 \x01(\tR\alinkUrl\x12#\n" +
+	"\rchannel_title\x18\x04 \x01(\tR\fchannelTitle\"Q\n" +
+	"\x18SearchYouTubeLiveRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1f\n" +
+	"\vmax_results\x18\x02 \x01(\x05R\n" +
+	"maxResults\"U\n" +
+	"\x19SearchYouTubeLiveResponse\x128\n" +
+	"\astreams\x18\x01 \x03(\v2\x1e.pixicast.v1.YouTubeLiveStreamR\astreams\"\xd3\x01\n" +
+	"\x11YouTubeLiveStream\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12#\n" +
+	"\rchannel_title\x18\x03 \x01(\tR\fchannelTitle\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
+	"\rthumbnail_url\x18\x05 \x01(\tR\fthumbnailUrl\x12!\n" +
+	"\fpublished_at\x18\x06 \x01(\tR\vpublishedAt\".\n" +
+	"\x14WatchTimelineRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\"\xb3\x01\n" +
+	"\x15WatchTimelineResponse\x123\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1f.pixicast.v1.TimelineChangeTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x02 \x01(\tR\tprogramId\x12.\n" +
+	"\aprogram\x18\x03 \x01(\v2\x14.pixicast.v1.ProgramR\aprogram\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"*\n" +
+	"\x12ListLiveNowRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"G\n" +
+	"\x13ListLiveNowResponse\x120\n" +
+	"\bprograms\x18\x01 \x03(\v2\x14.pixicast.v1.ProgramR\bprograms\"+\n" +
+	"\x13ListUpcomingRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"H\n" +
+	"\x14ListUpcomingResponse\x120\n" +
+	"\bprograms\x18\x01 \x03(\v2\x14.pixicast.v1.ProgramR\bprograms\"\xe5\x01\n" +
+	"\x15SearchTimelineRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12!\n" +
+	"\fplatform_ids\x18\x02 \x03(\tR\vplatformIds\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12%\n" +
+	"\x0efavorites_only\x18\x04 \x01(\bR\rfavoritesOnly\x12\x1d\n" +
+	"\n" +
+	"source_ids\x18\x05 \x03(\tR\tsourceIds\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\"\x91\x01\n" +
+	"\x16SearchTimelineResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.pixicast.v1.SearchTimelineResultR\aresults\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xd6\x01\n" +
+	"\x14SearchTimelineResult\x12.\n" +
+	"\aprogram\x18\x01 \x01(\v2\x14.pixicast.v1.ProgramR\aprogram\x12@\n" +
+	"\rtitle_snippet\x18\x02 \x03(\v2\x1b.pixicast.v1.SnippetSegmentR\ftitleSnippet\x12L\n" +
+	"\x13description_snippet\x18\x03 \x03(\v2\x1b.pixicast.v1.SnippetSegmentR\x12descriptionSnippet\"F\n" +
+	"\x0eSnippetSegment\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12 \n" +
+	"\vhighlighted\x18\x02 \x01(\bR\vhighlighted\"|\n" +
+	"\x14SetEventStateRequest\x12\x1f\n" +
+	"\vprogram_ids\x18\x01 \x03(\tR\n" +
+	"programIds\x12-\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x17.pixicast.v1.EventStateR\x05state\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\bR\x05value\"<\n" +
+	"\x15SetEventStateResponse\x12#\n" +
+	"\rupdated_count\x18\x01 \x01(\x03R\fupdatedCount\"\xe2\x01\n" +
+	"\x17MarkEventsBeforeRequest\x12\x1f\n" +
+	"\vbefore_time\x18\x01 \x01(\tR\n" +
+	"beforeTime\x12-\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x17.pixicast.v1.EventStateR\x05state\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\bR\x05value\x12!\n" +
+	"\fplatform_ids\x18\x04 \x03(\tR\vplatformIds\x12\x1f\n" +
+	"\vevent_types\x18\x05 \x03(\tR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\n" +
+	"source_ids\x18\x06 \x03(\tR\tsourceIds\"?\n" +
+	"\x18MarkEventsBeforeResponse\x12#\n" +
+	"\rupdated_count\x18\x01 \x01(\x03R\fupdatedCount\"\x17\n" +
+	"\x15ListWatchLaterRequest\"g\n" +
+	"\x16ListWatchLaterResponse\x120\n" +
+	"\bprograms\x18\x01 \x03(\v2\x14.pixicast.v1.ProgramR\bprograms\x12\x1b\n" +
+	"\tmax_items\x18\x02 \x01(\x05R\bmaxItems\"5\n" +
+	"\x14AddWatchLaterRequest\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\tR\tprogramId\"C\n" +
+	"\x15AddWatchLaterResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\bR\x05added\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\";\n" +
+	"\x18ReorderWatchLaterRequest\x12\x1f\n" +
+	"\vprogram_ids\x18\x01 \x03(\tR\n" +
+	"programIds\"\x1b\n" +
+	"\x19ReorderWatchLaterResponse\":\n" +
+	"\x17RemoveWatchLaterRequest\x12\x1f\n" +
+	"\vprogram_ids\x18\x01 \x03(\tR\n" +
+	"programIds\"?\n" +
+	"\x18RemoveWatchLaterResponse\x12#\n" +
+	"\rremoved_count\x18\x01 \x01(\x03R\fremovedCount\"\xae\x02\n" +
+	"\n" +
+	"SourceLink\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12,\n" +
+	"\x12source_platform_id\x18\x02 \x01(\tR\x10sourcePlatformId\x12.\n" +
+	"\x13source_display_name\x18\x03 \x01(\tR\x11sourceDisplayName\x12(\n" +
+	"\x10linked_source_id\x18\x04 \x01(\tR\x0elinkedSourceId\x12,\n" +
+	"\x12linked_platform_id\x18\x05 \x01(\tR\x10linkedPlatformId\x12.\n" +
+	"\x13linked_display_name\x18\x06 \x01(\tR\x11linkedDisplayName\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\x18\n" +
+	"\x16ListSourceLinksRequest\"H\n" +
+	"\x17ListSourceLinksResponse\x12-\n" +
+	"\x05links\x18\x01 \x03(\v2\x17.pixicast.v1.SourceLinkR\x05links\"[\n" +
+	"\x12LinkSourcesRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12(\n" +
+	"\x10linked_source_id\x18\x02 \x01(\tR\x0elinkedSourceId\"\x15\n" +
+	"\x13LinkSourcesResponse\"]\n" +
+	"\x14UnlinkSourcesRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12(\n" +
+	"\x10linked_source_id\x18\x02 \x01(\tR\x0elinkedSourceId\"\x17\n" +
+	"\x15UnlinkSourcesResponse\"\xfc\x01\n" +
+	"\bMuteRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x19.pixicast.v1.MuteRuleKindR\x04kind\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x120\n" +
+	"\x05field\x18\x04 \x01(\x0e2\x1a.pixicast.v1.MuteRuleFieldR\x05field\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x05R\x0fdurationSeconds\x12\x1b\n" +
+	"\tsource_id\x18\x06 \x01(\tR\bsourceId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\x16\n" +
+	"\x14ListMuteRulesRequest\"D\n" +
+	"\x15ListMuteRulesResponse\x12+\n" +
+	"\x05rules\x18\x01 \x03(\v2\x15.pixicast.v1.MuteRuleR\x05rules\"B\n" +
+	"\x15CreateMuteRuleRequest\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.pixicast.v1.MuteRuleR\x04rule\"C\n" +
+	"\x16CreateMuteRuleResponse\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.pixicast.v1.MuteRuleR\x04rule\"B\n" +
+	"\x15UpdateMuteRuleRequest\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.pixicast.v1.MuteRuleR\x04rule\"C\n" +
+	"\x16UpdateMuteRuleResponse\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.pixicast.v1.MuteRuleR\x04rule\"'\n" +
+	"\x15DeleteMuteRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteMuteRuleResponse\"\x90\x01\n" +
+	"\fTimelineView\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x03 \x01(\tR\n" +
+	"expression\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"\x1a\n" +
+	"\x18ListTimelineViewsRequest\"L\n" +
+	"\x19ListTimelineViewsResponse\x12/\n" +
+	"\x05views\x18\x01 \x03(\v2\x19.pixicast.v1.TimelineViewR\x05views\"O\n" +
+	"\x19CreateTimelineViewRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\"K\n" +
+	"\x1aCreateTimelineViewResponse\x12-\n" +
+	"\x04view\x18\x01 \x01(\v2\x19.pixicast.v1.TimelineViewR\x04view\"_\n" +
+	"\x19UpdateTimelineViewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x03 \x01(\tR\n" +
+	"expression\"K\n" +
+	"\x1aUpdateTimelineViewResponse\x12-\n" +
+	"\x04view\x18\x01 \x01(\v2\x19.pixicast.v1.TimelineViewR\x04view\"+\n" +
+	"\x19DeleteTimelineViewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteTimelineViewResponse*D\n" +
+	"\vDayBoundary\x12\x19\n" +
+	"\x15DAY_BOUNDARY_CALENDAR\x10\x00\x12\x1a\n" +
+	"\x16DAY_BOUNDARY_BROADCAST\x10\x01*H\n" +
+	"\rPageDirection\x12\x1a\n" +
+	"\x16PAGE_DIRECTION_FORWARD\x10\x00\x12\x1b\n" +
+	"\x17PAGE_DIRECTION_BACKWARD\x10\x01*D\n" +
+	"\fTimelineSort\x12\x18\n" +
+	"\x14TIMELINE_SORT_NEWEST\x10\x00\x12\x1a\n" +
+	"\x16TIMELINE_SORT_PRIORITY\x10\x01*\xa1\x01\n" +
+	"\x12TimelineChangeType\x12$\n" +
+	" TIMELINE_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dTIMELINE_CHANGE_TYPE_INSERTED\x10\x01\x12 \n" +
+	"\x1cTIMELINE_CHANGE_TYPE_UPDATED\x10\x02\x12 \n" +
+	"\x1cTIMELINE_CHANGE_TYPE_REMOVED\x10\x03*u\n" +
+	"\n" +
+	"EventState\x12\x1b\n" +
+	"\x17EVENT_STATE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13EVENT_STATE_WATCHED\x10\x01\x12\x16\n" +
+	"\x12EVENT_STATE_HIDDEN\x10\x02\x12\x19\n" +
+	"\x15EVENT_STATE_DISMISSED\x10\x03*\xe3\x01\n" +
+	"\fMuteRuleKind\x12\x1e\n" +
+	"\x1aMUTE_RULE_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16MUTE_RULE_KIND_KEYWORD\x10\x01\x12\x18\n" +
+	"\x14MUTE_RULE_KIND_REGEX\x10\x02\x12\x1c\n" +
+	"\x18MUTE_RULE_KIND_GAME_NAME\x10\x03\x12\x1f\n" +
+	"\x1bMUTE_RULE_KIND_MIN_DURATION\x10\x04\x12\x1f\n" +
+	"\x1bMUTE_RULE_KIND_MAX_DURATION\x10\x05\x12\x1d\n" +
+	"\x19MUTE_RULE_KIND_EVENT_TYPE\x10\x06*d\n" +
+	"\rMuteRuleField\x12\x17\n" +
+	"\x13MUTE_RULE_FIELD_ALL\x10\x00\x12\x19\n" +
+	"\x15MUTE_RULE_FIELD_TITLE\x10\x01\x12\x1f\n" +
+	"\x1bMUTE_RULE_FIELD_DESCRIPTION\x10\x022\xde\x10\n" +
 	"\x0fTimelineService\x12P\n" +
 	"\vGetTimeline\x12\x1f.pixicast.v1.GetTimelineRequest\x1a .pixicast.v1.GetTimelineResponse\x12b\n" +
-	"\x11SearchYouTubeLive\x12%.pixicast.v1.SearchYouTubeLiveRequest\x1a&.pixicast.v1.SearchYouTubeLiveResponseBEZCgithub.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1;pixicastv1b\x06proto3"
+	"\x11SearchYouTubeLive\x12%.pixicast.v1.SearchYouTubeLiveRequest\x1a&.pixicast.v1.SearchYouTubeLiveResponse\x12X\n" +
+	"\rWatchTimeline\x12!.pixicast.v1.WatchTimelineRequest\x1a\".pixicast.v1.WatchTimelineResponse0\x01\x12P\n" +
+	"\vListLiveNow\x12\x1f.pixicast.v1.ListLiveNowRequest\x1a .pixicast.v1.ListLiveNowResponse\x12S\n" +
+	"\fListUpcoming\x12 .pixicast.v1.ListUpcomingRequest\x1a!.pixicast.v1.ListUpcomingResponse\x12Y\n" +
+	"\x0eSearchTimeline\x12\".pixicast.v1.SearchTimelineRequest\x1a#.pixicast.v1.SearchTimelineResponse\x12V\n" +
+	"\rSetEventState\x12!.pixicast.v1.SetEventStateRequest\x1a\".pixicast.v1.SetEventStateResponse\x12_\n" +
+	"\x10MarkEventsBefore\x12$.pixicast.v1.MarkEventsBeforeRequest\x1a%.pixicast.v1.MarkEventsBeforeResponse\x12Y\n" +
+	"\x0eListWatchLater\x12\".pixicast.v1.ListWatchLaterRequest\x1a#.pixicast.v1.ListWatchLaterResponse\x12V\n" +
+	"\rAddWatchLater\x12!.pixicast.v1.AddWatchLaterRequest\x1a\".pixicast.v1.AddWatchLaterResponse\x12b\n" +
+	"\x11ReorderWatchLater\x12%.pixicast.v1.ReorderWatchLaterRequest\x1a&.pixicast.v1.ReorderWatchLaterResponse\x12_\n" +
+	"\x10RemoveWatchLater\x12$.pixicast.v1.RemoveWatchLaterRequest\x1a%.pixicast.v1.RemoveWatchLaterResponse\x12\\\n" +
+	"\x0fListSourceLinks\x12#.pixicast.v1.ListSourceLinksRequest\x1a$.pixicast.v1.ListSourceLinksResponse\x12P\n" +
+	"\vLinkSources\x12\x1f.pixicast.v1.LinkSourcesRequest\x1a .pixicast.v1.LinkSourcesResponse\x12V\n" +
+	"\rUnlinkSources\x12!.pixicast.v1.UnlinkSourcesRequest\x1a\".pixicast.v1.UnlinkSourcesResponse\x12V\n" +
+	"\rListMuteRules\x12!.pixicast.v1.ListMuteRulesRequest\x1a\".pixicast.v1.ListMuteRulesResponse\x12Y\n" +
+	"\x0eCreateMuteRule\x12\".pixicast.v1.CreateMuteRuleRequest\x1a#.pixicast.v1.CreateMuteRuleResponse\x12Y\n" +
+	"\x0eUpdateMuteRule\x12\".pixicast.v1.UpdateMuteRuleRequest\x1a#.pixicast.v1.UpdateMuteRuleResponse\x12Y\n" +
+	"\x0eDeleteMuteRule\x12\".pixicast.v1.DeleteMuteRuleRequest\x1a#.pixicast.v1.DeleteMuteRuleResponse\x12b\n" +
+	"\x11ListTimelineViews\x12%.pixicast.v1.ListTimelineViewsRequest\x1a&.pixicast.v1.ListTimelineViewsResponse\x12e\n" +
+	"\x12CreateTimelineView\x12&.pixicast.v1.CreateTimelineViewRequest\x1a'.pixicast.v1.CreateTimelineViewResponse\x12e\n" +
+	"\x12UpdateTimelineView\x12&.pixicast.v1.UpdateTimelineViewRequest\x1a'.pixicast.v1.UpdateTimelineViewResponse\x12e\n" +
+	"\x12DeleteTimelineView\x12&.pixicast.v1.DeleteTimelineViewRequest\x1a'.pixicast.v1.DeleteTimelineViewResponseBEZCgithub.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1;pixicastv1b\x06proto3"
 
 var (
 	file_proto_pixicast_v1_timeline_proto_rawDescOnce sync.Once
@@ -545,27 +3766,151 @@ func file_proto_pixicast_v1_timeline_proto_rawDescGZIP() []byte {
 	return file_proto_pixicast_v1_timeline_proto_rawDescData
 }
 
-var file_proto_pixicast_v1_timeline_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_pixicast_v1_timeline_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_pixicast_v1_timeline_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_proto_pixicast_v1_timeline_proto_goTypes = []any{
-	(*GetTimelineRequest)(nil),        // 0: pixicast.v1.GetTimelineRequest
-	(*GetTimelineResponse)(nil),       // 1: pixicast.v1.GetTimelineResponse
-	(*Program)(nil),                   // 2: pixicast.v1.Program
-	(*SearchYouTubeLiveRequest)(nil),  // 3: pixicast.v1.SearchYouTubeLiveRequest
-	(*SearchYouTubeLiveResponse)(nil), // 4: pixicast.v1.SearchYouTubeLiveResponse
-	(*YouTubeLiveStream)(nil),         // 5: pixicast.v1.YouTubeLiveStream
+	(DayBoundary)(0),                   // 0: pixicast.v1.DayBoundary
+	(PageDirection)(0),                 // 1: pixicast.v1.PageDirection
+	(TimelineSort)(0),                  // 2: pixicast.v1.TimelineSort
+	(TimelineChangeType)(0),            // 3: pixicast.v1.TimelineChangeType
+	(EventState)(0),                    // 4: pixicast.v1.EventState
+	(MuteRuleKind)(0),                  // 5: pixicast.v1.MuteRuleKind
+	(MuteRuleField)(0),                 // 6: pixicast.v1.MuteRuleField
+	(*GetTimelineRequest)(nil),         // 7: pixicast.v1.GetTimelineRequest
+	(*GetTimelineResponse)(nil),        // 8: pixicast.v1.GetTimelineResponse
+	(*Program)(nil),                    // 9: pixicast.v1.Program
+	(*AlternateLink)(nil),              // 10: pixicast.v1.AlternateLink
+	(*SearchYouTubeLiveRequest)(nil),   // 11: pixicast.v1.SearchYouTubeLiveRequest
+	(*SearchYouTubeLiveResponse)(nil),  // 12: pixicast.v1.SearchYouTubeLiveResponse
+	(*YouTubeLiveStream)(nil),          // 13: pixicast.v1.YouTubeLiveStream
+	(*WatchTimelineRequest)(nil),       // 14: pixicast.v1.WatchTimelineRequest
+	(*WatchTimelineResponse)(nil),      // 15: pixicast.v1.WatchTimelineResponse
+	(*ListLiveNowRequest)(nil),         // 16: pixicast.v1.ListLiveNowRequest
+	(*ListLiveNowResponse)(nil),        // 17: pixicast.v1.ListLiveNowResponse
+	(*ListUpcomingRequest)(nil),        // 18: pixicast.v1.ListUpcomingRequest
+	(*ListUpcomingResponse)(nil),       // 19: pixicast.v1.ListUpcomingResponse
+	(*SearchTimelineRequest)(nil),      // 20: pixicast.v1.SearchTimelineRequest
+	(*SearchTimelineResponse)(nil),     // 21: pixicast.v1.SearchTimelineResponse
+	(*SearchTimelineResult)(nil),       // 22: pixicast.v1.SearchTimelineResult
+	(*SnippetSegment)(nil),             // 23: pixicast.v1.SnippetSegment
+	(*SetEventStateRequest)(nil),       // 24: pixicast.v1.SetEventStateRequest
+	(*SetEventStateResponse)(nil),      // 25: pixicast.v1.SetEventStateResponse
+	(*MarkEventsBeforeRequest)(nil),    // 26: pixicast.v1.MarkEventsBeforeRequest
+	(*MarkEventsBeforeResponse)(nil),   // 27: pixicast.v1.MarkEventsBeforeResponse
+	(*ListWatchLaterRequest)(nil),      // 28: pixicast.v1.ListWatchLaterRequest
+	(*ListWatchLaterResponse)(nil),     // 29: pixicast.v1.ListWatchLaterResponse
+	(*AddWatchLaterRequest)(nil),       // 30: pixicast.v1.AddWatchLaterRequest
+	(*AddWatchLaterResponse)(nil),      // 31: pixicast.v1.AddWatchLaterResponse
+	(*ReorderWatchLaterRequest)(nil),   // 32: pixicast.v1.ReorderWatchLaterRequest
+	(*ReorderWatchLaterResponse)(nil),  // 33: pixicast.v1.ReorderWatchLaterResponse
+	(*RemoveWatchLaterRequest)(nil),    // 34: pixicast.v1.RemoveWatchLaterRequest
+	(*RemoveWatchLaterResponse)(nil),   // 35: pixicast.v1.RemoveWatchLaterResponse
+	(*SourceLink)(nil),                 // 36: pixicast.v1.SourceLink
+	(*ListSourceLinksRequest)(nil),     // 37: pixicast.v1.ListSourceLinksRequest
+	(*ListSourceLinksResponse)(nil),    // 38: pixicast.v1.ListSourceLinksResponse
+	(*LinkSourcesRequest)(nil),         // 39: pixicast.v1.LinkSourcesRequest
+	(*LinkSourcesResponse)(nil),        // 40: pixicast.v1.LinkSourcesResponse
+	(*UnlinkSourcesRequest)(nil),       // 41: pixicast.v1.UnlinkSourcesRequest
+	(*UnlinkSourcesResponse)(nil),      // 42: pixicast.v1.UnlinkSourcesResponse
+	(*MuteRule)(nil),                   // 43: pixicast.v1.MuteRule
+	(*ListMuteRulesRequest)(nil),       // 44: pixicast.v1.ListMuteRulesRequest
+	(*ListMuteRulesResponse)(nil),      // 45: pixicast.v1.ListMuteRulesResponse
+	(*CreateMuteRuleRequest)(nil),      // 46: pixicast.v1.CreateMuteRuleRequest
+	(*CreateMuteRuleResponse)(nil),     // 47: pixicast.v1.CreateMuteRuleResponse
+	(*UpdateMuteRuleRequest)(nil),      // 48: pixicast.v1.UpdateMuteRuleRequest
+	(*UpdateMuteRuleResponse)(nil),     // 49: pixicast.v1.UpdateMuteRuleResponse
+	(*DeleteMuteRuleRequest)(nil),      // 50: pixicast.v1.DeleteMuteRuleRequest
+	(*DeleteMuteRuleResponse)(nil),     // 51: pixicast.v1.DeleteMuteRuleResponse
+	(*TimelineView)(nil),               // 52: pixicast.v1.TimelineView
+	(*ListTimelineViewsRequest)(nil),   // 53: pixicast.v1.ListTimelineViewsRequest
+	(*ListTimelineViewsResponse)(nil),  // 54: pixicast.v1.ListTimelineViewsResponse
+	(*CreateTimelineViewRequest)(nil),  // 55: pixicast.v1.CreateTimelineViewRequest
+	(*CreateTimelineViewResponse)(nil), // 56: pixicast.v1.CreateTimelineViewResponse
+	(*UpdateTimelineViewRequest)(nil),  // 57: pixicast.v1.UpdateTimelineViewRequest
+	(*UpdateTimelineViewResponse)(nil), // 58: pixicast.v1.UpdateTimelineViewResponse
+	(*DeleteTimelineViewRequest)(nil),  // 59: pixicast.v1.DeleteTimelineViewRequest
+	(*DeleteTimelineViewResponse)(nil), // 60: pixicast.v1.DeleteTimelineViewResponse
 }
 var file_proto_pixicast_v1_timeline_proto_depIdxs = []int32{
-	2, // 0: pixicast.v1.GetTimelineResponse.programs:type_name -> pixicast.v1.Program
-	5, // 1: pixicast.v1.SearchYouTubeLiveResponse.streams:type_name -> pixicast.v1.YouTubeLiveStream
-	0, // 2: pixicast.v1.TimelineService.GetTimeline:input_type -> pixicast.v1.GetTimelineRequest
-	3, // 3: pixicast.v1.TimelineService.SearchYouTubeLive:input_type -> pixicast.v1.SearchYouTubeLiveRequest
-	1, // 4: pixicast.v1.TimelineService.GetTimeline:output_type -> pixicast.v1.GetTimelineResponse
-	4, // 5: pixicast.v1.TimelineService.SearchYouTubeLive:output_type -> pixicast.v1.SearchYouTubeLiveResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: pixicast.v1.GetTimelineRequest.day_boundary:type_name -> pixicast.v1.DayBoundary
+	1,  // 1: pixicast.v1.GetTimelineRequest.direction:type_name -> pixicast.v1.PageDirection
+	2,  // 2: pixicast.v1.GetTimelineRequest.sort:type_name -> pixicast.v1.TimelineSort
+	9,  // 3: pixicast.v1.GetTimelineResponse.programs:type_name -> pixicast.v1.Program
+	10, // 4: pixicast.v1.Program.alternate_links:type_name -> pixicast.v1.AlternateLink
+	13, // 5: pixicast.v1.SearchYouTubeLiveResponse.streams:type_name -> pixicast.v1.YouTubeLiveStream
+	3,  // 6: pixicast.v1.WatchTimelineResponse.type:type_name -> pixicast.v1.TimelineChangeType
+	9,  // 7: pixicast.v1.WatchTimelineResponse.program:type_name -> pixicast.v1.Program
+	9,  // 8: pixicast.v1.ListLiveNowResponse.programs:type_name -> pixicast.v1.Program
+	9,  // 9: pixicast.v1.ListUpcomingResponse.programs:type_name -> pixicast.v1.Program
+	22, // 10: pixicast.v1.SearchTimelineResponse.results:type_name -> pixicast.v1.SearchTimelineResult
+	9,  // 11: pixicast.v1.SearchTimelineResult.program:type_name -> pixicast.v1.Program
+	23, // 12: pixicast.v1.SearchTimelineResult.title_snippet:type_name -> pixicast.v1.SnippetSegment
+	23, // 13: pixicast.v1.SearchTimelineResult.description_snippet:type_name -> pixicast.v1.SnippetSegment
+	4,  // 14: pixicast.v1.SetEventStateRequest.state:type_name -> pixicast.v1.EventState
+	4,  // 15: pixicast.v1.MarkEventsBeforeRequest.state:type_name -> pixicast.v1.EventState
+	9,  // 16: pixicast.v1.ListWatchLaterResponse.programs:type_name -> pixicast.v1.Program
+	36, // 17: pixicast.v1.ListSourceLinksResponse.links:type_name -> pixicast.v1.SourceLink
+	5,  // 18: pixicast.v1.MuteRule.kind:type_name -> pixicast.v1.MuteRuleKind
+	6,  // 19: pixicast.v1.MuteRule.field:type_name -> pixicast.v1.MuteRuleField
+	43, // 20: pixicast.v1.ListMuteRulesResponse.rules:type_name -> pixicast.v1.MuteRule
+	43, // 21: pixicast.v1.CreateMuteRuleRequest.rule:type_name -> pixicast.v1.MuteRule
+	43, // 22: pixicast.v1.CreateMuteRuleResponse.rule:type_name -> pixicast.v1.MuteRule
+	43, // 23: pixicast.v1.UpdateMuteRuleRequest.rule:type_name -> pixicast.v1.MuteRule
+	43, // 24: pixicast.v1.UpdateMuteRuleResponse.rule:type_name -> pixicast.v1.MuteRule
+	52, // 25: pixicast.v1.ListTimelineViewsResponse.views:type_name -> pixicast.v1.TimelineView
+	52, // 26: pixicast.v1.CreateTimelineViewResponse.view:type_name -> pixicast.v1.TimelineView
+	52, // 27: pixicast.v1.UpdateTimelineViewResponse.view:type_name -> pixicast.v1.TimelineView
+	7,  // 28: pixicast.v1.TimelineService.GetTimeline:input_type -> pixicast.v1.GetTimelineRequest
+	11, // 29: pixicast.v1.TimelineService.SearchYouTubeLive:input_type -> pixicast.v1.SearchYouTubeLiveRequest
+	14, // 30: pixicast.v1.TimelineService.WatchTimeline:input_type -> pixicast.v1.WatchTimelineRequest
+	16, // 31: pixicast.v1.TimelineService.ListLiveNow:input_type -> pixicast.v1.ListLiveNowRequest
+	18, // 32: pixicast.v1.TimelineService.ListUpcoming:input_type -> pixicast.v1.ListUpcomingRequest
+	20, // 33: pixicast.v1.TimelineService.SearchTimeline:input_type -> pixicast.v1.SearchTimelineRequest
+	24, // 34: pixicast.v1.TimelineService.SetEventState:input_type -> pixicast.v1.SetEventStateRequest
+	26, // 35: pixicast.v1.TimelineService.MarkEventsBefore:input_type -> pixicast.v1.MarkEventsBeforeRequest
+	28, // 36: pixicast.v1.TimelineService.ListWatchLater:input_type -> pixicast.v1.ListWatchLaterRequest
+	30, // 37: pixicast.v1.TimelineService.AddWatchLater:input_type -> pixicast.v1.AddWatchLaterRequest
+	32, // 38: pixicast.v1.TimelineService.ReorderWatchLater:input_type -> pixicast.v1.ReorderWatchLaterRequest
+	34, // 39: pixicast.v1.TimelineService.RemoveWatchLater:input_type -> pixicast.v1.RemoveWatchLaterRequest
+	37, // 40: pixicast.v1.TimelineService.ListSourceLinks:input_type -> pixicast.v1.ListSourceLinksRequest
+	39, // 41: pixicast.v1.TimelineService.LinkSources:input_type -> pixicast.v1.LinkSourcesRequest
+	41, // 42: pixicast.v1.TimelineService.UnlinkSources:input_type -> pixicast.v1.UnlinkSourcesRequest
+	44, // 43: pixicast.v1.TimelineService.ListMuteRules:input_type -> pixicast.v1.ListMuteRulesRequest
+	46, // 44: pixicast.v1.TimelineService.CreateMuteRule:input_type -> pixicast.v1.CreateMuteRuleRequest
+	48, // 45: pixicast.v1.TimelineService.UpdateMuteRule:input_type -> pixicast.v1.UpdateMuteRuleRequest
+	50, // 46: pixicast.v1.TimelineService.DeleteMuteRule:input_type -> pixicast.v1.DeleteMuteRuleRequest
+	53, // 47: pixicast.v1.TimelineService.ListTimelineViews:input_type -> pixicast.v1.ListTimelineViewsRequest
+	55, // 48: pixicast.v1.TimelineService.CreateTimelineView:input_type -> pixicast.v1.CreateTimelineViewRequest
+	57, // 49: pixicast.v1.TimelineService.UpdateTimelineView:input_type -> pixicast.v1.UpdateTimelineViewRequest
+	59, // 50: pixicast.v1.TimelineService.DeleteTimelineView:input_type -> pixicast.v1.DeleteTimelineViewRequest
+	8,  // 51: pixicast.v1.TimelineService.GetTimeline:output_type -> pixicast.v1.GetTimelineResponse
+	12, // 52: pixicast.v1.TimelineService.SearchYouTubeLive:output_type -> pixicast.v1.SearchYouTubeLiveResponse
+	15, // 53: pixicast.v1.TimelineService.WatchTimeline:output_type -> pixicast.v1.WatchTimelineResponse
+	17, // 54: pixicast.v1.TimelineService.ListLiveNow:output_type -> pixicast.v1.ListLiveNowResponse
+	19, // 55: pixicast.v1.TimelineService.ListUpcoming:output_type -> pixicast.v1.ListUpcomingResponse
+	21, // 56: pixicast.v1.TimelineService.SearchTimeline:output_type -> pixicast.v1.SearchTimelineResponse
+	25, // 57: pixicast.v1.TimelineService.SetEventState:output_type -> pixicast.v1.SetEventStateResponse
+	27, // 58: pixicast.v1.TimelineService.MarkEventsBefore:output_type -> pixicast.v1.MarkEventsBeforeResponse
+	29, // 59: pixicast.v1.TimelineService.ListWatchLater:output_type -> pixicast.v1.ListWatchLaterResponse
+	31, // 60: pixicast.v1.TimelineService.AddWatchLater:output_type -> pixicast.v1.AddWatchLaterResponse
+	33, // 61: pixicast.v1.TimelineService.ReorderWatchLater:output_type -> pixicast.v1.ReorderWatchLaterResponse
+	35, // 62: pixicast.v1.TimelineService.RemoveWatchLater:output_type -> pixicast.v1.RemoveWatchLaterResponse
+	38, // 63: pixicast.v1.TimelineService.ListSourceLinks:output_type -> pixicast.v1.ListSourceLinksResponse
+	40, // 64: pixicast.v1.TimelineService.LinkSources:output_type -> pixicast.v1.LinkSourcesResponse
+	42, // 65: pixicast.v1.TimelineService.UnlinkSources:output_type -> pixicast.v1.UnlinkSourcesResponse
+	45, // 66: pixicast.v1.TimelineService.ListMuteRules:output_type -> pixicast.v1.ListMuteRulesResponse
+	47, // 67: pixicast.v1.TimelineService.CreateMuteRule:output_type -> pixicast.v1.CreateMuteRuleResponse
+	49, // 68: pixicast.v1.TimelineService.UpdateMuteRule:output_type -> pixicast.v1.UpdateMuteRuleResponse
+	51, // 69: pixicast.v1.TimelineService.DeleteMuteRule:output_type -> pixicast.v1.DeleteMuteRuleResponse
+	54, // 70: pixicast.v1.TimelineService.ListTimelineViews:output_type -> pixicast.v1.ListTimelineViewsResponse
+	56, // 71: pixicast.v1.TimelineService.CreateTimelineView:output_type -> pixicast.v1.CreateTimelineViewResponse
+	58, // 72: pixicast.v1.TimelineService.UpdateTimelineView:output_type -> pixicast.v1.UpdateTimelineViewResponse
+	60, // 73: pixicast.v1.TimelineService.DeleteTimelineView:output_type -> pixicast.v1.DeleteTimelineViewResponse
+	51, // [51:74] is the sub-list for method output_type
+	28, // [28:51] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_proto_pixicast_v1_timeline_proto_init() }
@@ -578,13 +3923,14 @@ func file_proto_pixicast_v1_timeline_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_pixicast_v1_timeline_proto_rawDesc), len(file_proto_pixicast_v1_timeline_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      7,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_pixicast_v1_timeline_proto_goTypes,
 		DependencyIndexes: file_proto_pixicast_v1_timeline_proto_depIdxs,
+		EnumInfos:         file_proto_pixicast_v1_timeline_proto_enumTypes,
 		MessageInfos:      file_proto_pixicast_v1_timeline_proto_msgTypes,
 	}.Build()
 	File_proto_pixicast_v1_timeline_proto = out.File
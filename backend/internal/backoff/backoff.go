@@ -0,0 +1,19 @@
+// Package backoff はリトライの待ち時間（指数バックオフ）
+package backoff
+
+import "time"
+
+// Exponential はattempt回目のリトライまでの待ち時間
+// baseから倍々に増やしてmaxDelayで頭打ちにする
+func Exponential(attempt int, base, maxDelay time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// Jitter はdelayをjitter（0〜1）で±20%ずらす（同時に失敗した呼び出しのリトライを分散する）
+func Jitter(delay time.Duration, jitter float64) time.Duration {
+	return time.Duration(float64(delay) * (0.8 + 0.4*jitter))
+}
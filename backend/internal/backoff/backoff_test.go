@@ -0,0 +1,44 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+)
+
+// TestExponential は倍々に増やして上限で頭打ちにする待ち時間のテスト
+func TestExponential(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: time.Second},
+		{attempt: 1, want: time.Second},
+		{attempt: 3, want: 4 * time.Second},
+		{attempt: 5, want: 10 * time.Second},
+		{attempt: 100, want: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := Exponential(tt.attempt, time.Second, 10*time.Second); got != tt.want {
+			t.Errorf("Exponential(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+// TestJitter は待ち時間を±20%ずらすことのテスト
+func TestJitter(t *testing.T) {
+	tests := []struct {
+		jitter float64
+		want   time.Duration
+	}{
+		{jitter: 0, want: 8 * time.Second},
+		{jitter: 0.5, want: 10 * time.Second},
+		{jitter: 1, want: 12 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := Jitter(10*time.Second, tt.jitter); got != tt.want {
+			t.Errorf("Jitter(10s, %v) = %v, want %v", tt.jitter, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// Package dberr はデータベースのエラーの判定
+package dberr
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolation は一意制約違反のSQLSTATE
+const uniqueViolation = "23505"
+
+// IsUniqueViolation は一意制約違反のエラーかどうかを返す
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
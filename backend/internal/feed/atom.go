@@ -0,0 +1,97 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// Atom（RFC 4287）の出力用構造体
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	NS      string      `xml:"xmlns,attr"`
+	Media   string      `xml:"xmlns:media,attr"`
+	Lang    string      `xml:"xml:lang,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href   string `xml:"href,attr"`
+	Rel    string `xml:"rel,attr,omitempty"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length int64  `xml:"length,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID         string          `xml:"id"`
+	Title      string          `xml:"title"`
+	Updated    string          `xml:"updated"`
+	Published  string          `xml:"published"`
+	Author     *atomPerson     `xml:"author"`
+	Links      []atomLink      `xml:"link"`
+	Categories []atomCategory  `xml:"category"`
+	Summary    string          `xml:"summary,omitempty"`
+	Thumbnail  *mediaThumbnail `xml:"media:thumbnail"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// WriteAtom はタイムラインの番組をAtom形式で書き出す
+// publishedは番組の日時、updatedは番組の更新日時
+func WriteAtom(w io.Writer, ch Channel, events []db.ListTimelineRow) error {
+	feed := atomFeed{
+		NS:      "http://www.w3.org/2005/Atom",
+		Media:   "http://search.yahoo.com/mrss/",
+		Lang:    "ja",
+		ID:      ch.FeedURL,
+		Title:   ch.Title,
+		Updated: feedUpdated(events).Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: ch.FeedURL, Rel: "self", Type: "application/atom+xml"},
+			{Href: ch.HomeURL, Rel: "alternate", Type: "text/html"},
+		},
+	}
+
+	for _, event := range events {
+		entry := atomEntry{
+			ID:         "urn:uuid:" + event.ID.String(),
+			Title:      event.Title,
+			Updated:    itemUpdated(event).Format(time.RFC3339),
+			Published:  itemTime(event).Format(time.RFC3339),
+			Categories: []atomCategory{{Term: event.PlatformID}, {Term: event.Type}},
+			Summary:    textValue(event.Description),
+		}
+		if name := textValue(event.SourceDisplayName); name != "" {
+			entry.Author = &atomPerson{Name: name}
+		}
+		if event.Url != "" {
+			entry.Links = append(entry.Links, atomLink{Href: event.Url, Rel: "alternate"})
+		}
+		if hasEnclosure(event) {
+			entry.Links = append(entry.Links, atomLink{
+				Href:   event.EnclosureUrl.String,
+				Rel:    "enclosure",
+				Type:   enclosureType(event),
+				Length: event.EnclosureLength.Int64,
+			})
+		}
+		if image := textValue(event.ImageUrl); image != "" {
+			entry.Thumbnail = &mediaThumbnail{URL: image}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return writeXML(w, feed)
+}
@@ -0,0 +1,71 @@
+package feed
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// Channel はフィード全体の情報
+type Channel struct {
+	Title       string
+	Description string
+	HomeURL     string // サイトのURL
+	FeedURL     string // このフィード自身のURL
+}
+
+// defaultEnclosureType はenclosureのMIMEタイプが不明な場合に使う値
+const defaultEnclosureType = "audio/mpeg"
+
+// itemTime は番組の日時（ListTimelineの並び順と同じく start_at → published_at → created_at）
+func itemTime(event db.ListTimelineRow) time.Time {
+	if event.StartAt.Valid {
+		return event.StartAt.Time.UTC()
+	}
+	if event.PublishedAt.Valid {
+		return event.PublishedAt.Time.UTC()
+	}
+	return event.CreatedAt.Time.UTC()
+}
+
+// itemUpdated は番組の更新日時
+func itemUpdated(event db.ListTimelineRow) time.Time {
+	if event.UpdatedAt.Valid {
+		return event.UpdatedAt.Time.UTC()
+	}
+	return event.CreatedAt.Time.UTC()
+}
+
+// feedUpdated はフィード全体の更新日時（番組の更新日時の最大値）
+// 現在時刻を使わないため、同じ入力からは常に同じ出力になる
+func feedUpdated(events []db.ListTimelineRow) time.Time {
+	updated := time.Unix(0, 0).UTC()
+	for _, event := range events {
+		if t := itemUpdated(event); t.After(updated) {
+			updated = t
+		}
+	}
+	return updated
+}
+
+// enclosureType はenclosureのMIMEタイプ（不明な場合はaudio/mpeg）
+func enclosureType(event db.ListTimelineRow) string {
+	if event.EnclosureType.Valid && event.EnclosureType.String != "" {
+		return event.EnclosureType.String
+	}
+	return defaultEnclosureType
+}
+
+// hasEnclosure は番組に音声ファイルがあるかどうか
+func hasEnclosure(event db.ListTimelineRow) bool {
+	return textValue(event.EnclosureUrl) != ""
+}
+
+// textValue はNULL許容の文字列を取り出す（NULLの場合は空文字）
+func textValue(t pgtype.Text) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.String
+}
@@ -0,0 +1,207 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// update を指定するとゴールデンファイルを現在の出力で更新する（go test ./internal/feed -update）
+var update = flag.Bool("update", false, "update golden files")
+
+// assertGolden は出力をtestdata配下のゴールデンファイルと比較
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s mismatch\n--- got ---\n%s\n--- want ---\n%s", name, got, want)
+	}
+}
+
+// testChannel はフィード出力テスト用のフィード情報
+var testChannel = Channel{
+	Title:       "Pixicast タイムライン",
+	Description: "購読チャンネルの新着",
+	HomeURL:     "https://example.com",
+	FeedURL:     "https://api.example.com/v1/feeds/secret.rss",
+}
+
+// TestWriteFeeds はRSS・Atom・JSON Feed出力のゴールデンテスト
+func TestWriteFeeds(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		write  func(io.Writer, Channel, []db.ListTimelineRow) error
+		valid  func([]byte) error
+	}{
+		{name: "rss", golden: "timeline.rss", write: WriteRSS, valid: validXML},
+		{name: "atom", golden: "timeline.atom", write: WriteAtom, valid: validXML},
+		{name: "json feed", golden: "timeline.json", write: WriteJSONFeed, valid: validJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var first, second bytes.Buffer
+			if err := tt.write(&first, testChannel, sampleEvents()); err != nil {
+				t.Fatalf("write error = %v", err)
+			}
+			if err := tt.write(&second, testChannel, sampleEvents()); err != nil {
+				t.Fatalf("write error = %v", err)
+			}
+			if !bytes.Equal(first.Bytes(), second.Bytes()) {
+				t.Errorf("output is not deterministic")
+			}
+			if err := tt.valid(first.Bytes()); err != nil {
+				t.Errorf("output is not well-formed: %v", err)
+			}
+			assertGolden(t, tt.golden, first.Bytes())
+		})
+	}
+}
+
+// TestWriteFeedsEmpty は番組が0件の場合も出力できることのテスト
+func TestWriteFeedsEmpty(t *testing.T) {
+	writers := map[string]func(io.Writer, Channel, []db.ListTimelineRow) error{
+		"rss":       WriteRSS,
+		"atom":      WriteAtom,
+		"json feed": WriteJSONFeed,
+	}
+	for name, write := range writers {
+		var buf bytes.Buffer
+		if err := write(&buf, testChannel, nil); err != nil {
+			t.Errorf("%s: write error = %v", name, err)
+		}
+	}
+}
+
+func validXML(b []byte) error {
+	dec := xml.NewDecoder(bytes.NewReader(b))
+	for {
+		if _, err := dec.Token(); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func validJSON(b []byte) error {
+	var v any
+	return json.Unmarshal(b, &v)
+}
+
+func testUUID(b byte) pgtype.UUID {
+	var u pgtype.UUID
+	u.Bytes[0] = b
+	u.Bytes[15] = b
+	u.Valid = true
+	return u
+}
+
+func testTime(s string) pgtype.Timestamptz {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
+
+func testText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: true}
+}
+
+// sampleEvents はフィード出力テスト用の番組（配信予定・ラジオ・配信中・動画・エピソード）
+func sampleEvents() []db.ListTimelineRow {
+	return []db.ListTimelineRow{
+		{
+			ID:                testUUID(1),
+			PlatformID:        "youtube",
+			Type:              "scheduled",
+			Title:             "【雑談】週末まったり配信; ゲストあり, 質問募集",
+			Description:       testText("今日はゲストと一緒に\nいろいろ話します。\\バックスラッシュも"),
+			StartAt:           testTime("2026-10-17T11:00:00Z"),
+			Url:               "https://www.youtube.com/watch?v=abc123",
+			ImageUrl:          testText("https://i.ytimg.com/vi/abc123/hqdefault.jpg"),
+			CreatedAt:         testTime("2026-10-15T09:00:00Z"),
+			UpdatedAt:         testTime("2026-10-16T09:30:00Z"),
+			SourceDisplayName: testText("テストチャンネル"),
+			SourceExternalID:  "UCtest",
+		},
+		{
+			ID:                testUUID(2),
+			PlatformID:        "radiko",
+			Type:              "radio",
+			Title:             "オールナイトニッポン",
+			StartAt:           testTime("2026-10-16T16:00:00Z"),
+			EndAt:             testTime("2026-10-16T18:00:00Z"),
+			Url:               "https://radiko.jp/#!/ts/LFR/20261017010000",
+			CreatedAt:         testTime("2026-10-14T00:00:00Z"),
+			UpdatedAt:         testTime("2026-10-14T00:00:00Z"),
+			SourceDisplayName: testText("ニッポン放送"),
+			SourceExternalID:  "LFR",
+		},
+		{
+			ID:                testUUID(3),
+			PlatformID:        "twitch",
+			Type:              "live",
+			Title:             "Ranked grind with a very long title that needs folding across multiple lines in the calendar output",
+			StartAt:           testTime("2026-10-16T08:00:00Z"),
+			Url:               "https://www.twitch.tv/streamer",
+			ImageUrl:          testText("https://static-cdn.jtvnw.net/previews-ttv/live_user_streamer-440x248.jpg"),
+			CreatedAt:         testTime("2026-10-16T08:00:00Z"),
+			UpdatedAt:         testTime("2026-10-16T08:05:00Z"),
+			SourceDisplayName: testText("Streamer"),
+			SourceExternalID:  "streamer",
+		},
+		{
+			ID:                testUUID(4),
+			PlatformID:        "youtube",
+			Type:              "video",
+			Title:             "アーカイブ動画",
+			PublishedAt:       testTime("2026-10-15T12:00:00Z"),
+			Url:               "https://www.youtube.com/watch?v=def456",
+			ImageUrl:          testText("https://i.ytimg.com/vi/def456/hqdefault.jpg"),
+			Duration:          testText("PT1H2M3S"),
+			CreatedAt:         testTime("2026-10-15T12:10:00Z"),
+			UpdatedAt:         testTime("2026-10-15T12:10:00Z"),
+			SourceDisplayName: testText("テストチャンネル"),
+			SourceExternalID:  "UCtest",
+		},
+		{
+			ID:                testUUID(5),
+			PlatformID:        "podcast",
+			Type:              "episode",
+			Title:             "第100回 <特別編> & おたより",
+			Description:       testText("記念すべき100回目です。"),
+			PublishedAt:       testTime("2026-10-14T21:00:00Z"),
+			Url:               "https://podcasts.apple.com/jp/podcast/id123456789",
+			ImageUrl:          testText("https://example.com/podcast/artwork.jpg"),
+			Duration:          testText("01:15:30"),
+			EnclosureUrl:      testText("https://example.com/podcast/100.mp3"),
+			EnclosureType:     testText("audio/mpeg"),
+			EnclosureLength:   pgtype.Int8{Int64: 12345678, Valid: true},
+			CreatedAt:         testTime("2026-10-14T21:30:00Z"),
+			UpdatedAt:         testTime("2026-10-14T21:30:00Z"),
+			SourceDisplayName: testText("テストポッドキャスト"),
+			SourceExternalID:  "https://example.com/podcast/feed.xml",
+		},
+	}
+}
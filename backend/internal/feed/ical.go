@@ -0,0 +1,128 @@
+package feed
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// CalendarEventTypes はカレンダーに載せるイベント種別（開始時刻が決まっているもの）
+var CalendarEventTypes = []string{"scheduled", "premiere", "radio", "live"}
+
+// defaultEventDuration は終了時刻が未定の番組に仮で設定する長さ
+const defaultEventDuration = time.Hour
+
+// maxICalDescriptionRunes はDESCRIPTIONに含める説明文の最大文字数
+const maxICalDescriptionRunes = 500
+
+// icalLineOctets はiCalendarの1行の最大オクテット数（RFC 5545 3.1）
+const icalLineOctets = 75
+
+// icalTimeFormat はUTCの日時形式
+const icalTimeFormat = "20060102T150405Z"
+
+// WriteICal はタイムラインの番組をiCalendar（RFC 5545）形式で書き出す
+// 開始時刻のない番組は出力しない
+// DTSTAMPには番組の更新日時を使うため、同じ入力からは常に同じ出力になる
+func WriteICal(w io.Writer, name string, events []db.ListTimelineRow) error {
+	var buf bytes.Buffer
+	writeICalLine(&buf, "BEGIN:VCALENDAR")
+	writeICalLine(&buf, "VERSION:2.0")
+	writeICalLine(&buf, "PRODID:-//Pixicast//Timeline//JA")
+	writeICalLine(&buf, "CALSCALE:GREGORIAN")
+	writeICalLine(&buf, "METHOD:PUBLISH")
+	writeICalLine(&buf, "X-WR-CALNAME:"+escapeICalText(name))
+	writeICalLine(&buf, "REFRESH-INTERVAL;VALUE=DURATION:PT1H")
+	writeICalLine(&buf, "X-PUBLISHED-TTL:PT1H")
+
+	for _, event := range events {
+		if !event.StartAt.Valid {
+			continue
+		}
+		start := event.StartAt.Time.UTC()
+		end := start.Add(defaultEventDuration)
+		if event.EndAt.Valid && event.EndAt.Time.After(start) {
+			end = event.EndAt.Time.UTC()
+		}
+		stamp := event.UpdatedAt.Time.UTC()
+		if !event.UpdatedAt.Valid {
+			stamp = event.CreatedAt.Time.UTC()
+		}
+
+		writeICalLine(&buf, "BEGIN:VEVENT")
+		writeICalLine(&buf, "UID:"+event.ID.String()+"@pixicast")
+		writeICalLine(&buf, "DTSTAMP:"+stamp.Format(icalTimeFormat))
+		writeICalLine(&buf, "DTSTART:"+start.Format(icalTimeFormat))
+		writeICalLine(&buf, "DTEND:"+end.Format(icalTimeFormat))
+		writeICalLine(&buf, "SUMMARY:"+escapeICalText(event.Title))
+		writeICalLine(&buf, "DESCRIPTION:"+escapeICalText(icalDescription(event)))
+		if event.Url != "" {
+			writeICalLine(&buf, "URL:"+event.Url)
+		}
+		writeICalLine(&buf, "CATEGORIES:"+escapeICalText(event.PlatformID)+","+escapeICalText(event.Type))
+		writeICalLine(&buf, "END:VEVENT")
+	}
+
+	writeICalLine(&buf, "END:VCALENDAR")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// icalDescription はチャンネル名・リンク・説明文からDESCRIPTIONの本文を作成
+func icalDescription(event db.ListTimelineRow) string {
+	var parts []string
+	if name := textValue(event.SourceDisplayName); name != "" {
+		parts = append(parts, name)
+	}
+	if event.Url != "" {
+		parts = append(parts, event.Url)
+	}
+	if description := textValue(event.Description); description != "" {
+		parts = append(parts, "", truncateRunes(description, maxICalDescriptionRunes))
+	}
+	return strings.Join(parts, "\n")
+}
+
+// escapeICalText はTEXT型の値をエスケープ（RFC 5545 3.3.11）
+func escapeICalText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeICalLine は1行を75オクテットで折り返してCRLF付きで書き出す
+// マルチバイト文字の途中では折り返さない
+func writeICalLine(buf *bytes.Buffer, line string) {
+	limit := icalLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// 継続行は先頭の空白を含めて75オクテット
+		limit = icalLineOctets - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+// truncateRunes は文字数で切り詰め、切り詰めた場合は末尾に「…」を付ける
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max]) + "…"
+}
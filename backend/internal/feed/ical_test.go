@@ -0,0 +1,82 @@
+package feed
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestWriteICal はiCalendar出力のゴールデンテスト
+func TestWriteICal(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteICal(&buf, "Pixicast", sampleEvents()); err != nil {
+		t.Fatalf("WriteICal() error = %v", err)
+	}
+	assertGolden(t, "calendar.ics", buf.Bytes())
+}
+
+// TestEscapeICalText はTEXT型のエスケープのテスト
+func TestEscapeICalText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "plain", input: "配信予定", want: "配信予定"},
+		{name: "comma and semicolon", input: "a,b;c", want: `a\,b\;c`},
+		{name: "backslash", input: `a\b`, want: `a\\b`},
+		{name: "newlines", input: "a\r\nb\nc\rd", want: `a\nb\nc\nd`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeICalText(tt.input); got != tt.want {
+				t.Errorf("escapeICalText(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWriteICalLine は75オクテットでの折り返しのテスト
+func TestWriteICalLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "short", input: "SUMMARY:short"},
+		{name: "ascii long", input: "SUMMARY:" + strings.Repeat("a", 200)},
+		{name: "multibyte long", input: "SUMMARY:" + strings.Repeat("あ", 100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writeICalLine(&buf, tt.input)
+			out := buf.String()
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("line must end with CRLF: %q", out)
+			}
+			lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			var joined strings.Builder
+			for i, line := range lines {
+				if len(line) > icalLineOctets {
+					t.Errorf("line %d has %d octets", i, len(line))
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d splits a multibyte character: %q", i, line)
+				}
+				if i > 0 {
+					if !strings.HasPrefix(line, " ") {
+						t.Errorf("continuation line %d must start with a space", i)
+					}
+					line = line[1:]
+				}
+				joined.WriteString(line)
+			}
+			if joined.String() != tt.input {
+				t.Errorf("unfolded = %q, want %q", joined.String(), tt.input)
+			}
+		})
+	}
+}
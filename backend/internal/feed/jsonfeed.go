@@ -0,0 +1,89 @@
+package feed
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// jsonFeedVersion はJSON Feedのバージョン
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+// JSON Feed 1.1 の出力用構造体（キーの順序はフィールドの順序で固定）
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	FeedURL     string         `json:"feed_url"`
+	Description string         `json:"description,omitempty"`
+	Language    string         `json:"language"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string               `json:"id"`
+	URL           string               `json:"url,omitempty"`
+	Title         string               `json:"title"`
+	ContentText   string               `json:"content_text"`
+	Image         string               `json:"image,omitempty"`
+	DatePublished string               `json:"date_published"`
+	DateModified  string               `json:"date_modified"`
+	Authors       []jsonFeedAuthor     `json:"authors,omitempty"`
+	Tags          []string             `json:"tags"`
+	Attachments   []jsonFeedAttachment `json:"attachments,omitempty"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedAttachment struct {
+	URL         string `json:"url"`
+	MimeType    string `json:"mime_type"`
+	SizeInBytes int64  `json:"size_in_bytes,omitempty"`
+}
+
+// WriteJSONFeed はタイムラインの番組をJSON Feed 1.1形式で書き出す
+// Podcastエピソードは音声ファイルをattachmentsに含める
+func WriteJSONFeed(w io.Writer, ch Channel, events []db.ListTimelineRow) error {
+	feed := jsonFeed{
+		Version:     jsonFeedVersion,
+		Title:       ch.Title,
+		HomePageURL: ch.HomeURL,
+		FeedURL:     ch.FeedURL,
+		Description: ch.Description,
+		Language:    "ja",
+		Items:       []jsonFeedItem{},
+	}
+
+	for _, event := range events {
+		item := jsonFeedItem{
+			ID:            event.ID.String(),
+			URL:           event.Url,
+			Title:         event.Title,
+			ContentText:   textValue(event.Description),
+			Image:         textValue(event.ImageUrl),
+			DatePublished: itemTime(event).Format(time.RFC3339),
+			DateModified:  itemUpdated(event).Format(time.RFC3339),
+			Tags:          []string{event.PlatformID, event.Type},
+		}
+		if name := textValue(event.SourceDisplayName); name != "" {
+			item.Authors = []jsonFeedAuthor{{Name: name}}
+		}
+		if hasEnclosure(event) {
+			item.Attachments = []jsonFeedAttachment{{
+				URL:         event.EnclosureUrl.String,
+				MimeType:    enclosureType(event),
+				SizeInBytes: event.EnclosureLength.Int64,
+			}}
+		}
+		feed.Items = append(feed.Items, item)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(feed)
+}
@@ -0,0 +1,121 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// RSS 2.0 の出力用構造体（要素の順序はフィールドの順序で固定）
+type rssRoot struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Media   string     `xml:"xmlns:media,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	AtomLink      rssSelf   `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssSelf struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string          `xml:"title"`
+	Link        string          `xml:"link,omitempty"`
+	Description string          `xml:"description,omitempty"`
+	Creator     string          `xml:"dc:creator,omitempty"`
+	Categories  []string        `xml:"category"`
+	GUID        rssGUID         `xml:"guid"`
+	PubDate     string          `xml:"pubDate"`
+	Enclosure   *rssEnclosure   `xml:"enclosure"`
+	Thumbnail   *mediaThumbnail `xml:"media:thumbnail"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// mediaThumbnail はMedia RSSのサムネイル（RSSとAtomで共通）
+type mediaThumbnail struct {
+	URL string `xml:"url,attr"`
+}
+
+// WriteRSS はタイムラインの番組をRSS 2.0形式で書き出す
+// Podcastエピソードは音声ファイルをenclosureに、画像はmedia:thumbnailに含める
+func WriteRSS(w io.Writer, ch Channel, events []db.ListTimelineRow) error {
+	root := rssRoot{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Media:   "http://search.yahoo.com/mrss/",
+		DC:      "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:         ch.Title,
+			Link:          ch.HomeURL,
+			Description:   ch.Description,
+			Language:      "ja",
+			LastBuildDate: feedUpdated(events).Format(time.RFC1123Z),
+			AtomLink:      rssSelf{Href: ch.FeedURL, Rel: "self", Type: "application/rss+xml"},
+		},
+	}
+
+	for _, event := range events {
+		item := rssItem{
+			Title:       event.Title,
+			Link:        event.Url,
+			Description: textValue(event.Description),
+			Creator:     textValue(event.SourceDisplayName),
+			Categories:  []string{event.PlatformID, event.Type},
+			GUID:        rssGUID{IsPermaLink: "false", Value: event.ID.String()},
+			PubDate:     itemTime(event).Format(time.RFC1123Z),
+		}
+		if hasEnclosure(event) {
+			item.Enclosure = &rssEnclosure{
+				URL:    event.EnclosureUrl.String,
+				Length: event.EnclosureLength.Int64,
+				Type:   enclosureType(event),
+			}
+		}
+		if image := textValue(event.ImageUrl); image != "" {
+			item.Thumbnail = &mediaThumbnail{URL: image}
+		}
+		root.Channel.Items = append(root.Channel.Items, item)
+	}
+
+	return writeXML(w, root)
+}
+
+// writeXML はXML宣言付きでインデントして書き出す
+func writeXML(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
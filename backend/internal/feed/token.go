@@ -0,0 +1,27 @@
+package feed
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// tokenBytes はシークレットの乱数バイト数（256bit）
+const tokenBytes = 32
+
+// NewToken はフィードURL用のシークレットとDB保存用のハッシュを生成
+func NewToken() (secret, hash string, err error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	secret = base64.RawURLEncoding.EncodeToString(buf)
+	return secret, HashToken(secret), nil
+}
+
+// HashToken はシークレットをDB保存用のSHA-256ハッシュ（16進数）に変換
+func HashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/kinchoKayaba/pixicast/backend/internal/auth"
+	"github.com/kinchoKayaba/pixicast/backend/internal/youtube"
+)
+
+// AdminHandler は運用者向けの管理APIのハンドラ
+type AdminHandler struct {
+	firebaseAuth *auth.FirebaseAuth
+	budget       *youtube.BudgetPlanner
+	adminUIDs    map[string]bool
+}
+
+// NewAdminHandler はハンドラを作成
+// adminUIDs はカンマ区切りの管理者のFirebase UID（空の場合は誰も管理APIを使えない）
+func NewAdminHandler(firebaseAuth *auth.FirebaseAuth, budget *youtube.BudgetPlanner, adminUIDs string) *AdminHandler {
+	uids := make(map[string]bool)
+	for _, uid := range strings.Split(adminUIDs, ",") {
+		if uid = strings.TrimSpace(uid); uid != "" {
+			uids[uid] = true
+		}
+	}
+	return &AdminHandler{
+		firebaseAuth: firebaseAuth,
+		budget:       budget,
+		adminUIDs:    uids,
+	}
+}
+
+// GetQuota はYouTube API Quotaの当日の予算と枠ごとの使用量を返す
+// GET /v1/admin/quota
+func (h *AdminHandler) GetQuota(w http.ResponseWriter, r *http.Request) {
+	uid, err := h.getUID(r)
+	if err != nil {
+		log.Printf("Authentication failed: %v", err)
+		respondError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+	if !h.adminUIDs[uid] {
+		log.Printf("⚠️ Admin API access denied: Firebase UID=%s", uid)
+		respondError(w, http.StatusForbidden, "admin only")
+		return
+	}
+
+	// 他のプロセス（スケジューラ・ワーカー）の使用量も反映する
+	if err := h.budget.Sync(r.Context()); err != nil {
+		log.Printf("Failed to load YouTube API quota usage: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to get quota usage")
+		return
+	}
+	respondJSON(w, http.StatusOK, h.budget.Status())
+}
+
+// getUID はAuthorizationヘッダーのIDトークンを検証してFirebase UIDを取得
+func (h *AdminHandler) getUID(r *http.Request) (string, error) {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		return "", fmt.Errorf("authorization header is required")
+	}
+
+	idToken, err := auth.ExtractTokenFromHeader(authHeader)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := h.firebaseAuth.VerifyIDToken(r.Context(), idToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to verify token: %w", err)
+	}
+	return token.UID, nil
+}
@@ -0,0 +1,377 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/auth"
+	"github.com/kinchoKayaba/pixicast/backend/internal/dberr"
+	"github.com/kinchoKayaba/pixicast/backend/internal/feed"
+	"github.com/kinchoKayaba/pixicast/backend/internal/timeline"
+)
+
+// calendarName はカレンダーアプリに表示するカレンダー名
+const calendarName = "Pixicast"
+
+// カレンダーに含める期間（現在時刻からの前後）
+const (
+	calendarPastWindow   = 7 * 24 * time.Hour
+	calendarFutureWindow = 30 * 24 * time.Hour
+)
+
+// maxCalendarEvents はカレンダーに含める最大件数
+const maxCalendarEvents = 1000
+
+// maxFeedItems はRSS・Atom・JSON Feedに含める最大件数
+const maxFeedItems = 50
+
+// feedWriters はフィードの拡張子ごとの出力形式とContent-Type
+var feedWriters = map[string]struct {
+	write       func(io.Writer, feed.Channel, []db.ListTimelineRow) error
+	contentType string
+}{
+	"rss":  {feed.WriteRSS, "application/rss+xml; charset=utf-8"},
+	"atom": {feed.WriteAtom, "application/atom+xml; charset=utf-8"},
+	"json": {feed.WriteJSONFeed, "application/feed+json; charset=utf-8"},
+}
+
+// FeedHandler はシークレットトークンで認証するフィード（カレンダー購読・RSS・Atom・JSON Feed）のハンドラ
+type FeedHandler struct {
+	pool         *pgxpool.Pool
+	queries      *db.Queries
+	firebaseAuth *auth.FirebaseAuth
+	publicURL    string // フィード・カレンダーのURLに使うAPIの外部公開URL（空の場合はリクエストのHost）
+	frontendURL  string // フィードのサイトURL（空の場合はAPIのURL）
+}
+
+// NewFeedHandler はハンドラを作成
+func NewFeedHandler(pool *pgxpool.Pool, queries *db.Queries, firebaseAuth *auth.FirebaseAuth, publicURL, frontendURL string) *FeedHandler {
+	return &FeedHandler{
+		pool:         pool,
+		queries:      queries,
+		firebaseAuth: firebaseAuth,
+		publicURL:    strings.TrimSuffix(publicURL, "/"),
+		frontendURL:  strings.TrimSuffix(frontendURL, "/"),
+	}
+}
+
+// FeedTokenResponse はトークン状態のレスポンスJSON
+// token と各URLは発行直後のレスポンスにのみ含まれる（DBにはハッシュしか保存しないため）
+type FeedTokenResponse struct {
+	Active      bool   `json:"active"`
+	Token       string `json:"token,omitempty"`
+	CalendarURL string `json:"calendar_url,omitempty"`
+	RSSURL      string `json:"rss_url,omitempty"`
+	AtomURL     string `json:"atom_url,omitempty"`
+	JSONFeedURL string `json:"json_feed_url,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	LastUsedAt  string `json:"last_used_at,omitempty"`
+}
+
+// GetFeedToken は有効なトークンの有無を返す
+func (h *FeedHandler) GetFeedToken(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserID(r)
+	if err != nil {
+		log.Printf("Authentication failed: %v", err)
+		respondError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	token, err := h.queries.GetActiveFeedToken(r.Context(), userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		respondJSON(w, http.StatusOK, FeedTokenResponse{Active: false})
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to get feed token: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to get feed token")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, FeedTokenResponse{
+		Active:     true,
+		CreatedAt:  formatTimestamp(token.CreatedAt),
+		LastUsedAt: formatTimestamp(token.LastUsedAt),
+	})
+}
+
+// IssueFeedToken はトークンを発行する（既存のトークンは無効化され、古いURLは使えなくなる）
+func (h *FeedHandler) IssueFeedToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, err := h.getUserID(r)
+	if err != nil {
+		log.Printf("Authentication failed: %v", err)
+		respondError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	secret, hash, err := feed.NewToken()
+	if err != nil {
+		log.Printf("Failed to generate feed token: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to generate feed token")
+		return
+	}
+
+	// 無効化と発行は同じトランザクションで行う（発行に失敗しても既存のURLは使えるままにする）
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("Failed to begin transaction: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to create feed token")
+		return
+	}
+	defer tx.Rollback(ctx)
+	qtx := h.queries.WithTx(tx)
+
+	if _, err := qtx.RevokeFeedTokens(ctx, userID); err != nil {
+		log.Printf("Failed to revoke feed tokens: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to revoke feed token")
+		return
+	}
+
+	token, err := qtx.CreateFeedToken(ctx, db.CreateFeedTokenParams{
+		UserID:    userID,
+		TokenHash: hash,
+	})
+	if dberr.IsUniqueViolation(err) {
+		// 同時に発行された別のリクエストが先にコミットした（有効なトークンはユーザーごとに1つ）
+		respondError(w, http.StatusConflict, "feed token is being issued by another request")
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to create feed token: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to create feed token")
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Failed to commit feed token: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to create feed token")
+		return
+	}
+
+	log.Printf("🔑 Issued feed token: user_id=%d", userID)
+
+	base := h.baseURL(r)
+	respondJSON(w, http.StatusCreated, FeedTokenResponse{
+		Active:      true,
+		Token:       secret,
+		CalendarURL: base + "/v1/calendar/" + secret + ".ics",
+		RSSURL:      base + "/v1/feeds/" + secret + ".rss",
+		AtomURL:     base + "/v1/feeds/" + secret + ".atom",
+		JSONFeedURL: base + "/v1/feeds/" + secret + ".json",
+		CreatedAt:   formatTimestamp(token.CreatedAt),
+	})
+}
+
+// RevokeFeedToken はトークンを無効化する
+func (h *FeedHandler) RevokeFeedToken(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserID(r)
+	if err != nil {
+		log.Printf("Authentication failed: %v", err)
+		respondError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	revoked, err := h.queries.RevokeFeedTokens(r.Context(), userID)
+	if err != nil {
+		log.Printf("Failed to revoke feed tokens: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to revoke feed token")
+		return
+	}
+
+	log.Printf("🔒 Revoked feed token: user_id=%d, count=%d", userID, revoked)
+	respondJSON(w, http.StatusOK, FeedTokenResponse{Active: false})
+}
+
+// Calendar は GET /v1/calendar/{secret}.ics で購読チャンネルの配信予定をiCalendar形式で返す
+func (h *FeedHandler) Calendar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	secret, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/v1/calendar/"), ".ics")
+	if !ok || secret == "" || strings.Contains(secret, "/") {
+		respondError(w, http.StatusNotFound, "calendar not found")
+		return
+	}
+
+	token, err := h.tokenFromSecret(r, secret)
+	if err != nil {
+		respondError(w, http.StatusNotFound, "calendar not found")
+		return
+	}
+
+	now := time.Now()
+	rows, err := h.queries.ListTimelineAscending(ctx, db.ListTimelineAscendingParams{
+		UserID:     token.UserID,
+		DayStart:   pgtype.Timestamptz{Time: now.Add(-calendarPastWindow), Valid: true},
+		DayEnd:     pgtype.Timestamptz{Time: now.Add(calendarFutureWindow), Valid: true},
+		EventTypes: feed.CalendarEventTypes,
+		PageLimit:  maxCalendarEvents,
+	})
+	if err != nil {
+		log.Printf("Failed to list calendar events: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to list events")
+		return
+	}
+	events := make([]db.ListTimelineRow, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, db.ListTimelineRow(row))
+	}
+
+	var buf bytes.Buffer
+	if err := feed.WriteICal(&buf, calendarName, events); err != nil {
+		log.Printf("Failed to write calendar: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to write calendar")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="pixicast.ics"`)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// Feed は GET /v1/feeds/{secret}.{rss|atom|json} で購読チャンネルのタイムラインをフィード形式で返す
+// クエリパラメータ platform（複数指定・カンマ区切り可）と favorites=true で絞り込める
+func (h *FeedHandler) Feed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	name := strings.TrimPrefix(r.URL.Path, "/v1/feeds/")
+	dot := strings.LastIndex(name, ".")
+	if dot <= 0 || strings.Contains(name, "/") {
+		respondError(w, http.StatusNotFound, "feed not found")
+		return
+	}
+	secret, format := name[:dot], name[dot+1:]
+	writer, ok := feedWriters[format]
+	if !ok {
+		respondError(w, http.StatusNotFound, "feed not found")
+		return
+	}
+
+	var platforms []string
+	for _, v := range r.URL.Query()["platform"] {
+		for _, p := range strings.Split(v, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				platforms = append(platforms, p)
+			}
+		}
+	}
+	favoritesOnly, _ := strconv.ParseBool(r.URL.Query().Get("favorites"))
+	filter, err := timeline.NewFilter(nil, platforms, nil, nil, favoritesOnly)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	token, err := h.tokenFromSecret(r, secret)
+	if err != nil {
+		respondError(w, http.StatusNotFound, "feed not found")
+		return
+	}
+
+	events, err := h.queries.ListTimeline(ctx, db.ListTimelineParams{
+		UserID:        token.UserID,
+		PlatformIds:   filter.PlatformIDs,
+		FavoritesOnly: filter.FavoritesOnly,
+		PageLimit:     maxFeedItems,
+	})
+	if err != nil {
+		log.Printf("Failed to list feed events: %v", err)
+		respondError(w, http.StatusInternalServerError, "failed to list events")
+		return
+	}
+
+	base := h.baseURL(r)
+	home := h.frontendURL
+	if home == "" {
+		home = base
+	}
+	ch := feed.Channel{
+		Title:       "Pixicast タイムライン",
+		Description: "Pixicastで購読しているチャンネルの配信・動画・番組",
+		HomeURL:     home,
+		FeedURL:     base + r.URL.RequestURI(),
+	}
+
+	var buf bytes.Buffer
+	if err := writer.write(&buf, ch, events); err != nil {
+		log.Printf("Failed to write %s feed: %v", format, err)
+		respondError(w, http.StatusInternalServerError, "failed to write feed")
+		return
+	}
+
+	w.Header().Set("Content-Type", writer.contentType)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// tokenFromSecret はURLのシークレットから有効なトークンを取得し、最終利用日時を更新
+func (h *FeedHandler) tokenFromSecret(r *http.Request, secret string) (db.FeedToken, error) {
+	token, err := h.queries.GetFeedTokenByHash(r.Context(), feed.HashToken(secret))
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			log.Printf("Failed to get feed token: %v", err)
+		}
+		return db.FeedToken{}, err
+	}
+	if err := h.queries.TouchFeedToken(r.Context(), token.ID); err != nil {
+		log.Printf("Failed to touch feed token: %v", err)
+	}
+	return token, nil
+}
+
+// getUserID はリクエストからuser_idを取得
+func (h *FeedHandler) getUserID(r *http.Request) (int64, error) {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		return 0, fmt.Errorf("authorization header is required")
+	}
+
+	idToken, err := auth.ExtractTokenFromHeader(authHeader)
+	if err != nil {
+		return 0, err
+	}
+
+	token, err := h.firebaseAuth.VerifyIDToken(r.Context(), idToken)
+	if err != nil {
+		return 0, fmt.Errorf("failed to verify token: %w", err)
+	}
+
+	return auth.GetUserIDFromToken(token), nil
+}
+
+// baseURL はフィード・カレンダーのURLに使うAPIの外部公開URL
+// X-Forwarded-Host などのヘッダーはクライアントが自由に設定できるため使わず、設定したURLを優先する
+// 未設定の場合（ローカル開発など）はリクエストのHostから組み立てる
+func (h *FeedHandler) baseURL(r *http.Request) string {
+	if h.publicURL != "" {
+		return h.publicURL
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// formatTimestamp はNULL許容の日時をRFC3339文字列に変換（NULLの場合は空文字）
+func formatTimestamp(t pgtype.Timestamptz) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339)
+}
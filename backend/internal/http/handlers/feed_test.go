@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+// TestFeedBaseURL はフィードのURLに使うAPIの外部公開URLのテスト
+func TestFeedBaseURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		publicURL string
+		want      string
+	}{
+		{name: "Configured public URL", publicURL: "https://api.pixicast.example/", want: "https://api.pixicast.example"},
+		{name: "Falls back to request host", publicURL: "", want: "http://api.internal:8080"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewFeedHandler(nil, nil, nil, tt.publicURL, "")
+			r := httptest.NewRequest("POST", "http://api.internal:8080/v1/feed-token", nil)
+			// 転送ヘッダーはクライアントが偽装できるため使わない
+			r.Header.Set("X-Forwarded-Proto", "https")
+			r.Header.Set("X-Forwarded-Host", "attacker.example")
+
+			if got := h.baseURL(r); got != tt.want {
+				t.Errorf("baseURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -60,7 +61,7 @@ type SearchHandler struct {
 	twitch       *twitch.Client
 	podcast      *podcast.Client
 	firebaseAuth *auth.FirebaseAuth
-	quotaTracker *youtube.QuotaTracker
+	budget       *youtube.BudgetPlanner
 	cache        map[string]cacheEntry
 	cacheMu      sync.RWMutex
 }
@@ -72,7 +73,7 @@ func NewSearchHandler(
 	twitchClient *twitch.Client,
 	podcastClient *podcast.Client,
 	firebaseAuth *auth.FirebaseAuth,
-	quotaTracker *youtube.QuotaTracker,
+	budget *youtube.BudgetPlanner,
 ) *SearchHandler {
 	return &SearchHandler{
 		queries:      queries,
@@ -80,7 +81,7 @@ func NewSearchHandler(
 		twitch:       twitchClient,
 		podcast:      podcastClient,
 		firebaseAuth: firebaseAuth,
-		quotaTracker: quotaTracker,
+		budget:       budget,
 		cache: make(map[string]cacheEntry),
 	}
 }
@@ -322,16 +323,18 @@ func (h *SearchHandler) searchExternalAPIs(ctx context.Context, query string, pl
 
 	// YouTube検索
 	if platform == "" || platform == "youtube" {
-		if h.quotaTracker.GetUsagePercent() >= 80 {
+		// 検索の枠の予算を使い切ったら外部APIを使わない（定期更新の予算を検索で使い切らないようにする）
+		if ok, reason := h.budget.Check(youtube.BucketSearch, youtube.SearchCost); !ok {
 			quotaWarning = "youtube_quota_limited"
-			log.Printf("SearchChannels: YouTube quota limited (%.1f%%), skipping external search", h.quotaTracker.GetUsagePercent())
-		} else if h.quotaTracker.CanUse(101) {
-			ytResults, err := h.youtube.SearchChannels(ctx, query, int64(maxResults))
-			if err != nil {
+			log.Printf("SearchChannels: YouTube quota limited (%s), skipping external search", reason)
+		} else {
+			ytResults, err := h.youtube.SearchChannels(youtube.WithBucket(ctx, youtube.BucketSearch), query, int64(maxResults))
+			if errors.Is(err, youtube.ErrQuotaExhausted) {
+				quotaWarning = "youtube_quota_limited"
+				log.Printf("SearchChannels: %v", err)
+			} else if err != nil {
 				log.Printf("SearchChannels: YouTube API search failed: %v", err)
 			} else {
-				h.quotaTracker.RecordUsage(ctx, "search.list", 100)
-				h.quotaTracker.RecordUsage(ctx, "channels.list", 1)
 
 				for _, yt := range ytResults {
 					ch := ChannelSearchResult{
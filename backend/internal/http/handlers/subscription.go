@@ -3,16 +3,22 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/kinchoKayaba/pixicast/backend/db"
 	"github.com/kinchoKayaba/pixicast/backend/internal/auth"
 	"github.com/kinchoKayaba/pixicast/backend/internal/ingest"
+	"github.com/kinchoKayaba/pixicast/backend/internal/jobqueue"
 	"github.com/kinchoKayaba/pixicast/backend/internal/podcast"
 	"github.com/kinchoKayaba/pixicast/backend/internal/radiko"
 	"github.com/kinchoKayaba/pixicast/backend/internal/twitch"
@@ -26,21 +32,26 @@ type SubscriptionHandler struct {
 	twitch       *twitch.Client
 	podcast      *podcast.Client
 	radiko       *radiko.Client
+	jobs         *jobqueue.Queue
 	firebaseAuth *auth.FirebaseAuth
 }
 
 // NewSubscriptionHandler はハンドラを作成
-func NewSubscriptionHandler(queries *db.Queries, youtubeClient *youtube.Client, twitchClient *twitch.Client, podcastClient *podcast.Client, radikoClient *radiko.Client, firebaseAuth *auth.FirebaseAuth) *SubscriptionHandler {
+func NewSubscriptionHandler(queries *db.Queries, youtubeClient *youtube.Client, twitchClient *twitch.Client, podcastClient *podcast.Client, radikoClient *radiko.Client, jobs *jobqueue.Queue, firebaseAuth *auth.FirebaseAuth) *SubscriptionHandler {
 	return &SubscriptionHandler{
 		queries:      queries,
 		youtube:      youtubeClient,
 		twitch:       twitchClient,
 		podcast:      podcastClient,
 		radiko:       radikoClient,
+		jobs:         jobs,
 		firebaseAuth: firebaseAuth,
 	}
 }
 
+// backfillSince は購読時に取り込む過去分のコンテンツの開始日時
+var backfillSince = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
 // CreateSubscriptionRequest はリクエストJSON
 type CreateSubscriptionRequest struct {
 	Platform string `json:"platform"` // "youtube"
@@ -54,15 +65,17 @@ type CreateSubscriptionResponse struct {
 
 // SubscriptionData は購読情報
 type SubscriptionData struct {
-	UserID       int64  `json:"user_id"`
-	Platform     string `json:"platform"`
-	SourceID     string `json:"source_id"`
-	ChannelID    string `json:"channel_id"`
-	Handle       string `json:"handle,omitempty"`
-	DisplayName  string `json:"display_name"`
-	ThumbnailURL string `json:"thumbnail_url,omitempty"`
-	Enabled      bool   `json:"enabled"`
-	IsFavorite   bool   `json:"is_favorite"`
+	UserID       int64    `json:"user_id"`
+	Platform     string   `json:"platform"`
+	SourceID     string   `json:"source_id"`
+	ChannelID    string   `json:"channel_id"`
+	Handle       string   `json:"handle,omitempty"`
+	DisplayName  string   `json:"display_name"`
+	ThumbnailURL string   `json:"thumbnail_url,omitempty"`
+	Enabled      bool     `json:"enabled"`
+	IsFavorite   bool     `json:"is_favorite"`
+	Priority     int32    `json:"priority"`
+	TagIDs       []string `json:"tag_ids,omitempty"`
 }
 
 // ErrorResponse はエラーレスポンス
@@ -75,52 +88,14 @@ type ErrorResponse struct {
 func (h *SubscriptionHandler) CreateSubscription(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Firebase認証: user_idを取得
-	userID, err := h.getUserIDFromRequest(r)
+	// Firebase認証: user_idとプラン種別を取得
+	userID, planType, err := h.authenticate(ctx, r.Header.Get("Authorization"))
 	if err != nil {
 		log.Printf("Authentication failed: %v", err)
 		respondError(w, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
-	// プラン別チャンネル数制限チェック
-	authHeader := r.Header.Get("Authorization")
-	if authHeader != "" {
-		idToken, err := auth.ExtractTokenFromHeader(authHeader)
-		if err == nil && idToken != "" {
-			token, err := h.firebaseAuth.VerifyIDToken(ctx, idToken)
-			if err == nil && token != nil {
-				planType := auth.GetPlanTypeFromToken(token)
-				log.Printf("📊 Plan check - planType: %s, userID: %d", planType, userID)
-				
-				planLimit, err := h.queries.GetPlanLimit(ctx, planType)
-				if err != nil {
-					log.Printf("❌ Failed to get plan limit for %s: %v", planType, err)
-					planLimit.MaxChannels = 5
-				}
-				log.Printf("📊 Plan limit - max_channels: %d, display_name: %s", planLimit.MaxChannels, planLimit.DisplayName)
-				
-				count, err := h.queries.CountUserSubscriptions(ctx, userID)
-				if err != nil {
-					log.Printf("❌ Failed to count subscriptions: %v", err)
-				} else {
-				log.Printf("📊 Current subscriptions: %d / %d", count, planLimit.MaxChannels)
-				if count >= int64(planLimit.MaxChannels) {
-					log.Printf("🚫 Subscription limit reached for planType: %s", planType)
-					if planType == "free_anonymous" {
-						respondError(w, http.StatusForbidden, fmt.Sprintf("Freeプランは%dチャンネルまでです。Googleログインして最大20チャンネル登録できるBasicプランにアップグレード！", planLimit.MaxChannels))
-					} else if planType == "free_login" {
-						respondError(w, http.StatusForbidden, fmt.Sprintf("Basicプランは%dチャンネルまでです。Plusプランで無制限登録しませんか？", planLimit.MaxChannels))
-					} else {
-						respondError(w, http.StatusForbidden, fmt.Sprintf("%sプランは%dチャンネルまでです。", planLimit.DisplayName, planLimit.MaxChannels))
-					}
-					return
-				}
-				}
-			}
-		}
-	}
-
 	// リクエスト解析
 	var req CreateSubscriptionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -128,59 +103,137 @@ func (h *SubscriptionHandler) CreateSubscription(w http.ResponseWriter, r *http.
 		return
 	}
 
+	subscription, err := h.createSubscription(ctx, userID, planType, req)
+	if err != nil {
+		respondRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(CreateSubscriptionResponse{
+		Subscription: *subscription,
+	})
+}
+
+// createSubscription はプラン別のチャンネル数上限をチェックして購読を登録
+// 登録済みのチャンネル（別の入力で登録したものを含む）は上限に数えず、登録済みの購読を返す
+func (h *SubscriptionHandler) createSubscription(ctx context.Context, userID int64, planType string, req CreateSubscriptionRequest) (*SubscriptionData, error) {
 	// バリデーション
 	if req.Platform != "youtube" && req.Platform != "twitch" && req.Platform != "podcast" && req.Platform != "radiko" {
-		respondError(w, http.StatusBadRequest, "only youtube, twitch, podcast, and radiko platforms are supported")
-		return
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("only youtube, twitch, podcast, and radiko platforms are supported"))
 	}
 	if req.Input == "" {
-		respondError(w, http.StatusBadRequest, "input is required")
-		return
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("input is required"))
 	}
 
 	// プラットフォーム別処理
 	switch req.Platform {
 	case "youtube":
-		h.handleYouTubeSubscription(ctx, w, req, userID)
+		return h.handleYouTubeSubscription(ctx, req, userID, planType)
 	case "twitch":
-		h.handleTwitchSubscription(ctx, w, req, userID)
+		return h.handleTwitchSubscription(ctx, req, userID, planType)
 	case "podcast":
-		h.handlePodcastSubscription(ctx, w, req, userID)
-	case "radiko":
-		h.handleRadikoSubscription(ctx, w, req, userID)
+		return h.handlePodcastSubscription(ctx, req, userID, planType)
 	default:
-		respondError(w, http.StatusBadRequest, fmt.Sprintf("unsupported platform: %s", req.Platform))
+		return h.handleRadikoSubscription(ctx, req, userID, planType)
 	}
 }
 
-// getUserIDFromRequest はリクエストからuser_idを取得
-func (h *SubscriptionHandler) getUserIDFromRequest(r *http.Request) (int64, error) {
-	authHeader := r.Header.Get("Authorization")
+// subscribe はソースを購読する
+// 購読済みの場合は有効・無効や優先度を変えずにそのまま返し、新しく購読する場合のみチャンネル数上限をチェックする
+func (h *SubscriptionHandler) subscribe(ctx context.Context, userID int64, planType string, sourceID pgtype.UUID) (db.UserSubscription, error) {
+	existing, err := h.queries.GetUserSubscription(ctx, db.GetUserSubscriptionParams{
+		UserID:   userID,
+		SourceID: sourceID,
+	})
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		log.Printf("Failed to get user subscription: %v", err)
+		return db.UserSubscription{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create subscription"))
+	}
+
+	if err := h.checkChannelLimit(ctx, userID, planType); err != nil {
+		return db.UserSubscription{}, err
+	}
+
+	subscription, err := h.queries.UpsertUserSubscription(ctx, db.UpsertUserSubscriptionParams{
+		UserID:   userID,
+		SourceID: sourceID,
+		Enabled:  true,
+		Priority: 0,
+	})
+	if err != nil {
+		log.Printf("Failed to upsert user subscription: %v", err)
+		return db.UserSubscription{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create subscription"))
+	}
+
+	log.Printf("Upserted user_subscription: user_id=%d, source_id=%s", userID, sourceID.String())
+	return subscription, nil
+}
+
+// checkChannelLimit はプラン別のチャンネル数上限に達していないかチェック
+func (h *SubscriptionHandler) checkChannelLimit(ctx context.Context, userID int64, planType string) error {
+	log.Printf("📊 Plan check - planType: %s, userID: %d", planType, userID)
+
+	planLimit, err := h.queries.GetPlanLimit(ctx, planType)
+	if err != nil {
+		log.Printf("❌ Failed to get plan limit for %s: %v", planType, err)
+		planLimit.MaxChannels = 5
+	}
+	log.Printf("📊 Plan limit - max_channels: %d, display_name: %s", planLimit.MaxChannels, planLimit.DisplayName)
+
+	count, err := h.queries.CountUserSubscriptions(ctx, userID)
+	if err != nil {
+		log.Printf("❌ Failed to count subscriptions: %v", err)
+		return nil
+	}
+	log.Printf("📊 Current subscriptions: %d / %d", count, planLimit.MaxChannels)
+	if count < int64(planLimit.MaxChannels) {
+		return nil
+	}
+
+	log.Printf("🚫 Subscription limit reached for planType: %s", planType)
+	var msg string
+	switch planType {
+	case "free_anonymous":
+		msg = fmt.Sprintf("Freeプランは%dチャンネルまでです。Googleログインして最大20チャンネル登録できるBasicプランにアップグレード！", planLimit.MaxChannels)
+	case "free_login":
+		msg = fmt.Sprintf("Basicプランは%dチャンネルまでです。Plusプランで無制限登録しませんか？", planLimit.MaxChannels)
+	default:
+		msg = fmt.Sprintf("%sプランは%dチャンネルまでです。", planLimit.DisplayName, planLimit.MaxChannels)
+	}
+	return connect.NewError(connect.CodePermissionDenied, errors.New(msg))
+}
+
+// authenticate はAuthorizationヘッダーのIDトークンを検証してuser_idとプラン種別を返す
+func (h *SubscriptionHandler) authenticate(ctx context.Context, authHeader string) (int64, string, error) {
 	if authHeader == "" {
-		return 0, fmt.Errorf("authorization header is required")
+		return 0, "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authorization header is required"))
 	}
 
 	idToken, err := auth.ExtractTokenFromHeader(authHeader)
 	if err != nil {
-		return 0, err
+		return 0, "", connect.NewError(connect.CodeUnauthenticated, err)
 	}
 
-	token, err := h.firebaseAuth.VerifyIDToken(r.Context(), idToken)
+	token, err := h.firebaseAuth.VerifyIDToken(ctx, idToken)
 	if err != nil {
-		return 0, fmt.Errorf("failed to verify token: %w", err)
+		return 0, "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to verify token: %w", err))
 	}
 
 	userID := auth.GetUserIDFromToken(token)
 	log.Printf("✅ Authenticated user: Firebase UID=%s, user_id=%d, anonymous=%v", token.UID, userID, token.Firebase.SignInProvider == "anonymous")
-	return userID, nil
+	return userID, auth.GetPlanTypeFromToken(token), nil
 }
 
 // handleYouTubeSubscription はYouTube購読処理
-func (h *SubscriptionHandler) handleYouTubeSubscription(ctx context.Context, w http.ResponseWriter, req CreateSubscriptionRequest, userID int64) {
+func (h *SubscriptionHandler) handleYouTubeSubscription(ctx context.Context, req CreateSubscriptionRequest, userID int64, planType string) (*SubscriptionData, error) {
 	channelID, handle, err := h.normalizeInput(req.Input)
 	if err != nil {
-		respondError(w, http.StatusBadRequest, err.Error())
-		return
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
 	log.Printf("Normalized YouTube input: channelID=%s, handle=%s", channelID, handle)
@@ -189,8 +242,7 @@ func (h *SubscriptionHandler) handleYouTubeSubscription(ctx context.Context, w h
 		resolvedID, err := h.youtube.ResolveHandle(ctx, handle)
 		if err != nil {
 			log.Printf("Failed to resolve handle @%s: %v", handle, err)
-			respondError(w, http.StatusNotFound, fmt.Sprintf("channel not found for handle: @%s", handle))
-			return
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("channel not found for handle: @%s", handle))
 		}
 		channelID = resolvedID
 		log.Printf("Resolved @%s to channelID: %s", handle, channelID)
@@ -199,8 +251,7 @@ func (h *SubscriptionHandler) handleYouTubeSubscription(ctx context.Context, w h
 	details, err := h.youtube.GetChannelDetails(ctx, channelID)
 	if err != nil {
 		log.Printf("Failed to get channel details for %s: %v", channelID, err)
-		respondError(w, http.StatusNotFound, "channel not found")
-		return
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("channel not found"))
 	}
 
 	if handle == "" && details.Handle != "" {
@@ -209,18 +260,7 @@ func (h *SubscriptionHandler) handleYouTubeSubscription(ctx context.Context, w h
 
 	log.Printf("YouTube channel details: %+v", details)
 
-	subscription, err := h.upsertYouTubeSubscription(ctx, userID, req.Platform, details)
-	if err != nil {
-		log.Printf("Failed to upsert YouTube subscription: %v", err)
-		respondError(w, http.StatusInternalServerError, "failed to create subscription")
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(CreateSubscriptionResponse{
-		Subscription: *subscription,
-	})
+	return h.upsertYouTubeSubscription(ctx, userID, planType, req.Platform, details)
 }
 
 // normalizeInput は入力を正規化してchannelIDまたはhandleを抽出
@@ -300,6 +340,7 @@ type subscriptionResult struct {
 func (h *SubscriptionHandler) upsertYouTubeSubscription(
 	ctx context.Context,
 	userID int64,
+	planType string,
 	platform string,
 	details *youtube.ChannelDetails,
 ) (*SubscriptionData, error) {
@@ -325,40 +366,20 @@ func (h *SubscriptionHandler) upsertYouTubeSubscription(
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to upsert source: %w", err)
+		log.Printf("Failed to upsert source: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create subscription"))
 	}
 
 	log.Printf("Upserted source: %s (id=%s)", source.ExternalID, source.ID.String())
 
-	// user_subscriptionsをupsert
-	subscription, err := h.queries.UpsertUserSubscription(ctx, db.UpsertUserSubscriptionParams{
-		UserID:   userID,
-		SourceID: source.ID,
-		Enabled:  true,
-		Priority: 0,
-	})
+	// user_subscriptionsに登録（購読済みの場合はそのまま）
+	subscription, err := h.subscribe(ctx, userID, planType, source.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to upsert user subscription: %w", err)
-	}
-
-	log.Printf("Upserted user_subscription: user_id=%d, source_id=%s", userID, source.ID.String())
-
-	// チャンネル追加後、2025/1/1以降の全動画を取得してDBに保存
-	go func() {
-		ingestCtx := context.Background()
-		since := "2025-01-01T00:00:00Z"
-		if err := ingest.FetchAndSaveChannelVideosSince(
-			ingestCtx,
-			h.queries,
-			h.youtube,
-			source.ID,
-			details.ChannelID,
-			0, // 全動画
-			since,
-		); err != nil {
-			log.Printf("Failed to fetch videos for channel %s: %v", details.ChannelID, err)
-		}
-	}()
+		return nil, err
+	}
+
+	// チャンネル追加後、2025/1/1以降の全動画の取り込みをジョブキューに登録
+	h.enqueueIngest(ctx, source.ID)
 
 	return &SubscriptionData{
 		UserID:       userID,
@@ -369,19 +390,22 @@ func (h *SubscriptionHandler) upsertYouTubeSubscription(
 		DisplayName:  details.DisplayName,
 		ThumbnailURL: details.ThumbnailURL,
 		Enabled:      subscription.Enabled,
+		IsFavorite:   subscription.IsFavorite,
+		Priority:     subscription.Priority,
 	}, nil
 }
 
-// enqueueIngest は非同期取り込みキック（スタブ）
-func (h *SubscriptionHandler) enqueueIngest(sourceID string) {
-	// TODO: 将来はCloud Tasks / PubSubに差し替え
-	log.Printf("TODO: Enqueue ingest for source_id=%s", sourceID)
-	
-	// 実際の取り込み処理はここに実装予定
-	// 例：
-	// - sourcesからuploads_playlist_idを取得
-	// - PlaylistItems APIで動画一覧を取得
-	// - programsテーブルに保存
+// enqueueIngest は購読したソースの過去分の取り込みをジョブキューに登録
+// 登録に失敗しても購読は成功させる（定期更新で取り込まれる）
+func (h *SubscriptionHandler) enqueueIngest(ctx context.Context, sourceID pgtype.UUID) {
+	created, err := h.jobs.EnqueueBackfill(ctx, sourceID, backfillSince)
+	if err != nil {
+		log.Printf("❌ Failed to enqueue ingest for source_id=%s: %v", sourceID.String(), err)
+		return
+	}
+	if created {
+		log.Printf("Enqueued ingest for source_id=%s", sourceID.String())
+	}
 }
 
 // ListSubscriptions は購読一覧取得API
@@ -390,7 +414,7 @@ func (h *SubscriptionHandler) ListSubscriptions(w http.ResponseWriter, r *http.R
 	ctx := r.Context()
 
 	// 認証: user_idを取得（認証なしの場合は空リスト返却）
-	userID, err := h.getUserIDFromRequest(r)
+	userID, _, err := h.authenticate(ctx, r.Header.Get("Authorization"))
 	if err != nil {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -398,133 +422,91 @@ func (h *SubscriptionHandler) ListSubscriptions(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// 購読一覧を取得
-	subscriptions, err := h.queries.ListUserEnabledSubscriptions(ctx, userID)
+	// include_disabled=true の場合は一時停止中の購読も含める
+	includeDisabled, _ := strconv.ParseBool(r.URL.Query().Get("include_disabled"))
+	subscriptions, err := h.listSubscriptions(ctx, userID, includeDisabled)
 	if err != nil {
-		log.Printf("Failed to list subscriptions: %v", err)
-		respondError(w, http.StatusInternalServerError, "failed to fetch subscriptions")
+		respondRPCError(w, err)
 		return
 	}
 
-	// レスポンス用に変換
-	var responseData []SubscriptionData
-	for _, sub := range subscriptions {
-		// NULL許容フィールドの処理
-		handle := ""
-		if sub.Handle.Valid {
-			handle = sub.Handle.String
+	// レスポンス
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"subscriptions": subscriptions,
+	})
+}
+
+// listSubscriptions は購読一覧を優先度順に取得
+func (h *SubscriptionHandler) listSubscriptions(ctx context.Context, userID int64, includeDisabled bool) ([]SubscriptionData, error) {
+	var rows []db.ListUserSubscriptionsRow
+	if includeDisabled {
+		all, err := h.queries.ListUserSubscriptions(ctx, userID)
+		if err != nil {
+			log.Printf("Failed to list subscriptions: %v", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch subscriptions"))
 		}
-		displayName := ""
-		if sub.DisplayName.Valid {
-			displayName = sub.DisplayName.String
+		rows = all
+	} else {
+		enabled, err := h.queries.ListUserEnabledSubscriptions(ctx, userID)
+		if err != nil {
+			log.Printf("Failed to list subscriptions: %v", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch subscriptions"))
 		}
-		thumbnailURL := ""
-		if sub.ThumbnailUrl.Valid {
-			thumbnailURL = sub.ThumbnailUrl.String
+		for _, row := range enabled {
+			rows = append(rows, db.ListUserSubscriptionsRow(row))
 		}
+	}
 
-		responseData = append(responseData, SubscriptionData{
+	tagIDs, err := h.subscriptionTagIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンス用に変換（NULL許容フィールドは空文字）
+	subscriptions := make([]SubscriptionData, 0, len(rows))
+	for _, sub := range rows {
+		subscriptions = append(subscriptions, SubscriptionData{
 			UserID:       userID,
 			Platform:     sub.PlatformID,
 			SourceID:     sub.ID.String(),
 			ChannelID:    sub.ExternalID,
-			Handle:       handle,
-			DisplayName:  displayName,
-			ThumbnailURL: thumbnailURL,
+			Handle:       sub.Handle.String,
+			DisplayName:  sub.DisplayName.String,
+			ThumbnailURL: sub.ThumbnailUrl.String,
 			Enabled:      sub.Enabled,
 			IsFavorite:   sub.IsFavorite,
+			Priority:     sub.Priority,
+			TagIDs:       tagIDs[sub.ID.String()],
 		})
 	}
-
-	// レスポンス
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"subscriptions": responseData,
-	})
+	return subscriptions, nil
 }
 
 // DeleteSubscription はチャンネル登録を解除
+// DELETE /v1/subscriptions/{channelId}
 func (h *SubscriptionHandler) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
 	log.Printf("DeleteSubscription called: method=%s, path=%s", r.Method, r.URL.Path)
-
-	// CORSヘッダー（最初に設定）
-	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Methods", "DELETE, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-
-	if r.Method == http.MethodOptions {
-		w.WriteHeader(http.StatusOK)
-		return
-	}
-
-	if r.Method != http.MethodDelete {
-		respondError(w, http.StatusMethodNotAllowed, "Method not allowed")
-		return
-	}
+	ctx := r.Context()
 
 	// 認証: user_idを取得
-	userID, err := h.getUserIDFromRequest(r)
+	userID, _, err := h.authenticate(ctx, r.Header.Get("Authorization"))
 	if err != nil {
 		log.Printf("Authentication failed: %v", err)
 		respondError(w, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
-	// URLからチャンネルIDを取得 (/v1/subscriptions/{channelId})
-	path := r.URL.Path
-	channelIDEncoded := strings.TrimPrefix(path, "/v1/subscriptions/")
-	
-	if channelIDEncoded == "" {
-		respondError(w, http.StatusBadRequest, "Channel ID is required")
-		return
-	}
-	
-	// URLデコード
-	channelID, err := url.PathUnescape(channelIDEncoded)
+	source, err := h.sourceFromPath(ctx, r)
 	if err != nil {
-		log.Printf("❌ Failed to decode channel ID: %v", err)
-		respondError(w, http.StatusBadRequest, "Invalid channel ID")
-		return
-	}
-	log.Printf("Deleting channel: %s", channelID)
-
-	ctx := context.Background()
-
-	// チャンネルを検索（複数のプラットフォームを試す）
-	var source db.Source
-	var findErr error
-	
-	// YouTube, Twitch, Podcastの順に試す
-	platforms := []string{"youtube", "twitch", "podcast"}
-	found := false
-	
-	for _, platform := range platforms {
-		source, findErr = h.queries.GetSourceByExternalID(ctx, db.GetSourceByExternalIDParams{
-			PlatformID: platform,
-		ExternalID: channelID,
-	})
-		if findErr == nil {
-			log.Printf("✅ Found source on platform: %s", platform)
-			found = true
-			break
-		}
-	}
-	
-	if !found {
-		log.Printf("❌ Failed to find source with ID %s on any platform", channelID)
-		respondError(w, http.StatusNotFound, "Channel not found")
+		respondRPCError(w, err)
 		return
 	}
+	log.Printf("Deleting channel: %s", source.ExternalID)
 
-	// 購読を削除（enabledをfalseにする）
-	err = h.queries.DeleteUserSubscription(ctx, db.DeleteUserSubscriptionParams{
-		UserID:   userID,
-		SourceID: source.ID,
-	})
-	if err != nil {
-		log.Printf("Failed to delete subscription: %v", err)
-		respondError(w, http.StatusInternalServerError, "Failed to delete subscription")
+	if err := h.deleteSubscription(ctx, userID, source.ID); err != nil {
+		respondRPCError(w, err)
 		return
 	}
 
@@ -537,8 +519,44 @@ func (h *SubscriptionHandler) DeleteSubscription(w http.ResponseWriter, r *http.
 	})
 }
 
+// deleteSubscription は購読を削除
+func (h *SubscriptionHandler) deleteSubscription(ctx context.Context, userID int64, sourceID pgtype.UUID) error {
+	err := h.queries.DeleteUserSubscription(ctx, db.DeleteUserSubscriptionParams{
+		UserID:   userID,
+		SourceID: sourceID,
+	})
+	if err != nil {
+		log.Printf("Failed to delete subscription: %v", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Failed to delete subscription"))
+	}
+	return nil
+}
+
+// sourceFromPath はURLパスの {channelId}（プラットフォーム上のID）からソースを検索
+func (h *SubscriptionHandler) sourceFromPath(ctx context.Context, r *http.Request) (db.Source, error) {
+	channelID := r.PathValue("channelId")
+	if channelID == "" {
+		return db.Source{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Channel ID is required"))
+	}
+
+	// チャンネルを検索（複数のプラットフォームを試す）
+	for _, platform := range []string{"youtube", "twitch", "podcast", "radiko"} {
+		source, err := h.queries.GetSourceByExternalID(ctx, db.GetSourceByExternalIDParams{
+			PlatformID: platform,
+			ExternalID: channelID,
+		})
+		if err == nil {
+			log.Printf("✅ Found source on platform: %s", platform)
+			return source, nil
+		}
+	}
+
+	log.Printf("❌ Failed to find source with ID %s on any platform", channelID)
+	return db.Source{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("Channel not found"))
+}
+
 // handleTwitchSubscription はTwitch購読処理
-func (h *SubscriptionHandler) handleTwitchSubscription(ctx context.Context, w http.ResponseWriter, req CreateSubscriptionRequest, userID int64) {
+func (h *SubscriptionHandler) handleTwitchSubscription(ctx context.Context, req CreateSubscriptionRequest, userID int64, planType string) (*SubscriptionData, error) {
 	input := strings.TrimPrefix(strings.TrimPrefix(req.Input, "https://www.twitch.tv/"), "@")
 
 	// 数値のみの場合はユーザーID、それ以外はlogin名として扱う
@@ -551,62 +569,46 @@ func (h *SubscriptionHandler) handleTwitchSubscription(ctx context.Context, w ht
 	}
 	if err != nil {
 		log.Printf("Failed to get Twitch user: %v", err)
-		respondError(w, http.StatusNotFound, "Twitch user not found")
-		return
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Twitch user not found"))
 	}
 
 	source, err := h.queries.UpsertSource(ctx, db.UpsertSourceParams{
-		PlatformID: "twitch", ExternalID: user.ID,
-		Handle: pgtype.Text{String: user.Login, Valid: true},
-		DisplayName: pgtype.Text{String: user.DisplayName, Valid: true},
-		ThumbnailUrl: pgtype.Text{String: user.ProfileImageURL, Valid: true},
+		PlatformID:        "twitch",
+		ExternalID:        user.ID,
+		Handle:            pgtype.Text{String: user.Login, Valid: true},
+		DisplayName:       pgtype.Text{String: user.DisplayName, Valid: true},
+		ThumbnailUrl:      pgtype.Text{String: user.ProfileImageURL, Valid: true},
 		UploadsPlaylistID: pgtype.Text{},
 	})
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "failed to create subscription")
-		return
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create subscription"))
 	}
 
-	subscription, err := h.queries.UpsertUserSubscription(ctx, db.UpsertUserSubscriptionParams{
-		UserID: userID, SourceID: source.ID, Enabled: true, Priority: 0,
-	})
+	subscription, err := h.subscribe(ctx, userID, planType, source.ID)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "failed to create subscription")
-		return
+		return nil, err
 	}
 
-	go func() {
-		since := "2025-01-01T00:00:00Z"
-		ingest.FetchAndSaveTwitchVideosSince(context.Background(), h.queries, h.twitch, source.ID, user.ID, since)
-	}()
+	h.enqueueIngest(ctx, source.ID)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(CreateSubscriptionResponse{
-		Subscription: SubscriptionData{
-			UserID: userID, Platform: "twitch", SourceID: source.ID.String(),
-			ChannelID: user.ID, Handle: user.Login, DisplayName: user.DisplayName,
-			ThumbnailURL: user.ProfileImageURL, Enabled: subscription.Enabled,
-		},
-	})
+	data := newSubscriptionData(source, subscription)
+	return &data, nil
 }
 
 // handlePodcastSubscription はPodcast購読処理
-func (h *SubscriptionHandler) handlePodcastSubscription(ctx context.Context, w http.ResponseWriter, req CreateSubscriptionRequest, userID int64) {
+func (h *SubscriptionHandler) handlePodcastSubscription(ctx context.Context, req CreateSubscriptionRequest, userID int64, planType string) (*SubscriptionData, error) {
 	// Apple PodcastsのURLからRSSフィードURLを取得
 	feedURL, err := h.podcast.ResolveFeedURL(ctx, req.Input)
 	if err != nil {
 		log.Printf("Failed to resolve feed URL: %v", err)
-		respondError(w, http.StatusBadRequest, "failed to resolve podcast feed URL")
-		return
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to resolve podcast feed URL"))
 	}
 	log.Printf("Resolved feed URL: %s", feedURL)
 
 	podcastFeed, _, err := h.podcast.ParseFeed(ctx, feedURL)
 	if err != nil {
 		log.Printf("Failed to parse podcast feed: %v", err)
-		respondError(w, http.StatusBadRequest, "invalid podcast feed URL")
-		return
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid podcast feed URL"))
 	}
 
 	// Apple Podcasts URLを取得（iTunes Search APIでタイトル検索）
@@ -614,46 +616,33 @@ func (h *SubscriptionHandler) handlePodcastSubscription(ctx context.Context, w h
 	if podcastFeed.Title != "" {
 		applePodcastURL = h.fetchApplePodcastsURL(ctx, podcastFeed.Title)
 	}
-	
+
 	source, err := h.queries.UpsertSource(ctx, db.UpsertSourceParams{
-		PlatformID: "podcast", ExternalID: feedURL,
-		Handle: pgtype.Text{},
-		DisplayName: pgtype.Text{String: podcastFeed.Title, Valid: true},
-		ThumbnailUrl: pgtype.Text{String: podcastFeed.ImageURL, Valid: true},
+		PlatformID:        "podcast",
+		ExternalID:        feedURL,
+		Handle:            pgtype.Text{},
+		DisplayName:       pgtype.Text{String: podcastFeed.Title, Valid: true},
+		ThumbnailUrl:      pgtype.Text{String: podcastFeed.ImageURL, Valid: true},
 		UploadsPlaylistID: pgtype.Text{},
-		ApplePodcastUrl: pgtype.Text{String: applePodcastURL, Valid: applePodcastURL != ""},
+		ApplePodcastUrl:   pgtype.Text{String: applePodcastURL, Valid: applePodcastURL != ""},
 	})
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "failed to create subscription")
-		return
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create subscription"))
 	}
 
-	subscription, err := h.queries.UpsertUserSubscription(ctx, db.UpsertUserSubscriptionParams{
-		UserID: userID, SourceID: source.ID, Enabled: true, Priority: 0,
-	})
+	subscription, err := h.subscribe(ctx, userID, planType, source.ID)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "failed to create subscription")
-		return
+		return nil, err
 	}
 
-	go func() {
-		since := "2025-01-01T00:00:00Z"
-		ingest.FetchAndSavePodcastEpisodesSince(context.Background(), h.queries, h.podcast, source.ID, feedURL, since)
-	}()
+	h.enqueueIngest(ctx, source.ID)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(CreateSubscriptionResponse{
-		Subscription: SubscriptionData{
-			UserID: userID, Platform: "podcast", SourceID: source.ID.String(),
-			ChannelID: feedURL, Handle: "", DisplayName: podcastFeed.Title,
-			ThumbnailURL: podcastFeed.ImageURL, Enabled: subscription.Enabled,
-		},
-	})
+	data := newSubscriptionData(source, subscription)
+	return &data, nil
 }
 
 // handleRadikoSubscription はRadiko購読処理
-func (h *SubscriptionHandler) handleRadikoSubscription(ctx context.Context, w http.ResponseWriter, req CreateSubscriptionRequest, userID int64) {
+func (h *SubscriptionHandler) handleRadikoSubscription(ctx context.Context, req CreateSubscriptionRequest, userID int64, planType string) (*SubscriptionData, error) {
 	// 入力形式: "TBS" (ステーションID) または "TBS:JP13" (ステーションID:エリアID)
 	parts := strings.Split(req.Input, ":")
 	stationID := strings.TrimSpace(parts[0])
@@ -668,129 +657,230 @@ func (h *SubscriptionHandler) handleRadikoSubscription(ctx context.Context, w ht
 	sourceID, err := ingest.FetchAndSaveRadikoStation(ctx, h.queries, h.radiko, stationID, areaID)
 	if err != nil {
 		log.Printf("Failed to get Radiko station: %v", err)
-		respondError(w, http.StatusNotFound, "Radiko station not found")
-		return
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Radiko station not found"))
 	}
 
 	// ソース情報を取得
 	source, err := h.queries.GetSourceByID(ctx, sourceID)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "failed to get source")
-		return
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get source"))
 	}
 
-	subscription, err := h.queries.UpsertUserSubscription(ctx, db.UpsertUserSubscriptionParams{
-		UserID: userID, SourceID: source.ID, Enabled: true, Priority: 0,
-	})
+	subscription, err := h.subscribe(ctx, userID, planType, source.ID)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "failed to create subscription")
-		return
+		return nil, err
 	}
 
-	go func() {
-		since := "2025-01-01T00:00:00Z"
-		ingest.FetchAndSaveRadikoPrograms(context.Background(), h.queries, h.radiko, source.ID, stationID, since)
-	}()
+	h.enqueueIngest(ctx, source.ID)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(CreateSubscriptionResponse{
-		Subscription: SubscriptionData{
-			UserID: userID, Platform: "radiko", SourceID: source.ID.String(),
-			ChannelID: stationID, Handle: stationID,
-			DisplayName: source.DisplayName.String, ThumbnailURL: source.ThumbnailUrl.String,
-			Enabled: subscription.Enabled,
-		},
-	})
+	data := newSubscriptionData(source, subscription)
+	data.Handle = stationID
+	return &data, nil
 }
 
 // ToggleFavorite はお気に入り状態を切り替え
+// POST /v1/subscriptions/{channelId}/favorite
 func (h *SubscriptionHandler) ToggleFavorite(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+	ctx := r.Context()
 
-	if r.Method == http.MethodOptions {
-		w.WriteHeader(http.StatusOK)
+	userID, planType, err := h.authenticate(ctx, r.Header.Get("Authorization"))
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	var reqBody struct {
+		IsFavorite bool `json:"is_favorite"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid JSON")
 		return
 	}
 
-	userID, err := h.getUserIDFromRequest(r)
+	source, err := h.sourceFromPath(ctx, r)
 	if err != nil {
-		respondError(w, http.StatusUnauthorized, "authentication required")
+		respondRPCError(w, err)
 		return
 	}
 
-	// プランチェック: お気に入りはBasic以上
-	authHeader := r.Header.Get("Authorization")
-	idToken, _ := auth.ExtractTokenFromHeader(authHeader)
-	token, _ := h.firebaseAuth.VerifyIDToken(r.Context(), idToken)
-	if token != nil && auth.GetPlanTypeFromToken(token) == "free_anonymous" {
-		respondError(w, http.StatusForbidden, "お気に入り機能はGoogleログイン後に利用できます！")
+	if _, err := h.setFavorite(ctx, userID, planType, source.ID, reqBody.IsFavorite); err != nil {
+		respondRPCError(w, err)
 		return
 	}
 
-	var reqBody struct {
-		IsFavorite bool `json:"is_favorite"`
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+}
+
+// setFavorite はお気に入り状態を設定（お気に入りはBasic以上）
+func (h *SubscriptionHandler) setFavorite(ctx context.Context, userID int64, planType string, sourceID pgtype.UUID, isFavorite bool) (*SubscriptionData, error) {
+	if planType == "free_anonymous" {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("お気に入り機能はGoogleログイン後に利用できます！"))
 	}
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		respondError(w, http.StatusBadRequest, "invalid JSON")
+
+	subscription, err := h.queries.ToggleSubscriptionFavorite(ctx, db.ToggleSubscriptionFavoriteParams{
+		UserID: userID, SourceID: sourceID, IsFavorite: isFavorite,
+	})
+	if err != nil {
+		return nil, subscriptionUpdateError(err, "Failed to toggle favorite")
+	}
+	return h.subscriptionData(ctx, subscription)
+}
+
+// PauseSubscription は購読を一時停止
+// POST /v1/subscriptions/{channelId}/pause
+func (h *SubscriptionHandler) PauseSubscription(w http.ResponseWriter, r *http.Request) {
+	h.respondSetEnabled(w, r, false)
+}
+
+// ResumeSubscription は一時停止した購読を再開
+// POST /v1/subscriptions/{channelId}/resume
+func (h *SubscriptionHandler) ResumeSubscription(w http.ResponseWriter, r *http.Request) {
+	h.respondSetEnabled(w, r, true)
+}
+
+// respondSetEnabled はURLパスの購読の有効・無効を切り替えて、更新後の購読を返す
+func (h *SubscriptionHandler) respondSetEnabled(w http.ResponseWriter, r *http.Request, enabled bool) {
+	ctx := r.Context()
+
+	userID, planType, err := h.authenticate(ctx, r.Header.Get("Authorization"))
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
-	path := r.URL.Path
-	// /v1/subscriptions/{channelId}/favorite から channelId を抽出
-	channelIDEncoded := strings.TrimPrefix(path, "/v1/subscriptions/")
-	channelIDEncoded = strings.TrimSuffix(channelIDEncoded, "/favorite")
-	
-	if channelIDEncoded == "" {
-		respondError(w, http.StatusBadRequest, "Channel ID is required")
+	source, err := h.sourceFromPath(ctx, r)
+	if err != nil {
+		respondRPCError(w, err)
 		return
 	}
-	
-	// URLデコード
-	channelID, err := url.PathUnescape(channelIDEncoded)
+
+	subscription, err := h.setEnabled(ctx, userID, planType, source.ID, enabled)
 	if err != nil {
-		log.Printf("❌ Failed to decode channel ID: %v", err)
-		respondError(w, http.StatusBadRequest, "Invalid channel ID")
+		respondRPCError(w, err)
 		return
 	}
 
-	ctx := context.Background()
-	
-	// チャンネルを検索（複数のプラットフォームを試す）
-	var source db.Source
-	var findErr error
-	platforms := []string{"youtube", "twitch", "podcast"}
-	found := false
-	
-	for _, platform := range platforms {
-		source, findErr = h.queries.GetSourceByExternalID(ctx, db.GetSourceByExternalIDParams{
-			PlatformID: platform,
-			ExternalID: channelID,
-		})
-		if findErr == nil {
-			found = true
-			break
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":      true,
+		"subscription": subscription,
+	})
+}
+
+// setEnabled は購読の有効・無効を切り替え（有効に戻す場合はチャンネル数上限をチェック）
+func (h *SubscriptionHandler) setEnabled(ctx context.Context, userID int64, planType string, sourceID pgtype.UUID, enabled bool) (*SubscriptionData, error) {
+	current, err := h.queries.GetUserSubscription(ctx, db.GetUserSubscriptionParams{
+		UserID:   userID,
+		SourceID: sourceID,
+	})
+	if err != nil {
+		return nil, subscriptionUpdateError(err, "failed to get subscription")
+	}
+	if enabled && !current.Enabled {
+		if err := h.checkChannelLimit(ctx, userID, planType); err != nil {
+			return nil, err
 		}
 	}
-	
-	if !found {
-			respondError(w, http.StatusNotFound, "Channel not found")
-			return
+
+	subscription, err := h.queries.UpdateSubscriptionEnabled(ctx, db.UpdateSubscriptionEnabledParams{
+		UserID:   userID,
+		SourceID: sourceID,
+		Enabled:  enabled,
+	})
+	if err != nil {
+		return nil, subscriptionUpdateError(err, "failed to update subscription")
 	}
+	return h.subscriptionData(ctx, subscription)
+}
 
-	_, err = h.queries.ToggleSubscriptionFavorite(ctx, db.ToggleSubscriptionFavoriteParams{
-		UserID: userID, SourceID: source.ID, IsFavorite: reqBody.IsFavorite,
+// setPriority は購読の優先度を設定
+func (h *SubscriptionHandler) setPriority(ctx context.Context, userID int64, sourceID pgtype.UUID, priority int32) (*SubscriptionData, error) {
+	subscription, err := h.queries.UpdateSubscriptionPriority(ctx, db.UpdateSubscriptionPriorityParams{
+		UserID:   userID,
+		SourceID: sourceID,
+		Priority: priority,
 	})
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "Failed to toggle favorite")
-		return
+		return nil, subscriptionUpdateError(err, "failed to update subscription")
 	}
+	return h.subscriptionData(ctx, subscription)
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+// reorderSubscriptions は購読を指定した順に並び替え（先頭ほど優先度が高くなる）
+// 無効にした購読を含むすべての購読を重複なく指定する必要がある
+func (h *SubscriptionHandler) reorderSubscriptions(ctx context.Context, userID int64, sourceIDs []pgtype.UUID) ([]SubscriptionData, error) {
+	current, err := h.listSubscriptions(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sourceIDs) != len(current) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("source_ids must contain all %d subscriptions", len(current)))
+	}
+	subscribed := make(map[string]bool, len(current))
+	for _, sub := range current {
+		subscribed[sub.SourceID] = true
+	}
+	seen := make(map[pgtype.UUID]bool, len(sourceIDs))
+	for _, sourceID := range sourceIDs {
+		if seen[sourceID] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("duplicate source_id: %s", sourceID.String()))
+		}
+		if !subscribed[sourceID.String()] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("not subscribed: %s", sourceID.String()))
+		}
+		seen[sourceID] = true
+	}
+
+	if err := h.queries.ReorderSubscriptions(ctx, db.ReorderSubscriptionsParams{
+		SourceIds: sourceIDs,
+		UserID:    userID,
+	}); err != nil {
+		log.Printf("Failed to reorder subscriptions: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to reorder subscriptions"))
+	}
+	log.Printf("✅ ReorderSubscriptions: user_id=%d, count=%d", userID, len(sourceIDs))
+
+	return h.listSubscriptions(ctx, userID, true)
+}
+
+// subscriptionData は購読とソースの情報からレスポンス用の購読情報を作成
+func (h *SubscriptionHandler) subscriptionData(ctx context.Context, subscription db.UserSubscription) (*SubscriptionData, error) {
+	source, err := h.queries.GetSourceByID(ctx, subscription.SourceID)
+	if err != nil {
+		log.Printf("Failed to get source %s: %v", subscription.SourceID.String(), err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get source"))
+	}
+	data := newSubscriptionData(source, subscription)
+	return &data, nil
+}
+
+// newSubscriptionData はソースと購読からレスポンス用の購読情報を作成
+func newSubscriptionData(source db.Source, subscription db.UserSubscription) SubscriptionData {
+	return SubscriptionData{
+		UserID:       subscription.UserID,
+		Platform:     source.PlatformID,
+		SourceID:     source.ID.String(),
+		ChannelID:    source.ExternalID,
+		Handle:       source.Handle.String,
+		DisplayName:  source.DisplayName.String,
+		ThumbnailURL: source.ThumbnailUrl.String,
+		Enabled:      subscription.Enabled,
+		IsFavorite:   subscription.IsFavorite,
+		Priority:     subscription.Priority,
+	}
+}
+
+// subscriptionUpdateError は購読の取得・更新エラーを変換（購読がない場合はNotFound）
+func subscriptionUpdateError(err error, msg string) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("subscription not found"))
+	}
+	log.Printf("❌ %s: %v", msg, err)
+	return connect.NewError(connect.CodeInternal, errors.New(msg))
 }
 
 // GetMe はユーザー情報とプラン情報を返す
@@ -799,48 +889,38 @@ func (h *SubscriptionHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// Firebase認証: user_idを取得
-	userID, err := h.getUserIDFromRequest(r)
+	userID, _, err := h.authenticate(ctx, r.Header.Get("Authorization"))
 	if err != nil {
 		log.Printf("Authentication failed: %v", err)
 		respondError(w, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
-	// ユーザー情報とプラン情報を取得
-	userWithPlan, err := h.queries.GetUserWithPlanInfo(ctx, userID)
-	if err != nil {
-		log.Printf("Failed to get user with plan info: %v", err)
-		respondError(w, http.StatusInternalServerError, "failed to get user info")
-		return
-	}
-
-	// 現在の登録チャンネル数を取得
-	count, err := h.queries.CountUserSubscriptions(ctx, userID)
+	userWithPlan, count, err := h.getMe(ctx, userID)
 	if err != nil {
-		log.Printf("Failed to count subscriptions: %v", err)
-		respondError(w, http.StatusInternalServerError, "failed to count subscriptions")
+		respondRPCError(w, err)
 		return
 	}
 
 	// レスポンスを作成
 	response := map[string]interface{}{
 		"user": map[string]interface{}{
-			"id":               userWithPlan.ID,
-			"firebase_uid":     userWithPlan.FirebaseUid,
-			"plan_type":        userWithPlan.PlanType,
-			"email":            userWithPlan.Email,
-			"display_name":     userWithPlan.DisplayName,
-			"photo_url":        userWithPlan.PhotoUrl,
-			"is_anonymous":     userWithPlan.IsAnonymous,
+			"id":           userWithPlan.ID,
+			"firebase_uid": userWithPlan.FirebaseUid,
+			"plan_type":    userWithPlan.PlanType,
+			"email":        userWithPlan.Email,
+			"display_name": userWithPlan.DisplayName,
+			"photo_url":    userWithPlan.PhotoUrl,
+			"is_anonymous": userWithPlan.IsAnonymous,
 		},
 		"plan": map[string]interface{}{
-			"type":             userWithPlan.PlanType,
-			"display_name":     userWithPlan.PlanDisplayName,
-			"max_channels":     userWithPlan.MaxChannels,
-			"price_monthly":    userWithPlan.PriceMonthly,
-			"has_favorites":    userWithPlan.HasFavorites,
-			"has_device_sync":  userWithPlan.HasDeviceSync,
-			"description":      userWithPlan.PlanDescription,
+			"type":            userWithPlan.PlanType,
+			"display_name":    userWithPlan.PlanDisplayName,
+			"max_channels":    userWithPlan.MaxChannels,
+			"price_monthly":   userWithPlan.PriceMonthly,
+			"has_favorites":   userWithPlan.HasFavorites,
+			"has_device_sync": userWithPlan.HasDeviceSync,
+			"description":     userWithPlan.PlanDescription,
 		},
 		"current_channels": count,
 	}
@@ -850,6 +930,22 @@ func (h *SubscriptionHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// getMe はユーザー情報・プラン情報と現在の登録チャンネル数を取得
+func (h *SubscriptionHandler) getMe(ctx context.Context, userID int64) (db.GetUserWithPlanInfoRow, int64, error) {
+	userWithPlan, err := h.queries.GetUserWithPlanInfo(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to get user with plan info: %v", err)
+		return userWithPlan, 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user info"))
+	}
+
+	count, err := h.queries.CountUserSubscriptions(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to count subscriptions: %v", err)
+		return userWithPlan, 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count subscriptions"))
+	}
+	return userWithPlan, count, nil
+}
+
 // isNumeric は文字列が数値のみかチェック
 func isNumeric(s string) bool {
 	for _, c := range s {
@@ -867,6 +963,32 @@ func respondError(w http.ResponseWriter, status int, message string) {
 	json.NewEncoder(w).Encode(ErrorResponse{Error: message})
 }
 
+// respondRPCError はConnectのエラーコードをHTTPステータスに変換してエラーレスポンスを返す
+func respondRPCError(w http.ResponseWriter, err error) {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		respondError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	status := http.StatusInternalServerError
+	switch connectErr.Code() {
+	case connect.CodeInvalidArgument, connect.CodeFailedPrecondition:
+		status = http.StatusBadRequest
+	case connect.CodeUnauthenticated:
+		status = http.StatusUnauthorized
+	case connect.CodePermissionDenied:
+		status = http.StatusForbidden
+	case connect.CodeNotFound:
+		status = http.StatusNotFound
+	case connect.CodeAlreadyExists:
+		status = http.StatusConflict
+	case connect.CodeResourceExhausted:
+		status = http.StatusTooManyRequests
+	}
+	respondError(w, status, connectErr.Message())
+}
+
 // fetchApplePodcastsURL はiTunes Search APIでApple Podcasts URLを取得
 func (h *SubscriptionHandler) fetchApplePodcastsURL(ctx context.Context, podcastTitle string) string {
 	searchURL := fmt.Sprintf("https://itunes.apple.com/search?term=%s&country=jp&entity=podcast&limit=1", url.QueryEscape(podcastTitle))
@@ -0,0 +1,385 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/internal/subscriptionio"
+)
+
+// 一括登録の上限（ファイルサイズと件数）
+// 登録には外部APIの呼び出しが必要なため、1回のリクエストで登録を試みる件数は maxImportCreates 件までにする
+const (
+	maxImportBytes   = 5 << 20
+	maxImportEntries = 500
+	maxImportCreates = 50
+)
+
+// 一括登録の行ごとの結果
+const (
+	ImportStatusCreated       = "created"        // 登録した
+	ImportStatusExists        = "exists"         // 登録済み（ファイル内の重複を含む）
+	ImportStatusLimitExceeded = "limit_exceeded" // プランのチャンネル数上限のため登録しなかった
+	ImportStatusFailed        = "failed"         // チャンネルが見つからないなどで登録できなかった
+	ImportStatusPending       = "pending"        // 1回のリクエストで登録する件数の上限のため未処理（同じファイルを再送すると続きを登録する）
+)
+
+// ImportResult は一括登録の1行の結果
+type ImportResult struct {
+	Row         int    `json:"row"` // ファイル内の購読の番号（1始まり）
+	Platform    string `json:"platform"`
+	Input       string `json:"input"`
+	Title       string `json:"title,omitempty"` // ファイルに書かれていた名前
+	Status      string `json:"status"`
+	SourceID    string `json:"source_id,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// ImportReport は一括登録の結果
+type ImportReport struct {
+	Format        string         `json:"format"`
+	Created       int            `json:"created"`
+	Existing      int            `json:"existing"`
+	LimitExceeded int            `json:"limit_exceeded"`
+	Failed        int            `json:"failed"`
+	Pending       int            `json:"pending"`
+	Results       []ImportResult `json:"results"`
+}
+
+// ImportSubscriptions はファイルから購読を一括登録
+// POST /v1/subscriptions/import?format={opml|takeout|json}（formatを省略した場合は内容から推定）
+func (h *SubscriptionHandler) ImportSubscriptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, planType, err := h.authenticate(ctx, r.Header.Get("Authorization"))
+	if err != nil {
+		log.Printf("Authentication failed: %v", err)
+		respondError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportBytes))
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		respondError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("file is too large: max %d bytes", maxImportBytes))
+		return
+	}
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+
+	report, err := h.importSubscriptions(ctx, userID, planType, r.URL.Query().Get("format"), data)
+	if err != nil {
+		respondRPCError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, report)
+}
+
+// ExportSubscriptions は購読をファイルに書き出す
+// GET /v1/subscriptions/export?format={opml|takeout|json}（デフォルト: json）
+func (h *SubscriptionHandler) ExportSubscriptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, _, err := h.authenticate(ctx, r.Header.Get("Authorization"))
+	if err != nil {
+		log.Printf("Authentication failed: %v", err)
+		respondError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = subscriptionio.FormatJSON
+	}
+	content, err := h.exportSubscriptions(ctx, userID, format)
+	if err != nil {
+		respondRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", subscriptionio.ContentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", subscriptionio.FileName(format)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
+
+// importSubscriptions はファイルの購読を順に登録し、行ごとの結果を返す
+// 登録済みのチャンネルは外部APIを呼ばずにスキップし、チャンネル数上限に達した後の行は登録しない
+// 登録を試みるのは maxImportCreates 件までで、残りの行は pending として返す（登録済みの行はスキップされるため、同じファイルを再送すれば続きから登録できる）
+// JSON形式の場合は有効・無効、お気に入り、優先度、タグも反映する（OPMLのフォルダ・categoryもタグとして反映）
+func (h *SubscriptionHandler) importSubscriptions(ctx context.Context, userID int64, planType, format string, data []byte) (*ImportReport, error) {
+	if len(data) > maxImportBytes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("file is too large: max %d bytes", maxImportBytes))
+	}
+	if format == "" {
+		format = subscriptionio.DetectFormat(data)
+	}
+	if !subscriptionio.IsFormat(format) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported format: %q (opml, takeout, json)", format))
+	}
+
+	entries, err := subscriptionio.Parse(format, bytes.NewReader(data))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(entries) > maxImportEntries {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("too many subscriptions: max %d", maxImportEntries))
+	}
+
+	current, err := h.listSubscriptions(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	subscribed := make(map[string]*SubscriptionData, len(current))
+	for i := range current {
+		addImportKeys(subscribed, &current[i])
+	}
+
+	tags, err := h.listTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tagIDs := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		tagIDs[tag.Name] = tag.ID
+	}
+
+	report := &ImportReport{Format: format, Results: make([]ImportResult, 0, len(entries))}
+	var limitErr error
+	attempted := 0 // 登録を試みた行数
+	for i, entry := range entries {
+		result := ImportResult{
+			Row:      i + 1,
+			Platform: entry.Platform,
+			Input:    entry.Input,
+			Title:    entry.Title,
+		}
+
+		if existing := h.findImported(subscribed, entry); existing != nil {
+			result.Status = ImportStatusExists
+			result.SourceID = existing.SourceID
+			result.DisplayName = existing.DisplayName
+			report.Existing++
+			report.Results = append(report.Results, result)
+			continue
+		}
+		if limitErr != nil {
+			result.Status = ImportStatusLimitExceeded
+			result.Error = rpcErrorMessage(limitErr)
+			report.LimitExceeded++
+			report.Results = append(report.Results, result)
+			continue
+		}
+		if attempted >= maxImportCreates {
+			result.Status = ImportStatusPending
+			report.Pending++
+			report.Results = append(report.Results, result)
+			continue
+		}
+
+		attempted++
+		subscription, err := h.createSubscription(ctx, userID, planType, CreateSubscriptionRequest{
+			Platform: entry.Platform,
+			Input:    entry.Input,
+		})
+		if connect.CodeOf(err) == connect.CodePermissionDenied {
+			// 上限に達した後は外部APIを呼ばずに残りの行も上限超過とする
+			limitErr = err
+			result.Status = ImportStatusLimitExceeded
+			result.Error = rpcErrorMessage(err)
+			report.LimitExceeded++
+			report.Results = append(report.Results, result)
+			continue
+		}
+		if err != nil {
+			result.Status = ImportStatusFailed
+			result.Error = rpcErrorMessage(err)
+			report.Failed++
+			report.Results = append(report.Results, result)
+			continue
+		}
+
+		// 別の入力（@handleとチャンネルIDなど）で登録済みだった場合も登録済みとして扱う
+		if existing := subscribed[importKey(subscription.Platform, subscription.ChannelID)]; existing != nil {
+			result.Status = ImportStatusExists
+			result.SourceID = existing.SourceID
+			result.DisplayName = existing.DisplayName
+			report.Existing++
+			report.Results = append(report.Results, result)
+			addImportKeys(subscribed, existing, entry.Input)
+			continue
+		}
+
+		h.applyImportSettings(ctx, userID, planType, subscription, entry, tagIDs)
+		result.Status = ImportStatusCreated
+		result.SourceID = subscription.SourceID
+		result.DisplayName = subscription.DisplayName
+		report.Created++
+		report.Results = append(report.Results, result)
+		addImportKeys(subscribed, subscription, entry.Input)
+	}
+
+	log.Printf("✅ ImportSubscriptions: user_id=%d, format=%s, created=%d, existing=%d, limit_exceeded=%d, failed=%d, pending=%d",
+		userID, format, report.Created, report.Existing, report.LimitExceeded, report.Failed, report.Pending)
+	return report, nil
+}
+
+// applyImportSettings は登録した購読にファイルの設定（有効・無効、お気に入り、優先度、タグ）を反映
+// 設定の反映に失敗しても購読の登録は取り消さない
+func (h *SubscriptionHandler) applyImportSettings(ctx context.Context, userID int64, planType string, subscription *SubscriptionData, entry subscriptionio.Entry, tagIDs map[string]string) {
+	var sourceID pgtype.UUID
+	if err := sourceID.Scan(subscription.SourceID); err != nil {
+		return
+	}
+
+	if !entry.Enabled {
+		if updated, err := h.setEnabled(ctx, userID, planType, sourceID, false); err == nil {
+			subscription.Enabled = updated.Enabled
+		}
+	}
+	if entry.IsFavorite && planType != "free_anonymous" {
+		if updated, err := h.setFavorite(ctx, userID, planType, sourceID, true); err == nil {
+			subscription.IsFavorite = updated.IsFavorite
+		}
+	}
+	if entry.Priority != 0 {
+		if updated, err := h.setPriority(ctx, userID, sourceID, entry.Priority); err == nil {
+			subscription.Priority = updated.Priority
+		}
+	}
+
+	if len(entry.Tags) == 0 {
+		return
+	}
+	var ids []pgtype.UUID
+	for _, name := range entry.Tags {
+		id, ok := tagIDs[strings.TrimSpace(name)]
+		if !ok {
+			tag, err := h.createTag(ctx, userID, name)
+			if err != nil {
+				log.Printf("⚠️ Skipped import tag %q: %v", name, err)
+				continue
+			}
+			id = tag.ID
+			tagIDs[tag.Name] = id
+		}
+		var tagID pgtype.UUID
+		if err := tagID.Scan(id); err == nil {
+			ids = append(ids, tagID)
+		}
+	}
+	if updated, err := h.setSubscriptionTags(ctx, userID, sourceID, ids); err == nil {
+		subscription.TagIDs = updated.TagIDs
+	}
+}
+
+// exportSubscriptions は無効にした購読を含むすべての購読をファイルに書き出す
+func (h *SubscriptionHandler) exportSubscriptions(ctx context.Context, userID int64, format string) ([]byte, error) {
+	if !subscriptionio.IsFormat(format) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported format: %q (opml, takeout, json)", format))
+	}
+
+	subscriptions, err := h.listSubscriptions(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := h.listTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tagNames := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		tagNames[tag.ID] = tag.Name
+	}
+
+	entries := make([]subscriptionio.Entry, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		entry := subscriptionio.Entry{
+			Platform:   sub.Platform,
+			Input:      sub.ChannelID,
+			Handle:     sub.Handle,
+			Title:      sub.DisplayName,
+			Enabled:    sub.Enabled,
+			IsFavorite: sub.IsFavorite,
+			Priority:   sub.Priority,
+		}
+		for _, id := range sub.TagIDs {
+			if name, ok := tagNames[id]; ok {
+				entry.Tags = append(entry.Tags, name)
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	var buf bytes.Buffer
+	if err := subscriptionio.Write(format, &buf, entries); err != nil {
+		log.Printf("Failed to write %s export: %v", format, err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to export subscriptions"))
+	}
+	log.Printf("✅ ExportSubscriptions: user_id=%d, format=%s, count=%d", userID, format, len(entries))
+	return buf.Bytes(), nil
+}
+
+// rpcErrorMessage はエラーの結果に表示するメッセージ（connectのエラー以外は内部エラー）
+func rpcErrorMessage(err error) string {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return "internal error"
+	}
+	return connectErr.Message()
+}
+
+// importKey は登録済みかどうかの判定に使うキー（@handleは大文字・小文字を区別しない）
+func importKey(platform, input string) string {
+	input = strings.TrimSpace(input)
+	if strings.HasPrefix(input, "@") {
+		input = strings.ToLower(input)
+	}
+	return platform + "\x00" + input
+}
+
+// findImported はファイルの行の購読が登録済みなら返す
+// YouTubeのURLは外部APIを呼ばずにチャンネルID・@handleに変換して探す
+func (h *SubscriptionHandler) findImported(subscribed map[string]*SubscriptionData, entry subscriptionio.Entry) *SubscriptionData {
+	if existing := subscribed[importKey(entry.Platform, entry.Input)]; existing != nil {
+		return existing
+	}
+	if entry.Platform != "youtube" {
+		return nil
+	}
+	channelID, handle, err := h.normalizeInput(entry.Input)
+	switch {
+	case err != nil:
+		return nil
+	case channelID != "":
+		return subscribed[importKey(entry.Platform, channelID)]
+	default:
+		return subscribed[importKey(entry.Platform, "@"+handle)]
+	}
+}
+
+// addImportKeys は購読をチャンネルID・ハンドル・登録に使った入力で引けるように追加
+func addImportKeys(subscribed map[string]*SubscriptionData, sub *SubscriptionData, inputs ...string) {
+	subscribed[importKey(sub.Platform, sub.ChannelID)] = sub
+	if sub.Handle != "" {
+		handle := sub.Handle
+		if sub.Platform == "youtube" && !strings.HasPrefix(handle, "@") {
+			handle = "@" + handle
+		}
+		subscribed[importKey(sub.Platform, handle)] = sub
+	}
+	for _, input := range inputs {
+		subscribed[importKey(sub.Platform, input)] = sub
+	}
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/jobqueue"
+)
+
+// 取り込み状況
+const (
+	IngestStateQueued  = "queued"  // 取り込み待ち（リトライ待ちを含む）
+	IngestStateRunning = "running" // 取り込み中
+	IngestStateDone    = "done"    // 取り込み完了
+	IngestStateFailed  = "failed"  // 取り込み失敗（リトライ上限・チャンネルの削除など）
+)
+
+// IngestStatusData は購読したソースの過去分の取り込み状況
+type IngestStatusData struct {
+	SourceID      string `json:"source_id"`
+	State         string `json:"state"`
+	EventCount    int64  `json:"event_count"` // 保存済みのイベント数
+	Attempts      int32  `json:"attempts"`
+	MaxAttempts   int32  `json:"max_attempts"`
+	LastError     string `json:"last_error,omitempty"`
+	FetchStatus   string `json:"fetch_status"` // sources.fetch_status
+	QueuedAt      string `json:"queued_at,omitempty"`
+	StartedAt     string `json:"started_at,omitempty"`
+	CompletedAt   string `json:"completed_at,omitempty"`
+	NextAttemptAt string `json:"next_attempt_at,omitempty"` // 取り込み待ちの場合の実行予定日時
+	LastFetchedAt string `json:"last_fetched_at,omitempty"`
+}
+
+// GetIngestStatus は購読したソースの取り込み状況取得API
+// GET /v1/subscriptions/{channelId}/ingest-status
+func (h *SubscriptionHandler) GetIngestStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, _, err := h.authenticate(ctx, r.Header.Get("Authorization"))
+	if err != nil {
+		log.Printf("Authentication failed: %v", err)
+		respondError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	source, err := h.sourceFromPath(ctx, r)
+	if err != nil {
+		respondRPCError(w, err)
+		return
+	}
+
+	status, err := h.ingestStatus(ctx, userID, source.ID)
+	if err != nil {
+		respondRPCError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, status)
+}
+
+// ingestStatus は購読したソースの取り込み状況を取得
+// 状態は最新の取り込みジョブから判定し、ジョブがない場合（ジョブキュー導入前の購読など）はsourcesの取得状況から判定する
+func (h *SubscriptionHandler) ingestStatus(ctx context.Context, userID int64, sourceID pgtype.UUID) (*IngestStatusData, error) {
+	if _, err := h.queries.GetUserSubscription(ctx, db.GetUserSubscriptionParams{
+		UserID:   userID,
+		SourceID: sourceID,
+	}); err != nil {
+		return nil, subscriptionUpdateError(err, "failed to get subscription")
+	}
+
+	source, err := h.queries.GetSourceByID(ctx, sourceID)
+	if err != nil {
+		log.Printf("Failed to get source: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get source"))
+	}
+
+	var job *db.UpdateSchedule
+	latest, err := h.queries.GetLatestScheduleBySource(ctx, db.GetLatestScheduleBySourceParams{
+		SourceID: sourceID,
+		JobType:  jobqueue.JobBackfill,
+	})
+	if err == nil {
+		job = &latest
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		log.Printf("Failed to get ingest job: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get ingest status"))
+	}
+
+	eventCount, err := h.queries.CountEventsBySource(ctx, sourceID)
+	if err != nil {
+		log.Printf("Failed to count events: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get ingest status"))
+	}
+
+	status := newIngestStatus(source, job, eventCount)
+	return &status, nil
+}
+
+// newIngestStatus は取り込みジョブとソースの取得状況から取り込み状況を作成
+func newIngestStatus(source db.Source, job *db.UpdateSchedule, eventCount int64) IngestStatusData {
+	status := IngestStatusData{
+		SourceID:      source.ID.String(),
+		EventCount:    eventCount,
+		FetchStatus:   source.FetchStatus,
+		LastFetchedAt: formatTimestamp(source.LastFetchedAt),
+	}
+
+	if job != nil {
+		status.Attempts = job.Attempts
+		status.MaxAttempts = job.MaxAttempts
+		status.LastError = job.ErrorMessage.String
+		status.QueuedAt = formatTimestamp(job.CreatedAt)
+		status.StartedAt = formatTimestamp(job.StartedAt)
+		switch job.Status {
+		case jobqueue.StatusPending:
+			status.State = IngestStateQueued
+			status.NextAttemptAt = formatTimestamp(job.ScheduledAt)
+			return status
+		case jobqueue.StatusRunning:
+			status.State = IngestStateRunning
+			return status
+		case jobqueue.StatusFailed:
+			status.State = IngestStateFailed
+			status.CompletedAt = formatTimestamp(job.CompletedAt)
+			return status
+		}
+		status.CompletedAt = formatTimestamp(job.CompletedAt)
+	}
+
+	// 取り込み完了後も定期更新でチャンネルの削除・BANなどが分かった場合は失敗として扱う
+	switch {
+	case source.FetchStatus != "" && source.FetchStatus != "ok":
+		status.State = IngestStateFailed
+		if status.LastError == "" {
+			status.LastError = "fetch status: " + source.FetchStatus
+		}
+	case job != nil || source.LastFetchedAt.Valid || eventCount > 0:
+		status.State = IngestStateDone
+	default:
+		// ジョブがなく一度も取得していないソースは定期更新で取り込まれる
+		status.State = IngestStateQueued
+	}
+	return status
+}
@@ -0,0 +1,499 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	pixicastv1 "github.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1"
+	"github.com/kinchoKayaba/pixicast/backend/internal/subscriptionio"
+)
+
+// SubscriptionService は購読管理のConnectサービス
+// REST API（SubscriptionHandler）と同じ処理を使う
+type SubscriptionService struct {
+	h *SubscriptionHandler
+}
+
+// NewSubscriptionService はサービスを作成
+func NewSubscriptionService(h *SubscriptionHandler) *SubscriptionService {
+	return &SubscriptionService{h: h}
+}
+
+func (s *SubscriptionService) CreateSubscription(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.CreateSubscriptionRequest],
+) (*connect.Response[pixicastv1.CreateSubscriptionResponse], error) {
+	userID, planType, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.h.createSubscription(ctx, userID, planType, CreateSubscriptionRequest{
+		Platform: req.Msg.Platform,
+		Input:    req.Msg.Input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pixicastv1.CreateSubscriptionResponse{
+		Subscription: subscriptionToProto(subscription),
+	}), nil
+}
+
+func (s *SubscriptionService) ListSubscriptions(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ListSubscriptionsRequest],
+) (*connect.Response[pixicastv1.ListSubscriptionsResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.h.listSubscriptions(ctx, userID, req.Msg.IncludeDisabled)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.h.listTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pixicastv1.ListSubscriptionsResponse{
+		Subscriptions: make([]*pixicastv1.Subscription, 0, len(subscriptions)),
+		Tags:          make([]*pixicastv1.SubscriptionTag, 0, len(tags)),
+	}
+	for i := range subscriptions {
+		res.Subscriptions = append(res.Subscriptions, subscriptionToProto(&subscriptions[i]))
+	}
+	for i := range tags {
+		res.Tags = append(res.Tags, tagToProto(&tags[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *SubscriptionService) DeleteSubscription(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.DeleteSubscriptionRequest],
+) (*connect.Response[pixicastv1.DeleteSubscriptionResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	sourceID, err := parseSourceID(req.Msg.SourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.h.deleteSubscription(ctx, userID, sourceID); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pixicastv1.DeleteSubscriptionResponse{}), nil
+}
+
+func (s *SubscriptionService) ToggleFavorite(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ToggleFavoriteRequest],
+) (*connect.Response[pixicastv1.ToggleFavoriteResponse], error) {
+	userID, planType, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	sourceID, err := parseSourceID(req.Msg.SourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.h.setFavorite(ctx, userID, planType, sourceID, req.Msg.IsFavorite)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pixicastv1.ToggleFavoriteResponse{
+		Subscription: subscriptionToProto(subscription),
+	}), nil
+}
+
+func (s *SubscriptionService) SetEnabled(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.SetEnabledRequest],
+) (*connect.Response[pixicastv1.SetEnabledResponse], error) {
+	userID, planType, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	sourceID, err := parseSourceID(req.Msg.SourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.h.setEnabled(ctx, userID, planType, sourceID, req.Msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pixicastv1.SetEnabledResponse{
+		Subscription: subscriptionToProto(subscription),
+	}), nil
+}
+
+func (s *SubscriptionService) SetPriority(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.SetPriorityRequest],
+) (*connect.Response[pixicastv1.SetPriorityResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	sourceID, err := parseSourceID(req.Msg.SourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.h.setPriority(ctx, userID, sourceID, req.Msg.Priority)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pixicastv1.SetPriorityResponse{
+		Subscription: subscriptionToProto(subscription),
+	}), nil
+}
+
+func (s *SubscriptionService) ReorderSubscriptions(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ReorderSubscriptionsRequest],
+) (*connect.Response[pixicastv1.ReorderSubscriptionsResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	sourceIDs := make([]pgtype.UUID, 0, len(req.Msg.SourceIds))
+	for _, raw := range req.Msg.SourceIds {
+		sourceID, err := parseSourceID(raw)
+		if err != nil {
+			return nil, err
+		}
+		sourceIDs = append(sourceIDs, sourceID)
+	}
+
+	subscriptions, err := s.h.reorderSubscriptions(ctx, userID, sourceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pixicastv1.ReorderSubscriptionsResponse{
+		Subscriptions: make([]*pixicastv1.Subscription, 0, len(subscriptions)),
+	}
+	for i := range subscriptions {
+		res.Subscriptions = append(res.Subscriptions, subscriptionToProto(&subscriptions[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *SubscriptionService) ListTags(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ListTagsRequest],
+) (*connect.Response[pixicastv1.ListTagsResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.h.listTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pixicastv1.ListTagsResponse{
+		Tags: make([]*pixicastv1.SubscriptionTag, 0, len(tags)),
+	}
+	for i := range tags {
+		res.Tags = append(res.Tags, tagToProto(&tags[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *SubscriptionService) CreateTag(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.CreateTagRequest],
+) (*connect.Response[pixicastv1.CreateTagResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.h.createTag(ctx, userID, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pixicastv1.CreateTagResponse{
+		Tag: tagToProto(tag),
+	}), nil
+}
+
+func (s *SubscriptionService) UpdateTag(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.UpdateTagRequest],
+) (*connect.Response[pixicastv1.UpdateTagResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	tagID, err := parseTagID(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.h.updateTag(ctx, userID, tagID, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pixicastv1.UpdateTagResponse{
+		Tag: tagToProto(tag),
+	}), nil
+}
+
+func (s *SubscriptionService) DeleteTag(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.DeleteTagRequest],
+) (*connect.Response[pixicastv1.DeleteTagResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	tagID, err := parseTagID(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.h.deleteTag(ctx, userID, tagID); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pixicastv1.DeleteTagResponse{}), nil
+}
+
+func (s *SubscriptionService) SetSubscriptionTags(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.SetSubscriptionTagsRequest],
+) (*connect.Response[pixicastv1.SetSubscriptionTagsResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	sourceID, err := parseSourceID(req.Msg.SourceId)
+	if err != nil {
+		return nil, err
+	}
+	tagIDs := make([]pgtype.UUID, 0, len(req.Msg.TagIds))
+	for _, raw := range req.Msg.TagIds {
+		tagID, err := parseTagID(raw)
+		if err != nil {
+			return nil, err
+		}
+		tagIDs = append(tagIDs, tagID)
+	}
+
+	subscription, err := s.h.setSubscriptionTags(ctx, userID, sourceID, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pixicastv1.SetSubscriptionTagsResponse{
+		Subscription: subscriptionToProto(subscription),
+	}), nil
+}
+
+func (s *SubscriptionService) ImportSubscriptions(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ImportSubscriptionsRequest],
+) (*connect.Response[pixicastv1.ImportSubscriptionsResponse], error) {
+	userID, planType, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.h.importSubscriptions(ctx, userID, planType, req.Msg.Format, req.Msg.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pixicastv1.ImportSubscriptionsResponse{
+		Format:        report.Format,
+		Created:       int32(report.Created),
+		Existing:      int32(report.Existing),
+		LimitExceeded: int32(report.LimitExceeded),
+		Failed:        int32(report.Failed),
+		Pending:       int32(report.Pending),
+		Results:       make([]*pixicastv1.ImportResult, 0, len(report.Results)),
+	}
+	for _, r := range report.Results {
+		res.Results = append(res.Results, &pixicastv1.ImportResult{
+			Row:         int32(r.Row),
+			Platform:    r.Platform,
+			Input:       r.Input,
+			Title:       r.Title,
+			Status:      r.Status,
+			SourceId:    r.SourceID,
+			DisplayName: r.DisplayName,
+			Error:       r.Error,
+		})
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *SubscriptionService) ExportSubscriptions(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ExportSubscriptionsRequest],
+) (*connect.Response[pixicastv1.ExportSubscriptionsResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	format := req.Msg.Format
+	if format == "" {
+		format = subscriptionio.FormatJSON
+	}
+	content, err := s.h.exportSubscriptions(ctx, userID, format)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pixicastv1.ExportSubscriptionsResponse{
+		Content:     content,
+		ContentType: subscriptionio.ContentType(format),
+		FileName:    subscriptionio.FileName(format),
+	}), nil
+}
+
+func (s *SubscriptionService) GetIngestStatus(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.GetIngestStatusRequest],
+) (*connect.Response[pixicastv1.GetIngestStatusResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	sourceID, err := parseSourceID(req.Msg.SourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.h.ingestStatus(ctx, userID, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pixicastv1.GetIngestStatusResponse{
+		Status: ingestStatusToProto(status),
+	}), nil
+}
+
+func (s *SubscriptionService) GetMe(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.GetMeRequest],
+) (*connect.Response[pixicastv1.GetMeResponse], error) {
+	userID, _, err := s.h.authenticate(ctx, req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	u, count, err := s.h.getMe(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pixicastv1.GetMeResponse{
+		User: &pixicastv1.User{
+			Id:          u.ID,
+			FirebaseUid: u.FirebaseUid,
+			PlanType:    u.PlanType,
+			Email:       u.Email.String,
+			DisplayName: u.DisplayName.String,
+			PhotoUrl:    u.PhotoUrl.String,
+			IsAnonymous: u.IsAnonymous,
+		},
+		Plan: &pixicastv1.Plan{
+			Type:          u.PlanType,
+			DisplayName:   u.PlanDisplayName.String,
+			MaxChannels:   u.MaxChannels.Int32,
+			PriceMonthly:  u.PriceMonthly.Int32,
+			HasFavorites:  u.HasFavorites.Bool,
+			HasDeviceSync: u.HasDeviceSync.Bool,
+			Description:   u.PlanDescription.String,
+		},
+		CurrentChannels: count,
+	}), nil
+}
+
+// parseSourceID はリクエストのsource_idをUUIDに変換
+func parseSourceID(s string) (pgtype.UUID, error) {
+	var id pgtype.UUID
+	if s == "" {
+		return id, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("source_id is required"))
+	}
+	if err := id.Scan(s); err != nil {
+		return id, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid source_id: %q", s))
+	}
+	return id, nil
+}
+
+// parseTagID はリクエストのタグIDをUUIDに変換
+func parseTagID(s string) (pgtype.UUID, error) {
+	var id pgtype.UUID
+	if err := id.Scan(s); err != nil {
+		return id, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid tag_id: %q", s))
+	}
+	return id, nil
+}
+
+// subscriptionToProto は購読情報をprotoに変換
+func subscriptionToProto(d *SubscriptionData) *pixicastv1.Subscription {
+	return &pixicastv1.Subscription{
+		SourceId:     d.SourceID,
+		Platform:     d.Platform,
+		ChannelId:    d.ChannelID,
+		Handle:       d.Handle,
+		DisplayName:  d.DisplayName,
+		ThumbnailUrl: d.ThumbnailURL,
+		Enabled:      d.Enabled,
+		IsFavorite:   d.IsFavorite,
+		Priority:     d.Priority,
+		TagIds:       d.TagIDs,
+	}
+}
+
+// ingestStatusToProto は取り込み状況をprotoに変換
+func ingestStatusToProto(d *IngestStatusData) *pixicastv1.IngestStatus {
+	return &pixicastv1.IngestStatus{
+		SourceId:      d.SourceID,
+		State:         d.State,
+		EventCount:    d.EventCount,
+		Attempts:      d.Attempts,
+		MaxAttempts:   d.MaxAttempts,
+		LastError:     d.LastError,
+		FetchStatus:   d.FetchStatus,
+		QueuedAt:      d.QueuedAt,
+		StartedAt:     d.StartedAt,
+		CompletedAt:   d.CompletedAt,
+		NextAttemptAt: d.NextAttemptAt,
+		LastFetchedAt: d.LastFetchedAt,
+	}
+}
+
+// tagToProto はタグ情報をprotoに変換
+func tagToProto(t *SubscriptionTagData) *pixicastv1.SubscriptionTag {
+	return &pixicastv1.SubscriptionTag{
+		Id:                t.ID,
+		Name:              t.Name,
+		SubscriptionCount: t.SubscriptionCount,
+		CreatedAt:         t.CreatedAt,
+	}
+}
@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/dberr"
+)
+
+// ユーザーごとのタグの最大数とタグ名の最大文字数
+const (
+	maxSubscriptionTags    = 50
+	maxSubscriptionTagName = 50
+)
+
+// SubscriptionTagData はタグ情報
+type SubscriptionTagData struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	SubscriptionCount int64  `json:"subscription_count"`
+	CreatedAt         string `json:"created_at"`
+}
+
+// listTags はタグの一覧を作成順で取得（タグごとの購読数付き）
+func (h *SubscriptionHandler) listTags(ctx context.Context, userID int64) ([]SubscriptionTagData, error) {
+	rows, err := h.queries.ListSubscriptionTags(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list subscription tags: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch tags"))
+	}
+
+	tags := make([]SubscriptionTagData, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, SubscriptionTagData{
+			ID:                row.ID.String(),
+			Name:              row.Name,
+			SubscriptionCount: row.SubscriptionCount,
+			CreatedAt:         row.CreatedAt.Time.Format(time.RFC3339),
+		})
+	}
+	return tags, nil
+}
+
+// createTag はタグを作成
+func (h *SubscriptionHandler) createTag(ctx context.Context, userID int64, name string) (*SubscriptionTagData, error) {
+	name, err := validateTagName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := h.queries.CountSubscriptionTags(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to count subscription tags: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count tags"))
+	}
+	if count >= maxSubscriptionTags {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("too many tags: max %d", maxSubscriptionTags))
+	}
+
+	tag, err := h.queries.CreateSubscriptionTag(ctx, db.CreateSubscriptionTagParams{
+		UserID: userID,
+		Name:   name,
+	})
+	if dberr.IsUniqueViolation(err) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("tag %q already exists", name))
+	}
+	if err != nil {
+		log.Printf("Failed to create subscription tag: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create tag"))
+	}
+	log.Printf("✅ CreateTag: user_id=%d, name=%s", userID, name)
+
+	return newTagData(tag, 0), nil
+}
+
+// updateTag はタグの名前を変更
+func (h *SubscriptionHandler) updateTag(ctx context.Context, userID int64, tagID pgtype.UUID, name string) (*SubscriptionTagData, error) {
+	name, err := validateTagName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := h.queries.UpdateSubscriptionTag(ctx, db.UpdateSubscriptionTagParams{
+		ID:     tagID,
+		UserID: userID,
+		Name:   name,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tag not found: %s", tagID.String()))
+	}
+	if dberr.IsUniqueViolation(err) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("tag %q already exists", name))
+	}
+	if err != nil {
+		log.Printf("Failed to update subscription tag: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update tag"))
+	}
+	log.Printf("✅ UpdateTag: user_id=%d, id=%s", userID, tagID.String())
+
+	// 購読数を含めて返すため一覧から取得
+	tags, err := h.listTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tags {
+		if tags[i].ID == tag.ID.String() {
+			return &tags[i], nil
+		}
+	}
+	return newTagData(tag, 0), nil
+}
+
+// deleteTag はタグを削除（割り当てはカスケード削除され、購読は残る）
+func (h *SubscriptionHandler) deleteTag(ctx context.Context, userID int64, tagID pgtype.UUID) error {
+	deleted, err := h.queries.DeleteSubscriptionTag(ctx, db.DeleteSubscriptionTagParams{
+		ID:     tagID,
+		UserID: userID,
+	})
+	if err != nil {
+		log.Printf("Failed to delete subscription tag: %v", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete tag"))
+	}
+	if deleted == 0 {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("tag not found: %s", tagID.String()))
+	}
+	log.Printf("✅ DeleteTag: user_id=%d, id=%s", userID, tagID.String())
+	return nil
+}
+
+// setSubscriptionTags は購読に割り当てるタグを置き換え（空の場合はすべて外す）
+func (h *SubscriptionHandler) setSubscriptionTags(ctx context.Context, userID int64, sourceID pgtype.UUID, tagIDs []pgtype.UUID) (*SubscriptionData, error) {
+	subscription, err := h.queries.GetUserSubscription(ctx, db.GetUserSubscriptionParams{
+		UserID:   userID,
+		SourceID: sourceID,
+	})
+	if err != nil {
+		return nil, subscriptionUpdateError(err, "failed to get subscription")
+	}
+
+	tags, err := h.listTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	owned := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		owned[tag.ID] = true
+	}
+	seen := make(map[pgtype.UUID]bool, len(tagIDs))
+	unique := make([]pgtype.UUID, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		if !owned[tagID.String()] {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tag not found: %s", tagID.String()))
+		}
+		if !seen[tagID] {
+			seen[tagID] = true
+			unique = append(unique, tagID)
+		}
+	}
+
+	if err := h.queries.SetSubscriptionTags(ctx, db.SetSubscriptionTagsParams{
+		UserID:   userID,
+		SourceID: sourceID,
+		TagIds:   unique,
+	}); err != nil {
+		log.Printf("Failed to set subscription tags: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set tags"))
+	}
+	log.Printf("✅ SetSubscriptionTags: user_id=%d, source_id=%s, tags=%d", userID, sourceID.String(), len(unique))
+
+	data, err := h.subscriptionData(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+	data.TagIDs = make([]string, 0, len(unique))
+	for _, tagID := range unique {
+		data.TagIDs = append(data.TagIDs, tagID.String())
+	}
+	return data, nil
+}
+
+// subscriptionTagIDs は購読ごとに割り当てたタグIDを取得（キーはsource_id）
+func (h *SubscriptionHandler) subscriptionTagIDs(ctx context.Context, userID int64) (map[string][]string, error) {
+	rows, err := h.queries.ListSubscriptionTagAssignments(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list subscription tag assignments: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch tags"))
+	}
+
+	tagIDs := make(map[string][]string)
+	for _, row := range rows {
+		sourceID := row.SourceID.String()
+		tagIDs[sourceID] = append(tagIDs[sourceID], row.TagID.String())
+	}
+	return tagIDs, nil
+}
+
+// validateTagName はタグ名を検証し、前後の空白を除いた名前を返す
+func validateTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	if len([]rune(name)) > maxSubscriptionTagName {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is too long: max %d characters", maxSubscriptionTagName))
+	}
+	return name, nil
+}
+
+// newTagData はDBのタグからレスポンス用のタグ情報を作成
+func newTagData(tag db.SubscriptionTag, subscriptionCount int64) *SubscriptionTagData {
+	return &SubscriptionTagData{
+		ID:                tag.ID.String(),
+		Name:              tag.Name,
+		SubscriptionCount: subscriptionCount,
+		CreatedAt:         tag.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
@@ -1,7 +1,18 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/subscriptionio"
 )
 
 // TestNormalizeInput は入力正規化のテスト
@@ -165,3 +176,228 @@ func TestParseYouTubeURL(t *testing.T) {
 	}
 }
 
+
+// TestRespondRPCError はConnectのエラーコードからHTTPステータスへの変換のテスト
+func TestRespondRPCError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Invalid argument",
+			err:        connect.NewError(connect.CodeInvalidArgument, errors.New("input is required")),
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "input is required",
+		},
+		{
+			name:       "Plan limit",
+			err:        connect.NewError(connect.CodePermissionDenied, errors.New("Freeプランは5チャンネルまでです。")),
+			wantStatus: http.StatusForbidden,
+			wantBody:   "Freeプランは5チャンネルまでです。",
+		},
+		{
+			name:       "Not found",
+			err:        connect.NewError(connect.CodeNotFound, errors.New("Channel not found")),
+			wantStatus: http.StatusNotFound,
+			wantBody:   "Channel not found",
+		},
+		{
+			name:       "Internal",
+			err:        connect.NewError(connect.CodeInternal, errors.New("failed to create subscription")),
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   "failed to create subscription",
+		},
+		{
+			name:       "Not a connect error",
+			err:        errors.New("boom"),
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   "internal error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			respondRPCError(w, tt.err)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("respondRPCError() status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			var body ErrorResponse
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			if body.Error != tt.wantBody {
+				t.Errorf("respondRPCError() error = %q, want %q", body.Error, tt.wantBody)
+			}
+		})
+	}
+}
+
+// TestValidateTagName はタグ名の検証のテスト
+func TestValidateTagName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		want     string
+		wantCode connect.Code
+	}{
+		{name: "Trimmed", input: "  VTubers  ", want: "VTubers"},
+		{name: "Japanese at limit", input: strings.Repeat("通", maxSubscriptionTagName), want: strings.Repeat("通", maxSubscriptionTagName)},
+		{name: "Empty", input: " \t", wantCode: connect.CodeInvalidArgument},
+		{name: "Too long", input: strings.Repeat("a", maxSubscriptionTagName+1), wantCode: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateTagName(tt.input)
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Fatalf("validateTagName(%q) error = %v, want code %v", tt.input, err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateTagName(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("validateTagName(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAddImportKeys は一括登録で登録済みの購読を判定するキーのテスト
+func TestAddImportKeys(t *testing.T) {
+	subscribed := map[string]*SubscriptionData{}
+	youtube := &SubscriptionData{Platform: "youtube", ChannelID: "UC111", Handle: "@Alice"}
+	twitch := &SubscriptionData{Platform: "twitch", ChannelID: "141981764", Handle: "twitchdev"}
+	addImportKeys(subscribed, youtube, "https://www.youtube.com/@alice")
+	addImportKeys(subscribed, twitch)
+
+	tests := []struct {
+		name     string
+		platform string
+		input    string
+		want     *SubscriptionData
+	}{
+		{name: "Channel ID", platform: "youtube", input: "UC111", want: youtube},
+		{name: "Handle ignores case", platform: "youtube", input: "@ALICE", want: youtube},
+		{name: "Input used for registration", platform: "youtube", input: " https://www.youtube.com/@alice ", want: youtube},
+		{name: "Twitch login", platform: "twitch", input: "twitchdev", want: twitch},
+		{name: "Same ID on another platform", platform: "twitch", input: "UC111"},
+		{name: "Not subscribed", platform: "youtube", input: "UC222"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := subscribed[importKey(tt.platform, tt.input)]; got != tt.want {
+				t.Errorf("subscribed[importKey(%q, %q)] = %+v, want %+v", tt.platform, tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFindImported は一括登録の行が別の形式の入力（URLなど）で登録済みの購読かどうかの判定のテスト
+func TestFindImported(t *testing.T) {
+	h := &SubscriptionHandler{}
+	subscribed := map[string]*SubscriptionData{}
+	youtube := &SubscriptionData{Platform: "youtube", ChannelID: "UC111", Handle: "@Alice"}
+	twitch := &SubscriptionData{Platform: "twitch", ChannelID: "141981764", Handle: "twitchdev"}
+	addImportKeys(subscribed, youtube)
+	addImportKeys(subscribed, twitch)
+
+	tests := []struct {
+		name  string
+		entry subscriptionio.Entry
+		want  *SubscriptionData
+	}{
+		{name: "Channel ID", entry: subscriptionio.Entry{Platform: "youtube", Input: "UC111"}, want: youtube},
+		{name: "Channel URL", entry: subscriptionio.Entry{Platform: "youtube", Input: "https://www.youtube.com/channel/UC111/videos"}, want: youtube},
+		{name: "Handle URL ignores case", entry: subscriptionio.Entry{Platform: "youtube", Input: "https://youtube.com/@ALICE"}, want: youtube},
+		{name: "Twitch login", entry: subscriptionio.Entry{Platform: "twitch", Input: "twitchdev"}, want: twitch},
+		{name: "Invalid input", entry: subscriptionio.Entry{Platform: "youtube", Input: "alice"}},
+		{name: "Not subscribed URL", entry: subscriptionio.Entry{Platform: "youtube", Input: "https://www.youtube.com/@bob"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := h.findImported(subscribed, tt.entry); got != tt.want {
+				t.Errorf("findImported(%+v) = %+v, want %+v", tt.entry, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewIngestStatus は取り込みジョブとソースの取得状況からの取り込み状況の判定のテスト
+func TestNewIngestStatus(t *testing.T) {
+	queuedAt := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+	ts := func(t time.Time) pgtype.Timestamptz { return pgtype.Timestamptz{Time: t, Valid: true} }
+	okSource := db.Source{FetchStatus: "ok"}
+	fetchedSource := db.Source{FetchStatus: "ok", LastFetchedAt: ts(queuedAt.Add(time.Hour))}
+
+	tests := []struct {
+		name       string
+		source     db.Source
+		job        *db.UpdateSchedule
+		eventCount int64
+		wantState  string
+		wantError  string
+		wantNextAt string
+	}{
+		{
+			name:       "Pending job",
+			source:     okSource,
+			job:        &db.UpdateSchedule{Status: "pending", CreatedAt: ts(queuedAt), ScheduledAt: ts(queuedAt)},
+			wantState:  IngestStateQueued,
+			wantNextAt: "2026-01-10T09:00:00Z",
+		},
+		{
+			name:       "Retrying job keeps last error",
+			source:     okSource,
+			job:        &db.UpdateSchedule{Status: "pending", Attempts: 2, ScheduledAt: ts(queuedAt.Add(time.Minute)), ErrorMessage: pgtype.Text{String: "quota exceeded", Valid: true}},
+			wantState:  IngestStateQueued,
+			wantError:  "quota exceeded",
+			wantNextAt: "2026-01-10T09:01:00Z",
+		},
+		{name: "Running job", source: okSource, job: &db.UpdateSchedule{Status: "running"}, eventCount: 12, wantState: IngestStateRunning},
+		{name: "Completed job", source: fetchedSource, job: &db.UpdateSchedule{Status: "completed"}, eventCount: 120, wantState: IngestStateDone},
+		{
+			name:      "Dead lettered job",
+			source:    okSource,
+			job:       &db.UpdateSchedule{Status: "failed", Attempts: 5, ErrorMessage: pgtype.Text{String: "channel not found", Valid: true}},
+			wantState: IngestStateFailed,
+			wantError: "channel not found",
+		},
+		{
+			name:      "Completed but source removed later",
+			source:    db.Source{FetchStatus: "not_found"},
+			job:       &db.UpdateSchedule{Status: "completed"},
+			wantState: IngestStateFailed,
+			wantError: "fetch status: not_found",
+		},
+		{name: "No job but fetched by batch", source: fetchedSource, wantState: IngestStateDone},
+		{name: "No job but events exist", source: okSource, eventCount: 3, wantState: IngestStateDone},
+		{name: "No job and never fetched", source: okSource, wantState: IngestStateQueued},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newIngestStatus(tt.source, tt.job, tt.eventCount)
+			if got.State != tt.wantState {
+				t.Errorf("State = %q, want %q", got.State, tt.wantState)
+			}
+			if got.LastError != tt.wantError {
+				t.Errorf("LastError = %q, want %q", got.LastError, tt.wantError)
+			}
+			if got.NextAttemptAt != tt.wantNextAt {
+				t.Errorf("NextAttemptAt = %q, want %q", got.NextAttemptAt, tt.wantNextAt)
+			}
+			if got.EventCount != tt.eventCount {
+				t.Errorf("EventCount = %d, want %d", got.EventCount, tt.eventCount)
+			}
+		})
+	}
+}
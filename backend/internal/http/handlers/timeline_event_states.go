@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	pixicastv1 "github.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1"
+	"github.com/kinchoKayaba/pixicast/backend/internal/timeline"
+)
+
+// eventStateNames はgRPCの番組状態とuser_event_statesの状態名の対応
+var eventStateNames = map[pixicastv1.EventState]string{
+	pixicastv1.EventState_EVENT_STATE_WATCHED:   "watched",
+	pixicastv1.EventState_EVENT_STATE_HIDDEN:    "hidden",
+	pixicastv1.EventState_EVENT_STATE_DISMISSED: "dismissed",
+}
+
+// SetEventState で一度に指定できる番組IDの最大数
+const maxEventStateIDs = 500
+
+// 番組の状態（視聴済み・非表示・却下）を設定・解除
+func (s *TimelineService) SetEventState(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.SetEventStateRequest],
+) (*connect.Response[pixicastv1.SetEventStateResponse], error) {
+	state, ok := eventStateNames[req.Msg.State]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid state: %v", req.Msg.State))
+	}
+	if len(req.Msg.ProgramIds) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("program_ids is required"))
+	}
+	if len(req.Msg.ProgramIds) > maxEventStateIDs {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("too many program_ids: max %d", maxEventStateIDs))
+	}
+
+	eventIDs, err := parseProgramIDs(req.Msg.ProgramIds)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := setEventStates(ctx, s.queries, db.SetEventStatesParams{
+		State:    state,
+		Value:    req.Msg.Value,
+		UserID:   userID,
+		EventIds: eventIDs,
+	})
+	if err != nil {
+		log.Printf("Failed to set event states: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	log.Printf("✅ SetEventState: user_id=%d, state=%s, value=%t, updated=%d", userID, state, req.Msg.Value, updated)
+
+	return connect.NewResponse(&pixicastv1.SetEventStateResponse{
+		UpdatedCount: updated,
+	}), nil
+}
+
+// 指定日時より前の番組の状態を一括で設定・解除
+func (s *TimelineService) MarkEventsBefore(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.MarkEventsBeforeRequest],
+) (*connect.Response[pixicastv1.MarkEventsBeforeResponse], error) {
+	state, ok := eventStateNames[req.Msg.State]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid state: %v", req.Msg.State))
+	}
+
+	beforeTime, err := time.Parse(time.RFC3339, req.Msg.BeforeTime)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid before_time: %w", err))
+	}
+
+	filter, err := timeline.NewFilter(nil, req.Msg.PlatformIds, req.Msg.EventTypes, req.Msg.SourceIds, false)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.queries.SetEventStatesBefore(ctx, db.SetEventStatesBeforeParams{
+		State:       state,
+		Value:       req.Msg.Value,
+		UserID:      userID,
+		BeforeTime:  pgtype.Timestamptz{Time: beforeTime, Valid: true},
+		PlatformIds: filter.PlatformIDs,
+		EventTypes:  filter.EventTypes,
+		SourceIds:   filter.SourceIDs,
+	})
+	if err != nil {
+		log.Printf("Failed to set event states before %s: %v", beforeTime.Format(time.RFC3339), err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	if !req.Msg.Value {
+		if err := deleteClearedEventStates(ctx, s.queries, userID, nil); err != nil {
+			log.Printf("Failed to set event states before %s: %v", beforeTime.Format(time.RFC3339), err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+		}
+	}
+	log.Printf("✅ MarkEventsBefore: user_id=%d, state=%s, value=%t, before=%s, updated=%d", userID, state, req.Msg.Value, beforeTime.Format(time.RFC3339), updated)
+
+	return connect.NewResponse(&pixicastv1.MarkEventsBeforeResponse{
+		UpdatedCount: updated,
+	}), nil
+}
+
+// setEventStates は番組の状態を設定・解除する（すべての状態を解除した番組の行は削除する）
+func setEventStates(ctx context.Context, queries *db.Queries, arg db.SetEventStatesParams) (int64, error) {
+	updated, err := queries.SetEventStates(ctx, arg)
+	if err != nil {
+		return 0, err
+	}
+	if !arg.Value {
+		if err := deleteClearedEventStates(ctx, queries, arg.UserID, arg.EventIds); err != nil {
+			return 0, err
+		}
+	}
+	return updated, nil
+}
+
+// deleteClearedEventStates はすべての状態を解除した行を削除する（eventIDsがnilの場合はユーザーのすべての行）
+func deleteClearedEventStates(ctx context.Context, queries *db.Queries, userID int64, eventIDs []pgtype.UUID) error {
+	deleted, err := queries.DeleteClearedEventStates(ctx, db.DeleteClearedEventStatesParams{
+		UserID:   userID,
+		EventIds: eventIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete cleared event states: %w", err)
+	}
+	if deleted > 0 {
+		log.Printf("🗑️  Deleted %d cleared event states: user_id=%d", deleted, userID)
+	}
+	return nil
+}
+
+// parseProgramIDs は番組IDのリストをUUIDに変換
+func parseProgramIDs(ids []string) ([]pgtype.UUID, error) {
+	eventIDs := make([]pgtype.UUID, 0, len(ids))
+	for _, id := range ids {
+		var eventID pgtype.UUID
+		if err := eventID.Scan(id); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid program_id: %q", id))
+		}
+		eventIDs = append(eventIDs, eventID)
+	}
+	return eventIDs, nil
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// fakeDB は実行したクエリ名と引数を記録する db.DBTX
+type fakeDB struct {
+	names []string
+	args  [][]interface{}
+}
+
+func (f *fakeDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	name, _, _ := strings.Cut(strings.TrimPrefix(sql, "-- name: "), " ")
+	f.names = append(f.names, name)
+	f.args = append(f.args, args)
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (f *fakeDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return nil, errors.New("unexpected query")
+}
+
+func (f *fakeDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return nil
+}
+
+// TestSetEventStates は番組の状態の設定・解除と、すべて解除した行の削除のテスト
+func TestSetEventStates(t *testing.T) {
+	eventIDs := []pgtype.UUID{{Bytes: [16]byte{1}, Valid: true}, {Bytes: [16]byte{2}, Valid: true}}
+
+	tests := []struct {
+		name      string
+		value     bool
+		wantNames []string
+	}{
+		{name: "Set", value: true, wantNames: []string{"SetEventStates"}},
+		{name: "Unset deletes cleared rows", value: false, wantNames: []string{"SetEventStates", "DeleteClearedEventStates"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeDB{}
+			updated, err := setEventStates(context.Background(), db.New(fake), db.SetEventStatesParams{
+				State:    "watched",
+				Value:    tt.value,
+				UserID:   42,
+				EventIds: eventIDs,
+			})
+			if err != nil {
+				t.Fatalf("setEventStates() error = %v", err)
+			}
+			if updated != 1 {
+				t.Errorf("updated = %d, want 1", updated)
+			}
+			if !slices.Equal(fake.names, tt.wantNames) {
+				t.Fatalf("queries = %v, want %v", fake.names, tt.wantNames)
+			}
+			if !tt.value {
+				// 解除した番組の行だけを削除する
+				args := fake.args[1]
+				if args[0] != int64(42) || !slices.Equal(args[1].([]pgtype.UUID), eventIDs) {
+					t.Errorf("DeleteClearedEventStates args = %v, want user 42 and %v", args, eventIDs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	pixicastv1 "github.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1"
+	"github.com/kinchoKayaba/pixicast/backend/internal/mute"
+)
+
+// ユーザーごとのミュートルールの最大数
+const maxMuteRules = 100
+
+// muteRuleKinds はミュートルールの種類とmute_rules.kindの対応
+var muteRuleKinds = map[pixicastv1.MuteRuleKind]string{
+	pixicastv1.MuteRuleKind_MUTE_RULE_KIND_KEYWORD:      mute.KindKeyword,
+	pixicastv1.MuteRuleKind_MUTE_RULE_KIND_REGEX:        mute.KindRegex,
+	pixicastv1.MuteRuleKind_MUTE_RULE_KIND_GAME_NAME:    mute.KindGameName,
+	pixicastv1.MuteRuleKind_MUTE_RULE_KIND_MIN_DURATION: mute.KindMinDuration,
+	pixicastv1.MuteRuleKind_MUTE_RULE_KIND_MAX_DURATION: mute.KindMaxDuration,
+	pixicastv1.MuteRuleKind_MUTE_RULE_KIND_EVENT_TYPE:   mute.KindEventType,
+}
+
+// muteRuleFields はキーワード・正規表現の対象とmute_rules.fieldの対応
+var muteRuleFields = map[pixicastv1.MuteRuleField]string{
+	pixicastv1.MuteRuleField_MUTE_RULE_FIELD_ALL:         mute.FieldAll,
+	pixicastv1.MuteRuleField_MUTE_RULE_FIELD_TITLE:       mute.FieldTitle,
+	pixicastv1.MuteRuleField_MUTE_RULE_FIELD_DESCRIPTION: mute.FieldDescription,
+}
+
+// タイムラインのミュートルールの一覧を取得
+func (s *TimelineService) ListMuteRules(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ListMuteRulesRequest],
+) (*connect.Response[pixicastv1.ListMuteRulesResponse], error) {
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListMuteRules(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list mute rules: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	rules := make([]*pixicastv1.MuteRule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, muteRuleToProto(row))
+	}
+
+	return connect.NewResponse(&pixicastv1.ListMuteRulesResponse{
+		Rules: rules,
+	}), nil
+}
+
+// ミュートルールを作成
+func (s *TimelineService) CreateMuteRule(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.CreateMuteRuleRequest],
+) (*connect.Response[pixicastv1.CreateMuteRuleResponse], error) {
+	rule, err := muteRuleFromProto(req.Msg.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.queries.CountMuteRules(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to count mute rules: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	if count >= maxMuteRules {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("too many mute rules: max %d", maxMuteRules))
+	}
+
+	row, err := s.queries.CreateMuteRule(ctx, db.CreateMuteRuleParams{
+		UserID:          userID,
+		SourceID:        rule.SourceID,
+		Kind:            rule.Kind,
+		Pattern:         rule.Pattern,
+		Field:           rule.Field,
+		DurationSeconds: int32(rule.Duration / time.Second),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("subscription not found: %s", req.Msg.Rule.SourceId))
+	}
+	if err != nil {
+		log.Printf("Failed to create mute rule: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	log.Printf("✅ CreateMuteRule: user_id=%d, kind=%s", userID, row.Kind)
+
+	return connect.NewResponse(&pixicastv1.CreateMuteRuleResponse{
+		Rule: muteRuleToProto(row),
+	}), nil
+}
+
+// ミュートルールを更新
+func (s *TimelineService) UpdateMuteRule(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.UpdateMuteRuleRequest],
+) (*connect.Response[pixicastv1.UpdateMuteRuleResponse], error) {
+	rule, err := muteRuleFromProto(req.Msg.Rule)
+	if err != nil {
+		return nil, err
+	}
+	var ruleID pgtype.UUID
+	if err := ruleID.Scan(req.Msg.Rule.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid id: %q", req.Msg.Rule.Id))
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.UpdateMuteRule(ctx, db.UpdateMuteRuleParams{
+		SourceID:        rule.SourceID,
+		Kind:            rule.Kind,
+		Pattern:         rule.Pattern,
+		Field:           rule.Field,
+		DurationSeconds: int32(rule.Duration / time.Second),
+		ID:              ruleID,
+		UserID:          userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// ルールが存在しない、またはsource_idのチャンネルを購読していない
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("mute rule or subscription not found"))
+	}
+	if err != nil {
+		log.Printf("Failed to update mute rule: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	log.Printf("✅ UpdateMuteRule: user_id=%d, id=%s", userID, req.Msg.Rule.Id)
+
+	return connect.NewResponse(&pixicastv1.UpdateMuteRuleResponse{
+		Rule: muteRuleToProto(row),
+	}), nil
+}
+
+// ミュートルールを削除
+func (s *TimelineService) DeleteMuteRule(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.DeleteMuteRuleRequest],
+) (*connect.Response[pixicastv1.DeleteMuteRuleResponse], error) {
+	var ruleID pgtype.UUID
+	if err := ruleID.Scan(req.Msg.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid id: %q", req.Msg.Id))
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.queries.DeleteMuteRule(ctx, db.DeleteMuteRuleParams{
+		ID:     ruleID,
+		UserID: userID,
+	})
+	if err != nil {
+		log.Printf("Failed to delete mute rule: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("mute rule not found: %s", req.Msg.Id))
+	}
+	log.Printf("✅ DeleteMuteRule: user_id=%d, id=%s", userID, req.Msg.Id)
+
+	return connect.NewResponse(&pixicastv1.DeleteMuteRuleResponse{}), nil
+}
+
+// muteRuleFromProto はリクエストのミュートルールを検証して変換
+func muteRuleFromProto(rule *pixicastv1.MuteRule) (mute.Rule, error) {
+	if rule == nil {
+		return mute.Rule{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rule is required"))
+	}
+	kind, ok := muteRuleKinds[rule.Kind]
+	if !ok {
+		return mute.Rule{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid kind: %v", rule.Kind))
+	}
+	field, ok := muteRuleFields[rule.Field]
+	if !ok {
+		return mute.Rule{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid field: %v", rule.Field))
+	}
+
+	r := mute.Rule{
+		Kind:     kind,
+		Pattern:  rule.Pattern,
+		Field:    field,
+		Duration: time.Duration(rule.DurationSeconds) * time.Second,
+	}
+	if rule.SourceId != "" {
+		if err := r.SourceID.Scan(rule.SourceId); err != nil {
+			return mute.Rule{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid source_id: %q", rule.SourceId))
+		}
+	}
+	if err := mute.Validate(r); err != nil {
+		return mute.Rule{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return r, nil
+}
+
+// muteRuleToProto はDBのミュートルールをgRPCの型に変換
+func muteRuleToProto(row db.MuteRule) *pixicastv1.MuteRule {
+	rule := &pixicastv1.MuteRule{
+		Id:              row.ID.String(),
+		Pattern:         row.Pattern,
+		DurationSeconds: row.DurationSeconds,
+		CreatedAt:       row.CreatedAt.Time.Format(time.RFC3339),
+	}
+	for kind, name := range muteRuleKinds {
+		if name == row.Kind {
+			rule.Kind = kind
+		}
+	}
+	for field, name := range muteRuleFields {
+		if name == row.Field {
+			rule.Field = field
+		}
+	}
+	if row.SourceID.Valid {
+		rule.SourceId = row.SourceID.String()
+	}
+	return rule
+}
+
+// muteEvaluator はユーザーのミュートルールを読み込む（取得できない場合はミュートしない）
+func (s *TimelineService) muteEvaluator(ctx context.Context, userID int64) *mute.Evaluator {
+	rows, err := s.queries.ListMuteRules(ctx, userID)
+	if err != nil {
+		log.Printf("⚠️  Failed to list mute rules: %v", err)
+		return nil
+	}
+
+	rules := make([]mute.Rule, 0, len(rows))
+	for _, row := range rows {
+		rule := mute.Rule{
+			Kind:     row.Kind,
+			Pattern:  row.Pattern,
+			Field:    row.Field,
+			Duration: time.Duration(row.DurationSeconds) * time.Second,
+			SourceID: row.SourceID,
+		}
+		if err := mute.Validate(rule); err != nil {
+			log.Printf("⚠️  Skipping invalid mute rule %s: %v", row.ID.String(), err)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	evaluator, err := mute.NewEvaluator(rules)
+	if err != nil {
+		log.Printf("⚠️  Failed to compile mute rules: %v", err)
+		return nil
+	}
+	return evaluator
+}
+
+// muteEventFromRow はタイムラインの行からミュートの判定に使う情報を取り出す
+func muteEventFromRow(event db.ListTimelineRow) mute.Event {
+	e := mute.Event{
+		SourceID:    event.SourceID,
+		Type:        event.Type,
+		Title:       event.Title,
+		Description: event.Description.String,
+		Duration:    mute.ParseDuration(event.Duration.String),
+	}
+	// 長さが保存されていない番組（ライブ配信など）は開始・終了時刻から求める
+	if e.Duration == 0 && event.StartAt.Valid && event.EndAt.Valid {
+		e.Duration = event.EndAt.Time.Sub(event.StartAt.Time)
+	}
+
+	// Twitchの配信カテゴリはmetricsに保存されている
+	if len(event.Metrics) > 0 {
+		var metrics struct {
+			GameName string `json:"game_name"`
+		}
+		if err := json.Unmarshal(event.Metrics, &metrics); err == nil {
+			e.GameName = metrics.GameName
+		}
+	}
+	return e
+}
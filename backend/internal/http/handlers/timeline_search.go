@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	pixicastv1 "github.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1"
+	"github.com/kinchoKayaba/pixicast/backend/internal/search"
+	"github.com/kinchoKayaba/pixicast/backend/internal/timeline"
+)
+
+// 説明文スニペットの最大文字数
+const descriptionSnippetRunes = 120
+
+// 購読チャンネルの番組を検索
+func (s *TimelineService) SearchTimeline(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.SearchTimelineRequest],
+) (*connect.Response[pixicastv1.SearchTimelineResponse], error) {
+	log.Printf("SearchTimeline called with query: %s, limit: %d", req.Msg.Query, req.Msg.Limit)
+
+	terms := search.Terms(req.Msg.Query)
+	if len(terms) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("query is required"))
+	}
+
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 20 // デフォルト20件
+	}
+	if limit > 50 {
+		limit = 50 // 最大50件
+	}
+
+	filter, err := timeline.NewFilter(nil, req.Msg.PlatformIds, req.Msg.EventTypes, req.Msg.SourceIds, req.Msg.FavoritesOnly)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var cursorTime pgtype.Timestamptz
+	var cursorLive pgtype.Bool
+	var cursorID pgtype.UUID
+	if req.Msg.Cursor != "" {
+		cursor, err := timeline.DecodeCursor(req.Msg.Cursor)
+		if err != nil || cursor.ByPriority {
+			return nil, connect.NewError(connect.CodeInvalidArgument, timeline.ErrInvalidCursor)
+		}
+		cursorTime = pgtype.Timestamptz{Time: cursor.SortTime, Valid: true}
+		cursorLive = pgtype.Bool{Bool: cursor.IsLive, Valid: true}
+		cursorID = cursor.EventID
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.SearchTimeline(ctx, db.SearchTimelineParams{
+		UserID:        userID,
+		Terms:         terms,
+		PlatformIds:   filter.PlatformIDs,
+		EventTypes:    filter.EventTypes,
+		SourceIds:     filter.SourceIDs,
+		FavoritesOnly: filter.FavoritesOnly,
+		CursorTime:    cursorTime,
+		CursorLive:    cursorLive,
+		CursorID:      cursorID,
+		PageLimit:     limit + 1, // 1件多く取得してhas_moreを判定
+	})
+	if err != nil {
+		log.Printf("Failed to search timeline: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	hasMore := false
+	if len(rows) > int(limit) {
+		hasMore = true
+		rows = rows[:limit]
+	}
+
+	results := []*pixicastv1.SearchTimelineResult{}
+	now := time.Now()
+	for _, row := range rows {
+		event := db.ListTimelineRow(row)
+		results = append(results, &pixicastv1.SearchTimelineResult{
+			Program:            programFromRow(event, now),
+			TitleSnippet:       snippetSegments(search.Snippet(event.Title, terms, 0)),
+			DescriptionSnippet: snippetSegments(search.Snippet(event.Description.String, terms, descriptionSnippetRunes)),
+		})
+	}
+
+	nextCursor := ""
+	if hasMore {
+		nextCursor = cursorFromRow(db.ListTimelineRow(rows[len(rows)-1])).Encode()
+	}
+	log.Printf("📤 SearchTimeline: user_id=%d, terms=%v, %d results, has_more: %v", userID, terms, len(results), hasMore)
+
+	return connect.NewResponse(&pixicastv1.SearchTimelineResponse{
+		Results:    results,
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	}), nil
+}
+
+// snippetSegments はスニペットをgRPCの型に変換
+func snippetSegments(segments []search.Segment) []*pixicastv1.SnippetSegment {
+	var out []*pixicastv1.SnippetSegment
+	for _, seg := range segments {
+		out = append(out, &pixicastv1.SnippetSegment{
+			Text:        seg.Text,
+			Highlighted: seg.Highlighted,
+		})
+	}
+	return out
+}
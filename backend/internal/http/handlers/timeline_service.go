@@ -0,0 +1,818 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	pixicastv1 "github.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1"
+	"github.com/kinchoKayaba/pixicast/backend/internal/auth"
+	"github.com/kinchoKayaba/pixicast/backend/internal/ingest"
+	"github.com/kinchoKayaba/pixicast/backend/internal/mute"
+	"github.com/kinchoKayaba/pixicast/backend/internal/timeline"
+	"github.com/kinchoKayaba/pixicast/backend/internal/youtube"
+)
+
+// TimelineService はタイムラインのConnectサービス
+// RPCはドメインごとのファイル（timeline_mute.go・timeline_views.go など）に分けている
+type TimelineService struct {
+	queries      *db.Queries
+	youtube      *youtube.Client
+	firebaseAuth *auth.FirebaseAuth
+}
+
+// NewTimelineService はサービスを作成
+func NewTimelineService(queries *db.Queries, youtubeClient *youtube.Client, firebaseAuth *auth.FirebaseAuth) *TimelineService {
+	return &TimelineService{
+		queries:      queries,
+		youtube:      youtubeClient,
+		firebaseAuth: firebaseAuth,
+	}
+}
+
+// parseDuration は ISO 8601 duration (PT1H30M15S) を "01:30:15" 形式に変換
+func parseDuration(isoDuration string) string {
+	if isoDuration == "" {
+		return "00:00"
+	}
+
+	re := regexp.MustCompile(`PT(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?`)
+	matches := re.FindStringSubmatch(isoDuration)
+	if matches == nil {
+		return "00:00"
+	}
+
+	hours, _ := strconv.Atoi(matches[1])
+	minutes, _ := strconv.Atoi(matches[2])
+	seconds, _ := strconv.Atoi(matches[3])
+
+	if hours > 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%02d:%02d", minutes, seconds)
+}
+
+// タイムライン取得
+func (s *TimelineService) GetTimeline(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.GetTimelineRequest],
+) (*connect.Response[pixicastv1.GetTimelineResponse], error) {
+	log.Printf("GetTimeline called for date: %s, youtube_channel_ids: %v, before_time: %s, cursor: %s, direction: %s, limit: %d",
+		req.Msg.Date, req.Msg.YoutubeChannelIds, req.Msg.BeforeTime, req.Msg.Cursor, req.Msg.Direction, req.Msg.Limit)
+
+	// 番組表表示（date指定）かどうか
+	dayView := req.Msg.Date != ""
+
+	// リクエストパラメータの処理
+	limit := int32(req.Msg.Limit)
+	if dayView {
+		// 番組表表示は1日分をまとめて返す
+		if limit <= 0 {
+			limit = 500 // デフォルト500件
+		}
+		if limit > 1000 {
+			limit = 1000 // 最大1000件
+		}
+	} else {
+		if limit <= 0 {
+			limit = 50 // デフォルト50件
+		}
+		if limit > 100 {
+			limit = 100 // 最大100件
+		}
+	}
+
+	// before_timeの処理（非推奨: cursorを使用）
+	var beforeTime pgtype.Timestamptz
+	if req.Msg.BeforeTime != "" {
+		t, err := time.Parse(time.RFC3339, req.Msg.BeforeTime)
+		if err != nil {
+			log.Printf("Failed to parse before_time: %v", err)
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid before_time format"))
+		}
+		beforeTime = pgtype.Timestamptz{Time: t, Valid: true}
+	} else {
+		beforeTime = pgtype.Timestamptz{Valid: false}
+	}
+
+	// 並び順の処理（優先度順はタイムラインのみ）
+	byPriority := req.Msg.Sort == pixicastv1.TimelineSort_TIMELINE_SORT_PRIORITY
+	if byPriority && dayView {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("sort is not supported with date"))
+	}
+
+	// cursorの処理
+	var cursorTime pgtype.Timestamptz
+	var cursorLive pgtype.Bool
+	var cursorID pgtype.UUID
+	var cursorPriority pgtype.Int4
+	if req.Msg.Cursor != "" {
+		cursor, err := timeline.DecodeCursor(req.Msg.Cursor)
+		if err != nil {
+			log.Printf("Failed to decode cursor: %v", err)
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if cursor.ByPriority != byPriority {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cursor does not match sort"))
+		}
+		cursorTime = pgtype.Timestamptz{Time: cursor.SortTime, Valid: true}
+		cursorLive = pgtype.Bool{Bool: cursor.IsLive, Valid: true}
+		cursorID = cursor.EventID
+		cursorPriority = pgtype.Int4{Int32: cursor.Priority, Valid: byPriority}
+	}
+	backward := req.Msg.Direction == pixicastv1.PageDirection_PAGE_DIRECTION_BACKWARD
+
+	// dateの処理（指定タイムゾーンでの1日の範囲に変換）
+	var dayStart, dayEnd pgtype.Timestamptz
+	if dayView {
+		broadcastDay := req.Msg.DayBoundary == pixicastv1.DayBoundary_DAY_BOUNDARY_BROADCAST
+		start, end, err := timeline.DayRange(req.Msg.Date, req.Msg.Timezone, broadcastDay)
+		if err != nil {
+			log.Printf("Failed to parse date: %v", err)
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		dayStart = pgtype.Timestamptz{Time: start, Valid: true}
+		dayEnd = pgtype.Timestamptz{Time: end, Valid: true}
+	}
+
+	// 絞り込み条件の処理（未指定の項目はnilを渡して絞り込まない）
+	filter, err := timeline.NewFilter(
+		req.Msg.YoutubeChannelIds,
+		req.Msg.PlatformIds,
+		req.Msg.EventTypes,
+		req.Msg.SourceIds,
+		req.Msg.FavoritesOnly,
+	)
+	if err == nil {
+		filter, err = filter.WithTagIDs(req.Msg.TagIds)
+	}
+	if err != nil {
+		log.Printf("Invalid timeline filter: %v", err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// 認証: user_idを取得
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("✅ GetTimeline: Authenticated user_id=%d", userID)
+
+	// 1. DBからデータを取得 (SQL実行) - 新スキーマのListTimelineを使用
+	// limit+1件取得して、has_moreを判定
+
+	// 表示順はタイムラインが新着順、番組表が開始時刻順
+	// 手前のページ（backward）は逆順で取得してから表示順に並べ直す
+	ascending := dayView != backward
+
+	// ミュートルール（include_muted指定時は適用しない）
+	var muter *mute.Evaluator
+	if !req.Msg.IncludeMuted {
+		muter = s.muteEvaluator(ctx, userID)
+	}
+
+	// 保存したビューの式
+	var viewFilter func(row db.ListTimelineRow) bool
+	if req.Msg.ViewId != "" {
+		viewFilter, err = s.timelineViewFilter(ctx, userID, req.Msg.ViewId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 優先度順の場合はカーソルに購読の優先度を含める
+	cursorAt := cursorFromRow
+	if byPriority {
+		priorities, err := s.subscriptionPriorities(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		cursorAt = func(row db.ListTimelineRow) timeline.Cursor {
+			c := cursorFromRow(row)
+			c.ByPriority = true
+			c.Priority = priorities[row.SourceID]
+			return c
+		}
+	}
+
+	params := db.ListTimelineByPriorityParams{
+		UserID:         userID,
+		BeforeTime:     beforeTime,
+		DayStart:       dayStart,
+		DayEnd:         dayEnd,
+		ChannelIds:     filter.ChannelIDs,
+		PlatformIds:    filter.PlatformIDs,
+		EventTypes:     filter.EventTypes,
+		SourceIds:      filter.SourceIDs,
+		TagIds:         filter.TagIDs,
+		FavoritesOnly:  filter.FavoritesOnly,
+		ExcludeWatched: req.Msg.ExcludeWatched,
+		ExcludeHidden:  req.Msg.ExcludeHidden,
+		CursorTime:     cursorTime,
+		CursorLive:     cursorLive,
+		CursorID:       cursorID,
+		CursorPriority: cursorPriority,
+		Ascending:      ascending,
+		PageLimit:      limit + simulcastLookahead + 1, // 1件多く取得してhas_moreを判定
+	}
+
+	// ミュートした番組・ビューの式を満たさない番組を除いてlimit件（と同時配信の先読み分）になるか、行がなくなるまで続きを取得する
+	// 除外が多い場合に取得回数が増えすぎないよう、取得件数を倍にしながら続きを取得する
+	want := int(limit) + simulcastLookahead
+	var timelineData []db.ListTimelineRow
+	exhausted := false
+	for batch := 1; ; batch++ {
+		rows, err := s.listTimeline(ctx, params, byPriority)
+		if err != nil {
+			log.Printf("Failed to fetch timeline: %v", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+		}
+		for _, row := range rows {
+			if muter.Muted(muteEventFromRow(row)) {
+				continue
+			}
+			if viewFilter != nil && !viewFilter(row) {
+				continue
+			}
+			timelineData = append(timelineData, row)
+		}
+		log.Printf("📊 DB timeline events fetched: %d (requested: %d, batch: %d), filter: %+v", len(rows), limit, batch, filter)
+
+		if len(timelineData) > want {
+			break
+		}
+		if len(rows) < int(params.PageLimit) {
+			exhausted = true // これ以上の行はない
+			break
+		}
+		next := cursorAt(rows[len(rows)-1])
+		params.CursorTime = pgtype.Timestamptz{Time: next.SortTime, Valid: true}
+		params.CursorLive = pgtype.Bool{Bool: next.IsLive, Valid: true}
+		params.CursorID = next.EventID
+		params.CursorPriority = pgtype.Int4{Int32: next.Priority, Valid: byPriority}
+		params.PageLimit = min(params.PageLimit*2, maxScanPageLimit)
+	}
+
+	// limit件に切り詰める（同時配信のグループが境界をまたぐ場合は、グループの最後の番組までこのページに含める）
+	var links []timeline.SourceLink
+	if len(timelineData) > 1 {
+		links = s.sourceLinks(ctx, userID)
+	}
+	pageEnd := len(timelineData)
+	if pageEnd > int(limit) {
+		pageEnd = timeline.SimulcastPageEnd(simulcastEventsFromRows(timelineData), links, int(limit))
+	}
+	hasMore := pageEnd < len(timelineData) || !exhausted
+	timelineData = timelineData[:pageEnd]
+	if backward {
+		slices.Reverse(timelineData)
+	}
+
+	// 2. DBの型(db.ListTimelineRow) を gRPCの型(pixicastv1.Program) に変換
+	// リンクしたソースの同時配信は1つの番組にまとめる（ページの件数はまとめた分だけ少なくなる）
+	responsePrograms := programsFromRowsWithLinks(timelineData, links, time.Now())
+
+	// next_cursorとprev_cursorの設定
+	// 続き方向は次のページがある場合のみ、手前方向は新着の再取得に使えるよう常に返す
+	nextCursor := ""
+	prevCursor := ""
+	if len(timelineData) > 0 {
+		first := timelineData[0]
+		last := timelineData[len(timelineData)-1]
+		prevCursor = cursorAt(first).Encode()
+		if hasMore || backward {
+			nextCursor = cursorAt(last).Encode()
+		}
+	} else if backward {
+		// 手前に新しいイベントがない場合は同じカーソルで再取得できるようにする
+		prevCursor = req.Msg.Cursor
+	}
+
+	log.Printf("📤 Returning %d programs, has_more: %v", len(responsePrograms), hasMore)
+
+	// レスポンスを返す（DBクエリで既にソート済み。番組表表示は開始時刻の昇順、優先度順は優先度の高い購読から新着順）
+	return connect.NewResponse(&pixicastv1.GetTimelineResponse{
+		Programs:   responsePrograms,
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+	}), nil
+}
+
+// listTimeline は並び順に応じたクエリでタイムラインを取得
+// 新着順・開始時刻順は並び順が固定のクエリでインデックスを使い、優先度順のみ並び順を切り替える
+func (s *TimelineService) listTimeline(ctx context.Context, params db.ListTimelineByPriorityParams, byPriority bool) ([]db.ListTimelineRow, error) {
+	if byPriority {
+		rows, err := s.queries.ListTimelineByPriority(ctx, params)
+		events := make([]db.ListTimelineRow, 0, len(rows))
+		for _, row := range rows {
+			events = append(events, db.ListTimelineRow(row))
+		}
+		return events, err
+	}
+
+	p := db.ListTimelineParams{
+		UserID:         params.UserID,
+		BeforeTime:     params.BeforeTime,
+		DayStart:       params.DayStart,
+		DayEnd:         params.DayEnd,
+		ChannelIds:     params.ChannelIds,
+		PlatformIds:    params.PlatformIds,
+		EventTypes:     params.EventTypes,
+		SourceIds:      params.SourceIds,
+		TagIds:         params.TagIds,
+		FavoritesOnly:  params.FavoritesOnly,
+		ExcludeWatched: params.ExcludeWatched,
+		ExcludeHidden:  params.ExcludeHidden,
+		CursorTime:     params.CursorTime,
+		CursorLive:     params.CursorLive,
+		CursorID:       params.CursorID,
+		PageLimit:      params.PageLimit,
+	}
+	if !params.Ascending {
+		return s.queries.ListTimeline(ctx, p)
+	}
+	rows, err := s.queries.ListTimelineAscending(ctx, db.ListTimelineAscendingParams(p))
+	events := make([]db.ListTimelineRow, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, db.ListTimelineRow(row))
+	}
+	return events, err
+}
+
+// WatchTimeline の変更履歴ポーリング間隔と1回あたりの取得件数
+const (
+	watchPollInterval = 5 * time.Second
+	watchBatchSize    = 100
+)
+
+// errWatchResync はカーソル以降の変更履歴を配信できないため、タイムラインを再取得してから接続し直す必要があるエラー
+var errWatchResync = errors.New("cursor expired: reload the timeline and reconnect without a cursor")
+
+// タイムラインの変更をストリーミング配信
+func (s *TimelineService) WatchTimeline(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.WatchTimelineRequest],
+	stream *connect.ServerStream[pixicastv1.WatchTimelineResponse],
+) error {
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return err
+	}
+
+	// カーソルの処理（空の場合は接続時点以降の変更のみ配信）
+	var cursor timeline.ChangeCursor
+	if req.Msg.Cursor != "" {
+		cursor, err = timeline.DecodeChangeCursor(req.Msg.Cursor)
+		if err != nil {
+			if _, parseErr := strconv.ParseInt(req.Msg.Cursor, 10, 64); parseErr == nil {
+				// 旧形式（変更履歴のID）のカーソルは作成日時が分からないため再取得してもらう
+				return connect.NewError(connect.CodeFailedPrecondition, errWatchResync)
+			}
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid cursor"))
+		}
+
+		// 保持期間を過ぎて削除された変更履歴がある場合は、取りこぼしを避けるため再取得してもらう
+		oldest, err := s.queries.GetOldestEventChangeTime(ctx)
+		if err != nil {
+			log.Printf("Failed to get oldest event change: %v", err)
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+		}
+		if !oldest.Valid || cursor.CreatedAt.Before(oldest.Time) {
+			log.Printf("⚠️ WatchTimeline: user_id=%d cursor expired (%s)", userID, cursor.CreatedAt.Format(time.RFC3339))
+			return connect.NewError(connect.CodeFailedPrecondition, errWatchResync)
+		}
+	} else {
+		watermark, err := s.queries.GetEventChangeWatermark(ctx)
+		if err != nil {
+			log.Printf("Failed to get event change watermark: %v", err)
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+		}
+		cursor = timeline.ChangeCursor{CreatedAt: watermark.Time, ID: math.MaxInt64}
+	}
+	log.Printf("📡 WatchTimeline: user_id=%d started from cursor=%s/%d", userID, cursor.CreatedAt.Format(time.RFC3339Nano), cursor.ID)
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		changes, err := s.queries.ListEventChangesForUser(ctx, db.ListEventChangesForUserParams{
+			UserID:         userID,
+			AfterCreatedAt: pgtype.Timestamptz{Time: cursor.CreatedAt, Valid: true},
+			AfterID:        cursor.ID,
+			Limit:          watchBatchSize,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Printf("Failed to list event changes: %v", err)
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+		}
+
+		for _, change := range changes {
+			cursor = timeline.ChangeCursor{CreatedAt: change.CreatedAt.Time, ID: change.ID}
+			res, err := s.timelineChange(ctx, userID, change)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				log.Printf("Failed to build timeline change %d: %v", change.ID, err)
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+			}
+			if err := stream.Send(res); err != nil {
+				log.Printf("📴 WatchTimeline: user_id=%d disconnected: %v", userID, err)
+				return nil
+			}
+		}
+
+		// 取得件数が上限に達した場合は待たずに続きを取得
+		if len(changes) == watchBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("📴 WatchTimeline: user_id=%d closed", userID)
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// timelineChange は変更履歴をストリーム配信用のレスポンスに変換
+func (s *TimelineService) timelineChange(ctx context.Context, userID int64, change db.EventChange) (*pixicastv1.WatchTimelineResponse, error) {
+	res := &pixicastv1.WatchTimelineResponse{
+		ProgramId: change.EventID.String(),
+		Cursor:    timeline.ChangeCursor{CreatedAt: change.CreatedAt.Time, ID: change.ID}.Encode(),
+	}
+
+	switch change.ChangeType {
+	case ingest.ChangeInserted:
+		res.Type = pixicastv1.TimelineChangeType_TIMELINE_CHANGE_TYPE_INSERTED
+	case ingest.ChangeUpdated:
+		res.Type = pixicastv1.TimelineChangeType_TIMELINE_CHANGE_TYPE_UPDATED
+	default:
+		res.Type = pixicastv1.TimelineChangeType_TIMELINE_CHANGE_TYPE_REMOVED
+		return res, nil
+	}
+
+	row, err := s.queries.GetTimelineEvent(ctx, db.GetTimelineEventParams{
+		ID:     change.EventID,
+		UserID: userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// 変更後にイベントが削除されている場合は削除として通知
+		res.Type = pixicastv1.TimelineChangeType_TIMELINE_CHANGE_TYPE_REMOVED
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.Program = programFromRow(db.ListTimelineRow(row), time.Now())
+	return res, nil
+}
+
+// 配信中・配信予定の取得件数（デフォルト20、最大100）
+func scheduleLimit(limit int32) int32 {
+	if limit <= 0 {
+		return 20
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
+
+// 配信中・放送中の番組を取得（ライブ配信・プレミア公開・ラジオ番組）
+func (s *TimelineService) ListLiveNow(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ListLiveNowRequest],
+) (*connect.Response[pixicastv1.ListLiveNowResponse], error) {
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListLiveEvents(ctx, db.ListLiveEventsParams{
+		UserID:     userID,
+		EventTypes: timeline.OnAirTypes,
+		Limit:      scheduleLimit(req.Msg.Limit),
+	})
+	if err != nil {
+		log.Printf("Failed to fetch live events: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	events := make([]db.ListTimelineRow, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, db.ListTimelineRow(row))
+	}
+	programs := s.programsFromRows(ctx, userID, events, time.Now())
+	log.Printf("📤 ListLiveNow: user_id=%d, %d programs", userID, len(programs))
+
+	return connect.NewResponse(&pixicastv1.ListLiveNowResponse{
+		Programs: programs,
+	}), nil
+}
+
+// 配信予定・放送予定の番組を取得
+func (s *TimelineService) ListUpcoming(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ListUpcomingRequest],
+) (*connect.Response[pixicastv1.ListUpcomingResponse], error) {
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListUpcomingEvents(ctx, db.ListUpcomingEventsParams{
+		UserID:     userID,
+		EventTypes: timeline.UpcomingTypes,
+		Limit:      scheduleLimit(req.Msg.Limit),
+	})
+	if err != nil {
+		log.Printf("Failed to fetch upcoming events: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	events := make([]db.ListTimelineRow, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, db.ListTimelineRow(row))
+	}
+	programs := s.programsFromRows(ctx, userID, events, time.Now())
+	log.Printf("📤 ListUpcoming: user_id=%d, %d programs", userID, len(programs))
+
+	return connect.NewResponse(&pixicastv1.ListUpcomingResponse{
+		Programs: programs,
+	}), nil
+}
+
+// programsFromRows はイベントの行を番組に変換し、リンクしたソースの同時配信を1つの番組にまとめる
+// まとめた番組は表示順で最初のイベントを代表とし、他のプラットフォームのイベントをalternate_linksに持つ
+func (s *TimelineService) programsFromRows(ctx context.Context, userID int64, events []db.ListTimelineRow, now time.Time) []*pixicastv1.Program {
+	var links []timeline.SourceLink
+	if len(events) > 1 {
+		links = s.sourceLinks(ctx, userID)
+	}
+	return programsFromRowsWithLinks(events, links, now)
+}
+
+// sourceLinks はユーザーがリンクしたソースの組を返す（取得できない場合は同時配信をまとめないようnil）
+func (s *TimelineService) sourceLinks(ctx context.Context, userID int64) []timeline.SourceLink {
+	rows, err := s.queries.ListSourceLinks(ctx, userID)
+	if err != nil {
+		log.Printf("⚠️  Failed to list source links: %v", err)
+		return nil
+	}
+	links := make([]timeline.SourceLink, 0, len(rows))
+	for _, row := range rows {
+		links = append(links, timeline.SourceLink{SourceID: row.SourceID, LinkedSourceID: row.LinkedSourceID})
+	}
+	return links
+}
+
+// simulcastEventsFromRows はタイムラインの行を同時配信の判定に使う情報に変換
+func simulcastEventsFromRows(events []db.ListTimelineRow) []timeline.SimulcastEvent {
+	simulcasts := make([]timeline.SimulcastEvent, 0, len(events))
+	for _, event := range events {
+		simulcasts = append(simulcasts, timeline.SimulcastEvent{
+			SourceID:   event.SourceID,
+			PlatformID: event.PlatformID,
+			Type:       event.Type,
+			Title:      event.Title,
+			StartAt:    event.StartAt.Time,
+		})
+	}
+	return simulcasts
+}
+
+// programsFromRowsWithLinks は取得済みのリンクで programsFromRows と同じ変換を行う
+func programsFromRowsWithLinks(events []db.ListTimelineRow, links []timeline.SourceLink, now time.Time) []*pixicastv1.Program {
+	programs := make([]*pixicastv1.Program, 0, len(events))
+	if len(links) == 0 {
+		for _, event := range events {
+			programs = append(programs, programFromRow(event, now))
+		}
+		return programs
+	}
+
+	for _, group := range timeline.GroupSimulcasts(simulcastEventsFromRows(events), links) {
+		program := programFromRow(events[group[0]], now)
+		for _, i := range group[1:] {
+			alternate := events[i]
+			program.AlternateLinks = append(program.AlternateLinks, &pixicastv1.AlternateLink{
+				ProgramId:    alternate.ID.String(),
+				PlatformName: alternate.PlatformID,
+				LinkUrl:      alternate.Url,
+				ChannelTitle: alternate.SourceDisplayName.String,
+			})
+		}
+		programs = append(programs, program)
+	}
+	return programs
+}
+
+// ミュート・ビューで除いた番組の分のページを埋めるために続きを取得する際の、1回あたりの取得件数の上限
+const maxScanPageLimit = 2000
+
+// simulcastLookahead はページの境界をまたぐ同時配信のグループを判定するために、limit件の先まで取得する件数
+const simulcastLookahead = 20
+
+// programFromRow はタイムラインの行(db.ListTimelineRow)をgRPCの型(pixicastv1.Program)に変換
+func programFromRow(event db.ListTimelineRow, now time.Time) *pixicastv1.Program {
+	// 放送中かどうかの判定（ライブ配信・プレミア公開・ラジオ番組）
+	isLive := timeline.OnAir(event.Type, event.StartAt, event.EndAt, now)
+
+	// NULL許容フィールドの処理
+	imageUrl := ""
+	if event.ImageUrl.Valid {
+		imageUrl = event.ImageUrl.String
+	}
+	description := ""
+	if event.Description.Valid {
+		description = event.Description.String
+	}
+	channelTitle := ""
+	if event.SourceDisplayName.Valid {
+		channelTitle = event.SourceDisplayName.String
+	}
+	channelThumbnailUrl := ""
+	if event.SourceThumbnailUrl.Valid {
+		channelThumbnailUrl = event.SourceThumbnailUrl.String
+	}
+
+	// start_at または published_at を使用
+	startAt := ""
+	publishedAt := ""
+	if event.StartAt.Valid {
+		startAt = event.StartAt.Time.Format(time.RFC3339)
+	} else if event.PublishedAt.Valid {
+		startAt = event.PublishedAt.Time.Format(time.RFC3339)
+		publishedAt = event.PublishedAt.Time.Format(time.RFC3339)
+	}
+
+	endAt := ""
+	if event.EndAt.Valid {
+		endAt = event.EndAt.Time.Format(time.RFC3339)
+	} else if event.PublishedAt.Valid {
+		endAt = event.PublishedAt.Time.Format(time.RFC3339)
+	}
+
+	// metricsから再生回数を取得
+	viewCount := int64(0)
+	if len(event.Metrics) > 0 {
+		var metricsData map[string]interface{}
+		if err := json.Unmarshal(event.Metrics, &metricsData); err == nil {
+			if views, ok := metricsData["views"].(float64); ok {
+				viewCount = int64(views)
+			}
+		}
+	}
+
+	// durationの取得
+	duration := ""
+	if event.Duration.Valid {
+		duration = event.Duration.String
+	}
+
+	// 開始までの秒数（カウントダウン表示用）
+	startsInSeconds := int64(0)
+	if event.StartAt.Valid {
+		startsInSeconds = int64(event.StartAt.Time.Sub(now) / time.Second)
+	}
+
+	return &pixicastv1.Program{
+		Id:                  event.ID.String(),
+		Title:               event.Title,
+		StartAt:             startAt,
+		EndAt:               endAt,
+		PlatformName:        event.PlatformID,
+		ImageUrl:            imageUrl,
+		LinkUrl:             event.Url,
+		IsLive:              isLive,
+		ChannelTitle:        channelTitle,
+		Description:         description,
+		Duration:            duration,
+		PublishedAt:         publishedAt,
+		ViewCount:           viewCount,
+		ChannelThumbnailUrl: channelThumbnailUrl,
+		StartsInSeconds:     startsInSeconds,
+		Watched:             event.WatchedAt.Valid,
+		Hidden:              event.HiddenAt.Valid,
+		Dismissed:           event.DismissedAt.Valid,
+	}
+}
+
+// cursorFromRow はタイムラインの行からページング用カーソルを作成
+// 並び順の時刻はListTimelineと同じく start_at → published_at → created_at の順で採用し、
+// 同じ時刻の番組は配信中を先に並べる
+func cursorFromRow(event db.ListTimelineRow) timeline.Cursor {
+	sortTime := event.CreatedAt.Time
+	if event.StartAt.Valid {
+		sortTime = event.StartAt.Time
+	} else if event.PublishedAt.Valid {
+		sortTime = event.PublishedAt.Time
+	}
+	return timeline.Cursor{SortTime: sortTime, IsLive: event.Type == "live", EventID: event.ID}
+}
+
+// subscriptionPriorities は購読ソースごとの優先度を返す（優先度順のカーソル用）
+func (s *TimelineService) subscriptionPriorities(ctx context.Context, userID int64) (map[pgtype.UUID]int32, error) {
+	subscriptions, err := s.queries.ListUserSubscriptions(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list subscriptions: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	priorities := make(map[pgtype.UUID]int32, len(subscriptions))
+	for _, sub := range subscriptions {
+		priorities[sub.ID] = sub.Priority
+	}
+	return priorities, nil
+}
+
+// authenticate はAuthorizationヘッダーのIDトークンを検証してuser_idを返す
+func (s *TimelineService) authenticate(ctx context.Context, header http.Header) (int64, error) {
+	userID, _, err := s.authenticateWithPlan(ctx, header)
+	return userID, err
+}
+
+// authenticateWithPlan はAuthorizationヘッダーのIDトークンを検証してuser_idとプラン種別を返す
+func (s *TimelineService) authenticateWithPlan(ctx context.Context, header http.Header) (int64, string, error) {
+	authHeader := header.Get("Authorization")
+	if authHeader == "" {
+		log.Printf("❌ Authorization header is missing")
+		return 0, "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	idToken, err := auth.ExtractTokenFromHeader(authHeader)
+	if err != nil {
+		log.Printf("❌ Failed to extract token: %v", err)
+		return 0, "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid authorization header"))
+	}
+
+	token, err := s.firebaseAuth.VerifyIDToken(ctx, idToken)
+	if err != nil {
+		log.Printf("❌ Failed to verify token: %v", err)
+		return 0, "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token"))
+	}
+
+	return auth.GetUserIDFromToken(token), auth.GetPlanTypeFromToken(token), nil
+}
+
+func (s *TimelineService) SearchYouTubeLive(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.SearchYouTubeLiveRequest],
+) (*connect.Response[pixicastv1.SearchYouTubeLiveResponse], error) {
+	log.Printf("SearchYouTubeLive called with query: %s, max_results: %d", req.Msg.Query, req.Msg.MaxResults)
+
+	// デフォルト値の設定
+	maxResults := int64(req.Msg.MaxResults)
+	if maxResults <= 0 {
+		maxResults = 10
+	}
+	if maxResults > 50 {
+		maxResults = 50
+	}
+
+	// YouTube APIでライブ配信を検索
+	streams, err := s.youtube.SearchLiveStreams(ctx, req.Msg.Query, maxResults)
+	if err != nil {
+		log.Printf("Failed to search YouTube live streams: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("YouTube API error"))
+	}
+
+	// レスポンスに変換
+	var responseStreams []*pixicastv1.YouTubeLiveStream
+	for _, stream := range streams {
+		thumbnailUrl := ""
+		if stream.Snippet.Thumbnails != nil && stream.Snippet.Thumbnails.High != nil {
+			thumbnailUrl = stream.Snippet.Thumbnails.High.Url
+		}
+
+		responseStreams = append(responseStreams, &pixicastv1.YouTubeLiveStream{
+			VideoId:      stream.Id.VideoId,
+			Title:        stream.Snippet.Title,
+			ChannelTitle: stream.Snippet.ChannelTitle,
+			Description:  stream.Snippet.Description,
+			ThumbnailUrl: thumbnailUrl,
+			PublishedAt:  stream.Snippet.PublishedAt,
+		})
+	}
+
+	return connect.NewResponse(&pixicastv1.SearchYouTubeLiveResponse{
+		Streams: responseStreams,
+	}), nil
+}
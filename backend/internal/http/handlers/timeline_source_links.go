@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	pixicastv1 "github.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1"
+)
+
+// 同じクリエイターとしてリンクしたソースの一覧を取得
+func (s *TimelineService) ListSourceLinks(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ListSourceLinksRequest],
+) (*connect.Response[pixicastv1.ListSourceLinksResponse], error) {
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListSourceLinks(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list source links: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	links := make([]*pixicastv1.SourceLink, 0, len(rows))
+	for _, row := range rows {
+		links = append(links, &pixicastv1.SourceLink{
+			SourceId:          row.SourceID.String(),
+			SourcePlatformId:  row.SourcePlatformID,
+			SourceDisplayName: row.SourceDisplayName.String,
+			LinkedSourceId:    row.LinkedSourceID.String(),
+			LinkedPlatformId:  row.LinkedPlatformID,
+			LinkedDisplayName: row.LinkedDisplayName.String,
+			CreatedAt:         row.CreatedAt.Time.Format(time.RFC3339),
+		})
+	}
+
+	return connect.NewResponse(&pixicastv1.ListSourceLinksResponse{
+		Links: links,
+	}), nil
+}
+
+// 2つのソースを同じクリエイターとしてリンク
+func (s *TimelineService) LinkSources(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.LinkSourcesRequest],
+) (*connect.Response[pixicastv1.LinkSourcesResponse], error) {
+	sourceID, linkedSourceID, err := parseSourceLink(req.Msg.SourceId, req.Msg.LinkedSourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	linked, err := s.queries.LinkSources(ctx, db.LinkSourcesParams{
+		UserID:         userID,
+		SourceID:       sourceID,
+		LinkedSourceID: linkedSourceID,
+	})
+	if err != nil {
+		log.Printf("Failed to link sources: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	if linked == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("both sources must be subscribed"))
+	}
+	log.Printf("✅ LinkSources: user_id=%d, %s <-> %s", userID, req.Msg.SourceId, req.Msg.LinkedSourceId)
+
+	return connect.NewResponse(&pixicastv1.LinkSourcesResponse{}), nil
+}
+
+// ソースのリンクを解除
+func (s *TimelineService) UnlinkSources(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.UnlinkSourcesRequest],
+) (*connect.Response[pixicastv1.UnlinkSourcesResponse], error) {
+	sourceID, linkedSourceID, err := parseSourceLink(req.Msg.SourceId, req.Msg.LinkedSourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	unlinked, err := s.queries.UnlinkSources(ctx, db.UnlinkSourcesParams{
+		UserID:         userID,
+		SourceID:       sourceID,
+		LinkedSourceID: linkedSourceID,
+	})
+	if err != nil {
+		log.Printf("Failed to unlink sources: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	if unlinked == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("source link not found"))
+	}
+	log.Printf("✅ UnlinkSources: user_id=%d, %s <-> %s", userID, req.Msg.SourceId, req.Msg.LinkedSourceId)
+
+	return connect.NewResponse(&pixicastv1.UnlinkSourcesResponse{}), nil
+}
+
+// parseSourceLink はリンクする2つのソースIDを検証してUUIDに変換
+func parseSourceLink(sourceID, linkedSourceID string) (pgtype.UUID, pgtype.UUID, error) {
+	var a, b pgtype.UUID
+	if err := a.Scan(sourceID); err != nil {
+		return a, b, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid source_id: %q", sourceID))
+	}
+	if err := b.Scan(linkedSourceID); err != nil {
+		return a, b, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid linked_source_id: %q", linkedSourceID))
+	}
+	if a == b {
+		return a, b, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cannot link a source to itself"))
+	}
+	return a, b, nil
+}
@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	pixicastv1 "github.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1"
+	"github.com/kinchoKayaba/pixicast/backend/internal/dberr"
+	"github.com/kinchoKayaba/pixicast/backend/internal/view"
+)
+
+// ユーザーごとのビューの最大数とビュー名の最大文字数
+const (
+	maxTimelineViews    = 50
+	maxTimelineViewName = 50
+)
+
+// 保存したタイムラインのビューの一覧を取得
+func (s *TimelineService) ListTimelineViews(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ListTimelineViewsRequest],
+) (*connect.Response[pixicastv1.ListTimelineViewsResponse], error) {
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListTimelineViews(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list timeline views: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	views := make([]*pixicastv1.TimelineView, 0, len(rows))
+	for _, row := range rows {
+		views = append(views, timelineViewToProto(row))
+	}
+
+	return connect.NewResponse(&pixicastv1.ListTimelineViewsResponse{
+		Views: views,
+	}), nil
+}
+
+// タイムラインのビューを保存
+func (s *TimelineService) CreateTimelineView(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.CreateTimelineViewRequest],
+) (*connect.Response[pixicastv1.CreateTimelineViewResponse], error) {
+	name, err := validateTimelineView(req.Msg.Name, req.Msg.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.queries.CountTimelineViews(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to count timeline views: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	if count >= maxTimelineViews {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("too many views: max %d", maxTimelineViews))
+	}
+
+	row, err := s.queries.CreateTimelineView(ctx, db.CreateTimelineViewParams{
+		UserID:     userID,
+		Name:       name,
+		Expression: req.Msg.Expression,
+	})
+	if dberr.IsUniqueViolation(err) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("view %q already exists", name))
+	}
+	if err != nil {
+		log.Printf("Failed to create timeline view: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	log.Printf("✅ CreateTimelineView: user_id=%d, name=%s", userID, name)
+
+	return connect.NewResponse(&pixicastv1.CreateTimelineViewResponse{
+		View: timelineViewToProto(row),
+	}), nil
+}
+
+// タイムラインのビューを更新
+func (s *TimelineService) UpdateTimelineView(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.UpdateTimelineViewRequest],
+) (*connect.Response[pixicastv1.UpdateTimelineViewResponse], error) {
+	var viewID pgtype.UUID
+	if err := viewID.Scan(req.Msg.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid id: %q", req.Msg.Id))
+	}
+	name, err := validateTimelineView(req.Msg.Name, req.Msg.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.UpdateTimelineView(ctx, db.UpdateTimelineViewParams{
+		ID:         viewID,
+		UserID:     userID,
+		Name:       name,
+		Expression: req.Msg.Expression,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("view not found: %s", req.Msg.Id))
+	}
+	if dberr.IsUniqueViolation(err) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("view %q already exists", name))
+	}
+	if err != nil {
+		log.Printf("Failed to update timeline view: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	log.Printf("✅ UpdateTimelineView: user_id=%d, id=%s", userID, req.Msg.Id)
+
+	return connect.NewResponse(&pixicastv1.UpdateTimelineViewResponse{
+		View: timelineViewToProto(row),
+	}), nil
+}
+
+// タイムラインのビューを削除
+func (s *TimelineService) DeleteTimelineView(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.DeleteTimelineViewRequest],
+) (*connect.Response[pixicastv1.DeleteTimelineViewResponse], error) {
+	var viewID pgtype.UUID
+	if err := viewID.Scan(req.Msg.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid id: %q", req.Msg.Id))
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.queries.DeleteTimelineView(ctx, db.DeleteTimelineViewParams{
+		ID:     viewID,
+		UserID: userID,
+	})
+	if err != nil {
+		log.Printf("Failed to delete timeline view: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("view not found: %s", req.Msg.Id))
+	}
+	log.Printf("✅ DeleteTimelineView: user_id=%d, id=%s", userID, req.Msg.Id)
+
+	return connect.NewResponse(&pixicastv1.DeleteTimelineViewResponse{}), nil
+}
+
+// validateTimelineView はビューの名前と式を検証し、前後の空白を除いた名前を返す
+func validateTimelineView(name, expression string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	if len([]rune(name)) > maxTimelineViewName {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is too long: max %d characters", maxTimelineViewName))
+	}
+	if _, err := view.Compile(expression); err != nil {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid expression: %w", err))
+	}
+	return name, nil
+}
+
+// timelineViewToProto はDBのビューをgRPCの型に変換
+func timelineViewToProto(row db.TimelineView) *pixicastv1.TimelineView {
+	return &pixicastv1.TimelineView{
+		Id:         row.ID.String(),
+		Name:       row.Name,
+		Expression: row.Expression,
+		CreatedAt:  row.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:  row.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+// timelineViewFilter は保存したビューを読み込み、タイムラインの行が式を満たすかどうかを判定する関数を返す
+func (s *TimelineService) timelineViewFilter(ctx context.Context, userID int64, viewID string) (func(row db.ListTimelineRow) bool, error) {
+	var id pgtype.UUID
+	if err := id.Scan(viewID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid view_id: %q", viewID))
+	}
+
+	v, err := s.queries.GetTimelineView(ctx, db.GetTimelineViewParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("view not found: %s", viewID))
+	}
+	if err != nil {
+		log.Printf("Failed to get timeline view: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	expr, err := view.Compile(v.Expression)
+	if err != nil {
+		// 保存時に検証しているため、式の仕様が変わった場合のみ
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("view %q has an invalid expression: %w", v.Name, err))
+	}
+
+	// source.is_favorite の判定用
+	favorites := make(map[pgtype.UUID]bool)
+	rows, err := s.queries.ListFavoriteSubscriptions(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list favorite subscriptions: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	for _, row := range rows {
+		favorites[row.ID] = true
+	}
+
+	now := time.Now()
+	return func(row db.ListTimelineRow) bool {
+		return expr.Match(viewEventFromRow(row, favorites[row.SourceID], now))
+	}, nil
+}
+
+// viewEventFromRow はタイムラインの行からビューの式の評価に使う情報を取り出す
+func viewEventFromRow(event db.ListTimelineRow, favorite bool, now time.Time) *view.Event {
+	program := programFromRow(event, now)
+	startAt := event.StartAt.Time
+	if !event.StartAt.Valid {
+		startAt = event.PublishedAt.Time
+	}
+	return &view.Event{
+		Platform:    event.PlatformID,
+		Type:        event.Type,
+		Title:       event.Title,
+		Description: event.Description.String,
+		IsLive:      program.IsLive,
+		Watched:     program.Watched,
+		Hidden:      program.Hidden,
+		Duration:    muteEventFromRow(event).Duration,
+		ViewCount:   program.ViewCount,
+		StartAt:     startAt,
+		Source: view.Source{
+			ID:         event.SourceID.String(),
+			Name:       event.SourceDisplayName.String,
+			Handle:     event.SourceHandle.String,
+			IsFavorite: favorite,
+		},
+	}
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	pixicastv1 "github.com/kinchoKayaba/pixicast/backend/gen/pixicast/v1"
+)
+
+// 「あとで見る」のプラン情報が取得できない場合の最大件数
+const defaultMaxWatchLater = 10
+
+// maxWatchLater はプランごとの「あとで見る」の最大件数を返す
+func (s *TimelineService) maxWatchLater(ctx context.Context, planType string) int32 {
+	planLimit, err := s.queries.GetPlanLimit(ctx, planType)
+	if err != nil {
+		log.Printf("❌ Failed to get plan limit for %s: %v", planType, err)
+		return defaultMaxWatchLater
+	}
+	return planLimit.MaxWatchLater
+}
+
+// 「あとで見る」キューを並び順で取得
+func (s *TimelineService) ListWatchLater(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ListWatchLaterRequest],
+) (*connect.Response[pixicastv1.ListWatchLaterResponse], error) {
+	userID, planType, err := s.authenticateWithPlan(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListWatchLater(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list watch later: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	now := time.Now()
+	programs := make([]*pixicastv1.Program, 0, len(rows))
+	for _, row := range rows {
+		programs = append(programs, programFromRow(db.ListTimelineRow(row), now))
+	}
+
+	return connect.NewResponse(&pixicastv1.ListWatchLaterResponse{
+		Programs: programs,
+		MaxItems: s.maxWatchLater(ctx, planType),
+	}), nil
+}
+
+// 「あとで見る」キューの末尾に番組を追加
+func (s *TimelineService) AddWatchLater(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.AddWatchLaterRequest],
+) (*connect.Response[pixicastv1.AddWatchLaterResponse], error) {
+	var eventID pgtype.UUID
+	if err := eventID.Scan(req.Msg.ProgramId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid program_id: %q", req.Msg.ProgramId))
+	}
+
+	userID, planType, err := s.authenticateWithPlan(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	maxItems := s.maxWatchLater(ctx, planType)
+	added, err := s.queries.AddWatchLater(ctx, db.AddWatchLaterParams{
+		UserID:   userID,
+		EventID:  eventID,
+		MaxItems: maxItems,
+	})
+	if err != nil {
+		log.Printf("Failed to add watch later: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	queued, err := s.queries.ListWatchLaterEventIDs(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list watch later event ids: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	if added == 0 {
+		// 追加しなかった理由を判定（追加済み → 上限 → 購読していない・存在しない番組の順）
+		if slices.Contains(queued, eventID) {
+			return connect.NewResponse(&pixicastv1.AddWatchLaterResponse{
+				Added: false,
+				Count: int32(len(queued)),
+			}), nil
+		}
+		if len(queued) >= int(maxItems) {
+			log.Printf("🚫 Watch later limit reached: user_id=%d, planType=%s, max=%d", userID, planType, maxItems)
+			return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("watch later is limited to %d programs on this plan", maxItems))
+		}
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("program not found: %s", req.Msg.ProgramId))
+	}
+	log.Printf("✅ AddWatchLater: user_id=%d, program_id=%s", userID, req.Msg.ProgramId)
+
+	return connect.NewResponse(&pixicastv1.AddWatchLaterResponse{
+		Added: true,
+		Count: int32(len(queued)),
+	}), nil
+}
+
+// 「あとで見る」キューを並び替え
+func (s *TimelineService) ReorderWatchLater(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.ReorderWatchLaterRequest],
+) (*connect.Response[pixicastv1.ReorderWatchLaterResponse], error) {
+	eventIDs, err := parseProgramIDs(req.Msg.ProgramIds)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	queued, err := s.queries.ListWatchLaterEventIDs(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list watch later event ids: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	// キューの全件を重複なく指定していることを確認
+	if len(eventIDs) != len(queued) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("program_ids must contain all %d queued programs", len(queued)))
+	}
+	seen := make(map[pgtype.UUID]bool, len(eventIDs))
+	for i, eventID := range eventIDs {
+		if seen[eventID] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("duplicate program_id: %s", req.Msg.ProgramIds[i]))
+		}
+		if !slices.Contains(queued, eventID) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("program is not in watch later: %s", req.Msg.ProgramIds[i]))
+		}
+		seen[eventID] = true
+	}
+
+	if err := s.queries.ReorderWatchLater(ctx, db.ReorderWatchLaterParams{
+		EventIds: eventIDs,
+		UserID:   userID,
+	}); err != nil {
+		log.Printf("Failed to reorder watch later: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	log.Printf("✅ ReorderWatchLater: user_id=%d, count=%d", userID, len(eventIDs))
+
+	return connect.NewResponse(&pixicastv1.ReorderWatchLaterResponse{}), nil
+}
+
+// 「あとで見る」キューから番組を削除
+func (s *TimelineService) RemoveWatchLater(
+	ctx context.Context,
+	req *connect.Request[pixicastv1.RemoveWatchLaterRequest],
+) (*connect.Response[pixicastv1.RemoveWatchLaterResponse], error) {
+	if len(req.Msg.ProgramIds) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("program_ids is required"))
+	}
+	if len(req.Msg.ProgramIds) > maxEventStateIDs {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("too many program_ids: max %d", maxEventStateIDs))
+	}
+
+	eventIDs, err := parseProgramIDs(req.Msg.ProgramIds)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.queries.RemoveWatchLater(ctx, db.RemoveWatchLaterParams{
+		UserID:   userID,
+		EventIds: eventIDs,
+	})
+	if err != nil {
+		log.Printf("Failed to remove watch later: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	log.Printf("✅ RemoveWatchLater: user_id=%d, removed=%d", userID, removed)
+
+	return connect.NewResponse(&pixicastv1.RemoveWatchLaterResponse{
+		RemovedCount: removed,
+	}), nil
+}
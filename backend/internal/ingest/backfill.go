@@ -0,0 +1,67 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/jobqueue"
+	"github.com/kinchoKayaba/pixicast/backend/internal/podcast"
+	"github.com/kinchoKayaba/pixicast/backend/internal/radiko"
+	"github.com/kinchoKayaba/pixicast/backend/internal/twitch"
+	"github.com/kinchoKayaba/pixicast/backend/internal/youtube"
+)
+
+// Backfill は購読時の過去分の取り込みジョブ（jobqueue.JobBackfill）を実行する
+type Backfill struct {
+	refresher *Refresher
+	budget    *youtube.BudgetPlanner
+}
+
+// NewBackfill は過去分の取り込みジョブのハンドラを作成
+// YouTubeはbudgetの過去分の取り込みの枠を使い切ったら、Quotaがリセットされるまで見送る
+func NewBackfill(queries *db.Queries, youtubeClient *youtube.Client, twitchClient *twitch.Client, podcastClient *podcast.Client, radikoClient *radiko.Client, budget *youtube.BudgetPlanner) *Backfill {
+	return &Backfill{
+		refresher: NewRefresher(queries, youtubeClient, twitchClient, podcastClient, radikoClient),
+		budget:    budget,
+	}
+}
+
+// Run はソースのプラットフォームに応じて指定日時以降のコンテンツを取り込む
+func (b *Backfill) Run(ctx context.Context, job jobqueue.Job) error {
+	var payload jobqueue.BackfillPayload
+	if err := job.DecodePayload(&payload); err != nil {
+		return jobqueue.Permanent(err)
+	}
+	since := ""
+	if !payload.Since.IsZero() {
+		since = payload.Since.UTC().Format(time.RFC3339)
+	}
+
+	useBudget := job.PlatformID == "youtube" && b.budget != nil
+	if useBudget {
+		if ok, reason := b.budget.Check(youtube.BucketBackfill, youtube.BackfillCost); !ok {
+			return jobqueue.Defer(fmt.Errorf("youtube quota: %s", reason), b.budget.ResetsAt())
+		}
+	}
+
+	// 使用量はYouTubeクライアントが過去分の取り込みの枠に記録する
+	if err := b.refresher.fetch(youtube.WithBucket(ctx, youtube.BucketBackfill), job.SourceID, job.PlatformID, job.ExternalID, since); err != nil {
+		if errors.Is(err, errUnsupportedPlatform) {
+			return jobqueue.Permanent(err)
+		}
+		if useBudget && errors.Is(err, youtube.ErrQuotaExhausted) {
+			return jobqueue.Defer(err, b.budget.ResetsAt())
+		}
+		return err
+	}
+
+	// 取得成功: last_fetched_atを更新（取り込み状況の表示に使う）
+	if err := b.refresher.markFetched(ctx, job.SourceID); err != nil {
+		log.Printf("⚠️ Failed to update last_fetched_at for %s: %v", job.ExternalID, err)
+	}
+	return nil
+}
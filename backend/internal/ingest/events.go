@@ -0,0 +1,76 @@
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/search"
+)
+
+// イベント変更の種類（event_changes.change_type）
+const (
+	ChangeInserted = "inserted"
+	ChangeUpdated  = "updated"
+	ChangeRemoved  = "removed"
+)
+
+// saveEvent はイベントをupsertし、タイムラインに影響する変更があれば変更履歴に記録する
+func saveEvent(ctx context.Context, queries *db.Queries, arg db.UpsertEventParams) (db.Event, error) {
+	prev, prevErr := queries.GetEventByExternalID(ctx, db.GetEventByExternalIDParams{
+		PlatformID:      arg.PlatformID,
+		ExternalEventID: arg.ExternalEventID,
+	})
+
+	// SearchTimeline 用の正規化テキスト
+	arg.SearchText = pgtype.Text{String: search.Normalize(arg.Title + "\n" + arg.Description.String), Valid: true}
+
+	event, err := queries.UpsertEvent(ctx, arg)
+	if err != nil {
+		return event, err
+	}
+
+	changeType := ""
+	switch {
+	case errors.Is(prevErr, pgx.ErrNoRows):
+		changeType = ChangeInserted
+	case prevErr != nil:
+		// 変更前の状態が不明な場合は更新として通知する
+		changeType = ChangeUpdated
+	case eventChanged(prev, event):
+		changeType = ChangeUpdated
+	}
+	if changeType == "" {
+		return event, nil
+	}
+
+	if err := queries.InsertEventChange(ctx, db.InsertEventChangeParams{
+		EventID:    event.ID,
+		SourceID:   event.SourceID,
+		ChangeType: changeType,
+	}); err != nil {
+		// 変更履歴の記録失敗はイベント保存自体を失敗にしない
+		log.Printf("⚠️ Failed to record event change %s: %v", event.ExternalEventID, err)
+	}
+	return event, nil
+}
+
+// eventChanged はタイムライン表示に影響する項目（タイトル・配信状態・再生回数）が変わったかを判定
+func eventChanged(prev, next db.Event) bool {
+	return prev.Title != next.Title ||
+		prev.Type != next.Type ||
+		!sameTimestamp(prev.StartAt, next.StartAt) ||
+		!sameTimestamp(prev.EndAt, next.EndAt) ||
+		!bytes.Equal(prev.Metrics, next.Metrics)
+}
+
+func sameTimestamp(a, b pgtype.Timestamptz) bool {
+	if a.Valid != b.Valid {
+		return false
+	}
+	return !a.Valid || a.Time.Equal(b.Time)
+}
@@ -54,7 +54,7 @@ func FetchAndSavePodcastEpisodesSince(
 			episodeURL = applePodcastURL // Apple Podcasts番組ページを優先
 		}
 
-		_, err = queries.UpsertEvent(ctx, db.UpsertEventParams{
+		_, err = saveEvent(ctx, queries, db.UpsertEventParams{
 			PlatformID:      "podcast",
 			SourceID:        sourceID,
 			ExternalEventID: episode.GUID,
@@ -68,6 +68,9 @@ func FetchAndSavePodcastEpisodesSince(
 			ImageUrl:        pgtype.Text{String: episode.ImageURL, Valid: episode.ImageURL != ""},
 			Metrics:         nil,
 			Duration:        pgtype.Text{String: episode.Duration, Valid: episode.Duration != ""},
+			EnclosureUrl:    pgtype.Text{String: episode.EnclosureURL, Valid: episode.EnclosureURL != ""},
+			EnclosureType:   pgtype.Text{String: episode.EnclosureType, Valid: episode.EnclosureType != ""},
+			EnclosureLength: pgtype.Int8{Int64: episode.EnclosureLength, Valid: episode.EnclosureLength > 0},
 		})
 		if err != nil {
 			log.Printf("Failed to upsert episode %s: %v", episode.GUID, err)
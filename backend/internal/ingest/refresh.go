@@ -0,0 +1,116 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/podcast"
+	"github.com/kinchoKayaba/pixicast/backend/internal/radiko"
+	"github.com/kinchoKayaba/pixicast/backend/internal/twitch"
+	"github.com/kinchoKayaba/pixicast/backend/internal/youtube"
+)
+
+// refreshOverlap は前回取得時刻から遡って取り込む時間（取得中に公開されたコンテンツの取りこぼし防止）
+const refreshOverlap = 5 * time.Minute
+
+// RefreshSince は定期更新で取り込むコンテンツの開始日時（RFC3339）
+//   - YouTube: 前回取得時刻以降（初回は過去3ヶ月分）
+//   - Twitch: ライブは常時チェック、VODは前回取得時刻と1週間前の新しい方以降
+//   - Podcast: 前回取得時刻と1週間前の新しい方以降（初回は過去3ヶ月分）
+//   - Radiko: 前回取得時刻以降（初回は1週間前から）
+func RefreshSince(platform string, lastFetchedAt pgtype.Timestamptz, now time.Time) string {
+	var since time.Time
+	switch platform {
+	case "twitch":
+		since = now.AddDate(0, 0, -7)
+		if lastFetchedAt.Valid {
+			since = later(since, lastFetchedAt.Time.Add(-refreshOverlap))
+		}
+	case "podcast":
+		since = now.AddDate(0, -3, 0)
+		if lastFetchedAt.Valid {
+			since = later(now.AddDate(0, 0, -7), lastFetchedAt.Time.Add(-refreshOverlap))
+		}
+	case "radiko":
+		since = now.AddDate(0, 0, -7)
+		if lastFetchedAt.Valid {
+			since = lastFetchedAt.Time.Add(-refreshOverlap)
+		}
+	default:
+		since = now.AddDate(0, -3, 0)
+		if lastFetchedAt.Valid {
+			since = lastFetchedAt.Time.Add(-refreshOverlap)
+		}
+	}
+	return since.Format(time.RFC3339)
+}
+
+func later(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// Refresher はソースの定期更新（前回取得時刻以降のコンテンツの取り込み）
+type Refresher struct {
+	queries *db.Queries
+	youtube *youtube.Client
+	twitch  *twitch.Client
+	podcast *podcast.Client
+	radiko  *radiko.Client
+}
+
+// NewRefresher は定期更新を作成
+func NewRefresher(queries *db.Queries, youtubeClient *youtube.Client, twitchClient *twitch.Client, podcastClient *podcast.Client, radikoClient *radiko.Client) *Refresher {
+	return &Refresher{
+		queries: queries,
+		youtube: youtubeClient,
+		twitch:  twitchClient,
+		podcast: podcastClient,
+		radiko:  radikoClient,
+	}
+}
+
+// Refresh はソースの前回取得時刻以降のコンテンツを取り込み、成功したらlast_fetched_atを更新
+func (r *Refresher) Refresh(ctx context.Context, sourceID pgtype.UUID, platform, externalID string, lastFetchedAt pgtype.Timestamptz) error {
+	since := RefreshSince(platform, lastFetchedAt, time.Now())
+	if err := r.fetch(ctx, sourceID, platform, externalID, since); err != nil {
+		return err
+	}
+	return r.markFetched(ctx, sourceID)
+}
+
+// errUnsupportedPlatform は取り込みに対応していないプラットフォーム
+var errUnsupportedPlatform = errors.New("unsupported platform")
+
+// fetch はプラットフォームに応じて指定日時以降のコンテンツを取り込む
+func (r *Refresher) fetch(ctx context.Context, sourceID pgtype.UUID, platform, externalID, since string) error {
+	switch platform {
+	case "youtube":
+		return FetchAndSaveChannelVideosSince(ctx, r.queries, r.youtube, sourceID, externalID, 0, since)
+	case "twitch":
+		return FetchAndSaveTwitchVideosSince(ctx, r.queries, r.twitch, sourceID, externalID, since)
+	case "podcast":
+		return FetchAndSavePodcastEpisodesSince(ctx, r.queries, r.podcast, sourceID, externalID, since)
+	case "radiko":
+		return FetchAndSaveRadikoPrograms(ctx, r.queries, r.radiko, sourceID, externalID, since)
+	default:
+		return fmt.Errorf("%w: %s", errUnsupportedPlatform, platform)
+	}
+}
+
+// markFetched は取得成功としてlast_fetched_atを更新
+func (r *Refresher) markFetched(ctx context.Context, sourceID pgtype.UUID) error {
+	if _, err := r.queries.UpdateSourceFetchStatus(ctx, db.UpdateSourceFetchStatusParams{
+		ID:          sourceID,
+		FetchStatus: "ok",
+	}); err != nil {
+		return fmt.Errorf("failed to update last_fetched_at: %w", err)
+	}
+	return nil
+}
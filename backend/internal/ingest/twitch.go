@@ -2,6 +2,7 @@ package ingest
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -32,6 +33,7 @@ func FetchAndSaveTwitchVideosSince(
 	// 1. まず現在配信中のライブストリームを取得
 	var liveStreamStartTimes []time.Time // ライブストリームの開始時刻リスト
 	var currentLiveStreamIDs []string     // 現在配信中のstream IDリスト
+	liveGameNames := make(map[string]string) // stream IDごとの配信カテゴリ（VODのカテゴリに使う）
 	streams, err := twitchClient.GetStreams(ctx, userID)
 	if err != nil {
 		log.Printf("⚠️ Failed to get live streams (non-fatal): %v", err)
@@ -45,9 +47,14 @@ func FetchAndSaveTwitchVideosSince(
 			// ライブ配信のURL
 			liveURL := fmt.Sprintf("https://www.twitch.tv/%s", stream.UserLogin)
 
-			metrics := []byte(fmt.Sprintf(`{"viewers": %d}`, stream.ViewerCount))
+			// 配信カテゴリ（ゲーム名）はミュートルールの判定に使う
+			metrics, _ := json.Marshal(map[string]interface{}{
+				"viewers":   stream.ViewerCount,
+				"game_name": stream.GameName,
+			})
+			liveGameNames[stream.ID] = stream.GameName
 
-			_, err = queries.UpsertEvent(ctx, db.UpsertEventParams{
+			_, err = saveEvent(ctx, queries, db.UpsertEventParams{
 				PlatformID:      "twitch",
 				SourceID:        sourceID,
 				ExternalEventID: stream.ID,
@@ -80,6 +87,7 @@ func FetchAndSaveTwitchVideosSince(
 		return fmt.Errorf("failed to get videos: %w", err)
 	}
 
+	var channel *twitch.TwitchChannel // VODのカテゴリが不明な場合のみ取得する
 	for _, video := range videos {
 		if !cutoff.IsZero() && video.CreatedAt.Before(cutoff) {
 			continue
@@ -104,13 +112,21 @@ func FetchAndSaveTwitchVideosSince(
 		// VODは常に"video"タイプとして保存（配信終了後のアーカイブのため）
 		eventType := "video"
 
-		metrics := []byte(fmt.Sprintf(`{"views": %d}`, video.ViewCount))
+		// ライブ配信と同じく配信カテゴリ（ゲーム名）を保存する
+		gameName, ok := liveGameNames[video.StreamID]
+		if !ok {
+			gameName = twitchVideoGameName(ctx, queries, twitchClient, &channel, userID, video)
+		}
+		metrics, _ := json.Marshal(map[string]interface{}{
+			"views":     video.ViewCount,
+			"game_name": gameName,
+		})
 
 		// TwitchのサムネイルURLのプレースホルダーを実際のサイズに置換
 		thumbnailURL := strings.ReplaceAll(video.ThumbnailURL, "%{width}", "640")
 		thumbnailURL = strings.ReplaceAll(thumbnailURL, "%{height}", "360")
 
-		_, err = queries.UpsertEvent(ctx, db.UpsertEventParams{
+		_, err = saveEvent(ctx, queries, db.UpsertEventParams{
 			PlatformID:      "twitch",
 			SourceID:        sourceID,
 			ExternalEventID: video.ID,
@@ -136,3 +152,43 @@ func FetchAndSaveTwitchVideosSince(
 	return nil
 }
 
+// twitchVideoGameName はVODの配信カテゴリ（ゲーム名）を返す
+// Helix の /videos はカテゴリを返さないため、保存済みのVOD・元のライブ配信のカテゴリを使い、
+// どちらもない場合はチャンネルの現在のカテゴリを使う（channel に取得結果をキャッシュする）
+func twitchVideoGameName(
+	ctx context.Context,
+	queries *db.Queries,
+	twitchClient *twitch.Client,
+	channel **twitch.TwitchChannel,
+	userID string,
+	video twitch.TwitchVideo,
+) string {
+	for _, externalID := range []string{video.ID, video.StreamID} {
+		if externalID == "" {
+			continue
+		}
+		event, err := queries.GetEventByExternalID(ctx, db.GetEventByExternalIDParams{
+			PlatformID:      "twitch",
+			ExternalEventID: externalID,
+		})
+		if err != nil {
+			continue
+		}
+		var metrics struct {
+			GameName string `json:"game_name"`
+		}
+		if json.Unmarshal(event.Metrics, &metrics) == nil && metrics.GameName != "" {
+			return metrics.GameName
+		}
+	}
+
+	if *channel == nil {
+		ch, err := twitchClient.GetChannel(ctx, userID)
+		if err != nil {
+			log.Printf("⚠️ Failed to get channel information (non-fatal): %v", err)
+			ch = &twitch.TwitchChannel{}
+		}
+		*channel = ch
+	}
+	return (*channel).GameName
+}
@@ -118,7 +118,7 @@ func FetchAndSaveChannelVideosSince(
 		}
 
 		// DBに保存
-		_, err = queries.UpsertEvent(ctx, db.UpsertEventParams{
+		_, err = saveEvent(ctx, queries, db.UpsertEventParams{
 			PlatformID:      "youtube",
 			SourceID:        sourceID,
 			ExternalEventID: video.Id.VideoId,
@@ -0,0 +1,159 @@
+// Package jobqueue はupdate_scheduleテーブルを使った永続的なジョブキュー
+//
+// ジョブはワーカーがリースを取得して実行する。リースの期限までに完了・延長しないジョブ
+// （ワーカーのクラッシュなど）は他のワーカーが取得し直す。失敗したジョブはバックオフして
+// リトライし、max_attempts回失敗したらfailed（デッドレター）にする。
+package jobqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// ジョブの種類
+const (
+	// JobBackfill は購読時の過去分の取り込み
+	JobBackfill = "backfill"
+)
+
+// ジョブの状態（update_schedule.status）
+const (
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+// ジョブの優先度（update_schedule.priority_level）
+const (
+	PriorityHigh   = "high"
+	PriorityMedium = "medium"
+	PriorityLow    = "low"
+)
+
+// DefaultMaxAttempts はfailedにするまでのデフォルトの最大実行回数
+const DefaultMaxAttempts = 5
+
+// Store はジョブキューが使うクエリ（*db.Queries が実装する）
+type Store interface {
+	CreateUpdateSchedule(ctx context.Context, arg db.CreateUpdateScheduleParams) (db.UpdateSchedule, error)
+	GetActiveScheduleByDedupeKey(ctx context.Context, dedupeKey pgtype.Text) (db.UpdateSchedule, error)
+	ClaimPendingSchedules(ctx context.Context, arg db.ClaimPendingSchedulesParams) ([]db.ClaimPendingSchedulesRow, error)
+	ExtendScheduleLease(ctx context.Context, arg db.ExtendScheduleLeaseParams) (int64, error)
+	UpdateScheduleStatus(ctx context.Context, arg db.UpdateScheduleStatusParams) (int64, error)
+	DeferSchedule(ctx context.Context, arg db.DeferScheduleParams) (int64, error)
+}
+
+// Job はワーカーが実行するジョブ
+type Job struct {
+	ID          pgtype.UUID
+	SourceID    pgtype.UUID
+	Type        string
+	Payload     []byte
+	ExternalID  string // ソースのプラットフォーム上のID（YouTubeのチャンネルIDなど）
+	PlatformID  string
+	Attempts    int32 // 今回の実行を含む実行回数
+	MaxAttempts int32
+}
+
+// DecodePayload はジョブの内容をvにデコード
+func (j Job) DecodePayload(v any) error {
+	if len(j.Payload) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(j.Payload, v); err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+	return nil
+}
+
+// EnqueueParams はジョブの登録内容
+type EnqueueParams struct {
+	SourceID    pgtype.UUID
+	Type        string
+	Payload     any       // JSONにエンコードする（nilの場合は {}）
+	DedupeKey   string    // 空文字の場合は重複を許す
+	Priority    string    // 空文字の場合は medium
+	RunAt       time.Time // ゼロ値の場合はすぐに実行
+	MaxAttempts int32     // 0の場合は DefaultMaxAttempts
+}
+
+// Queue はジョブの登録
+type Queue struct {
+	store Store
+}
+
+// New はジョブキューを作成
+func New(store Store) *Queue {
+	return &Queue{store: store}
+}
+
+// Enqueue はジョブを登録
+// DedupeKeyが同じ実行待ち・実行中のジョブがある場合は新しく作成せず、既存のジョブを返す（created=false）
+func (q *Queue) Enqueue(ctx context.Context, p EnqueueParams) (job db.UpdateSchedule, created bool, err error) {
+	payload := []byte("{}")
+	if p.Payload != nil {
+		payload, err = json.Marshal(p.Payload)
+		if err != nil {
+			return db.UpdateSchedule{}, false, fmt.Errorf("failed to encode payload: %w", err)
+		}
+	}
+	if p.Priority == "" {
+		p.Priority = PriorityMedium
+	}
+	if p.RunAt.IsZero() {
+		p.RunAt = time.Now()
+	}
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultMaxAttempts
+	}
+	dedupeKey := pgtype.Text{String: p.DedupeKey, Valid: p.DedupeKey != ""}
+
+	job, err = q.store.CreateUpdateSchedule(ctx, db.CreateUpdateScheduleParams{
+		SourceID:      p.SourceID,
+		ScheduledAt:   pgtype.Timestamptz{Time: p.RunAt, Valid: true},
+		PriorityLevel: p.Priority,
+		JobType:       p.Type,
+		Payload:       payload,
+		DedupeKey:     dedupeKey,
+		MaxAttempts:   p.MaxAttempts,
+	})
+	if err == nil {
+		return job, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) || !dedupeKey.Valid {
+		return db.UpdateSchedule{}, false, fmt.Errorf("failed to enqueue job: %w", err)
+	}
+
+	// 冪等キーが重複した（ON CONFLICT DO NOTHING で行が返らない）
+	job, err = q.store.GetActiveScheduleByDedupeKey(ctx, dedupeKey)
+	if err != nil {
+		return db.UpdateSchedule{}, false, fmt.Errorf("failed to get existing job: %w", err)
+	}
+	return job, false, nil
+}
+
+// BackfillPayload は過去分の取り込みジョブの内容
+type BackfillPayload struct {
+	Since time.Time `json:"since"` // この日時以降のコンテンツを取り込む
+}
+
+// EnqueueBackfill はソースの過去分の取り込みジョブを登録
+// 同じソースの取り込みが実行待ち・実行中の場合は登録しない
+func (q *Queue) EnqueueBackfill(ctx context.Context, sourceID pgtype.UUID, since time.Time) (created bool, err error) {
+	_, created, err = q.Enqueue(ctx, EnqueueParams{
+		SourceID:  sourceID,
+		Type:      JobBackfill,
+		Payload:   BackfillPayload{Since: since},
+		DedupeKey: JobBackfill + ":" + sourceID.String(),
+		Priority:  PriorityHigh,
+	})
+	return created, err
+}
@@ -0,0 +1,383 @@
+package jobqueue
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	mathrand "math/rand/v2"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/backoff"
+)
+
+// Handler はジョブを実行する
+// エラーを返すとリトライし、Permanent でラップしたエラーはリトライせずにfailedにする
+// Defer でラップしたエラーは実行回数に数えずに指定した日時に再実行する
+type Handler func(ctx context.Context, job Job) error
+
+// permanentError はリトライしても成功しないエラー
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent はリトライしないエラーにラップ
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent はリトライしないエラーかどうか
+func IsPermanent(err error) bool {
+	var pe *permanentError
+	return errors.As(err, &pe)
+}
+
+// deferredError はジョブの失敗ではなく、実行を見送るエラー（Quotaの予算が足りないなど）
+type deferredError struct {
+	err   error
+	until time.Time
+}
+
+func (e *deferredError) Error() string { return e.err.Error() }
+func (e *deferredError) Unwrap() error { return e.err }
+
+// Defer は実行回数に数えずにuntilに再実行するエラーにラップ
+func Defer(err error, until time.Time) error {
+	if err == nil {
+		return nil
+	}
+	return &deferredError{err: err, until: until}
+}
+
+// リトライのバックオフ
+const (
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+// retryDelay はattempts回目の実行が失敗したときの次の実行までの待ち時間
+// 30秒から倍々に増やして1時間で頭打ちにし、jitter（0〜1）で±20%ずらす
+func retryDelay(attempts int32, jitter float64) time.Duration {
+	return backoff.Jitter(backoff.Exponential(int(attempts), retryBaseDelay, retryMaxDelay), jitter)
+}
+
+// WorkerConfig はワーカーの設定
+type WorkerConfig struct {
+	Owner        string        // リースの所有者名（空文字の場合はホスト名とプロセスIDから生成）
+	Lease        time.Duration // リースの期間（実行中は1/3ごとに延長する）
+	PollInterval time.Duration // 実行できるジョブがないときの待ち時間
+	Concurrency  int           // 同時に実行するジョブ数
+}
+
+// Worker はジョブキューからジョブを取得して実行する
+type Worker struct {
+	store    Store
+	cfg      WorkerConfig
+	handlers map[string]Handler
+	now      func() time.Time
+
+	sem     chan struct{}  // 実行中のジョブの枠（Concurrency件）
+	running sync.WaitGroup // 実行中のジョブ
+}
+
+// NewWorker はワーカーを作成
+func NewWorker(store Store, cfg WorkerConfig) *Worker {
+	if cfg.Owner == "" {
+		cfg.Owner = defaultOwner()
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 5 * time.Minute
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 5 * time.Second
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 2
+	}
+	return &Worker{
+		store:    store,
+		cfg:      cfg,
+		handlers: make(map[string]Handler),
+		now:      time.Now,
+		sem:      make(chan struct{}, cfg.Concurrency),
+	}
+}
+
+// defaultOwner はホスト名・プロセスID・乱数からリースの所有者名を生成
+func defaultOwner() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "worker"
+	}
+	b := make([]byte, 4)
+	rand.Read(b)
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(b))
+}
+
+// Handle はジョブの種類ごとのハンドラを登録
+func (w *Worker) Handle(jobType string, h Handler) {
+	w.handlers[jobType] = h
+}
+
+// Run はctxがキャンセルされるまでジョブを実行し続ける
+// 実行中のジョブの枠が空くたびに次のジョブを取得するため、長いジョブが他のジョブの取得を止めない
+// キャンセルされた場合は実行中のジョブを実行待ちに戻してから返る
+func (w *Worker) Run(ctx context.Context) error {
+	log.Printf("✅ Job worker started: owner=%s, concurrency=%d", w.cfg.Owner, w.cfg.Concurrency)
+	defer func() {
+		w.running.Wait()
+		log.Printf("Job worker stopped: owner=%s", w.cfg.Owner)
+	}()
+	for {
+		// 枠が空くまで待つ
+		select {
+		case <-ctx.Done():
+			return nil
+		case w.sem <- struct{}{}:
+		}
+
+		n, err := w.start(ctx, 1+w.acquire(w.cfg.Concurrency-1))
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			log.Printf("❌ Job worker error: %v", err)
+		}
+		if n > 0 && err == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.cfg.PollInterval):
+		}
+	}
+}
+
+// RunOnce は空いている枠の数だけ実行できるジョブを取得し、実行中のジョブがすべて終わるまで待つ
+// 取得したジョブ数を返す
+func (w *Worker) RunOnce(ctx context.Context) (int, error) {
+	n, err := w.start(ctx, w.acquire(w.cfg.Concurrency))
+	w.running.Wait()
+	return n, err
+}
+
+// acquire は空いている枠を最大n件確保し、確保した件数を返す
+func (w *Worker) acquire(n int) int {
+	for i := range n {
+		select {
+		case w.sem <- struct{}{}:
+		default:
+			return i
+		}
+	}
+	return n
+}
+
+// start は確保したslots件の枠の数だけジョブを取得して実行を開始する
+// ジョブの終了時と、ジョブを取得できなかった分の枠はすぐに解放する
+func (w *Worker) start(ctx context.Context, slots int) (int, error) {
+	if slots == 0 {
+		return 0, nil
+	}
+	rows, err := w.store.ClaimPendingSchedules(ctx, db.ClaimPendingSchedulesParams{
+		LeaseOwner:   w.owner(),
+		LeaseSeconds: w.leaseSeconds(),
+		BatchSize:    int32(slots),
+	})
+	for range slots - len(rows) {
+		<-w.sem
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim jobs: %w", err)
+	}
+
+	for _, row := range rows {
+		w.running.Add(1)
+		go func() {
+			defer w.running.Done()
+			defer func() { <-w.sem }()
+			w.process(ctx, newJob(row))
+		}()
+	}
+	return len(rows), nil
+}
+
+func newJob(row db.ClaimPendingSchedulesRow) Job {
+	return Job{
+		ID:          row.ID,
+		SourceID:    row.SourceID,
+		Type:        row.JobType,
+		Payload:     row.Payload,
+		ExternalID:  row.ExternalID,
+		PlatformID:  row.PlatformID,
+		Attempts:    row.Attempts,
+		MaxAttempts: row.MaxAttempts,
+	}
+}
+
+// process はジョブを1件実行して結果を記録
+func (w *Worker) process(ctx context.Context, job Job) {
+	// リースが切れて取得し直されるたびに実行回数が増えるため、実行中のクラッシュを繰り返すジョブもここで止まる
+	if job.Attempts > job.MaxAttempts {
+		w.finish(job, StatusFailed, fmt.Errorf("exceeded %d attempts without finishing", job.MaxAttempts), time.Time{})
+		return
+	}
+	handler, ok := w.handlers[job.Type]
+	if !ok {
+		w.finish(job, StatusFailed, fmt.Errorf("unknown job type: %s", job.Type), time.Time{})
+		return
+	}
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var lost bool
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		lost = w.heartbeat(jobCtx, job)
+		if lost {
+			cancel()
+		}
+	}()
+
+	err := runHandler(jobCtx, handler, job)
+	cancel()
+	<-heartbeatDone
+
+	var deferred *deferredError
+	switch {
+	case lost:
+		// 他のワーカーに取得し直されたため結果は記録しない
+		log.Printf("⚠️ Job %s lost its lease: owner=%s", job.ID.String(), w.cfg.Owner)
+	case err == nil:
+		w.finish(job, StatusCompleted, nil, time.Time{})
+	case ctx.Err() != nil:
+		// シャットダウン: 実行回数に数えずに、すぐに他のワーカーが実行できるよう実行待ちに戻す
+		w.postpone(job, fmt.Errorf("worker stopped: %w", err), w.now())
+	case errors.As(err, &deferred):
+		w.postpone(job, err, deferred.until)
+	case IsPermanent(err) || job.Attempts >= job.MaxAttempts:
+		w.finish(job, StatusFailed, err, time.Time{})
+	default:
+		w.finish(job, StatusPending, err, w.now().Add(retryDelay(job.Attempts, mathrand.Float64())))
+	}
+}
+
+// runHandler はハンドラのpanicをエラーとして扱う
+func runHandler(ctx context.Context, handler Handler, job Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// heartbeat はジョブの実行中にリースを延長し続ける
+// リースを失った（他のワーカーに取得された）場合はtrueを返す
+func (w *Worker) heartbeat(ctx context.Context, job Job) bool {
+	ticker := time.NewTicker(w.cfg.Lease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+		n, err := w.store.ExtendScheduleLease(ctx, db.ExtendScheduleLeaseParams{
+			LeaseSeconds: w.leaseSeconds(),
+			ID:           job.ID,
+			LeaseOwner:   w.owner(),
+		})
+		if err != nil {
+			// 一時的なDBエラーは次の延長で回復を試みる
+			log.Printf("⚠️ Failed to extend lease of job %s: %v", job.ID.String(), err)
+			continue
+		}
+		if n == 0 {
+			return true
+		}
+	}
+}
+
+// finish はジョブの結果を記録してリースを解放
+// retryAtを指定した場合はその日時に再実行する
+func (w *Worker) finish(job Job, status string, jobErr error, retryAt time.Time) {
+	// シャットダウン中も記録できるよう、ワーカーのctxとは別のctxを使う
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	arg := db.UpdateScheduleStatusParams{
+		Status:     status,
+		ID:         job.ID,
+		LeaseOwner: w.owner(),
+	}
+	if jobErr != nil {
+		arg.ErrorMessage = pgtype.Text{String: jobErr.Error(), Valid: true}
+	}
+	if !retryAt.IsZero() {
+		arg.RetryAt = pgtype.Timestamptz{Time: retryAt, Valid: true}
+	}
+	n, err := w.store.UpdateScheduleStatus(ctx, arg)
+	if err != nil {
+		log.Printf("❌ Failed to update job %s to %s: %v", job.ID.String(), status, err)
+		return
+	}
+	if n == 0 {
+		log.Printf("⚠️ Job %s lost its lease before finishing: owner=%s", job.ID.String(), w.cfg.Owner)
+		return
+	}
+
+	switch status {
+	case StatusCompleted:
+		log.Printf("✅ Job %s (%s) completed: source=%s", job.ID.String(), job.Type, job.ExternalID)
+	case StatusFailed:
+		log.Printf("❌ Job %s (%s) failed after %d attempts: %v", job.ID.String(), job.Type, job.Attempts, jobErr)
+	default:
+		log.Printf("⚠️ Job %s (%s) will retry at %s (attempt %d/%d): %v",
+			job.ID.String(), job.Type, retryAt.Format(time.RFC3339), job.Attempts, job.MaxAttempts, jobErr)
+	}
+}
+
+// postpone はジョブを実行回数に数えずに実行待ちに戻し、untilに再実行する
+func (w *Worker) postpone(job Job, jobErr error, until time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	n, err := w.store.DeferSchedule(ctx, db.DeferScheduleParams{
+		ErrorMessage: pgtype.Text{String: jobErr.Error(), Valid: true},
+		RetryAt:      pgtype.Timestamptz{Time: until, Valid: true},
+		ID:           job.ID,
+		LeaseOwner:   w.owner(),
+	})
+	if err != nil {
+		log.Printf("❌ Failed to defer job %s: %v", job.ID.String(), err)
+		return
+	}
+	if n == 0 {
+		log.Printf("⚠️ Job %s lost its lease before deferring: owner=%s", job.ID.String(), w.cfg.Owner)
+		return
+	}
+	log.Printf("⚠️ Job %s (%s) deferred until %s: %v", job.ID.String(), job.Type, until.Format(time.RFC3339), jobErr)
+}
+
+func (w *Worker) owner() pgtype.Text {
+	return pgtype.Text{String: w.cfg.Owner, Valid: true}
+}
+
+func (w *Worker) leaseSeconds() int32 {
+	return int32(w.cfg.Lease / time.Second)
+}
@@ -0,0 +1,414 @@
+package jobqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// fakeStore はupdate_scheduleのインメモリ実装
+type fakeStore struct {
+	mu     sync.Mutex
+	jobs   []db.UpdateSchedule
+	nextID byte
+	// extendRows はExtendScheduleLeaseが返す行数（-1の場合は実際のリースを確認する）
+	extendRows int64
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{extendRows: -1}
+}
+
+func (s *fakeStore) CreateUpdateSchedule(ctx context.Context, arg db.CreateUpdateScheduleParams) (db.UpdateSchedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, j := range s.jobs {
+		if arg.DedupeKey.Valid && j.DedupeKey == arg.DedupeKey && (j.Status == StatusPending || j.Status == StatusRunning) {
+			return db.UpdateSchedule{}, pgx.ErrNoRows
+		}
+	}
+	s.nextID++
+	job := db.UpdateSchedule{
+		ID:            pgtype.UUID{Bytes: [16]byte{s.nextID}, Valid: true},
+		SourceID:      arg.SourceID,
+		ScheduledAt:   arg.ScheduledAt,
+		PriorityLevel: arg.PriorityLevel,
+		Status:        StatusPending,
+		JobType:       arg.JobType,
+		Payload:       arg.Payload,
+		DedupeKey:     arg.DedupeKey,
+		MaxAttempts:   arg.MaxAttempts,
+	}
+	s.jobs = append(s.jobs, job)
+	return job, nil
+}
+
+func (s *fakeStore) GetActiveScheduleByDedupeKey(ctx context.Context, dedupeKey pgtype.Text) (db.UpdateSchedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, j := range s.jobs {
+		if j.DedupeKey == dedupeKey && (j.Status == StatusPending || j.Status == StatusRunning) {
+			return j, nil
+		}
+	}
+	return db.UpdateSchedule{}, pgx.ErrNoRows
+}
+
+func (s *fakeStore) ClaimPendingSchedules(ctx context.Context, arg db.ClaimPendingSchedulesParams) ([]db.ClaimPendingSchedulesRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	var rows []db.ClaimPendingSchedulesRow
+	for i := range s.jobs {
+		j := &s.jobs[i]
+		ready := j.Status == StatusPending && !j.ScheduledAt.Time.After(now)
+		expired := j.Status == StatusRunning && j.LeaseExpiresAt.Time.Before(now)
+		if !ready && !expired || len(rows) >= int(arg.BatchSize) {
+			continue
+		}
+		j.Status = StatusRunning
+		j.LeaseOwner = arg.LeaseOwner
+		j.LeaseExpiresAt = pgtype.Timestamptz{Time: now.Add(time.Duration(arg.LeaseSeconds) * time.Second), Valid: true}
+		j.Attempts++
+		rows = append(rows, db.ClaimPendingSchedulesRow{
+			ID:          j.ID,
+			SourceID:    j.SourceID,
+			Status:      j.Status,
+			JobType:     j.JobType,
+			Payload:     j.Payload,
+			Attempts:    j.Attempts,
+			MaxAttempts: j.MaxAttempts,
+			ExternalID:  "UCtest",
+			PlatformID:  "youtube",
+		})
+	}
+	return rows, nil
+}
+
+func (s *fakeStore) ExtendScheduleLease(ctx context.Context, arg db.ExtendScheduleLeaseParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.extendRows >= 0 {
+		return s.extendRows, nil
+	}
+	for i := range s.jobs {
+		if j := &s.jobs[i]; j.ID == arg.ID && j.Status == StatusRunning && j.LeaseOwner == arg.LeaseOwner {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (s *fakeStore) UpdateScheduleStatus(ctx context.Context, arg db.UpdateScheduleStatusParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.jobs {
+		j := &s.jobs[i]
+		if j.ID != arg.ID || (arg.LeaseOwner.Valid && j.LeaseOwner != arg.LeaseOwner) {
+			continue
+		}
+		j.Status = arg.Status
+		j.ErrorMessage = arg.ErrorMessage
+		if arg.RetryAt.Valid {
+			j.ScheduledAt = arg.RetryAt
+		}
+		j.LeaseOwner = pgtype.Text{}
+		j.LeaseExpiresAt = pgtype.Timestamptz{}
+		return 1, nil
+	}
+	return 0, nil
+}
+
+func (s *fakeStore) DeferSchedule(ctx context.Context, arg db.DeferScheduleParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.jobs {
+		j := &s.jobs[i]
+		if j.ID != arg.ID || j.LeaseOwner != arg.LeaseOwner {
+			continue
+		}
+		j.Status = StatusPending
+		j.Attempts = max(j.Attempts-1, 0)
+		j.ErrorMessage = arg.ErrorMessage
+		j.ScheduledAt = arg.RetryAt
+		j.LeaseOwner = pgtype.Text{}
+		j.LeaseExpiresAt = pgtype.Timestamptz{}
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// job はIDでジョブを取得
+func (s *fakeStore) job(id pgtype.UUID) db.UpdateSchedule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, j := range s.jobs {
+		if j.ID == id {
+			return j
+		}
+	}
+	return db.UpdateSchedule{}
+}
+
+var testSourceID = pgtype.UUID{Bytes: [16]byte{0xaa}, Valid: true}
+
+// TestEnqueue は冪等キーによる重複登録の防止のテスト
+func TestEnqueue(t *testing.T) {
+	store := newFakeStore()
+	q := New(store)
+	ctx := context.Background()
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	created, err := q.EnqueueBackfill(ctx, testSourceID, since)
+	if err != nil || !created {
+		t.Fatalf("EnqueueBackfill() = %v, %v, want true, nil", created, err)
+	}
+	created, err = q.EnqueueBackfill(ctx, testSourceID, since)
+	if err != nil || created {
+		t.Fatalf("EnqueueBackfill() duplicate = %v, %v, want false, nil", created, err)
+	}
+	if len(store.jobs) != 1 {
+		t.Fatalf("jobs = %d, want 1", len(store.jobs))
+	}
+
+	job := store.jobs[0]
+	if job.JobType != JobBackfill || job.PriorityLevel != PriorityHigh || job.MaxAttempts != DefaultMaxAttempts {
+		t.Errorf("job = %+v", job)
+	}
+	var payload BackfillPayload
+	if err := (Job{Payload: job.Payload}).DecodePayload(&payload); err != nil || !payload.Since.Equal(since) {
+		t.Errorf("payload = %+v, %v, want since %v", payload, err, since)
+	}
+
+	// 完了したジョブと同じキーは再び登録できる
+	store.jobs[0].Status = StatusCompleted
+	created, err = q.EnqueueBackfill(ctx, testSourceID, since)
+	if err != nil || !created {
+		t.Fatalf("EnqueueBackfill() after completed = %v, %v, want true, nil", created, err)
+	}
+}
+
+// TestWorkerRunOnce はジョブの実行結果ごとの状態遷移のテスト
+func TestWorkerRunOnce(t *testing.T) {
+	errTemporary := errors.New("temporary")
+
+	tests := []struct {
+		name        string
+		jobType     string
+		attempts    int32 // 実行前の実行回数
+		handlerErr  error
+		wantStatus  string
+		wantRetry   bool
+		wantMessage string
+	}{
+		{name: "success", jobType: JobBackfill, wantStatus: StatusCompleted},
+		{name: "temporary error retries", jobType: JobBackfill, handlerErr: errTemporary, wantStatus: StatusPending, wantRetry: true, wantMessage: "temporary"},
+		{name: "last attempt dead letters", jobType: JobBackfill, attempts: 2, handlerErr: errTemporary, wantStatus: StatusFailed, wantMessage: "temporary"},
+		{name: "permanent error dead letters", jobType: JobBackfill, handlerErr: Permanent(errTemporary), wantStatus: StatusFailed, wantMessage: "temporary"},
+		{name: "unknown job type dead letters", jobType: "unknown", wantStatus: StatusFailed, wantMessage: "unknown job type: unknown"},
+		{name: "crash loop dead letters", jobType: JobBackfill, attempts: 3, wantStatus: StatusFailed, wantMessage: "exceeded 3 attempts without finishing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeStore()
+			job, _, err := New(store).Enqueue(context.Background(), EnqueueParams{SourceID: testSourceID, Type: tt.jobType, MaxAttempts: 3})
+			if err != nil {
+				t.Fatalf("Enqueue() error = %v", err)
+			}
+			store.jobs[0].Attempts = tt.attempts
+
+			w := NewWorker(store, WorkerConfig{Owner: "test"})
+			var called int
+			w.Handle(JobBackfill, func(ctx context.Context, job Job) error {
+				called++
+				if job.ExternalID != "UCtest" || job.Attempts != tt.attempts+1 {
+					t.Errorf("job = %+v", job)
+				}
+				return tt.handlerErr
+			})
+			before := time.Now()
+			if n, err := w.RunOnce(context.Background()); n != 1 || err != nil {
+				t.Fatalf("RunOnce() = %d, %v, want 1, nil", n, err)
+			}
+
+			got := store.job(job.ID)
+			if got.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got.Status, tt.wantStatus)
+			}
+			if got.ErrorMessage.String != tt.wantMessage {
+				t.Errorf("error_message = %q, want %q", got.ErrorMessage.String, tt.wantMessage)
+			}
+			if got.LeaseOwner.Valid {
+				t.Errorf("lease was not released: %+v", got.LeaseOwner)
+			}
+			if retried := got.ScheduledAt.Time.After(before.Add(20 * time.Second)); retried != tt.wantRetry {
+				t.Errorf("scheduled_at = %v, want retry %v", got.ScheduledAt.Time, tt.wantRetry)
+			}
+			if n, _ := w.RunOnce(context.Background()); n != 0 {
+				t.Errorf("RunOnce() claimed %d jobs again", n)
+			}
+		})
+	}
+}
+
+// TestWorkerDefer は実行を見送ったジョブを実行回数に数えずに再実行することのテスト
+func TestWorkerDefer(t *testing.T) {
+	store := newFakeStore()
+	job, _, _ := New(store).Enqueue(context.Background(), EnqueueParams{SourceID: testSourceID, Type: JobBackfill, MaxAttempts: 3})
+	store.jobs[0].Attempts = 2
+	until := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	w := NewWorker(store, WorkerConfig{Owner: "test"})
+	w.Handle(JobBackfill, func(ctx context.Context, job Job) error {
+		return Defer(errors.New("quota budget exhausted"), until)
+	})
+	if n, err := w.RunOnce(context.Background()); n != 1 || err != nil {
+		t.Fatalf("RunOnce() = %d, %v, want 1, nil", n, err)
+	}
+
+	// 最後の実行でもfailedにせず、実行回数は実行前のまま
+	got := store.job(job.ID)
+	if got.Status != StatusPending || got.Attempts != 2 {
+		t.Errorf("status = %q, attempts = %d, want pending, 2", got.Status, got.Attempts)
+	}
+	if !got.ScheduledAt.Time.Equal(until) {
+		t.Errorf("scheduled_at = %v, want %v", got.ScheduledAt.Time, until)
+	}
+	if got.ErrorMessage.String != "quota budget exhausted" || got.LeaseOwner.Valid {
+		t.Errorf("job = %+v", got)
+	}
+}
+
+// TestWorkerLostLease はリースを失ったジョブのキャンセルのテスト
+func TestWorkerLostLease(t *testing.T) {
+	store := newFakeStore()
+	job, _, _ := New(store).Enqueue(context.Background(), EnqueueParams{SourceID: testSourceID, Type: JobBackfill})
+	store.extendRows = 0
+
+	w := NewWorker(store, WorkerConfig{Owner: "test", Lease: 30 * time.Millisecond})
+	w.Handle(JobBackfill, func(ctx context.Context, job Job) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+			t.Error("job was not cancelled")
+			return nil
+		}
+	})
+	if _, err := w.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+
+	// 結果は記録せず、リースは他のワーカーが持っている状態のまま
+	if got := store.job(job.ID); got.Status != StatusRunning || got.Attempts != 1 {
+		t.Errorf("job = %+v, want running", got)
+	}
+}
+
+// TestWorkerShutdown は停止時に実行中のジョブを実行回数に数えずに実行待ちに戻すテスト
+func TestWorkerShutdown(t *testing.T) {
+	store := newFakeStore()
+	job, _, _ := New(store).Enqueue(context.Background(), EnqueueParams{SourceID: testSourceID, Type: JobBackfill, MaxAttempts: 3})
+	store.jobs[0].Attempts = 2
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := NewWorker(store, WorkerConfig{Owner: "test", PollInterval: time.Millisecond})
+	w.Handle(JobBackfill, func(ctx context.Context, job Job) error {
+		cancel()
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err := w.Run(ctx); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	// 再起動を繰り返してもfailedにならないよう、実行回数は実行前のまま
+	got := store.job(job.ID)
+	if got.Status != StatusPending || got.ScheduledAt.Time.After(time.Now()) || got.LeaseOwner.Valid {
+		t.Errorf("job = %+v, want pending and runnable", got)
+	}
+	if got.Attempts != 2 {
+		t.Errorf("attempts = %d, want 2", got.Attempts)
+	}
+}
+
+// TestWorkerRunSemaphore は長いジョブの実行中も空いた枠で次のジョブを取得して実行するテスト
+func TestWorkerRunSemaphore(t *testing.T) {
+	store := newFakeStore()
+	q := New(store)
+	slow, _, _ := q.Enqueue(context.Background(), EnqueueParams{SourceID: testSourceID, Type: JobBackfill})
+	for range 3 {
+		q.Enqueue(context.Background(), EnqueueParams{SourceID: testSourceID, Type: JobBackfill})
+	}
+
+	release := make(chan struct{})
+	done := make(chan pgtype.UUID, 4)
+	w := NewWorker(store, WorkerConfig{Owner: "test", PollInterval: time.Millisecond, Concurrency: 2})
+	w.Handle(JobBackfill, func(ctx context.Context, job Job) error {
+		if job.ID == slow.ID {
+			<-release
+		}
+		done <- job.ID
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		w.Run(ctx)
+	}()
+
+	// 1つの枠が長いジョブでふさがっていても、残りの3件はもう1つの枠で順に実行される
+	for range 3 {
+		select {
+		case id := <-done:
+			if id == slow.ID {
+				t.Fatal("slow job finished before release")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("jobs were blocked by the slow job")
+		}
+	}
+	close(release)
+	if id := <-done; id != slow.ID {
+		t.Errorf("last job = %v, want the slow job", id)
+	}
+	cancel()
+	<-stopped
+
+	for i := range store.jobs {
+		if got := store.job(store.jobs[i].ID); got.Status != StatusCompleted {
+			t.Errorf("job %d = %+v, want completed", i, got)
+		}
+	}
+}
+
+// TestRetryDelay はリトライのバックオフのテスト
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		jitter   float64
+		want     time.Duration
+	}{
+		{attempts: 1, jitter: 0.5, want: 30 * time.Second},
+		{attempts: 2, jitter: 0.5, want: time.Minute},
+		{attempts: 4, jitter: 0.5, want: 4 * time.Minute},
+		{attempts: 4, jitter: 0, want: 192 * time.Second},
+		{attempts: 4, jitter: 1, want: 288 * time.Second},
+		{attempts: 20, jitter: 0.5, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempts, tt.jitter); got != tt.want {
+			t.Errorf("retryDelay(%d, %v) = %v, want %v", tt.attempts, tt.jitter, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package mute
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/internal/search"
+	"github.com/kinchoKayaba/pixicast/backend/internal/timeline"
+)
+
+// ミュートルールの種類（mute_rules.kind）
+const (
+	KindKeyword     = "keyword"      // タイトル・説明文にキーワードを含む
+	KindRegex       = "regex"        // タイトル・説明文が正規表現にマッチする
+	KindGameName    = "game_name"    // Twitchの配信カテゴリ（ゲーム名）が一致する
+	KindMinDuration = "min_duration" // 長さが指定秒数より短い
+	KindMaxDuration = "max_duration" // 長さが指定秒数より長い
+	KindEventType   = "event_type"   // イベント種別が一致する
+)
+
+// Kinds はミュートルールの種類の一覧
+var Kinds = []string{KindKeyword, KindRegex, KindGameName, KindMinDuration, KindMaxDuration, KindEventType}
+
+// キーワード・正規表現の対象（mute_rules.field）
+const (
+	FieldAll         = "all"         // タイトルと説明文
+	FieldTitle       = "title"       // タイトルのみ
+	FieldDescription = "description" // 説明文のみ
+)
+
+// Fields はキーワード・正規表現の対象の一覧
+var Fields = []string{FieldAll, FieldTitle, FieldDescription}
+
+// MaxPatternLength はキーワード・正規表現の最大文字数
+const MaxPatternLength = 200
+
+// Rule はミュートルール
+type Rule struct {
+	Kind     string
+	Pattern  string        // キーワード・正規表現・ゲーム名・イベント種別
+	Field    string        // キーワード・正規表現の対象（空の場合はタイトルと説明文）
+	Duration time.Duration // min_duration・max_duration の長さ
+	SourceID pgtype.UUID   // 対象のソース（Validでない場合はすべてのソース）
+}
+
+// Event はミュートの判定に使うイベントの情報
+type Event struct {
+	SourceID    pgtype.UUID
+	Type        string
+	Title       string
+	Description string
+	GameName    string
+	Duration    time.Duration // 長さが不明な場合は0
+}
+
+// Validate はルールの内容を検証する
+func Validate(rule Rule) error {
+	_, err := compile(rule)
+	return err
+}
+
+// Evaluator はミュートルールをまとめて判定する
+type Evaluator struct {
+	rules []compiledRule
+}
+
+// NewEvaluator はルールを検証・コンパイルして Evaluator を作成
+func NewEvaluator(rules []Rule) (*Evaluator, error) {
+	e := &Evaluator{rules: make([]compiledRule, 0, len(rules))}
+	for i, rule := range rules {
+		c, err := compile(rule)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		e.rules = append(e.rules, c)
+	}
+	return e, nil
+}
+
+// Empty はルールが1件もないかどうかを返す
+func (e *Evaluator) Empty() bool {
+	return e == nil || len(e.rules) == 0
+}
+
+// Muted はイベントがいずれかのルールにマッチするかどうかを返す
+func (e *Evaluator) Muted(event Event) bool {
+	if e == nil {
+		return false
+	}
+	for _, rule := range e.rules {
+		if rule.matches(event) {
+			return true
+		}
+	}
+	return false
+}
+
+// compiledRule は判定用に前処理したルール
+type compiledRule struct {
+	Rule
+	keyword string // 正規化したキーワード
+	re      *regexp.Regexp
+}
+
+// compile はルールを検証して判定用に前処理する
+func compile(rule Rule) (compiledRule, error) {
+	c := compiledRule{Rule: rule}
+	if c.Field == "" {
+		c.Field = FieldAll
+	}
+	if !slices.Contains(Fields, c.Field) {
+		return c, fmt.Errorf("invalid field: %q", rule.Field)
+	}
+	if len([]rune(rule.Pattern)) > MaxPatternLength {
+		return c, fmt.Errorf("pattern is too long: max %d characters", MaxPatternLength)
+	}
+
+	switch rule.Kind {
+	case KindKeyword:
+		c.keyword = search.Normalize(strings.TrimSpace(rule.Pattern))
+		if c.keyword == "" {
+			return c, fmt.Errorf("keyword is required")
+		}
+	case KindRegex:
+		if rule.Pattern == "" {
+			return c, fmt.Errorf("regex is required")
+		}
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return c, fmt.Errorf("invalid regex: %w", err)
+		}
+		c.re = re
+	case KindGameName:
+		if strings.TrimSpace(rule.Pattern) == "" {
+			return c, fmt.Errorf("game name is required")
+		}
+	case KindMinDuration, KindMaxDuration:
+		if rule.Duration <= 0 {
+			return c, fmt.Errorf("duration must be positive")
+		}
+	case KindEventType:
+		if !slices.Contains(timeline.EventTypes, rule.Pattern) {
+			return c, fmt.Errorf("invalid event_type: %q", rule.Pattern)
+		}
+	default:
+		return c, fmt.Errorf("invalid kind: %q", rule.Kind)
+	}
+	return c, nil
+}
+
+// matches はイベントがルールにマッチするかどうかを返す
+func (c compiledRule) matches(event Event) bool {
+	if c.SourceID.Valid && c.SourceID != event.SourceID {
+		return false
+	}
+
+	switch c.Kind {
+	case KindKeyword:
+		return c.matchText(event, func(text string) bool {
+			return strings.Contains(search.Normalize(text), c.keyword)
+		})
+	case KindRegex:
+		return c.matchText(event, c.re.MatchString)
+	case KindGameName:
+		return event.GameName != "" && strings.EqualFold(strings.TrimSpace(c.Pattern), event.GameName)
+	case KindMinDuration:
+		return event.Duration > 0 && event.Duration < c.Duration
+	case KindMaxDuration:
+		return event.Duration > 0 && event.Duration > c.Duration
+	case KindEventType:
+		return event.Type == c.Pattern
+	}
+	return false
+}
+
+// matchText はルールの対象（タイトル・説明文）のいずれかが match を満たすかどうかを返す
+func (c compiledRule) matchText(event Event, match func(string) bool) bool {
+	if c.Field != FieldDescription && match(event.Title) {
+		return true
+	}
+	if c.Field != FieldTitle && event.Description != "" && match(event.Description) {
+		return true
+	}
+	return false
+}
+
+// isoDurationRegex はISO 8601形式の長さ（YouTube APIの形式）
+var isoDurationRegex = regexp.MustCompile(`^PT(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?$`)
+
+// ParseDuration は events.duration の文字列を長さに変換する
+// "01:30:15"・"30:15"（YouTube・radiko・Podcast）、"5415"（Podcastの秒数）、
+// "1h30m15s"（Twitch）、"PT1H30M15S"（ISO 8601）の形式に対応し、解釈できない場合は0を返す
+func ParseDuration(s string) time.Duration {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+
+	if matches := isoDurationRegex.FindStringSubmatch(s); matches != nil {
+		var d time.Duration
+		for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+			if n, err := strconv.Atoi(matches[i+1]); err == nil {
+				d += time.Duration(n) * unit
+			}
+		}
+		return d
+	}
+
+	if parts := strings.Split(s, ":"); len(parts) == 2 || len(parts) == 3 {
+		var d time.Duration
+		for _, part := range parts {
+			n, err := strconv.Atoi(part)
+			if err != nil || n < 0 {
+				return 0
+			}
+			d = d*60 + time.Duration(n)
+		}
+		return d * time.Second
+	}
+
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 {
+		return time.Duration(n) * time.Second
+	}
+
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return d
+	}
+	return 0
+}
@@ -0,0 +1,197 @@
+package mute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TestValidate はミュートルールの検証のテスト
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr bool
+	}{
+		{name: "Keyword", rule: Rule{Kind: KindKeyword, Pattern: "切り抜き"}},
+		{name: "Keyword on title", rule: Rule{Kind: KindKeyword, Pattern: "shorts", Field: FieldTitle}},
+		{name: "Regex", rule: Rule{Kind: KindRegex, Pattern: `(?i)#shorts?\b`}},
+		{name: "Game name", rule: Rule{Kind: KindGameName, Pattern: "Just Chatting"}},
+		{name: "Min duration", rule: Rule{Kind: KindMinDuration, Duration: time.Minute}},
+		{name: "Event type", rule: Rule{Kind: KindEventType, Pattern: "premiere"}},
+		{name: "Empty keyword", rule: Rule{Kind: KindKeyword, Pattern: "  "}, wantErr: true},
+		{name: "Invalid regex", rule: Rule{Kind: KindRegex, Pattern: `(unclosed`}, wantErr: true},
+		{name: "Zero duration", rule: Rule{Kind: KindMaxDuration}, wantErr: true},
+		{name: "Unknown event type", rule: Rule{Kind: KindEventType, Pattern: "short"}, wantErr: true},
+		{name: "Unknown field", rule: Rule{Kind: KindKeyword, Pattern: "x", Field: "channel"}, wantErr: true},
+		{name: "Unknown kind", rule: Rule{Kind: "viewer_count"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestEvaluatorMuted はミュートの判定のテスト
+func TestEvaluatorMuted(t *testing.T) {
+	source := pgtype.UUID{Bytes: [16]byte{15: 1}, Valid: true}
+	otherSource := pgtype.UUID{Bytes: [16]byte{15: 2}, Valid: true}
+
+	video := Event{
+		SourceID:    source,
+		Type:        "video",
+		Title:       "【切り抜き】ＡＰＥＸで神プレイ",
+		Description: "本編はこちら #Shorts",
+		Duration:    45 * time.Second,
+	}
+	stream := Event{
+		SourceID: otherSource,
+		Type:     "live",
+		Title:    "Late night stream",
+		GameName: "Just Chatting",
+	}
+
+	tests := []struct {
+		name  string
+		rules []Rule
+		event Event
+		want  bool
+	}{
+		{
+			name:  "No rules",
+			event: video,
+			want:  false,
+		},
+		{
+			name:  "Keyword ignores width and case",
+			rules: []Rule{{Kind: KindKeyword, Pattern: "apex"}},
+			event: video,
+			want:  true,
+		},
+		{
+			name:  "Keyword in description",
+			rules: []Rule{{Kind: KindKeyword, Pattern: "本編"}},
+			event: video,
+			want:  true,
+		},
+		{
+			name:  "Keyword limited to title",
+			rules: []Rule{{Kind: KindKeyword, Pattern: "本編", Field: FieldTitle}},
+			event: video,
+			want:  false,
+		},
+		{
+			name:  "Regex limited to description",
+			rules: []Rule{{Kind: KindRegex, Pattern: `(?i)#shorts\b`, Field: FieldDescription}},
+			event: video,
+			want:  true,
+		},
+		{
+			name:  "Regex no match",
+			rules: []Rule{{Kind: KindRegex, Pattern: `^\[LIVE\]`}},
+			event: stream,
+			want:  false,
+		},
+		{
+			name:  "Game name ignores case",
+			rules: []Rule{{Kind: KindGameName, Pattern: "just chatting"}},
+			event: stream,
+			want:  true,
+		},
+		{
+			name:  "Game name on event without game",
+			rules: []Rule{{Kind: KindGameName, Pattern: "Just Chatting"}},
+			event: video,
+			want:  false,
+		},
+		{
+			name:  "Shorter than minimum",
+			rules: []Rule{{Kind: KindMinDuration, Duration: time.Minute}},
+			event: video,
+			want:  true,
+		},
+		{
+			name:  "Not longer than maximum",
+			rules: []Rule{{Kind: KindMaxDuration, Duration: time.Hour}},
+			event: video,
+			want:  false,
+		},
+		{
+			name:  "Unknown duration is never muted",
+			rules: []Rule{{Kind: KindMinDuration, Duration: time.Minute}, {Kind: KindMaxDuration, Duration: time.Minute}},
+			event: stream,
+			want:  false,
+		},
+		{
+			name:  "Event type",
+			rules: []Rule{{Kind: KindEventType, Pattern: "live"}},
+			event: stream,
+			want:  true,
+		},
+		{
+			name:  "Scoped to another source",
+			rules: []Rule{{Kind: KindEventType, Pattern: "video", SourceID: otherSource}},
+			event: video,
+			want:  false,
+		},
+		{
+			name:  "Scoped to the same source",
+			rules: []Rule{{Kind: KindEventType, Pattern: "video", SourceID: source}},
+			event: video,
+			want:  true,
+		},
+		{
+			name: "Any rule matches",
+			rules: []Rule{
+				{Kind: KindEventType, Pattern: "radio"},
+				{Kind: KindKeyword, Pattern: "late night"},
+			},
+			event: stream,
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewEvaluator(tt.rules)
+			if err != nil {
+				t.Fatalf("NewEvaluator() error = %v", err)
+			}
+			if got := e.Muted(tt.event); got != tt.want {
+				t.Errorf("Muted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseDuration は events.duration の変換のテスト
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Duration
+	}{
+		{input: "01:30:15", want: time.Hour + 30*time.Minute + 15*time.Second},
+		{input: "04:05", want: 4*time.Minute + 5*time.Second},
+		{input: "5415", want: time.Hour + 30*time.Minute + 15*time.Second},
+		{input: "1h30m15s", want: time.Hour + 30*time.Minute + 15*time.Second},
+		{input: "PT45S", want: 45 * time.Second},
+		{input: "PT2H", want: 2 * time.Hour},
+		{input: "", want: 0},
+		{input: "1:2:3:4", want: 0},
+		{input: "unknown", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := ParseDuration(tt.input); got != tt.want {
+				t.Errorf("ParseDuration(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/mmcdole/gofeed"
@@ -34,6 +35,10 @@ type PodcastEpisode struct {
 	URL         string
 	ImageURL    string
 	Duration    string
+	// 音声ファイル（RSSのenclosure）
+	EnclosureURL    string
+	EnclosureType   string
+	EnclosureLength int64
 }
 
 func NewClient() *Client {
@@ -205,6 +210,13 @@ func (c *Client) ParseFeed(ctx context.Context, feedURL string) (*PodcastFeed, [
 			episode.Duration = item.ITunesExt.Duration
 		}
 
+		if len(item.Enclosures) > 0 {
+			enclosure := item.Enclosures[0]
+			episode.EnclosureURL = enclosure.URL
+			episode.EnclosureType = enclosure.Type
+			episode.EnclosureLength, _ = strconv.ParseInt(enclosure.Length, 10, 64)
+		}
+
 		episodes = append(episodes, episode)
 	}
 
@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// PatternStore は投稿パターンの学習で使うクエリ（*db.Queries が実装する）
+type PatternStore interface {
+	ListSourcesForPatternLearning(ctx context.Context) ([]db.ListSourcesForPatternLearningRow, error)
+	ListEventTimesBySource(ctx context.Context, arg db.ListEventTimesBySourceParams) ([]db.ListEventTimesBySourceRow, error)
+	UpsertSourcePostingPattern(ctx context.Context, arg db.UpsertSourcePostingPatternParams) error
+}
+
+// LearnPatterns は有効な購読がある全ソース（全プラットフォーム）の投稿パターンを学習し、次に取得する時刻の予測と合わせて保存
+// 学習できたソース数を返す（1件の失敗では止めない）
+func LearnPatterns(ctx context.Context, store PatternStore, now time.Time) (int, error) {
+	sources, err := store.ListSourcesForPatternLearning(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list sources: %w", err)
+	}
+
+	learned := 0
+	for _, src := range sources {
+		if ctx.Err() != nil {
+			return learned, ctx.Err()
+		}
+
+		events, err := store.ListEventTimesBySource(ctx, db.ListEventTimesBySourceParams{
+			SourceID: src.ID,
+			Since:    pgtype.Timestamptz{Time: now.Add(-patternWindow), Valid: true},
+		})
+		if err != nil {
+			log.Printf("❌ [%s] Failed to get events of %s: %v", src.PlatformID, src.ExternalID, err)
+			continue
+		}
+		samples := make([]Sample, 0, len(events))
+		for _, e := range events {
+			if e.OccurredAt.Valid {
+				samples = append(samples, Sample{At: e.OccurredAt.Time, Live: IsLiveEventType(e.Type)})
+			}
+		}
+
+		p := Learn(samples, now)
+		p.Platform = src.PlatformID
+		params := db.UpsertSourcePostingPatternParams{
+			SourceID:           src.ID,
+			PostingHistogram:   p.Histogram,
+			PostingLiveRatio:   p.LiveRatio,
+			PostingSampleCount: int32(p.Samples),
+			PostingConfidence:  p.Confidence(),
+		}
+		// 一度も取得していないソースは次の確認ですぐ取得されるため予測しない
+		if src.LastFetchedAt.Valid {
+			interval := time.Duration(src.UpdateIntervalMinutes) * time.Minute
+			next, expected := p.NextFetch(src.LastFetchedAt.Time, interval)
+			params.NextFetchAt = pgtype.Timestamptz{Time: next, Valid: true}
+			params.NextExpectedAt = pgtype.Timestamptz{Time: expected, Valid: !expected.IsZero()}
+		}
+		if err := store.UpsertSourcePostingPattern(ctx, params); err != nil {
+			log.Printf("❌ [%s] Failed to save posting pattern of %s: %v", src.PlatformID, src.ExternalID, err)
+			continue
+		}
+		learned++
+	}
+	return learned, nil
+}
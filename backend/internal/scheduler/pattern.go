@@ -0,0 +1,187 @@
+package scheduler
+
+import (
+	"math"
+	"time"
+)
+
+// 投稿パターンの学習
+// 過去のイベントの配信開始・公開日時を曜日×時刻（日本時間）ごとに集計し、次に投稿されそうな時間帯に合わせて取得する
+// 例: 毎週金曜18時のライブ → 金曜17:45に取得、毎日21時台の動画 → 毎日22:05に取得
+// Twitchは配信予定を取り込まないため、配信開始の時刻から時間帯の間は minFetchGap ごとに取得する（金曜18:00・18:15…）
+const (
+	patternSlots    = 7 * 24                  // 曜日×時刻の数（日曜0時=0〜土曜23時=167）
+	patternWindow   = 26 * 7 * 24 * time.Hour // 学習に使う期間（過去半年）
+	patternHalfLife = 8 * 7 * 24 * time.Hour  // 重みが半分になる期間（最近の投稿パターンを優先する）
+
+	// minPatternConfidence より確度が低いソースは更新間隔どおりに取得する
+	minPatternConfidence = 0.3
+	// fullPatternSamples 件未満のソースは件数に応じて確度を下げる（数件の偶然の一致を投稿パターンとしない）
+	fullPatternSamples = 12
+	// likelySlotRatio 最も多い時間帯に対してこの割合以上の時間帯を「投稿されそうな時間帯」とする
+	likelySlotRatio = 0.5
+
+	liveLead       = 15 * time.Minute // ライブ・予定は開始前に取得する（配信予定の枠を取り込む）
+	uploadDelay    = 5 * time.Minute  // 動画は時間帯の終わりから少し後に取得する
+	minFetchGap    = 15 * time.Minute // 前回の取得から次の取得までの最短の間隔
+	maxIntervalMul = 2                // 投稿がなさそうな間に延ばす更新間隔の最大倍率
+)
+
+// patternLocation は投稿パターンを集計するタイムゾーン（日本時間、夏時間なし）
+var patternLocation = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// Sample は学習に使うイベント
+type Sample struct {
+	At   time.Time // 配信開始日時（ライブ・予定など）または公開日時
+	Live bool      // ライブ・予定・プレミア・ラジオ
+}
+
+// IsLiveEventType は開始日時に合わせて取得するイベントタイプか
+func IsLiveEventType(eventType string) bool {
+	switch eventType {
+	case "live", "scheduled", "premiere", "radio":
+		return true
+	}
+	return false
+}
+
+// Pattern はソースの投稿パターン
+type Pattern struct {
+	Histogram []float64 // 曜日×時刻（日本時間）ごとの重み付きの投稿数（patternSlots要素）
+	LiveRatio float64   // ライブ・予定などの割合（重み付き）
+	Samples   int       // 学習に使ったイベント数
+	Platform  string    // ソースのプラットフォーム（取得する時刻の決め方が変わる）
+}
+
+// Learn はnowより前patternWindow以内のイベントから投稿パターンを学習
+// 新しいイベントほど重くし（patternHalfLifeで半分）、未来のイベント（配信予定など）は使わない
+func Learn(samples []Sample, now time.Time) Pattern {
+	p := Pattern{Histogram: make([]float64, patternSlots)}
+	var total, live float64
+	for _, s := range samples {
+		age := now.Sub(s.At)
+		if age < 0 || age > patternWindow {
+			continue
+		}
+		w := math.Exp2(-float64(age) / float64(patternHalfLife))
+		p.Histogram[slotOf(s.At)] += w
+		total += w
+		if s.Live {
+			live += w
+		}
+		p.Samples++
+	}
+	if total > 0 {
+		p.LiveRatio = live / total
+	}
+	return p
+}
+
+// slotOf は日時の曜日×時刻（日本時間）
+func slotOf(t time.Time) int {
+	t = t.In(patternLocation)
+	return int(t.Weekday())*24 + t.Hour()
+}
+
+// total は重み付きの投稿数の合計
+func (p Pattern) total() float64 {
+	var total float64
+	for _, w := range p.Histogram {
+		total += w
+	}
+	return total
+}
+
+// Score は日時の時間帯に投稿される割合（0〜1、全時間帯の合計が1）
+func (p Pattern) Score(t time.Time) float64 {
+	total := p.total()
+	if len(p.Histogram) != patternSlots || total == 0 {
+		return 0
+	}
+	return p.Histogram[slotOf(t)] / total
+}
+
+// Confidence は投稿パターンの確度（0=ばらばら〜1=毎回同じ時間帯）
+// 時間帯の分布のエントロピーから求め、学習に使ったイベントが少ない場合は下げる
+func (p Pattern) Confidence() float64 {
+	total := p.total()
+	if len(p.Histogram) != patternSlots || total == 0 {
+		return 0
+	}
+	var entropy float64
+	for _, w := range p.Histogram {
+		if w > 0 {
+			share := w / total
+			entropy -= share * math.Log(share)
+		}
+	}
+	confidence := 1 - entropy/math.Log(patternSlots)
+	return confidence * min(1, float64(p.Samples)/fullPatternSamples)
+}
+
+// NextFetch はfetchedAtに取得したソースの次に取得する時刻と、次に投稿されそうな時間帯の開始日時を返す
+// 確度が低い場合は更新間隔どおり（投稿されそうな時間帯はゼロ値）
+// 確度が高い場合は次に投稿されそうな時間帯に合わせて早め、投稿がなさそうな間は更新間隔のmaxIntervalMul倍まで延ばす
+func (p Pattern) NextFetch(fetchedAt time.Time, interval time.Duration) (next, expected time.Time) {
+	next = fetchedAt.Add(interval)
+	if p.Confidence() < minPatternConfidence {
+		return next, time.Time{}
+	}
+
+	expected, target, ok := p.nextLikely(fetchedAt)
+	if !ok {
+		return next, time.Time{}
+	}
+	limit := fetchedAt.Add(interval * maxIntervalMul)
+	if target.After(limit) {
+		target = limit
+	}
+	return target, expected
+}
+
+// nextLikely はfetchedAt以降で最初の投稿されそうな時間帯の開始日時と、その時間帯に合わせて取得する時刻
+func (p Pattern) nextLikely(fetchedAt time.Time) (expected, target time.Time, ok bool) {
+	var peak float64
+	for _, w := range p.Histogram {
+		peak = max(peak, w)
+	}
+	if peak == 0 {
+		return time.Time{}, time.Time{}, false
+	}
+
+	local := fetchedAt.In(patternLocation)
+	slotStart := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), 0, 0, 0, patternLocation)
+	// 直近の時間帯が前回の取得に近すぎて見送った場合も翌週の同じ時間帯まで確認する
+	for range patternSlots + 2 {
+		if p.Histogram[slotOf(slotStart)] >= peak*likelySlotRatio {
+			target := p.fetchTime(slotStart)
+			if target.Sub(fetchedAt) >= minFetchGap {
+				return slotStart, target, true
+			}
+			// 時間帯の間に配信が始まることがあるため、時間帯が終わるまで続けて取得する
+			if p.pollsDuringSlot() && fetchedAt.Add(minFetchGap).Before(slotStart.Add(time.Hour)) {
+				return slotStart, fetchedAt.Add(minFetchGap), true
+			}
+		}
+		slotStart = slotStart.Add(time.Hour)
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// fetchTime は投稿されそうな時間帯に合わせて取得する時刻
+// ライブ・予定が多いソースは開始前（Twitchは開始時刻）、動画が多いソースは時間帯の終わりの後
+func (p Pattern) fetchTime(slotStart time.Time) time.Time {
+	if p.pollsDuringSlot() {
+		return slotStart
+	}
+	if p.LiveRatio >= 0.5 {
+		return slotStart.Add(-liveLead)
+	}
+	return slotStart.Add(time.Hour + uploadDelay)
+}
+
+// pollsDuringSlot は時間帯の間に取得を繰り返すか
+// Twitchは配信予定の枠を取り込まないため、開始前に取得しても配信は見つからない
+func (p Pattern) pollsDuringSlot() bool {
+	return p.Platform == "twitch" && p.LiveRatio >= 0.5
+}
@@ -0,0 +1,376 @@
+package scheduler
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// jst は日本時間の日時
+func jst(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, patternLocation)
+}
+
+// weekly は毎週同じ曜日・時刻のn件のイベント（firstから1週間ずつ遡る）
+func weekly(first time.Time, n int, live bool) []Sample {
+	samples := make([]Sample, 0, n)
+	for i := range n {
+		samples = append(samples, Sample{At: first.AddDate(0, 0, -7*i), Live: live})
+	}
+	return samples
+}
+
+// daily は毎日同じ時刻のn件のイベント（firstから1日ずつ遡る）
+func daily(first time.Time, n int, live bool) []Sample {
+	samples := make([]Sample, 0, n)
+	for i := range n {
+		samples = append(samples, Sample{At: first.AddDate(0, 0, -i), Live: live})
+	}
+	return samples
+}
+
+// TestLearn は投稿パターンの学習（曜日×時刻の集計・重み・対象期間）のテスト
+func TestLearn(t *testing.T) {
+	now := jst(2025, 6, 5, 12, 0) // 木曜
+
+	t.Run("buckets by weekday and hour in JST", func(t *testing.T) {
+		// UTC 09:05 = 日本時間 金曜18:05
+		p := Learn([]Sample{{At: time.Date(2025, 5, 30, 9, 5, 0, 0, time.UTC)}}, now)
+		if got := p.Histogram[5*24+18]; got == 0 {
+			t.Errorf("slot Fri 18:00 = %v, want > 0", got)
+		}
+		if p.Samples != 1 {
+			t.Errorf("Samples = %d, want 1", p.Samples)
+		}
+	})
+
+	t.Run("recent events weigh more", func(t *testing.T) {
+		p := Learn([]Sample{
+			{At: now.Add(-time.Hour)},
+			{At: now.Add(-time.Hour - patternHalfLife)},
+		}, now)
+		recent := p.Histogram[slotOf(now.Add(-time.Hour))]
+		if math.Abs(recent-(1+0.5)*math.Exp2(-float64(time.Hour)/float64(patternHalfLife))) > 1e-9 {
+			t.Errorf("weight = %v, want 1.5 (half for the older event)", recent)
+		}
+	})
+
+	t.Run("ignores future and too old events", func(t *testing.T) {
+		p := Learn([]Sample{
+			{At: now.Add(time.Hour)},
+			{At: now.Add(-patternWindow - time.Hour)},
+			{At: now.Add(-24 * time.Hour)},
+		}, now)
+		if p.Samples != 1 {
+			t.Errorf("Samples = %d, want 1", p.Samples)
+		}
+	})
+
+	t.Run("live ratio", func(t *testing.T) {
+		samples := append(weekly(jst(2025, 5, 30, 18, 0), 3, true), weekly(jst(2025, 5, 30, 18, 0), 1, false)...)
+		p := Learn(samples, now)
+		// 同じ日時のライブ1件と動画1件は同じ重み、古い2件のライブは重みが小さい
+		if p.LiveRatio <= 0.5 || p.LiveRatio >= 1 {
+			t.Errorf("LiveRatio = %v, want between 0.5 and 1", p.LiveRatio)
+		}
+	})
+}
+
+// TestPatternScore は時間帯ごとのスコアのテスト
+func TestPatternScore(t *testing.T) {
+	now := jst(2025, 6, 5, 12, 0)
+	p := Learn(append(
+		weekly(jst(2025, 5, 30, 18, 0), 3, true),   // 金曜18時
+		weekly(jst(2025, 6, 2, 20, 0), 1, true)..., // 月曜20時
+	), now)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		min  float64
+		max  float64
+	}{
+		{name: "main slot", at: jst(2025, 6, 6, 18, 30), min: 0.6, max: 0.8},
+		{name: "minor slot", at: jst(2025, 6, 9, 20, 59), min: 0.2, max: 0.4},
+		{name: "next hour", at: jst(2025, 6, 6, 19, 0), min: 0, max: 0},
+		{name: "same hour other weekday", at: jst(2025, 6, 5, 18, 0), min: 0, max: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Score(tt.at); got < tt.min || got > tt.max {
+				t.Errorf("Score() = %v, want between %v and %v", got, tt.min, tt.max)
+			}
+		})
+	}
+
+	var sum float64
+	for i := range patternSlots {
+		sum += p.Score(jst(2025, 6, 1, 0, 0).Add(time.Duration(i) * time.Hour))
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("sum of scores = %v, want 1", sum)
+	}
+	if got := (Pattern{}).Score(now); got != 0 {
+		t.Errorf("Score() of empty pattern = %v, want 0", got)
+	}
+}
+
+// TestPatternConfidence は投稿パターンの確度のテスト
+func TestPatternConfidence(t *testing.T) {
+	now := jst(2025, 6, 5, 12, 0)
+
+	// 2時間おきにばらばらの時間帯
+	var scattered []Sample
+	for i := range 84 {
+		scattered = append(scattered, Sample{At: now.Add(-time.Duration(2*i+1) * time.Hour)})
+	}
+
+	tests := []struct {
+		name    string
+		samples []Sample
+		min     float64
+		max     float64
+	}{
+		{name: "weekly same slot", samples: weekly(jst(2025, 5, 30, 18, 0), 12, true), min: 0.99, max: 1},
+		{name: "daily same hour", samples: daily(jst(2025, 6, 4, 21, 10), 28, false), min: 0.55, max: 0.65},
+		{name: "few samples", samples: weekly(jst(2025, 5, 30, 18, 0), 2, true), min: 0.1, max: 0.2},
+		{name: "scattered", samples: scattered, min: 0, max: 0.2},
+		{name: "no samples", samples: nil, min: 0, max: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Learn(tt.samples, now).Confidence(); got < tt.min || got > tt.max {
+				t.Errorf("Confidence() = %v, want between %v and %v", got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+// TestPatternNextFetch は投稿パターンに合わせた次に取得する時刻のテスト
+func TestPatternNextFetch(t *testing.T) {
+	now := jst(2025, 6, 5, 12, 0)
+	fridayLive := Learn(weekly(jst(2025, 5, 30, 18, 0), 12, true), now)
+	dailyUpload := Learn(daily(jst(2025, 6, 4, 21, 10), 28, false), now)
+	fewSamples := Learn(weekly(jst(2025, 5, 30, 18, 0), 2, true), now)
+	twitchLive := fridayLive
+	twitchLive.Platform = "twitch"
+
+	tests := []struct {
+		name         string
+		pattern      Pattern
+		fetchedAt    time.Time
+		interval     time.Duration
+		wantNext     time.Time
+		wantExpected time.Time
+	}{
+		{
+			name:         "stretches interval while no content is expected",
+			pattern:      fridayLive,
+			fetchedAt:    jst(2025, 6, 5, 12, 0),
+			interval:     6 * time.Hour,
+			wantNext:     jst(2025, 6, 6, 0, 0),
+			wantExpected: jst(2025, 6, 6, 18, 0),
+		},
+		{
+			name:         "fetches before expected live",
+			pattern:      fridayLive,
+			fetchedAt:    jst(2025, 6, 6, 12, 0),
+			interval:     6 * time.Hour,
+			wantNext:     jst(2025, 6, 6, 17, 45),
+			wantExpected: jst(2025, 6, 6, 18, 0),
+		},
+		{
+			name:         "skips slot too close to last fetch",
+			pattern:      fridayLive,
+			fetchedAt:    jst(2025, 6, 6, 17, 40),
+			interval:     time.Hour,
+			wantNext:     jst(2025, 6, 6, 19, 40),
+			wantExpected: jst(2025, 6, 13, 18, 0),
+		},
+		{
+			name:         "fetches twitch at start of expected live",
+			pattern:      twitchLive,
+			fetchedAt:    jst(2025, 6, 6, 12, 0),
+			interval:     6 * time.Hour,
+			wantNext:     jst(2025, 6, 6, 18, 0),
+			wantExpected: jst(2025, 6, 6, 18, 0),
+		},
+		{
+			name:         "polls twitch during expected live",
+			pattern:      twitchLive,
+			fetchedAt:    jst(2025, 6, 6, 18, 0),
+			interval:     6 * time.Hour,
+			wantNext:     jst(2025, 6, 6, 18, 15),
+			wantExpected: jst(2025, 6, 6, 18, 0),
+		},
+		{
+			name:         "polls twitch until end of expected live",
+			pattern:      twitchLive,
+			fetchedAt:    jst(2025, 6, 6, 18, 45),
+			interval:     6 * time.Hour,
+			wantNext:     jst(2025, 6, 7, 6, 45),
+			wantExpected: jst(2025, 6, 13, 18, 0),
+		},
+		{
+			name:         "fetches after expected upload",
+			pattern:      dailyUpload,
+			fetchedAt:    jst(2025, 6, 9, 20, 0),
+			interval:     3 * time.Hour,
+			wantNext:     jst(2025, 6, 9, 22, 5),
+			wantExpected: jst(2025, 6, 9, 21, 0),
+		},
+		{
+			name:         "fetches after upload in current slot",
+			pattern:      dailyUpload,
+			fetchedAt:    jst(2025, 6, 9, 21, 30),
+			interval:     3 * time.Hour,
+			wantNext:     jst(2025, 6, 9, 22, 5),
+			wantExpected: jst(2025, 6, 9, 21, 0),
+		},
+		{
+			name:      "low confidence keeps interval",
+			pattern:   fewSamples,
+			fetchedAt: jst(2025, 6, 6, 12, 0),
+			interval:  6 * time.Hour,
+			wantNext:  jst(2025, 6, 6, 18, 0),
+		},
+		{
+			name:      "no pattern keeps interval",
+			pattern:   Pattern{},
+			fetchedAt: jst(2025, 6, 6, 12, 0),
+			interval:  time.Hour,
+			wantNext:  jst(2025, 6, 6, 13, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, expected := tt.pattern.NextFetch(tt.fetchedAt, tt.interval)
+			if !next.Equal(tt.wantNext) {
+				t.Errorf("next = %s, want %s", next, tt.wantNext)
+			}
+			if !expected.Equal(tt.wantExpected) {
+				t.Errorf("expected = %s, want %s", expected, tt.wantExpected)
+			}
+		})
+	}
+}
+
+// fakePatternStore は投稿パターンの学習で使うクエリのインメモリ実装
+type fakePatternStore struct {
+	sources []db.ListSourcesForPatternLearningRow
+	events  map[pgtype.UUID][]db.ListEventTimesBySourceRow
+	saved   map[pgtype.UUID]db.UpsertSourcePostingPatternParams
+}
+
+func (s *fakePatternStore) ListSourcesForPatternLearning(ctx context.Context) ([]db.ListSourcesForPatternLearningRow, error) {
+	return s.sources, nil
+}
+
+func (s *fakePatternStore) ListEventTimesBySource(ctx context.Context, arg db.ListEventTimesBySourceParams) ([]db.ListEventTimesBySourceRow, error) {
+	var rows []db.ListEventTimesBySourceRow
+	for _, e := range s.events[arg.SourceID] {
+		if !e.OccurredAt.Time.Before(arg.Since.Time) {
+			rows = append(rows, e)
+		}
+	}
+	return rows, nil
+}
+
+func (s *fakePatternStore) UpsertSourcePostingPattern(ctx context.Context, arg db.UpsertSourcePostingPatternParams) error {
+	s.saved[arg.SourceID] = arg
+	return nil
+}
+
+// TestLearnPatterns は全ソースの投稿パターンの学習と予測の保存のテスト
+func TestLearnPatterns(t *testing.T) {
+	now := jst(2025, 6, 5, 12, 0)
+	live := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	unfetched := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+
+	var liveEvents []db.ListEventTimesBySourceRow
+	for _, s := range weekly(jst(2025, 5, 30, 18, 0), 12, true) {
+		liveEvents = append(liveEvents, db.ListEventTimesBySourceRow{
+			Type:       "live",
+			OccurredAt: pgtype.Timestamptz{Time: s.At, Valid: true},
+		})
+	}
+
+	store := &fakePatternStore{
+		sources: []db.ListSourcesForPatternLearningRow{
+			{
+				ID:                    live,
+				PlatformID:            "twitch",
+				LastFetchedAt:         pgtype.Timestamptz{Time: jst(2025, 6, 6, 12, 0), Valid: true},
+				UpdateIntervalMinutes: 360,
+			},
+			{ID: unfetched, PlatformID: "podcast", UpdateIntervalMinutes: 360},
+		},
+		events: map[pgtype.UUID][]db.ListEventTimesBySourceRow{live: liveEvents},
+		saved:  make(map[pgtype.UUID]db.UpsertSourcePostingPatternParams),
+	}
+
+	learned, err := LearnPatterns(context.Background(), store, now)
+	if err != nil {
+		t.Fatalf("LearnPatterns() error = %v", err)
+	}
+	if learned != 2 {
+		t.Errorf("learned = %d, want 2", learned)
+	}
+
+	got := store.saved[live]
+	if got.PostingSampleCount != 12 || got.PostingLiveRatio != 1 || got.PostingConfidence < 0.99 {
+		t.Errorf("pattern = samples %d, live %v, confidence %v", got.PostingSampleCount, got.PostingLiveRatio, got.PostingConfidence)
+	}
+	if len(got.PostingHistogram) != patternSlots {
+		t.Errorf("histogram length = %d, want %d", len(got.PostingHistogram), patternSlots)
+	}
+	// Twitchは配信予定を取り込まないため開始前ではなく開始時刻に取得する
+	if !got.NextFetchAt.Time.Equal(jst(2025, 6, 6, 18, 0)) || !got.NextExpectedAt.Time.Equal(jst(2025, 6, 6, 18, 0)) {
+		t.Errorf("next fetch = %s (expected %s), want 18:00 (18:00)", got.NextFetchAt.Time, got.NextExpectedAt.Time)
+	}
+
+	// 一度も取得していないソースは予測しない
+	if got := store.saved[unfetched]; got.NextFetchAt.Valid || got.NextExpectedAt.Valid || got.PostingSampleCount != 0 {
+		t.Errorf("unfetched source = %+v, want no prediction", got)
+	}
+}
+
+// TestRefreshSchedulesNext は更新後に投稿パターンから次に取得する時刻を保存することのテスト
+func TestRefreshSchedulesNext(t *testing.T) {
+	now := jst(2025, 6, 6, 12, 0)
+	pattern := Learn(weekly(jst(2025, 5, 30, 18, 0), 12, true), now)
+
+	learned := source(1, "streamer")
+	learned.UpdateIntervalMinutes = 360
+	learned.PostingHistogram = pattern.Histogram
+	learned.PostingLiveRatio = pattern.LiveRatio
+	learned.PostingSampleCount = int32(pattern.Samples)
+	unlearned := source(2, "feed")
+	unlearned.UpdateIntervalMinutes = 360
+
+	store := &fakeStore{}
+	fetcher := newFakeFetcher()
+	close(fetcher.release)
+	s := New(store, fetcher, nil, Config{Pools: map[string]int{"twitch": 1}})
+	s.now = func() time.Time { return now }
+	s.pools["twitch"].busy = 2
+
+	s.refresh(context.Background(), "twitch", learned)
+	s.refresh(context.Background(), "twitch", unlearned)
+
+	got, ok := store.nextFetch[learned.ID]
+	if !ok {
+		t.Fatal("next fetch time was not saved")
+	}
+	if !got.NextFetchAt.Time.Equal(jst(2025, 6, 6, 17, 45)) || !got.NextExpectedAt.Time.Equal(jst(2025, 6, 6, 18, 0)) {
+		t.Errorf("next fetch = %s (expected %s), want 17:45 (18:00)", got.NextFetchAt.Time, got.NextExpectedAt.Time)
+	}
+	if _, ok := store.nextFetch[unlearned.ID]; ok {
+		t.Error("next fetch time saved for source without pattern")
+	}
+}
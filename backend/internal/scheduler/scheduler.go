@@ -0,0 +1,346 @@
+// Package scheduler はソースの優先度（source_priority）に従って定期更新を実行し続けるスケジューラ
+//
+// 更新間隔（high=1h, medium=3h, low=6h）を過ぎたソースを優先度の高い順に取得し、
+// プラットフォームごとのワーカープールで更新する。YouTubeは優先度ごとのAPI Quotaの予算を確認してから実行する。
+// 投稿パターンを学習済みのソース（LearnPatterns）は、次に投稿されそうな時間帯に合わせて次に取得する時刻を決める。
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/backoff"
+	"github.com/kinchoKayaba/pixicast/backend/internal/youtube"
+)
+
+// 更新に失敗したソースの再実行までの待ち時間（失敗するたびに倍にする）
+const (
+	failureBaseDelay = 15 * time.Minute
+	failureMaxDelay  = 6 * time.Hour
+)
+
+// Store はスケジューラが使うクエリ（*db.Queries が実装する）
+type Store interface {
+	GetSourcesByPriority(ctx context.Context, arg db.GetSourcesByPriorityParams) ([]db.GetSourcesByPriorityRow, error)
+	UpdateSourceNextFetch(ctx context.Context, arg db.UpdateSourceNextFetchParams) error
+}
+
+// Fetcher はソースを更新する（*ingest.Refresher が実装する）
+type Fetcher interface {
+	Refresh(ctx context.Context, sourceID pgtype.UUID, platform, externalID string, lastFetchedAt pgtype.Timestamptz) error
+}
+
+// Quota はYouTube API Quotaの枠ごとの予算の確認（*youtube.BudgetPlanner が実装する）
+// 使用量はYouTubeクライアントがctxの枠（youtube.WithBucket）に記録する
+type Quota interface {
+	CanSpend(bucket string, cost int) bool
+}
+
+// Config はスケジューラの設定
+type Config struct {
+	Pools           map[string]int // プラットフォームごとのワーカー数（含まれないプラットフォームは更新しない）
+	PollInterval    time.Duration  // 更新間隔を過ぎたソースを確認する間隔
+	ShutdownTimeout time.Duration  // 停止時に実行中の更新の完了を待つ時間（過ぎたらキャンセル）
+}
+
+// DefaultPools はデフォルトのプラットフォームごとのワーカー数
+var DefaultPools = map[string]int{
+	"youtube": 4,
+	"twitch":  4,
+	"podcast": 8,
+	"radiko":  2,
+}
+
+// Scheduler はソースの定期更新を実行し続ける
+type Scheduler struct {
+	store   Store
+	fetcher Fetcher
+	quota   Quota
+	cfg     Config
+	now     func() time.Time
+
+	pools map[string]*pool
+	wg    sync.WaitGroup
+
+	mu       sync.Mutex
+	inFlight map[pgtype.UUID]bool    // 実行待ち・実行中のソース
+	failures map[pgtype.UUID]failure // 更新に失敗したソース
+	reserved map[string]int          // 枠ごとの実行待ち・実行中のYouTubeの更新で使う見込みのQuota
+	// 予算が足りずにYouTubeの更新を見送っている枠（ログを状態が変わったときだけ出すため）
+	quotaDeferred map[string]bool
+}
+
+// pool はプラットフォームごとのワーカープール
+type pool struct {
+	workers int
+	queue   chan db.GetSourcesByPriorityRow
+	busy    int // 実行待ち・実行中の数（Scheduler.mu で保護）
+}
+
+// failure は更新に失敗したソースの再実行の待ち
+type failure struct {
+	platform string
+	count    int
+	until    time.Time
+}
+
+// New はスケジューラを作成
+func New(store Store, fetcher Fetcher, quota Quota, cfg Config) *Scheduler {
+	if len(cfg.Pools) == 0 {
+		cfg.Pools = DefaultPools
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Minute
+	}
+	if cfg.ShutdownTimeout <= 0 {
+		cfg.ShutdownTimeout = 30 * time.Second
+	}
+
+	s := &Scheduler{
+		store:    store,
+		fetcher:  fetcher,
+		quota:    quota,
+		cfg:      cfg,
+		now:      time.Now,
+		pools:    make(map[string]*pool),
+		inFlight: make(map[pgtype.UUID]bool),
+		failures: make(map[pgtype.UUID]failure),
+		reserved: make(map[string]int),
+
+		quotaDeferred: make(map[string]bool),
+	}
+	for platform, workers := range cfg.Pools {
+		if workers <= 0 {
+			continue
+		}
+		s.pools[platform] = &pool{
+			workers: workers,
+			queue:   make(chan db.GetSourcesByPriorityRow, workers),
+		}
+	}
+	return s
+}
+
+// Run はctxがキャンセルされる（SIGTERMなど）まで更新を実行し続ける
+// キャンセルされたら新しい更新を始めず、実行中の更新の完了をShutdownTimeoutまで待ってから返る
+func (s *Scheduler) Run(ctx context.Context) error {
+	// 実行中の更新はctxのキャンセル後もShutdownTimeoutまで続ける
+	workCtx, cancelWork := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelWork()
+	s.start(workCtx)
+	log.Printf("✅ Scheduler started: pools=%v, poll=%s", s.cfg.Pools, s.cfg.PollInterval)
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		s.dispatch(ctx)
+		select {
+		case <-ctx.Done():
+			log.Println("Scheduler stopping: waiting for running refreshes...")
+			if !s.stop(s.cfg.ShutdownTimeout) {
+				log.Printf("⚠️ Scheduler shutdown timed out after %s: cancelling running refreshes", s.cfg.ShutdownTimeout)
+				cancelWork()
+				s.wg.Wait()
+			}
+			log.Println("Scheduler stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// start はワーカーを起動
+func (s *Scheduler) start(ctx context.Context) {
+	for platform, p := range s.pools {
+		for range p.workers {
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				for src := range p.queue {
+					s.refresh(ctx, platform, src)
+				}
+			}()
+		}
+	}
+}
+
+// stop は実行待ちのソースがなくなり、実行中の更新が完了するまで最大timeout待つ
+// 時間内に完了した場合はtrueを返す
+func (s *Scheduler) stop(timeout time.Duration) bool {
+	for _, p := range s.pools {
+		close(p.queue)
+	}
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// dispatch は更新間隔を過ぎたソースを空いているワーカーに割り当てる
+func (s *Scheduler) dispatch(ctx context.Context) {
+	for platform, p := range s.pools {
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.mu.Lock()
+		free := p.workers - p.busy
+		busy := p.busy
+		s.mu.Unlock()
+		if free <= 0 {
+			continue
+		}
+
+		// 実行待ち・実行中のソースも更新間隔を過ぎたまま返るため、その分多く取得する
+		rows, err := s.store.GetSourcesByPriority(ctx, db.GetSourcesByPriorityParams{
+			PlatformID: platform,
+			Limit:      int32(free + busy + s.waiting(platform)),
+		})
+		if err != nil {
+			log.Printf("❌ Failed to get sources to refresh (%s): %v", platform, err)
+			continue
+		}
+
+		for _, src := range rows {
+			if free == 0 {
+				break
+			}
+			if !s.claim(platform, src) {
+				continue
+			}
+			p.queue <- src
+			free--
+		}
+	}
+}
+
+// waiting は再実行を待っているプラットフォームのソース数
+func (s *Scheduler) waiting(platform string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	now := s.now()
+	for _, f := range s.failures {
+		if f.platform == platform && now.Before(f.until) {
+			n++
+		}
+	}
+	return n
+}
+
+// claim はソースを実行待ちにする（実行待ち・実行中・再実行待ちのソースと優先度の予算が足りないYouTubeは除く）
+func (s *Scheduler) claim(platform string, src db.GetSourcesByPriorityRow) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inFlight[src.ID] {
+		return false
+	}
+	if f, ok := s.failures[src.ID]; ok && s.now().Before(f.until) {
+		return false
+	}
+	if platform == "youtube" && s.quota != nil {
+		bucket := quotaBucket(src.PriorityLevel)
+		if !s.quota.CanSpend(bucket, s.reserved[bucket]+youtube.RefreshCost) {
+			if !s.quotaDeferred[bucket] {
+				log.Printf("⚠️ YouTube API Quota budget for %s priority is low: deferring refreshes", bucket)
+				s.quotaDeferred[bucket] = true
+			}
+			return false
+		}
+		if s.quotaDeferred[bucket] {
+			log.Printf("🔄 YouTube refreshes for %s priority resumed", bucket)
+			s.quotaDeferred[bucket] = false
+		}
+		s.reserved[bucket] += youtube.RefreshCost
+	}
+	s.inFlight[src.ID] = true
+	s.pools[platform].busy++
+	return true
+}
+
+// refresh はソースを1件更新して結果を記録
+func (s *Scheduler) refresh(ctx context.Context, platform string, src db.GetSourcesByPriorityRow) {
+	name := src.ExternalID
+	if src.DisplayName.Valid {
+		name = src.DisplayName.String
+	}
+
+	start := s.now()
+	bucket := quotaBucket(src.PriorityLevel)
+	err := s.fetcher.Refresh(youtube.WithBucket(ctx, bucket), src.ID, platform, src.ExternalID, src.LastFetchedAt)
+
+	s.mu.Lock()
+	delete(s.inFlight, src.ID)
+	s.pools[platform].busy--
+	if platform == "youtube" && s.quota != nil {
+		s.reserved[bucket] -= youtube.RefreshCost
+	}
+	var retryAt time.Time
+	if err != nil {
+		f := s.failures[src.ID]
+		f.platform = platform
+		f.count++
+		f.until = s.now().Add(failureDelay(f.count))
+		s.failures[src.ID] = f
+		retryAt = f.until
+	} else {
+		delete(s.failures, src.ID)
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		log.Printf("❌ [%s] Failed to refresh %s (%s priority): %v (retry after %s)",
+			platform, name, src.PriorityLevel, err, retryAt.Format(time.RFC3339))
+		return
+	}
+	s.scheduleNext(ctx, src)
+	log.Printf("✅ [%s] Refreshed %s (%s priority, every %d min) in %s",
+		platform, name, src.PriorityLevel, src.UpdateIntervalMinutes, s.now().Sub(start).Round(time.Millisecond))
+}
+
+// scheduleNext は投稿パターンを学習済みのソースの次に取得する時刻を更新
+func (s *Scheduler) scheduleNext(ctx context.Context, src db.GetSourcesByPriorityRow) {
+	if len(src.PostingHistogram) != patternSlots {
+		return
+	}
+	p := Pattern{
+		Histogram: src.PostingHistogram,
+		LiveRatio: src.PostingLiveRatio,
+		Samples:   int(src.PostingSampleCount),
+		Platform:  src.PlatformID,
+	}
+	next, expected := p.NextFetch(s.now(), time.Duration(src.UpdateIntervalMinutes)*time.Minute)
+	if err := s.store.UpdateSourceNextFetch(context.WithoutCancel(ctx), db.UpdateSourceNextFetchParams{
+		SourceID:       src.ID,
+		NextExpectedAt: pgtype.Timestamptz{Time: expected, Valid: !expected.IsZero()},
+		NextFetchAt:    pgtype.Timestamptz{Time: next, Valid: true},
+	}); err != nil {
+		log.Printf("⚠️ Failed to update next fetch time of %s: %v", src.ExternalID, err)
+	}
+}
+
+// quotaBucket はソースの優先度のYouTube API Quotaの枠（不明な優先度は低優先度の枠）
+func quotaBucket(priorityLevel string) string {
+	switch priorityLevel {
+	case youtube.BucketHigh, youtube.BucketMedium:
+		return priorityLevel
+	default:
+		return youtube.BucketLow
+	}
+}
+
+// failureDelay はcount回続けて失敗したソースの再実行までの待ち時間
+func failureDelay(count int) time.Duration {
+	return backoff.Exponential(count, failureBaseDelay, failureMaxDelay)
+}
@@ -0,0 +1,438 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"github.com/kinchoKayaba/pixicast/backend/internal/youtube"
+)
+
+// fakeStore は更新間隔を過ぎたソースを優先度順に返すインメモリ実装
+type fakeStore struct {
+	sources   map[string][]db.GetSourcesByPriorityRow
+	mu        sync.Mutex
+	nextFetch map[pgtype.UUID]db.UpdateSourceNextFetchParams
+}
+
+func (s *fakeStore) GetSourcesByPriority(ctx context.Context, arg db.GetSourcesByPriorityParams) ([]db.GetSourcesByPriorityRow, error) {
+	rows := s.sources[arg.PlatformID]
+	if int(arg.Limit) < len(rows) {
+		rows = rows[:arg.Limit]
+	}
+	return rows, nil
+}
+
+func (s *fakeStore) UpdateSourceNextFetch(ctx context.Context, arg db.UpdateSourceNextFetchParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.nextFetch == nil {
+		s.nextFetch = make(map[pgtype.UUID]db.UpdateSourceNextFetchParams)
+	}
+	s.nextFetch[arg.SourceID] = arg
+	return nil
+}
+
+// fakeFetcher はreleaseが閉じられるまで（またはctxがキャンセルされるまで）更新をブロックする
+type fakeFetcher struct {
+	mu      sync.Mutex
+	calls   map[pgtype.UUID]int
+	buckets map[string]int // YouTube API Quotaの枠ごとの更新数
+	peak    map[string]int
+	active  map[string]int
+	err     error
+	release chan struct{}
+	started chan pgtype.UUID
+}
+
+func newFakeFetcher() *fakeFetcher {
+	return &fakeFetcher{
+		calls:   make(map[pgtype.UUID]int),
+		buckets: make(map[string]int),
+		peak:    make(map[string]int),
+		active:  make(map[string]int),
+		release: make(chan struct{}),
+		started: make(chan pgtype.UUID, 100),
+	}
+}
+
+func (f *fakeFetcher) Refresh(ctx context.Context, sourceID pgtype.UUID, platform, externalID string, lastFetchedAt pgtype.Timestamptz) error {
+	f.mu.Lock()
+	f.calls[sourceID]++
+	if platform == "youtube" {
+		f.buckets[youtube.BucketFromContext(ctx)]++
+	}
+	f.active[platform]++
+	f.peak[platform] = max(f.peak[platform], f.active[platform])
+	f.mu.Unlock()
+	f.started <- sourceID
+
+	var err error
+	select {
+	case <-f.release:
+		err = f.err
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	f.mu.Lock()
+	f.active[platform]--
+	f.mu.Unlock()
+	return err
+}
+
+func (f *fakeFetcher) callCount(id pgtype.UUID) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[id]
+}
+
+// fakeQuota は枠ごとの残りの予算を固定値で返す
+type fakeQuota struct {
+	budgets map[string]int
+}
+
+func (q *fakeQuota) CanSpend(bucket string, cost int) bool {
+	return q.budgets[bucket] >= cost
+}
+
+func source(id byte, externalID string) db.GetSourcesByPriorityRow {
+	return db.GetSourcesByPriorityRow{
+		ID:         pgtype.UUID{Bytes: [16]byte{id}, Valid: true},
+		ExternalID: externalID,
+	}
+}
+
+// waitStarted はn件の更新が始まるまで待つ
+func waitStarted(t *testing.T, f *fakeFetcher, n int) []pgtype.UUID {
+	t.Helper()
+	var ids []pgtype.UUID
+	for range n {
+		select {
+		case id := <-f.started:
+			ids = append(ids, id)
+		case <-time.After(time.Second):
+			t.Fatalf("only %d of %d refreshes started", len(ids), n)
+		}
+	}
+	return ids
+}
+
+// TestDispatchPoolLimit はプラットフォームごとのワーカー数と実行中のソースの重複実行のテスト
+func TestDispatchPoolLimit(t *testing.T) {
+	store := &fakeStore{sources: map[string][]db.GetSourcesByPriorityRow{
+		"podcast": {source(1, "a"), source(2, "b"), source(3, "c")},
+	}}
+	fetcher := newFakeFetcher()
+	s := New(store, fetcher, nil, Config{Pools: map[string]int{"podcast": 2}})
+	ctx := context.Background()
+	s.start(ctx)
+
+	s.dispatch(ctx)
+	started := waitStarted(t, fetcher, 2)
+	if started[0] == source(3, "c").ID || started[1] == source(3, "c").ID {
+		t.Errorf("lower priority source started before higher priority ones: %v", started)
+	}
+
+	// ワーカーが空いていないので何も割り当てない
+	s.dispatch(ctx)
+	select {
+	case id := <-fetcher.started:
+		t.Fatalf("unexpected refresh while pool is full: %v", id)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(fetcher.release)
+	if !s.stop(time.Second) {
+		t.Fatal("stop timed out")
+	}
+	if got := fetcher.peak["podcast"]; got != 2 {
+		t.Errorf("peak concurrency = %d, want 2", got)
+	}
+	for _, id := range started {
+		if got := fetcher.callCount(id); got != 1 {
+			t.Errorf("source %v refreshed %d times, want 1", id, got)
+		}
+	}
+}
+
+// TestDispatchSkipsInFlight は実行中のソースを再度割り当てないことのテスト
+func TestDispatchSkipsInFlight(t *testing.T) {
+	store := &fakeStore{sources: map[string][]db.GetSourcesByPriorityRow{
+		"twitch": {source(1, "a"), source(2, "b")},
+	}}
+	fetcher := newFakeFetcher()
+	s := New(store, fetcher, nil, Config{Pools: map[string]int{"twitch": 2}})
+	ctx := context.Background()
+	s.start(ctx)
+
+	// 1件目だけ更新間隔を過ぎている状態で割り当てる
+	store.sources["twitch"] = []db.GetSourcesByPriorityRow{source(1, "a")}
+	s.dispatch(ctx)
+	waitStarted(t, fetcher, 1)
+
+	// 実行中の1件目は更新間隔を過ぎたまま返るが、2件目だけを割り当てる
+	store.sources["twitch"] = []db.GetSourcesByPriorityRow{source(1, "a"), source(2, "b")}
+	s.dispatch(ctx)
+	started := waitStarted(t, fetcher, 1)
+	if started[0] != source(2, "b").ID {
+		t.Errorf("started %v, want source 2", started[0])
+	}
+
+	close(fetcher.release)
+	s.stop(time.Second)
+	if got := fetcher.callCount(source(1, "a").ID); got != 1 {
+		t.Errorf("in-flight source refreshed %d times, want 1", got)
+	}
+}
+
+// TestDispatchQuota はYouTube API Quotaの優先度の予算が足りない場合にその優先度のYouTubeだけを見送ることのテスト
+func TestDispatchQuota(t *testing.T) {
+	tests := []struct {
+		name        string
+		budgets     map[string]int
+		wantYouTube int
+		wantBuckets map[string]int // 枠ごとの更新数
+	}{
+		{
+			name:        "enough quota",
+			budgets:     map[string]int{"high": 100, "low": 100},
+			wantYouTube: 3,
+			wantBuckets: map[string]int{"high": 2, "low": 1},
+		},
+		{
+			name:        "quota for one source",
+			budgets:     map[string]int{"high": 5, "low": 100},
+			wantYouTube: 2,
+			wantBuckets: map[string]int{"high": 1, "low": 1},
+		},
+		{
+			name:        "low priority budget exhausted",
+			budgets:     map[string]int{"high": 100},
+			wantYouTube: 2,
+			wantBuckets: map[string]int{"high": 2},
+		},
+		{
+			name:        "quota exhausted",
+			budgets:     map[string]int{},
+			wantYouTube: 0,
+			wantBuckets: map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 不明な優先度は低優先度の枠で確認する
+			youtubeSources := []db.GetSourcesByPriorityRow{source(1, "UC1"), source(2, "UC2"), source(3, "UC3")}
+			youtubeSources[0].PriorityLevel = "high"
+			youtubeSources[1].PriorityLevel = "high"
+			youtubeSources[2].PriorityLevel = "unknown"
+			store := &fakeStore{sources: map[string][]db.GetSourcesByPriorityRow{
+				"youtube": youtubeSources,
+				"podcast": {source(4, "feed")},
+			}}
+			fetcher := newFakeFetcher()
+			quota := &fakeQuota{budgets: tt.budgets}
+			s := New(store, fetcher, quota, Config{
+				Pools: map[string]int{"youtube": 3, "podcast": 1},
+			})
+			ctx := context.Background()
+			s.start(ctx)
+
+			s.dispatch(ctx)
+			waitStarted(t, fetcher, tt.wantYouTube+1)
+			close(fetcher.release)
+			s.stop(time.Second)
+
+			youtubeCalls := 0
+			for id := byte(1); id <= 3; id++ {
+				youtubeCalls += fetcher.callCount(source(id, "").ID)
+			}
+			if youtubeCalls != tt.wantYouTube {
+				t.Errorf("youtube refreshes = %d, want %d", youtubeCalls, tt.wantYouTube)
+			}
+			if got := fetcher.callCount(source(4, "feed").ID); got != 1 {
+				t.Errorf("podcast refreshes = %d, want 1", got)
+			}
+			// 使用量はYouTubeクライアントがctxの枠に記録する
+			if !maps.Equal(fetcher.buckets, tt.wantBuckets) {
+				t.Errorf("refreshes by quota bucket = %v, want %v", fetcher.buckets, tt.wantBuckets)
+			}
+		})
+	}
+}
+
+// TestDispatchFailureBackoff は更新に失敗したソースを待ち時間が過ぎるまで再実行しないことのテスト
+func TestDispatchFailureBackoff(t *testing.T) {
+	store := &fakeStore{sources: map[string][]db.GetSourcesByPriorityRow{
+		"radiko": {source(1, "TBS")},
+	}}
+	fetcher := newFakeFetcher()
+	fetcher.err = errors.New("temporary failure")
+	close(fetcher.release)
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	s := New(store, fetcher, nil, Config{Pools: map[string]int{"radiko": 1}})
+	var mu sync.Mutex
+	s.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+	ctx := context.Background()
+	s.start(ctx)
+
+	// waitIdle は実行中の更新が終わるまで待つ
+	waitIdle := func() {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for {
+			s.mu.Lock()
+			busy := s.pools["radiko"].busy
+			s.mu.Unlock()
+			if busy == 0 {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatal("refresh did not finish")
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	s.dispatch(ctx)
+	waitStarted(t, fetcher, 1)
+	waitIdle()
+
+	// 待ち時間（15分）内は再実行しない
+	advance(10 * time.Minute)
+	s.dispatch(ctx)
+	waitIdle()
+	if got := fetcher.callCount(source(1, "TBS").ID); got != 1 {
+		t.Fatalf("refreshes during backoff = %d, want 1", got)
+	}
+
+	// 待ち時間を過ぎたら再実行する
+	advance(10 * time.Minute)
+	s.dispatch(ctx)
+	waitStarted(t, fetcher, 1)
+	waitIdle()
+	if got := fetcher.callCount(source(1, "TBS").ID); got != 2 {
+		t.Fatalf("refreshes after backoff = %d, want 2", got)
+	}
+
+	s.mu.Lock()
+	f := s.failures[source(1, "TBS").ID]
+	s.mu.Unlock()
+	if f.count != 2 || !f.until.Equal(now.Add(30*time.Minute)) {
+		t.Errorf("failure = %+v, want count 2 until +30m", f)
+	}
+	s.stop(time.Second)
+}
+
+// TestStop は停止時に実行中の更新の完了を待つことのテスト
+func TestStop(t *testing.T) {
+	tests := []struct {
+		name      string
+		finish    bool
+		wantClean bool
+	}{
+		{name: "waits for running refresh", finish: true, wantClean: true},
+		{name: "times out on stuck refresh", finish: false, wantClean: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakeStore{sources: map[string][]db.GetSourcesByPriorityRow{
+				"podcast": {source(1, "feed")},
+			}}
+			fetcher := newFakeFetcher()
+			s := New(store, fetcher, nil, Config{Pools: map[string]int{"podcast": 1}})
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			s.start(ctx)
+
+			s.dispatch(ctx)
+			waitStarted(t, fetcher, 1)
+			if tt.finish {
+				time.AfterFunc(20*time.Millisecond, func() { close(fetcher.release) })
+			}
+
+			if got := s.stop(200 * time.Millisecond); got != tt.wantClean {
+				t.Errorf("stop() = %v, want %v", got, tt.wantClean)
+			}
+			// タイムアウトした場合は実行中の更新をキャンセルすると終わる
+			cancel()
+			s.wg.Wait()
+		})
+	}
+}
+
+// TestRun はRunがctxのキャンセルで実行中の更新を待ってから返ることのテスト
+func TestRun(t *testing.T) {
+	store := &fakeStore{sources: map[string][]db.GetSourcesByPriorityRow{
+		"podcast": {source(1, "feed")},
+	}}
+	fetcher := newFakeFetcher()
+	s := New(store, fetcher, nil, Config{
+		Pools:           map[string]int{"podcast": 1},
+		PollInterval:    time.Hour,
+		ShutdownTimeout: time.Second,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+	waitStarted(t, fetcher, 1)
+
+	cancel()
+	select {
+	case <-done:
+		t.Fatal("Run returned before the running refresh finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(fetcher.release)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return")
+	}
+	if got := fetcher.callCount(source(1, "feed").ID); got != 1 {
+		t.Errorf("refreshes = %d, want 1", got)
+	}
+}
+
+// TestFailureDelay は失敗回数に応じた再実行までの待ち時間のテスト
+func TestFailureDelay(t *testing.T) {
+	tests := []struct {
+		count int
+		want  time.Duration
+	}{
+		{count: 1, want: 15 * time.Minute},
+		{count: 2, want: 30 * time.Minute},
+		{count: 3, want: time.Hour},
+		{count: 5, want: 4 * time.Hour},
+		{count: 6, want: 6 * time.Hour},
+		{count: 100, want: 6 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := failureDelay(tt.count); got != tt.want {
+			t.Errorf("failureDelay(%d) = %s, want %s", tt.count, got, tt.want)
+		}
+	}
+}
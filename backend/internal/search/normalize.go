@@ -0,0 +1,74 @@
+package search
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxTerms は1回の検索で使う検索語の最大数
+const maxTerms = 5
+
+// Normalize は検索用に文字列を正規化する
+// NFKC（全角英数・半角カナの統一）、小文字化、カタカナ→ひらがな、空白の統一を行う
+// events.search_text の生成と検索語の正規化の両方で使う
+func Normalize(s string) string {
+	runes, _ := normalizeRunes(s)
+	return strings.TrimSpace(string(runes))
+}
+
+// Terms は検索クエリを正規化して空白区切りの検索語に分割する
+func Terms(query string) []string {
+	var terms []string
+	for _, t := range strings.Fields(Normalize(query)) {
+		if len(terms) >= maxTerms {
+			break
+		}
+		if !slices.Contains(terms, t) {
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// normalizeRunes は正規化後の文字列と、各文字が元の文字列の何文字目に対応するかを返す
+// スニペットのハイライト位置を元の文字列に戻すために使う
+func normalizeRunes(s string) ([]rune, []int) {
+	var out []rune
+	var offsets []int
+	for i, r := range []rune(s) {
+		for _, nr := range norm.NFKC.String(string(r)) {
+			// 半角カナの濁点・半濁点は直前の文字と合成する（ｶﾞ → ガ）
+			if (nr == '゙' || nr == '゚') && len(out) > 0 {
+				composed := []rune(norm.NFC.String(string(out[len(out)-1]) + string(nr)))
+				if len(composed) == 1 {
+					out[len(out)-1] = foldRune(composed[0])
+					continue
+				}
+			}
+
+			nr = foldRune(nr)
+			// 連続する空白は1つにまとめる
+			if nr == ' ' && len(out) > 0 && out[len(out)-1] == ' ' {
+				continue
+			}
+			out = append(out, nr)
+			offsets = append(offsets, i)
+		}
+	}
+	return out, offsets
+}
+
+// foldRune は1文字を小文字・ひらがな・半角空白に揃える
+func foldRune(r rune) rune {
+	switch {
+	case unicode.IsSpace(r):
+		return ' '
+	case r >= 'ァ' && r <= 'ヶ':
+		return r - ('ァ' - 'ぁ')
+	default:
+		return unicode.ToLower(r)
+	}
+}
@@ -0,0 +1,96 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestNormalize は検索用の正規化のテスト
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "Full-width alphanumerics", input: "ＰＯＤＣＡＳＴ　２０２５", want: "podcast 2025"},
+		{name: "Katakana to hiragana", input: "ポッドキャスト", want: "ぽっどきゃすと"},
+		{name: "Half-width katakana with dakuten", input: "ｶﾞｼﾞｪｯﾄ", want: "がじぇっと"},
+		{name: "Mixed kanji and spaces", input: "  深夜  ラジオ\n特集 ", want: "深夜 らじお 特集"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.input); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTerms は検索語の分割のテスト
+func TestTerms(t *testing.T) {
+	got := Terms("ラジオ　らじお Podcast  podcast")
+	want := []string{"らじお", "podcast"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Terms() = %v, want %v", got, want)
+	}
+}
+
+// TestSnippet はハイライト付きスニペットのテスト
+func TestSnippet(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		maxRunes int
+		want     []Segment
+	}{
+		{
+			name:  "Kana-insensitive match keeps original text",
+			text:  "今週のポッドキャスト配信",
+			terms: []string{"ぽっどきゃすと"},
+			want: []Segment{
+				{Text: "今週の"},
+				{Text: "ポッドキャスト", Highlighted: true},
+				{Text: "配信"},
+			},
+		},
+		{
+			name:  "Full-width and case-insensitive match",
+			text:  "ＡＩ特集 ai news",
+			terms: []string{"ai"},
+			want: []Segment{
+				{Text: "ＡＩ", Highlighted: true},
+				{Text: "特集 "},
+				{Text: "ai", Highlighted: true},
+				{Text: " news"},
+			},
+		},
+		{
+			name:     "Window around first match",
+			text:     "0123456789ラジオ0123456789",
+			terms:    []string{"らじお"},
+			maxRunes: 8,
+			want: []Segment{
+				{Text: "…89"},
+				{Text: "ラジオ", Highlighted: true},
+				{Text: "012…"},
+			},
+		},
+		{
+			name:  "No match",
+			text:  "雑談配信",
+			terms: []string{"ゲーム"},
+			want:  []Segment{{Text: "雑談配信"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Snippet(tt.text, tt.terms, tt.maxRunes)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Snippet() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
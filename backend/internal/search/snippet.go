@@ -0,0 +1,88 @@
+package search
+
+// Segment はスニペットの一部分（ハイライトの有無つき）
+type Segment struct {
+	Text        string
+	Highlighted bool
+}
+
+// Snippet は検索語にマッチした箇所をハイライトしたスニペットを作成する
+// maxRunes が0より大きい場合は最初のマッチ周辺を最大 maxRunes 文字で切り出す
+func Snippet(text string, terms []string, maxRunes int) []Segment {
+	if text == "" {
+		return nil
+	}
+	original := []rune(text)
+	normalized, offsets := normalizeRunes(text)
+
+	// マッチ箇所を元の文字列の位置で収集（重複・隣接は結合）
+	marks := make([]bool, len(original))
+	first := -1
+	for _, term := range terms {
+		t := []rune(term)
+		for i := 0; i+len(t) <= len(normalized) && len(t) > 0; i++ {
+			if !hasPrefix(normalized[i:], t) {
+				continue
+			}
+			start := offsets[i]
+			end := offsets[i+len(t)-1] + 1
+			for j := start; j < end; j++ {
+				marks[j] = true
+			}
+			if first == -1 || start < first {
+				first = start
+			}
+		}
+	}
+
+	// 切り出し範囲を決める（マッチ箇所が前方1/4あたりに来るようにする）
+	from, to := 0, len(original)
+	if maxRunes > 0 && len(original) > maxRunes {
+		if first > maxRunes/4 {
+			from = first - maxRunes/4
+		}
+		to = from + maxRunes
+		if to > len(original) {
+			to = len(original)
+			from = to - maxRunes
+		}
+	}
+
+	var segments []Segment
+	if from > 0 {
+		segments = append(segments, Segment{Text: "…"})
+	}
+	for i := from; i < to; {
+		j := i
+		for j < to && marks[j] == marks[i] {
+			j++
+		}
+		segments = appendSegment(segments, Segment{Text: string(original[i:j]), Highlighted: marks[i]})
+		i = j
+	}
+	if to < len(original) {
+		segments = appendSegment(segments, Segment{Text: "…"})
+	}
+	return segments
+}
+
+// appendSegment はハイライトの有無が同じ隣接セグメントを結合しながら追加する
+func appendSegment(segments []Segment, s Segment) []Segment {
+	if n := len(segments); n > 0 && segments[n-1].Highlighted == s.Highlighted {
+		segments[n-1].Text += s.Text
+		return segments
+	}
+	return append(segments, s)
+}
+
+func hasPrefix(s, prefix []rune) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if s[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
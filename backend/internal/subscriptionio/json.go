@@ -0,0 +1,83 @@
+package subscriptionio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// jsonVersion はPixicast JSON形式のバージョン
+const jsonVersion = 1
+
+// Pixicast JSON形式の構造体
+type jsonDoc struct {
+	Version       int                `json:"version"`
+	Subscriptions []jsonSubscription `json:"subscriptions"`
+}
+
+type jsonSubscription struct {
+	Platform    string   `json:"platform"`
+	ChannelID   string   `json:"channel_id"`
+	Handle      string   `json:"handle,omitempty"`
+	DisplayName string   `json:"display_name,omitempty"`
+	Enabled     *bool    `json:"enabled,omitempty"` // 省略時は有効
+	IsFavorite  bool     `json:"is_favorite"`
+	Priority    int32    `json:"priority"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// parseJSON はPixicast JSON形式を読み込む
+// channel_idがない場合はhandleを登録に使う
+func parseJSON(r io.Reader) ([]Entry, error) {
+	var doc jsonDoc
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if doc.Version > jsonVersion {
+		return nil, fmt.Errorf("unsupported version: %d", doc.Version)
+	}
+
+	entries := make([]Entry, 0, len(doc.Subscriptions))
+	for _, s := range doc.Subscriptions {
+		input := s.ChannelID
+		if input == "" {
+			input = s.Handle
+		}
+		entries = append(entries, Entry{
+			Platform:   s.Platform,
+			Input:      input,
+			Handle:     s.Handle,
+			Title:      s.DisplayName,
+			Enabled:    s.Enabled == nil || *s.Enabled,
+			IsFavorite: s.IsFavorite,
+			Priority:   s.Priority,
+			Tags:       s.Tags,
+		})
+	}
+	return entries, nil
+}
+
+// writeJSON はすべての購読をPixicast JSON形式で書き出す
+func writeJSON(w io.Writer, entries []Entry) error {
+	doc := jsonDoc{
+		Version:       jsonVersion,
+		Subscriptions: make([]jsonSubscription, 0, len(entries)),
+	}
+	for _, e := range entries {
+		enabled := e.Enabled
+		doc.Subscriptions = append(doc.Subscriptions, jsonSubscription{
+			Platform:    e.Platform,
+			ChannelID:   e.Input,
+			Handle:      e.Handle,
+			DisplayName: e.Title,
+			Enabled:     &enabled,
+			IsFavorite:  e.IsFavorite,
+			Priority:    e.Priority,
+			Tags:        e.Tags,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
@@ -0,0 +1,174 @@
+package subscriptionio
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// YouTubeのチャンネルのフィードURL・チャンネルURL
+const (
+	youtubeFeedURL    = "https://www.youtube.com/feeds/videos.xml?channel_id="
+	youtubeChannelURL = "https://www.youtube.com/channel/"
+)
+
+// OPML 2.0 の出力用構造体
+type opmlDoc struct {
+	XMLName xml.Name `xml:"opml"`
+	Version string   `xml:"version,attr"`
+	Title   string   `xml:"head>title"`
+	Body    opmlBody `xml:"body"`
+}
+
+// opmlBody は出力するoutlineがない場合もbody要素を出力するための構造体
+type opmlBody struct {
+	Outlines []opmlOutput `xml:"outline"`
+}
+
+type opmlOutput struct {
+	Text     string `xml:"text,attr"`
+	Title    string `xml:"title,attr,omitempty"`
+	Type     string `xml:"type,attr"`
+	XMLURL   string `xml:"xmlUrl,attr"`
+	HTMLURL  string `xml:"htmlUrl,attr,omitempty"`
+	Category string `xml:"category,attr,omitempty"`
+}
+
+// opmlInput は読み込み用のoutline
+// 属性名の大文字・小文字がアプリによって異なる（xmlUrl / xmlurl）ため属性はまとめて受け取る
+type opmlInput struct {
+	Attrs    []xml.Attr  `xml:",any,attr"`
+	Outlines []opmlInput `xml:"outline"`
+}
+
+// attr は属性の値を大文字・小文字を区別せずに取得
+func (o opmlInput) attr(name string) string {
+	for _, a := range o.Attrs {
+		if strings.EqualFold(a.Name.Local, name) {
+			return strings.TrimSpace(a.Value)
+		}
+	}
+	return ""
+}
+
+// parseOPML はOPMLのフィードを購読として読み込む
+// フォルダ（xmlUrlのないoutline）の名前とcategory属性はタグとして扱う
+func parseOPML(r io.Reader) ([]Entry, error) {
+	var doc struct {
+		Body []opmlInput `xml:"body>outline"`
+	}
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid OPML: %w", err)
+	}
+
+	var entries []Entry
+	var walk func(outlines []opmlInput, folders []string)
+	walk = func(outlines []opmlInput, folders []string) {
+		for _, o := range outlines {
+			feedURL := o.attr("xmlUrl")
+			if feedURL == "" {
+				// フォルダ
+				name := o.attr("text")
+				if name == "" {
+					name = o.attr("title")
+				}
+				next := folders
+				if name != "" {
+					next = append(append([]string(nil), folders...), name)
+				}
+				walk(o.Outlines, next)
+				continue
+			}
+
+			title := o.attr("title")
+			if title == "" {
+				title = o.attr("text")
+			}
+			e := Entry{
+				Platform: "podcast",
+				Input:    feedURL,
+				Title:    title,
+				Enabled:  true,
+				Tags:     appendTags(folders, o.attr("category")),
+			}
+			if channelID := youtubeFeedChannelID(feedURL); channelID != "" {
+				e.Platform = "youtube"
+				e.Input = channelID
+			}
+			entries = append(entries, e)
+		}
+	}
+	walk(doc.Body, nil)
+	return entries, nil
+}
+
+// appendTags はフォルダ名にcategory属性（"/News,/Radio" の形式）のタグを重複なく追加
+func appendTags(folders []string, category string) []string {
+	var tags []string
+	tags = append(tags, folders...)
+	for _, c := range strings.Split(category, ",") {
+		c = strings.TrimSpace(strings.Trim(strings.TrimSpace(c), "/"))
+		if c == "" || slices.Contains(tags, c) {
+			continue
+		}
+		tags = append(tags, c)
+	}
+	return tags
+}
+
+// youtubeFeedChannelID はYouTubeのチャンネルフィードURLからチャンネルIDを取り出す（それ以外は空文字）
+func youtubeFeedChannelID(feedURL string) string {
+	u, err := url.Parse(feedURL)
+	if err != nil {
+		return ""
+	}
+	host := strings.TrimPrefix(u.Hostname(), "www.")
+	if host != "youtube.com" || u.Path != "/feeds/videos.xml" {
+		return ""
+	}
+	return u.Query().Get("channel_id")
+}
+
+// writeOPML はYouTubeとPodcastの購読をOPML 2.0で書き出す（タグはcategory属性。タグ名の「,」は区切り文字のため除く）
+func writeOPML(w io.Writer, entries []Entry) error {
+	doc := opmlDoc{Version: "2.0", Title: "Pixicast Subscriptions"}
+	for _, e := range entries {
+		o := opmlOutput{
+			Text:  e.Title,
+			Title: e.Title,
+			Type:  "rss",
+		}
+		switch e.Platform {
+		case "youtube":
+			o.XMLURL = youtubeFeedURL + url.QueryEscape(e.Input)
+			o.HTMLURL = youtubeChannelURL + e.Input
+		case "podcast":
+			o.XMLURL = e.Input
+		default:
+			continue
+		}
+		if o.Text == "" {
+			o.Text = o.XMLURL
+		}
+		var categories []string
+		for _, tag := range e.Tags {
+			categories = append(categories, "/"+strings.ReplaceAll(tag, ",", ""))
+		}
+		o.Category = strings.Join(categories, ",")
+		doc.Body.Outlines = append(doc.Body.Outlines, o)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,90 @@
+package subscriptionio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// 一括登録・書き出しのファイル形式
+const (
+	FormatOPML    = "opml"    // OPML（Podcastアプリ・RSSリーダー。YouTubeのチャンネルフィードも扱う）
+	FormatTakeout = "takeout" // Google TakeoutのYouTube登録チャンネル（subscriptions.csv）
+	FormatJSON    = "json"    // Pixicast独自のJSON形式（すべてのプラットフォームと購読の設定を含む）
+)
+
+// Entry はファイル内の1件の購読
+type Entry struct {
+	Platform   string // youtube / twitch / podcast / radiko
+	Input      string // 登録に使う値（POST /v1/subscriptions のinputと同じ。チャンネルID・フィードURLなど）
+	Handle     string
+	Title      string
+	Enabled    bool
+	IsFavorite bool
+	Priority   int32
+	Tags       []string // タグ名（OPMLではフォルダ・category、JSONではtags）
+}
+
+// format はファイル形式ごとの読み込み・書き出し処理
+type format struct {
+	parse       func(io.Reader) ([]Entry, error)
+	write       func(io.Writer, []Entry) error
+	contentType string
+	fileName    string
+}
+
+var formats = map[string]format{
+	FormatOPML:    {parseOPML, writeOPML, "text/x-opml; charset=utf-8", "pixicast-subscriptions.opml"},
+	FormatTakeout: {parseTakeout, writeTakeout, "text/csv; charset=utf-8", "subscriptions.csv"},
+	FormatJSON:    {parseJSON, writeJSON, "application/json; charset=utf-8", "pixicast-subscriptions.json"},
+}
+
+// utf8BOM はExcelなどが付けるUTF-8のBOM
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// IsFormat はサポートしているファイル形式かどうか
+func IsFormat(name string) bool {
+	_, ok := formats[name]
+	return ok
+}
+
+// DetectFormat はファイルの内容から形式を推定（JSON → OPML → CSVの順）
+func DetectFormat(data []byte) string {
+	data = bytes.TrimSpace(bytes.TrimPrefix(data, utf8BOM))
+	switch {
+	case bytes.HasPrefix(data, []byte("{")):
+		return FormatJSON
+	case bytes.HasPrefix(data, []byte("<")):
+		return FormatOPML
+	default:
+		return FormatTakeout
+	}
+}
+
+// Parse はファイルを読み込んで購読の一覧を返す（ファイル内の順序を保つ）
+func Parse(name string, r io.Reader) ([]Entry, error) {
+	f, ok := formats[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported format: %q", name)
+	}
+	return f.parse(r)
+}
+
+// Write は購読の一覧を書き出す（形式が扱えないプラットフォームの購読は含めない）
+func Write(name string, w io.Writer, entries []Entry) error {
+	f, ok := formats[name]
+	if !ok {
+		return fmt.Errorf("unsupported format: %q", name)
+	}
+	return f.write(w, entries)
+}
+
+// ContentType は書き出したファイルのContent-Type
+func ContentType(name string) string {
+	return formats[name].contentType
+}
+
+// FileName は書き出したファイルのデフォルトのファイル名
+func FileName(name string) string {
+	return formats[name].fileName
+}
@@ -0,0 +1,223 @@
+package subscriptionio
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// update を指定するとゴールデンファイルを現在の出力で更新する（go test ./internal/subscriptionio -update）
+var update = flag.Bool("update", false, "update golden files")
+
+// assertGolden は出力をtestdata配下のゴールデンファイルと比較
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s mismatch\n--- got ---\n%s\n--- want ---\n%s", name, got, want)
+	}
+}
+
+// testEntries は書き出しテスト用の購読
+var testEntries = []Entry{
+	{Platform: "youtube", Input: "UCx1nAvtVDIsaGmCMSe8ofsQ", Handle: "@pixicast", Title: "Pixicast Channel", Enabled: true, IsFavorite: true, Priority: 3, Tags: []string{"VTubers"}},
+	{Platform: "twitch", Input: "141981764", Handle: "twitchdev", Title: "TwitchDev", Enabled: false},
+	{Platform: "podcast", Input: "https://example.com/feed.xml?a=1&b=2", Title: "通勤ポッドキャスト", Enabled: true, Tags: []string{"Podcasts to commute", "News, radio"}},
+	{Platform: "radiko", Input: "TBS", Handle: "TBS", Title: "TBSラジオ", Enabled: true, Tags: []string{"News, radio"}},
+}
+
+// TestWrite は各形式の書き出しのゴールデンテスト
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		format string
+		golden string
+	}{
+		{format: FormatOPML, golden: "subscriptions.opml"},
+		{format: FormatTakeout, golden: "subscriptions.csv"},
+		{format: FormatJSON, golden: "subscriptions.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(tt.format, &buf, testEntries); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			assertGolden(t, tt.golden, buf.Bytes())
+			if got := DetectFormat(buf.Bytes()); got != tt.format {
+				t.Errorf("DetectFormat() = %q, want %q", got, tt.format)
+			}
+		})
+	}
+}
+
+// TestRoundTrip は書き出したファイルを読み込んで同じ購読に戻ることのテスト
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		format string
+		want   []Entry
+	}{
+		{
+			format: FormatOPML,
+			want: []Entry{
+				{Platform: "youtube", Input: "UCx1nAvtVDIsaGmCMSe8ofsQ", Title: "Pixicast Channel", Enabled: true, Tags: []string{"VTubers"}},
+				{Platform: "podcast", Input: "https://example.com/feed.xml?a=1&b=2", Title: "通勤ポッドキャスト", Enabled: true, Tags: []string{"Podcasts to commute", "News radio"}},
+			},
+		},
+		{
+			format: FormatTakeout,
+			want: []Entry{
+				{Platform: "youtube", Input: "UCx1nAvtVDIsaGmCMSe8ofsQ", Title: "Pixicast Channel", Enabled: true},
+			},
+		},
+		{
+			format: FormatJSON,
+			want:   testEntries,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(tt.format, &buf, testEntries); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			got, err := Parse(tt.format, &buf)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParse は他のアプリが書き出したファイルの読み込みのテスト
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		input   string
+		want    []Entry
+		wantErr bool
+	}{
+		{
+			name:   "OPML folders and lowercase attributes",
+			format: FormatOPML,
+			input: `<?xml version="1.0"?>
+<opml version="1.1"><head><title>Podcasts</title></head><body>
+  <outline text="News">
+    <outline text="Morning" type="rss" xmlurl="https://example.com/morning.rss"/>
+    <outline title="Radio">
+      <outline title="Evening" text="ignored" xmlUrl="https://example.com/evening.rss" category="/News,/Talk"/>
+    </outline>
+  </outline>
+  <outline text="Tech" xmlUrl="https://www.youtube.com/feeds/videos.xml?channel_id=UCabc"/>
+</body></opml>`,
+			want: []Entry{
+				{Platform: "podcast", Input: "https://example.com/morning.rss", Title: "Morning", Enabled: true, Tags: []string{"News"}},
+				{Platform: "podcast", Input: "https://example.com/evening.rss", Title: "Evening", Enabled: true, Tags: []string{"News", "Radio", "Talk"}},
+				{Platform: "youtube", Input: "UCabc", Title: "Tech", Enabled: true},
+			},
+		},
+		{
+			name:    "Broken OPML",
+			format:  FormatOPML,
+			input:   `<opml><body><outline text="a">`,
+			wantErr: true,
+		},
+		{
+			name:   "Japanese Takeout with BOM",
+			format: FormatTakeout,
+			input:  "\xef\xbb\xbfチャンネル ID,チャンネルの URL,チャンネルのタイトル\nUC111,http://www.youtube.com/channel/UC111,\"歌, ゲーム\"\n\n,https://www.youtube.com/@bob,Bob\n",
+			want: []Entry{
+				{Platform: "youtube", Input: "UC111", Title: "歌, ゲーム", Enabled: true},
+				{Platform: "youtube", Input: "https://www.youtube.com/@bob", Title: "Bob", Enabled: true},
+			},
+		},
+		{
+			name:   "Takeout without header",
+			format: FormatTakeout,
+			input:  "UC222,http://www.youtube.com/channel/UC222,Carol\n",
+			want: []Entry{
+				{Platform: "youtube", Input: "UC222", Title: "Carol", Enabled: true},
+			},
+		},
+		{
+			name:    "Broken CSV",
+			format:  FormatTakeout,
+			input:   "Channel Id,Channel Url,Channel Title\nUC333,\"unterminated\n",
+			wantErr: true,
+		},
+		{
+			name:   "JSON handle only and default enabled",
+			format: FormatJSON,
+			input:  `{"version":1,"subscriptions":[{"platform":"youtube","handle":"@alice"}]}`,
+			want: []Entry{
+				{Platform: "youtube", Input: "@alice", Handle: "@alice", Enabled: true},
+			},
+		},
+		{
+			name:    "JSON future version",
+			format:  FormatJSON,
+			input:   `{"version":2,"subscriptions":[]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Unknown format",
+			format:  "xlsx",
+			input:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.format, strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDetectFormat はファイル形式の推定のテスト
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "JSON with BOM", input: "\xef\xbb\xbf  {\"version\":1}", want: FormatJSON},
+		{name: "OPML", input: "\n<?xml version=\"1.0\"?><opml/>", want: FormatOPML},
+		{name: "CSV", input: "Channel Id,Channel Url,Channel Title\n", want: FormatTakeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectFormat([]byte(tt.input)); got != tt.want {
+				t.Errorf("DetectFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
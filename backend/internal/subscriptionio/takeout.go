@@ -0,0 +1,86 @@
+package subscriptionio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// takeoutHeader はTakeoutのsubscriptions.csvのヘッダー（英語版）
+// 日本語版は「チャンネル ID,チャンネルの URL,チャンネルのタイトル」で、列の順序は同じ
+var takeoutHeader = []string{"Channel Id", "Channel Url", "Channel Title"}
+
+// parseTakeout はGoogle TakeoutのYouTube登録チャンネル（subscriptions.csv）を読み込む
+// 列はチャンネルID・チャンネルURL・タイトルの順。ヘッダーの言語によらず1行目がチャンネルIDでなければヘッダーとして読み飛ばす
+func parseTakeout(r io.Reader) ([]Entry, error) {
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(len(utf8BOM)); err == nil && bytes.Equal(bom, utf8BOM) {
+		br.Discard(len(utf8BOM))
+	}
+
+	cr := csv.NewReader(br)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var entries []Entry
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+
+		var channelID, channelURL, title string
+		if len(record) > 0 {
+			channelID = strings.TrimSpace(record[0])
+		}
+		if len(record) > 1 {
+			channelURL = strings.TrimSpace(record[1])
+		}
+		if len(record) > 2 {
+			title = strings.TrimSpace(record[2])
+		}
+		if line == 1 && !strings.HasPrefix(channelID, "UC") && !strings.Contains(channelURL, "youtube.com") {
+			continue
+		}
+		if channelID == "" && channelURL == "" {
+			continue
+		}
+
+		input := channelID
+		if input == "" {
+			input = channelURL
+		}
+		entries = append(entries, Entry{
+			Platform: "youtube",
+			Input:    input,
+			Title:    title,
+			Enabled:  true,
+		})
+	}
+	return entries, nil
+}
+
+// writeTakeout はYouTubeの購読をTakeoutと同じ形式のCSVで書き出す
+func writeTakeout(w io.Writer, entries []Entry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(takeoutHeader); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.Platform != "youtube" {
+			continue
+		}
+		if err := cw.Write([]string{e.Input, "http://www.youtube.com/channel/" + e.Input, e.Title}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
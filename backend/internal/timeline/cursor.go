@@ -0,0 +1,128 @@
+package timeline
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// legacyCursorVersion は配信中フラグを含まない旧形式のカーソルのバージョン（読み込みのみ対応）
+const legacyCursorVersion byte = 1
+
+// legacyPriorityCursorVersion は配信中フラグを含まない旧形式の優先度順のカーソルのバージョン
+const legacyPriorityCursorVersion byte = 2
+
+// changeCursorVersion はWatchTimelineのカーソル形式のバージョン
+const changeCursorVersion byte = 3
+
+// cursorVersion はカーソル形式のバージョン（形式を変更したら上げる）
+const cursorVersion byte = 4
+
+// priorityCursorVersion は優先度順のカーソル形式のバージョン
+const priorityCursorVersion byte = 5
+
+// legacyCursorLen はバージョン(1) + 並び順の時刻(8) + イベントID(16) のバイト長
+const legacyCursorLen = 1 + 8 + 16
+
+// cursorLen は legacyCursorLen + 配信中フラグ(1) のバイト長
+const cursorLen = legacyCursorLen + 1
+
+// priorityCursorLen は cursorLen + 購読の優先度(4) のバイト長
+const priorityCursorLen = cursorLen + 4
+
+// changeCursorLen はバージョン(1) + 変更履歴の作成日時(8) + 変更履歴のID(8) のバイト長
+const changeCursorLen = 1 + 8 + 8
+
+// ErrInvalidCursor はカーソルが不正な場合のエラー
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor はタイムラインのページング位置（並び順の時刻・配信中かどうか・イベントID）
+// 優先度順の場合は購読の優先度も含む
+type Cursor struct {
+	SortTime   time.Time
+	IsLive     bool // 同じ時刻の番組は配信中を先に並べる
+	EventID    pgtype.UUID
+	ByPriority bool  // 優先度順のページング位置かどうか
+	Priority   int32 // ByPriorityの場合の購読の優先度
+}
+
+// Encode はカーソルをクライアントに渡す不透明な文字列に変換
+func (c Cursor) Encode() string {
+	buf := make([]byte, cursorLen, priorityCursorLen)
+	buf[0] = cursorVersion
+	// DBのtimestamptzはマイクロ秒精度のためマイクロ秒で保持
+	binary.BigEndian.PutUint64(buf[1:9], uint64(c.SortTime.UnixMicro()))
+	copy(buf[9:], c.EventID.Bytes[:])
+	if c.IsLive {
+		buf[legacyCursorLen] = 1
+	}
+	if c.ByPriority {
+		buf[0] = priorityCursorVersion
+		buf = binary.BigEndian.AppendUint32(buf, uint32(c.Priority))
+	}
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// DecodeCursor はEncodeで生成した文字列をカーソルに戻す
+func DecodeCursor(s string) (Cursor, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(buf) == 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	// 旧形式は配信中フラグなし（false）として読み込む
+	var priority []byte
+	switch {
+	case buf[0] == cursorVersion && len(buf) == cursorLen:
+	case buf[0] == priorityCursorVersion && len(buf) == priorityCursorLen:
+		priority = buf[cursorLen:]
+	case buf[0] == legacyCursorVersion && len(buf) == legacyCursorLen:
+	case buf[0] == legacyPriorityCursorVersion && len(buf) == legacyCursorLen+4:
+		priority = buf[legacyCursorLen:]
+	default:
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	c := Cursor{
+		SortTime: time.UnixMicro(int64(binary.BigEndian.Uint64(buf[1:9]))).UTC(),
+		EventID:  pgtype.UUID{Valid: true},
+	}
+	copy(c.EventID.Bytes[:], buf[9:legacyCursorLen])
+	if buf[0] == cursorVersion || buf[0] == priorityCursorVersion {
+		c.IsLive = buf[legacyCursorLen] == 1
+	}
+	if priority != nil {
+		c.ByPriority = true
+		c.Priority = int32(binary.BigEndian.Uint32(priority))
+	}
+	return c, nil
+}
+
+// ChangeCursor はWatchTimelineの再開位置（最後に配信した変更履歴の作成日時とID）
+type ChangeCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// Encode はカーソルをクライアントに渡す不透明な文字列に変換
+func (c ChangeCursor) Encode() string {
+	buf := make([]byte, changeCursorLen)
+	buf[0] = changeCursorVersion
+	binary.BigEndian.PutUint64(buf[1:9], uint64(c.CreatedAt.UnixMicro()))
+	binary.BigEndian.PutUint64(buf[9:], uint64(c.ID))
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// DecodeChangeCursor はChangeCursor.Encodeで生成した文字列をカーソルに戻す
+func DecodeChangeCursor(s string) (ChangeCursor, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(buf) != changeCursorLen || buf[0] != changeCursorVersion {
+		return ChangeCursor{}, ErrInvalidCursor
+	}
+	return ChangeCursor{
+		CreatedAt: time.UnixMicro(int64(binary.BigEndian.Uint64(buf[1:9]))).UTC(),
+		ID:        int64(binary.BigEndian.Uint64(buf[9:])),
+	}, nil
+}
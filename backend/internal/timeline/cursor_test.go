@@ -0,0 +1,162 @@
+package timeline
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TestCursorRoundTrip はカーソルのエンコード・デコードのテスト
+func TestCursorRoundTrip(t *testing.T) {
+	var id pgtype.UUID
+	if err := id.Scan("7f1c2f3e-8a4b-4c5d-9e6f-0a1b2c3d4e5f"); err != nil {
+		t.Fatalf("failed to build uuid: %v", err)
+	}
+	for _, live := range []bool{false, true} {
+		want := Cursor{
+			SortTime: time.Date(2025, 4, 1, 5, 0, 0, 123456000, time.UTC),
+			IsLive:   live,
+			EventID:  id,
+		}
+
+		got, err := DecodeCursor(want.Encode())
+		if err != nil {
+			t.Fatalf("DecodeCursor() unexpected error: %v", err)
+		}
+		if !got.SortTime.Equal(want.SortTime) {
+			t.Errorf("SortTime = %v, want %v", got.SortTime, want.SortTime)
+		}
+		if got.IsLive != want.IsLive {
+			t.Errorf("IsLive = %v, want %v", got.IsLive, want.IsLive)
+		}
+		if got.EventID != want.EventID {
+			t.Errorf("EventID = %v, want %v", got.EventID, want.EventID)
+		}
+	}
+}
+
+// TestDecodeLegacyCursor は配信中フラグを含まない旧形式のカーソルの読み込みのテスト
+func TestDecodeLegacyCursor(t *testing.T) {
+	var id pgtype.UUID
+	if err := id.Scan("7f1c2f3e-8a4b-4c5d-9e6f-0a1b2c3d4e5f"); err != nil {
+		t.Fatalf("failed to build uuid: %v", err)
+	}
+	sortTime := time.Date(2025, 4, 1, 5, 0, 0, 0, time.UTC)
+	legacy := func(version byte, priority ...byte) string {
+		buf := []byte{version}
+		buf = binary.BigEndian.AppendUint64(buf, uint64(sortTime.UnixMicro()))
+		buf = append(buf, id.Bytes[:]...)
+		return base64.RawURLEncoding.EncodeToString(append(buf, priority...))
+	}
+
+	tests := []struct {
+		name   string
+		cursor string
+		want   Cursor
+	}{
+		{
+			name:   "v1",
+			cursor: legacy(1),
+			want:   Cursor{SortTime: sortTime, EventID: id},
+		},
+		{
+			name:   "v2 priority",
+			cursor: legacy(2, 0, 0, 0, 7),
+			want:   Cursor{SortTime: sortTime, EventID: id, ByPriority: true, Priority: 7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeCursor(tt.cursor)
+			if err != nil {
+				t.Fatalf("DecodeCursor() unexpected error: %v", err)
+			}
+			if !got.SortTime.Equal(tt.want.SortTime) || got.IsLive || got.EventID != tt.want.EventID ||
+				got.ByPriority != tt.want.ByPriority || got.Priority != tt.want.Priority {
+				t.Errorf("DecodeCursor() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPriorityCursorRoundTrip は優先度順のカーソルのエンコード・デコードのテスト
+func TestPriorityCursorRoundTrip(t *testing.T) {
+	var id pgtype.UUID
+	if err := id.Scan("7f1c2f3e-8a4b-4c5d-9e6f-0a1b2c3d4e5f"); err != nil {
+		t.Fatalf("failed to build uuid: %v", err)
+	}
+
+	for _, priority := range []int32{0, 12, -3} {
+		want := Cursor{
+			SortTime:   time.Date(2025, 4, 1, 5, 0, 0, 0, time.UTC),
+			EventID:    id,
+			ByPriority: true,
+			Priority:   priority,
+		}
+
+		got, err := DecodeCursor(want.Encode())
+		if err != nil {
+			t.Fatalf("DecodeCursor() unexpected error: %v", err)
+		}
+		if !got.SortTime.Equal(want.SortTime) || got.EventID != want.EventID {
+			t.Errorf("DecodeCursor() = %+v, want %+v", got, want)
+		}
+		if !got.ByPriority || got.Priority != priority {
+			t.Errorf("Priority = %d (ByPriority=%v), want %d", got.Priority, got.ByPriority, priority)
+		}
+	}
+}
+
+// TestDecodeCursorInvalid は不正なカーソルのテスト
+func TestDecodeCursorInvalid(t *testing.T) {
+	valid := Cursor{SortTime: time.Unix(0, 0), EventID: pgtype.UUID{Valid: true}}.Encode()
+	priority := Cursor{SortTime: time.Unix(0, 0), EventID: pgtype.UUID{Valid: true}, ByPriority: true}.Encode()
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "Empty", cursor: ""},
+		{name: "RFC3339 timestamp", cursor: "2025-04-01T05:00:00Z"},
+		{name: "Truncated", cursor: valid[:len(valid)-2]},
+		{name: "Unknown version", cursor: "Ag" + valid[2:]},
+		{name: "Change cursor version", cursor: "Aw" + valid[2:]},
+		{name: "Future version", cursor: "Bg" + valid[2:]},
+		{name: "Truncated priority", cursor: priority[:len(priority)-3]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeCursor(tt.cursor); err != ErrInvalidCursor {
+				t.Errorf("DecodeCursor(%q) error = %v, want ErrInvalidCursor", tt.cursor, err)
+			}
+		})
+	}
+}
+
+// TestChangeCursorRoundTrip はWatchTimelineのカーソルのエンコード・デコードのテスト
+func TestChangeCursorRoundTrip(t *testing.T) {
+	want := ChangeCursor{
+		CreatedAt: time.Date(2025, 4, 1, 5, 0, 0, 123456000, time.UTC),
+		ID:        987654321012,
+	}
+
+	got, err := DecodeChangeCursor(want.Encode())
+	if err != nil {
+		t.Fatalf("DecodeChangeCursor() unexpected error: %v", err)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) || got.ID != want.ID {
+		t.Errorf("DecodeChangeCursor() = %+v, want %+v", got, want)
+	}
+
+	// タイムラインのカーソルや旧形式（変更履歴のID）は受け付けない
+	for _, s := range []string{"12345", (Cursor{SortTime: want.CreatedAt}).Encode()} {
+		if _, err := DecodeChangeCursor(s); err != ErrInvalidCursor {
+			t.Errorf("DecodeChangeCursor(%q) error = %v, want ErrInvalidCursor", s, err)
+		}
+	}
+}
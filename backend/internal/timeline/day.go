@@ -0,0 +1,39 @@
+package timeline
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // alpineなどタイムゾーンDBがない実行環境向けに埋め込む
+)
+
+// DefaultTimezone は日付指定時のデフォルトタイムゾーン
+const DefaultTimezone = "Asia/Tokyo"
+
+// broadcastDayStartHour はラジオの放送日（5:00〜29:00）の開始時刻
+const broadcastDayStartHour = 5
+
+// DayRange は指定日（YYYY-MM-DD）の表示範囲 [start, end) を返す
+// broadcastDay が true の場合は放送日（5:00〜翌5:00）を1日とする
+func DayRange(date, timezone string, broadcastDay bool) (time.Time, time.Time, error) {
+	if timezone == "" {
+		timezone = DefaultTimezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+
+	day, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q: %w", date, err)
+	}
+
+	hour := 0
+	if broadcastDay {
+		hour = broadcastDayStartHour
+	}
+	// time.Date で組み立てることで夏時間の切り替え日も正しく扱う
+	start := time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, loc)
+	end := time.Date(day.Year(), day.Month(), day.Day()+1, hour, 0, 0, 0, loc)
+	return start, end, nil
+}
@@ -0,0 +1,80 @@
+package timeline
+
+import (
+	"testing"
+	"time"
+)
+
+// TestDayRange は日付指定時の表示範囲計算のテスト
+func TestDayRange(t *testing.T) {
+	tests := []struct {
+		name         string
+		date         string
+		timezone     string
+		broadcastDay bool
+		wantStart    string
+		wantEnd      string
+		wantErr      bool
+	}{
+		{
+			name:      "Default Asia/Tokyo calendar day",
+			date:      "2025-04-01",
+			wantStart: "2025-03-31T15:00:00Z",
+			wantEnd:   "2025-04-01T15:00:00Z",
+		},
+		{
+			name:         "Broadcast day 5:00-29:00",
+			date:         "2025-04-01",
+			timezone:     "Asia/Tokyo",
+			broadcastDay: true,
+			wantStart:    "2025-03-31T20:00:00Z",
+			wantEnd:      "2025-04-01T20:00:00Z",
+		},
+		{
+			name:      "DST start day is 23 hours",
+			date:      "2025-03-09",
+			timezone:  "America/New_York",
+			wantStart: "2025-03-09T05:00:00Z",
+			wantEnd:   "2025-03-10T04:00:00Z",
+		},
+		{
+			name:      "UTC",
+			date:      "2025-12-31",
+			timezone:  "UTC",
+			wantStart: "2025-12-31T00:00:00Z",
+			wantEnd:   "2026-01-01T00:00:00Z",
+		},
+		{
+			name:    "Invalid date",
+			date:    "2025/04/01",
+			wantErr: true,
+		},
+		{
+			name:     "Invalid timezone",
+			date:     "2025-04-01",
+			timezone: "Mars/Olympus",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := DayRange(tt.date, tt.timezone, tt.broadcastDay)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("DayRange() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DayRange() unexpected error: %v", err)
+			}
+			if got := start.UTC().Format(time.RFC3339); got != tt.wantStart {
+				t.Errorf("DayRange() start = %v, want %v", got, tt.wantStart)
+			}
+			if got := end.UTC().Format(time.RFC3339); got != tt.wantEnd {
+				t.Errorf("DayRange() end = %v, want %v", got, tt.wantEnd)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package timeline
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Platforms はタイムラインで扱うプラットフォームの一覧
+var Platforms = []string{"youtube", "twitch", "podcast", "radiko"}
+
+// EventTypes はイベント種別（events.type）の一覧
+var EventTypes = []string{"live", "scheduled", "video", "premiere", "radio", "episode"}
+
+// Filter はタイムラインの絞り込み条件（空の項目は絞り込まない）
+type Filter struct {
+	ChannelIDs    []string
+	PlatformIDs   []string
+	EventTypes    []string
+	SourceIDs     []pgtype.UUID
+	TagIDs        []pgtype.UUID // いずれかのタグを割り当てた購読のみ
+	FavoritesOnly bool
+}
+
+// NewFilter はリクエストの値を検証して絞り込み条件を作成
+func NewFilter(channelIDs, platformIDs, eventTypes, sourceIDs []string, favoritesOnly bool) (Filter, error) {
+	f := Filter{FavoritesOnly: favoritesOnly}
+
+	if len(channelIDs) > 0 {
+		f.ChannelIDs = channelIDs
+	}
+
+	for _, p := range platformIDs {
+		if !slices.Contains(Platforms, p) {
+			return Filter{}, fmt.Errorf("invalid platform_id: %q", p)
+		}
+		f.PlatformIDs = append(f.PlatformIDs, p)
+	}
+
+	for _, t := range eventTypes {
+		if !slices.Contains(EventTypes, t) {
+			return Filter{}, fmt.Errorf("invalid event_type: %q", t)
+		}
+		f.EventTypes = append(f.EventTypes, t)
+	}
+
+	sources, err := parseUUIDs("source_id", sourceIDs)
+	if err != nil {
+		return Filter{}, err
+	}
+	f.SourceIDs = sources
+
+	return f, nil
+}
+
+// WithTagIDs はタグIDを検証して絞り込み条件に追加
+func (f Filter) WithTagIDs(tagIDs []string) (Filter, error) {
+	tags, err := parseUUIDs("tag_id", tagIDs)
+	if err != nil {
+		return Filter{}, err
+	}
+	f.TagIDs = tags
+	return f, nil
+}
+
+// parseUUIDs はIDの一覧をUUIDに変換（空の場合はnil）
+func parseUUIDs(field string, ids []string) ([]pgtype.UUID, error) {
+	var uuids []pgtype.UUID
+	for _, id := range ids {
+		var uuid pgtype.UUID
+		if err := uuid.Scan(id); err != nil {
+			return nil, fmt.Errorf("invalid %s: %q", field, id)
+		}
+		uuids = append(uuids, uuid)
+	}
+	return uuids, nil
+}
@@ -0,0 +1,100 @@
+package timeline
+
+import (
+	"testing"
+)
+
+// TestNewFilter は絞り込み条件の検証のテスト
+func TestNewFilter(t *testing.T) {
+	tests := []struct {
+		name        string
+		platformIDs []string
+		eventTypes  []string
+		sourceIDs   []string
+		wantErr     bool
+	}{
+		{
+			name: "No filters",
+		},
+		{
+			name:        "Live Twitch and radio",
+			platformIDs: []string{"twitch", "radiko"},
+			eventTypes:  []string{"live", "radio"},
+		},
+		{
+			name:      "Source ID",
+			sourceIDs: []string{"7f1c2f3e-8a4b-4c5d-9e6f-0a1b2c3d4e5f"},
+		},
+		{
+			name:        "Unknown platform",
+			platformIDs: []string{"niconico"},
+			wantErr:     true,
+		},
+		{
+			name:       "Unknown event type",
+			eventTypes: []string{"short"},
+			wantErr:    true,
+		},
+		{
+			name:      "Invalid source ID",
+			sourceIDs: []string{"UCxxxxxxxxxxxx"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFilter(nil, tt.platformIDs, tt.eventTypes, tt.sourceIDs, false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			// 未指定の項目はSQLでNULL（絞り込みなし）として扱うためnilであること
+			if len(tt.platformIDs) == 0 && f.PlatformIDs != nil {
+				t.Errorf("PlatformIDs = %v, want nil", f.PlatformIDs)
+			}
+			if len(tt.eventTypes) == 0 && f.EventTypes != nil {
+				t.Errorf("EventTypes = %v, want nil", f.EventTypes)
+			}
+			if len(f.SourceIDs) != len(tt.sourceIDs) {
+				t.Errorf("SourceIDs = %v, want %d items", f.SourceIDs, len(tt.sourceIDs))
+			}
+		})
+	}
+}
+
+// TestFilterWithTagIDs はタグIDの絞り込み条件のテスト
+func TestFilterWithTagIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		tagIDs  []string
+		wantErr bool
+	}{
+		{name: "No tags"},
+		{name: "Two tags", tagIDs: []string{"7f1c2f3e-8a4b-4c5d-9e6f-0a1b2c3d4e5f", "0b3e6d1a-2c4f-4a8e-9d7b-5f6a7b8c9d0e"}},
+		{name: "Invalid tag ID", tagIDs: []string{"VTubers"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Filter{FavoritesOnly: true}.WithTagIDs(tt.tagIDs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WithTagIDs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(tt.tagIDs) == 0 && f.TagIDs != nil {
+				t.Errorf("TagIDs = %v, want nil", f.TagIDs)
+			}
+			if len(f.TagIDs) != len(tt.tagIDs) {
+				t.Errorf("TagIDs = %v, want %d items", f.TagIDs, len(tt.tagIDs))
+			}
+			if !f.FavoritesOnly {
+				t.Error("FavoritesOnly was not kept")
+			}
+		})
+	}
+}
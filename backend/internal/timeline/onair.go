@@ -0,0 +1,31 @@
+package timeline
+
+import (
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// OnAirTypes は配信中・放送中になるイベント種別（ライブ配信・プレミア公開・ラジオ番組、全プラットフォーム）
+// ListLiveEvents の event_types に渡す
+var OnAirTypes = []string{"live", "premiere", "radio"}
+
+// UpcomingTypes は配信予定・放送予定として表示するイベント種別
+// ListUpcomingEvents の event_types に渡す
+var UpcomingTypes = []string{"scheduled", "premiere", "live", "radio"}
+
+// OnAir は番組がnowの時点で配信中・放送中かどうか（ListLiveEvents と同じ条件）
+func OnAir(eventType string, startAt, endAt pgtype.Timestamptz, now time.Time) bool {
+	return slices.Contains(OnAirTypes, eventType) &&
+		startAt.Valid &&
+		!startAt.Time.After(now) &&
+		(!endAt.Valid || endAt.Time.After(now))
+}
+
+// Upcoming は番組がnowの時点で配信予定・放送予定かどうか（ListUpcomingEvents と同じ条件）
+func Upcoming(eventType string, startAt pgtype.Timestamptz, now time.Time) bool {
+	return slices.Contains(UpcomingTypes, eventType) &&
+		startAt.Valid &&
+		startAt.Time.After(now)
+}
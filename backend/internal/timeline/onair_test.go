@@ -0,0 +1,49 @@
+package timeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TestOnAirAndUpcoming は配信中・配信予定の判定のテスト
+func TestOnAirAndUpcoming(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: now.Add(d), Valid: true}
+	}
+	none := pgtype.Timestamptz{}
+
+	tests := []struct {
+		name         string
+		eventType    string
+		startAt      pgtype.Timestamptz
+		endAt        pgtype.Timestamptz
+		wantOnAir    bool
+		wantUpcoming bool
+	}{
+		{name: "live stream", eventType: "live", startAt: at(-time.Hour), endAt: none, wantOnAir: true},
+		{name: "radio on air", eventType: "radio", startAt: at(-30 * time.Minute), endAt: at(30 * time.Minute), wantOnAir: true},
+		{name: "radio ended", eventType: "radio", startAt: at(-2 * time.Hour), endAt: at(-time.Hour)},
+		{name: "radio upcoming", eventType: "radio", startAt: at(time.Hour), endAt: at(2 * time.Hour), wantUpcoming: true},
+		{name: "radio starting now", eventType: "radio", startAt: at(0), endAt: at(time.Hour), wantOnAir: true},
+		{name: "premiere playing", eventType: "premiere", startAt: at(-5 * time.Minute), endAt: none, wantOnAir: true},
+		{name: "premiere upcoming", eventType: "premiere", startAt: at(time.Hour), endAt: none, wantUpcoming: true},
+		{name: "scheduled stream", eventType: "scheduled", startAt: at(time.Hour), endAt: none, wantUpcoming: true},
+		{name: "scheduled stream past start", eventType: "scheduled", startAt: at(-time.Hour), endAt: none},
+		{name: "video", eventType: "video", startAt: none, endAt: none},
+		{name: "episode", eventType: "episode", startAt: at(-time.Hour), endAt: none},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OnAir(tt.eventType, tt.startAt, tt.endAt, now); got != tt.wantOnAir {
+				t.Errorf("OnAir() = %v, want %v", got, tt.wantOnAir)
+			}
+			if got := Upcoming(tt.eventType, tt.startAt, now); got != tt.wantUpcoming {
+				t.Errorf("Upcoming() = %v, want %v", got, tt.wantUpcoming)
+			}
+		})
+	}
+}
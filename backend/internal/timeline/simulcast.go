@@ -0,0 +1,200 @@
+package timeline
+
+import (
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kinchoKayaba/pixicast/backend/internal/search"
+)
+
+// SimulcastWindow は同時配信とみなす開始時刻の差の上限
+const SimulcastWindow = 30 * time.Minute
+
+// SimulcastTitleSimilarity は同時配信とみなすタイトル類似度（0〜1）の下限
+const SimulcastTitleSimilarity = 0.5
+
+// simulcastTypes は同時配信の判定対象のイベント種別（アーカイブ動画・エピソードは対象外）
+var simulcastTypes = []string{"live", "scheduled", "premiere"}
+
+// SimulcastEvent は同時配信の判定に使うイベントの情報
+type SimulcastEvent struct {
+	SourceID   pgtype.UUID
+	PlatformID string
+	Type       string
+	Title      string
+	StartAt    time.Time // 開始時刻が未定の場合はゼロ値
+}
+
+// SourceLink は同じクリエイターとしてリンクされた2つのソース
+type SourceLink struct {
+	SourceID       pgtype.UUID
+	LinkedSourceID pgtype.UUID
+}
+
+// GroupSimulcasts はリンクされたソースの同時配信をまとめ、グループごとのインデックスを返す
+// 各グループの先頭が代表のイベント（events内で最初に現れたもの）で、同じプラットフォームのイベントは1グループに1件まで
+// まとめる対象がないイベントも要素1つのグループとして、eventsの順序を保って返す
+func GroupSimulcasts(events []SimulcastEvent, links []SourceLink) [][]int {
+	creators := creatorGroups(links)
+
+	groups := make([][]int, 0, len(events))
+	grouped := make([]bool, len(events))
+	for i, event := range events {
+		if grouped[i] {
+			continue
+		}
+		group := []int{i}
+		grouped[i] = true
+
+		creator, linked := creators[event.SourceID]
+		if linked && isSimulcastCandidate(event) {
+			platforms := map[string]bool{event.PlatformID: true}
+			for j := i + 1; j < len(events); j++ {
+				other := events[j]
+				if grouped[j] || platforms[other.PlatformID] || !isSimulcastCandidate(other) {
+					continue
+				}
+				if c, ok := creators[other.SourceID]; !ok || c != creator {
+					continue
+				}
+				if !IsSimulcast(event, other) {
+					continue
+				}
+				group = append(group, j)
+				grouped[j] = true
+				platforms[other.PlatformID] = true
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// SimulcastPageEnd はeventsの先頭limit件をページとしたときに、同時配信のグループがページの境界をまたがないページの件数を返す
+// 境界をまたぐグループがあれば、そのグループの最後のイベントまでページを延ばす（延ばした分に始まるグループも同様）
+// eventsにはページの続きを先読みした分も含める（先読みの範囲を超えるグループは判定できない）
+func SimulcastPageEnd(events []SimulcastEvent, links []SourceLink, limit int) int {
+	if limit >= len(events) {
+		return len(events)
+	}
+	if len(links) == 0 {
+		return limit
+	}
+
+	// グループのインデックスは昇順で、先頭がグループの最初のイベント
+	groups := GroupSimulcasts(events, links)
+	end := limit
+	for {
+		extended := end
+		for _, group := range groups {
+			if group[0] < end {
+				extended = max(extended, group[len(group)-1]+1)
+			}
+		}
+		if extended == end {
+			return end
+		}
+		end = extended
+	}
+}
+
+// IsSimulcast は2つのイベントの開始時刻とタイトルが同時配信とみなせるかどうかを返す
+// ソースのリンクとプラットフォームの違いは呼び出し側で確認する
+func IsSimulcast(a, b SimulcastEvent) bool {
+	if a.StartAt.IsZero() || b.StartAt.IsZero() {
+		return false
+	}
+	diff := a.StartAt.Sub(b.StartAt)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > SimulcastWindow {
+		return false
+	}
+	return TitleSimilarity(a.Title, b.Title) >= SimulcastTitleSimilarity
+}
+
+// TitleSimilarity はタイトルの類似度を0〜1で返す
+// 正規化して記号・空白を除いた文字列の2文字ずつの組（bigram）のDice係数で、一方が他方を含む場合は1
+func TitleSimilarity(a, b string) float64 {
+	ra := titleRunes(a)
+	rb := titleRunes(b)
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+	sa := string(ra)
+	sb := string(rb)
+	if strings.Contains(sa, sb) || strings.Contains(sb, sa) {
+		return 1
+	}
+	if len(ra) < 2 || len(rb) < 2 {
+		return 0
+	}
+
+	bigrams := make(map[string]int, len(ra)-1)
+	for i := 0; i+1 < len(ra); i++ {
+		bigrams[string(ra[i:i+2])]++
+	}
+	matches := 0
+	for i := 0; i+1 < len(rb); i++ {
+		key := string(rb[i : i+2])
+		if bigrams[key] > 0 {
+			bigrams[key]--
+			matches++
+		}
+	}
+	return float64(2*matches) / float64(len(ra)-1+len(rb)-1)
+}
+
+// titleRunes はタイトルを正規化して文字と数字だけを残す
+func titleRunes(title string) []rune {
+	var runes []rune
+	for _, r := range search.Normalize(title) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
+
+// isSimulcastCandidate は同時配信の判定対象のイベントかどうかを返す
+func isSimulcastCandidate(event SimulcastEvent) bool {
+	return slices.Contains(simulcastTypes, event.Type)
+}
+
+// creatorGroups はリンクをたどって、ソースごとに同じクリエイターのグループの代表ソースを返す
+// A-B、B-Cとリンクされている場合はA・B・Cが同じグループになる
+func creatorGroups(links []SourceLink) map[pgtype.UUID]pgtype.UUID {
+	parent := make(map[pgtype.UUID]pgtype.UUID)
+	var find func(id pgtype.UUID) pgtype.UUID
+	find = func(id pgtype.UUID) pgtype.UUID {
+		p, ok := parent[id]
+		if !ok {
+			parent[id] = id
+			return id
+		}
+		if p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+
+	for _, link := range links {
+		a := find(link.SourceID)
+		b := find(link.LinkedSourceID)
+		if a != b {
+			parent[b] = a
+		}
+	}
+
+	groups := make(map[pgtype.UUID]pgtype.UUID, len(parent))
+	for id := range parent {
+		groups[id] = find(id)
+	}
+	return groups
+}
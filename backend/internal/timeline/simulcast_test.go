@@ -0,0 +1,226 @@
+package timeline
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// testSourceID はテスト用のソースIDを作成
+func testSourceID(n byte) pgtype.UUID {
+	return pgtype.UUID{Bytes: [16]byte{15: n}, Valid: true}
+}
+
+// TestTitleSimilarity はタイトル類似度のテスト
+func TestTitleSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool // SimulcastTitleSimilarity以上かどうか
+	}{
+		{
+			name: "Same title",
+			a:    "【歌枠】まったり歌います #12",
+			b:    "【歌枠】まったり歌います #12",
+			want: true,
+		},
+		{
+			name: "Decorations and width differ",
+			a:    "【歌枠】まったり歌います！ #１２",
+			b:    "歌枠 まったり歌います #12",
+			want: true,
+		},
+		{
+			name: "Platform suffix",
+			a:    "Minecraft hardcore day 5",
+			b:    "Minecraft hardcore day 5 | Twitch simulcast",
+			want: true,
+		},
+		{
+			name: "Katakana and hiragana",
+			a:    "マイクラ建築配信",
+			b:    "まいくら建築配信！",
+			want: true,
+		},
+		{
+			name: "Different shows",
+			a:    "【歌枠】まったり歌います",
+			b:    "【雑談】今週の振り返り",
+			want: false,
+		},
+		{
+			name: "Empty title",
+			a:    "",
+			b:    "Minecraft",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TitleSimilarity(tt.a, tt.b)
+			if (got >= SimulcastTitleSimilarity) != tt.want {
+				t.Errorf("TitleSimilarity(%q, %q) = %.2f, want similar = %v", tt.a, tt.b, got, tt.want)
+			}
+			if rev := TitleSimilarity(tt.b, tt.a); rev != got {
+				t.Errorf("TitleSimilarity is not symmetric: %.2f != %.2f", got, rev)
+			}
+		})
+	}
+}
+
+// TestGroupSimulcasts は同時配信のグループ化のテスト
+func TestGroupSimulcasts(t *testing.T) {
+	start := time.Date(2026, 1, 10, 20, 0, 0, 0, time.UTC)
+	youtube := testSourceID(1)
+	twitch := testSourceID(2)
+	podcast := testSourceID(3)
+	other := testSourceID(4)
+	links := []SourceLink{
+		{SourceID: youtube, LinkedSourceID: twitch},
+		{SourceID: twitch, LinkedSourceID: podcast},
+	}
+
+	tests := []struct {
+		name   string
+		events []SimulcastEvent
+		links  []SourceLink
+		want   [][]int
+	}{
+		{
+			name: "Linked live streams",
+			events: []SimulcastEvent{
+				{SourceID: youtube, PlatformID: "youtube", Type: "live", Title: "Minecraft day 5", StartAt: start},
+				{SourceID: twitch, PlatformID: "twitch", Type: "live", Title: "Minecraft day 5!", StartAt: start.Add(2 * time.Minute)},
+			},
+			links: links,
+			want:  [][]int{{0, 1}},
+		},
+		{
+			name: "Not linked",
+			events: []SimulcastEvent{
+				{SourceID: youtube, PlatformID: "youtube", Type: "live", Title: "Minecraft day 5", StartAt: start},
+				{SourceID: other, PlatformID: "twitch", Type: "live", Title: "Minecraft day 5", StartAt: start},
+			},
+			links: links,
+			want:  [][]int{{0}, {1}},
+		},
+		{
+			name: "No links",
+			events: []SimulcastEvent{
+				{SourceID: youtube, PlatformID: "youtube", Type: "live", Title: "Minecraft day 5", StartAt: start},
+				{SourceID: twitch, PlatformID: "twitch", Type: "live", Title: "Minecraft day 5", StartAt: start},
+			},
+			want: [][]int{{0}, {1}},
+		},
+		{
+			name: "Start time too far apart",
+			events: []SimulcastEvent{
+				{SourceID: youtube, PlatformID: "youtube", Type: "scheduled", Title: "Minecraft day 5", StartAt: start},
+				{SourceID: twitch, PlatformID: "twitch", Type: "scheduled", Title: "Minecraft day 5", StartAt: start.Add(2 * time.Hour)},
+			},
+			links: links,
+			want:  [][]int{{0}, {1}},
+		},
+		{
+			name: "Different titles",
+			events: []SimulcastEvent{
+				{SourceID: youtube, PlatformID: "youtube", Type: "live", Title: "【歌枠】まったり歌います", StartAt: start},
+				{SourceID: twitch, PlatformID: "twitch", Type: "live", Title: "【雑談】今週の振り返り", StartAt: start},
+			},
+			links: links,
+			want:  [][]int{{0}, {1}},
+		},
+		{
+			name: "Archived video is not merged",
+			events: []SimulcastEvent{
+				{SourceID: youtube, PlatformID: "youtube", Type: "video", Title: "Minecraft day 5", StartAt: start},
+				{SourceID: twitch, PlatformID: "twitch", Type: "live", Title: "Minecraft day 5", StartAt: start},
+			},
+			links: links,
+			want:  [][]int{{0}, {1}},
+		},
+		{
+			name: "Transitive links and order kept",
+			events: []SimulcastEvent{
+				{SourceID: other, PlatformID: "youtube", Type: "live", Title: "Other show", StartAt: start},
+				{SourceID: podcast, PlatformID: "podcast", Type: "premiere", Title: "Minecraft day 5", StartAt: start},
+				{SourceID: youtube, PlatformID: "youtube", Type: "live", Title: "Minecraft day 5", StartAt: start},
+				{SourceID: twitch, PlatformID: "twitch", Type: "live", Title: "Minecraft day 5", StartAt: start},
+			},
+			links: links,
+			want:  [][]int{{0}, {1, 2, 3}},
+		},
+		{
+			name: "One event per platform",
+			events: []SimulcastEvent{
+				{SourceID: youtube, PlatformID: "youtube", Type: "live", Title: "Minecraft day 5", StartAt: start},
+				{SourceID: twitch, PlatformID: "twitch", Type: "live", Title: "Minecraft day 5", StartAt: start},
+				{SourceID: twitch, PlatformID: "twitch", Type: "live", Title: "Minecraft day 5 (sub)", StartAt: start},
+			},
+			links: links,
+			want:  [][]int{{0, 1}, {2}},
+		},
+		{
+			name: "Unknown start time",
+			events: []SimulcastEvent{
+				{SourceID: youtube, PlatformID: "youtube", Type: "scheduled", Title: "Minecraft day 5"},
+				{SourceID: twitch, PlatformID: "twitch", Type: "scheduled", Title: "Minecraft day 5"},
+			},
+			links: links,
+			want:  [][]int{{0}, {1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GroupSimulcasts(tt.events, tt.links)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GroupSimulcasts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSimulcastPageEnd は同時配信のグループがページの境界をまたがないページの件数のテスト
+func TestSimulcastPageEnd(t *testing.T) {
+	start := time.Date(2026, 1, 10, 20, 0, 0, 0, time.UTC)
+	youtube := testSourceID(1)
+	twitch := testSourceID(2)
+	other := testSourceID(4)
+	links := []SourceLink{{SourceID: youtube, LinkedSourceID: twitch}}
+
+	// 新着順: 0=別チャンネル, 1=YouTube, 2=別チャンネル, 3=Twitch（1と同時配信）, 4=別チャンネル
+	events := []SimulcastEvent{
+		{SourceID: other, PlatformID: "youtube", Type: "live", Title: "News", StartAt: start.Add(20 * time.Minute)},
+		{SourceID: youtube, PlatformID: "youtube", Type: "live", Title: "Minecraft day 5", StartAt: start.Add(10 * time.Minute)},
+		{SourceID: other, PlatformID: "youtube", Type: "video", Title: "Cooking", StartAt: start.Add(5 * time.Minute)},
+		{SourceID: twitch, PlatformID: "twitch", Type: "live", Title: "Minecraft day 5", StartAt: start},
+		{SourceID: other, PlatformID: "youtube", Type: "video", Title: "Travel", StartAt: start.Add(-time.Hour)},
+	}
+
+	tests := []struct {
+		name  string
+		limit int
+		links []SourceLink
+		want  int
+	}{
+		{name: "Group spans page boundary", limit: 2, links: links, want: 4},
+		{name: "Boundary inside group gap", limit: 3, links: links, want: 4},
+		{name: "Group starts after page", limit: 1, links: links, want: 1},
+		{name: "Group inside page", limit: 4, links: links, want: 4},
+		{name: "No links", limit: 2, want: 2},
+		{name: "Fewer events than limit", limit: 10, links: links, want: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SimulcastPageEnd(events, tt.links, tt.limit); got != tt.want {
+				t.Errorf("SimulcastPageEnd(limit=%d) = %d, want %d", tt.limit, got, tt.want)
+			}
+		})
+	}
+}
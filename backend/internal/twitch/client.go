@@ -6,17 +6,38 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math/rand/v2"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"time"
+
+	"github.com/kinchoKayaba/pixicast/backend/internal/backoff"
+)
+
+// Twitch API のエンドポイント
+const (
+	helixURL = "https://api.twitch.tv/helix"
+	tokenURL = "https://id.twitch.tv/oauth2/token"
 )
 
+// リトライの設定（429・5xx・通信エラー）
+const (
+	maxRetries     = 3
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 10 * time.Second
+)
+
+// Client はTwitch Helix APIのクライアント
+// 複数のgoroutineから共有して使える
 type Client struct {
-	clientID     string
-	clientSecret string
-	accessToken  string
-	tokenExpiry  time.Time
-	httpClient   *http.Client
+	clientID   string
+	apiURL     string
+	httpClient *http.Client
+	tokens     *tokenSource
+	limiter    *rateLimiter
+	sleep      func(ctx context.Context, d time.Duration) error
 }
 
 type TwitchUser struct {
@@ -27,180 +48,152 @@ type TwitchUser struct {
 }
 
 type TwitchVideo struct {
-	ID          string    `json:"id"`
-	UserID      string    `json:"user_id"`
-	UserLogin   string    `json:"user_login"`
-	UserName    string    `json:"user_name"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	PublishedAt time.Time `json:"published_at"`
-	URL         string    `json:"url"`
-	ThumbnailURL string   `json:"thumbnail_url"`
-	Viewable    string    `json:"viewable"`
-	ViewCount   int       `json:"view_count"`
-	Type        string    `json:"type"`
-	Duration    string    `json:"duration"`
+	ID           string    `json:"id"`
+	UserID       string    `json:"user_id"`
+	UserLogin    string    `json:"user_login"`
+	UserName     string    `json:"user_name"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	CreatedAt    time.Time `json:"created_at"`
+	PublishedAt  time.Time `json:"published_at"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	Viewable     string    `json:"viewable"`
+	ViewCount    int       `json:"view_count"`
+	Type         string    `json:"type"`
+	Duration     string    `json:"duration"`
+	StreamID     string    `json:"stream_id"` // アーカイブの元のライブ配信のID（アーカイブ以外は空）
 }
 
+// NewClient は環境変数 TWITCH_CLIENT_ID / TWITCH_CLIENT_SECRET のクライアントを作成
 func NewClient() *Client {
-	return &Client{
-		clientID:     os.Getenv("TWITCH_CLIENT_ID"),
-		clientSecret: os.Getenv("TWITCH_CLIENT_SECRET"),
-		httpClient:   &http.Client{Timeout: 10 * time.Second},
-	}
+	return newClient(os.Getenv("TWITCH_CLIENT_ID"), os.Getenv("TWITCH_CLIENT_SECRET"), helixURL, tokenURL)
 }
 
-func (c *Client) ensureAccessToken(ctx context.Context) error {
-	if c.accessToken != "" && time.Now().Before(c.tokenExpiry) {
-		return nil
-	}
-
-	tokenURL := "https://id.twitch.tv/oauth2/token"
-	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create token request: %w", err)
+func newClient(clientID, clientSecret, apiURL, tokenURL string) *Client {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	return &Client{
+		clientID:   clientID,
+		apiURL:     apiURL,
+		httpClient: httpClient,
+		tokens: &tokenSource{
+			clientID:     clientID,
+			clientSecret: clientSecret,
+			tokenURL:     tokenURL,
+			httpClient:   httpClient,
+			now:          time.Now,
+		},
+		limiter: &rateLimiter{now: time.Now, sleep: sleepContext},
+		sleep:   sleepContext,
 	}
+}
 
-	q := req.URL.Query()
-	q.Add("client_id", c.clientID)
-	q.Add("client_secret", c.clientSecret)
-	q.Add("grant_type", "client_credentials")
-	req.URL.RawQuery = q.Encode()
+// retryDelay はattempt回目のリトライまでの待ち時間
+// 500msから倍々に増やして10秒で頭打ちにし、jitter（0〜1）で±20%ずらす
+func retryDelay(attempt int, jitter float64) time.Duration {
+	return backoff.Jitter(backoff.Exponential(attempt, retryBaseDelay, retryMaxDelay), jitter)
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to request token: %w", err)
-	}
-	defer resp.Body.Close()
+// get はHelix APIのpathを呼び出してレスポンスをoutにデコード
+// 401はトークンを取得し直して1回だけ再試行し、429・5xx・通信エラーはバックオフして再試行する
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	reqURL := c.apiURL + path + "?" + query.Encode()
+	refreshed := false
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			delay := retryDelay(attempt, rand.Float64())
+			if err := c.sleep(ctx, delay); err != nil {
+				return err
+			}
+		}
+		if err := c.limiter.wait(ctx); err != nil {
+			return err
+		}
+		token, err := c.tokens.get(ctx)
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Client-ID", c.clientID)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil || attempt >= maxRetries {
+				return fmt.Errorf("failed to get %s: %w", path, err)
+			}
+			log.Printf("⚠️ Twitch %s failed (attempt %d/%d): %v", path, attempt+1, maxRetries+1, err)
+			continue
+		}
+		c.limiter.update(resp.Header)
+
+		if resp.StatusCode == http.StatusOK {
+			defer resp.Body.Close()
+			if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+				return fmt.Errorf("failed to decode %s response: %w", path, err)
+			}
+			return nil
+		}
 
-	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("token request failed: %s, body: %s", resp.Status, string(body))
+		resp.Body.Close()
+		err = fmt.Errorf("%s request failed: %s, body: %s", path, resp.Status, string(body))
+
+		switch {
+		case resp.StatusCode == http.StatusUnauthorized && !refreshed:
+			// トークンが失効・取り消しされた
+			refreshed = true
+			c.tokens.invalidate(token)
+			log.Printf("🔄 Twitch access token rejected: refreshing")
+			attempt-- // トークンの更新はリトライに数えない
+		case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+			if attempt >= maxRetries {
+				return err
+			}
+			log.Printf("⚠️ Twitch %s returned %s (attempt %d/%d): retrying", path, resp.Status, attempt+1, maxRetries+1)
+		default:
+			return err
+		}
 	}
-
-	var tokenResp struct {
-		AccessToken string `json:"access_token"`
-		ExpiresIn   int    `json:"expires_in"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
-		return fmt.Errorf("failed to decode token response: %w", err)
-	}
-
-	c.accessToken = tokenResp.AccessToken
-	c.tokenExpiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
-	log.Printf("✅ Twitch access token acquired (expires in %d seconds)", tokenResp.ExpiresIn)
-	return nil
 }
 
+// GetUserByLogin はログイン名でユーザーを取得
 func (c *Client) GetUserByLogin(ctx context.Context, login string) (*TwitchUser, error) {
-	if err := c.ensureAccessToken(ctx); err != nil {
-		return nil, err
-	}
-
-	url := fmt.Sprintf("https://api.twitch.tv/helix/users?login=%s", login)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Client-ID", c.clientID)
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get user: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("user request failed: %s, body: %s", resp.Status, string(body))
-	}
-
-	var usersResp struct {
-		Data []TwitchUser `json:"data"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&usersResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	if len(usersResp.Data) == 0 {
-		return nil, fmt.Errorf("user not found: %s", login)
-	}
-
-	return &usersResp.Data[0], nil
+	return c.getUser(ctx, url.Values{"login": {login}}, login)
 }
 
+// GetUserByID はユーザーIDでユーザーを取得
 func (c *Client) GetUserByID(ctx context.Context, id string) (*TwitchUser, error) {
-	if err := c.ensureAccessToken(ctx); err != nil {
-		return nil, err
-	}
-
-	url := fmt.Sprintf("https://api.twitch.tv/helix/users?id=%s", id)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Client-ID", c.clientID)
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get user: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("user request failed: %s, body: %s", resp.Status, string(body))
-	}
+	return c.getUser(ctx, url.Values{"id": {id}}, id)
+}
 
+func (c *Client) getUser(ctx context.Context, query url.Values, name string) (*TwitchUser, error) {
 	var usersResp struct {
 		Data []TwitchUser `json:"data"`
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&usersResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.get(ctx, "/users", query, &usersResp); err != nil {
+		return nil, err
 	}
 
 	if len(usersResp.Data) == 0 {
-		return nil, fmt.Errorf("user not found: %s", id)
+		return nil, fmt.Errorf("user not found: %s", name)
 	}
 
 	return &usersResp.Data[0], nil
 }
 
+// GetVideos はユーザーの動画（アーカイブ・クリップなど）を新しい順に最大first件取得
 func (c *Client) GetVideos(ctx context.Context, userID string, first int) ([]TwitchVideo, error) {
-	if err := c.ensureAccessToken(ctx); err != nil {
-		return nil, err
-	}
-
-	url := fmt.Sprintf("https://api.twitch.tv/helix/videos?user_id=%s&first=%d", userID, first)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Client-ID", c.clientID)
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get videos: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("videos request failed: %s, body: %s", resp.Status, string(body))
-	}
-
 	var videosResp struct {
 		Data []TwitchVideo `json:"data"`
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&videosResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	query := url.Values{"user_id": {userID}, "first": {strconv.Itoa(first)}}
+	if err := c.get(ctx, "/videos", query, &videosResp); err != nil {
+		return nil, err
 	}
 
 	return videosResp.Data, nil
@@ -218,42 +211,44 @@ type TwitchSearchChannel struct {
 
 // SearchChannels はTwitch Helix APIでチャンネルを検索
 func (c *Client) SearchChannels(ctx context.Context, query string, first int) ([]TwitchSearchChannel, error) {
-	if err := c.ensureAccessToken(ctx); err != nil {
-		return nil, err
-	}
-
 	if first <= 0 {
 		first = 10
 	}
 
-	reqURL := fmt.Sprintf("https://api.twitch.tv/helix/search/channels?query=%s&first=%d&live_only=false", query, first)
-	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create search request: %w", err)
+	var searchResp struct {
+		Data []TwitchSearchChannel `json:"data"`
 	}
+	params := url.Values{"query": {query}, "first": {strconv.Itoa(first)}, "live_only": {"false"}}
+	if err := c.get(ctx, "/search/channels", params, &searchResp); err != nil {
+		return nil, err
+	}
+
+	return searchResp.Data, nil
+}
 
-	req.Header.Set("Client-ID", c.clientID)
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+// TwitchChannel はチャンネル情報（現在の配信カテゴリなど）
+type TwitchChannel struct {
+	BroadcasterID    string `json:"broadcaster_id"`
+	BroadcasterLogin string `json:"broadcaster_login"`
+	GameID           string `json:"game_id"`
+	GameName         string `json:"game_name"`
+	Title            string `json:"title"`
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search channels: %w", err)
+// GetChannel は指定されたユーザーのチャンネル情報を取得
+func (c *Client) GetChannel(ctx context.Context, broadcasterID string) (*TwitchChannel, error) {
+	var channelsResp struct {
+		Data []TwitchChannel `json:"data"`
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("search channels failed: %s, body: %s", resp.Status, string(body))
+	if err := c.get(ctx, "/channels", url.Values{"broadcaster_id": {broadcasterID}}, &channelsResp); err != nil {
+		return nil, err
 	}
 
-	var searchResp struct {
-		Data []TwitchSearchChannel `json:"data"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&searchResp); err != nil {
-		return nil, fmt.Errorf("failed to decode search response: %w", err)
+	if len(channelsResp.Data) == 0 {
+		return nil, fmt.Errorf("channel not found: %s", broadcasterID)
 	}
 
-	return searchResp.Data, nil
+	return &channelsResp.Data[0], nil
 }
 
 // TwitchStream は配信中のストリーム情報
@@ -275,37 +270,12 @@ type TwitchStream struct {
 
 // GetStreams は指定されたユーザーの現在配信中のストリームを取得
 func (c *Client) GetStreams(ctx context.Context, userID string) ([]TwitchStream, error) {
-	if err := c.ensureAccessToken(ctx); err != nil {
-		return nil, err
-	}
-
-	url := fmt.Sprintf("https://api.twitch.tv/helix/streams?user_id=%s", userID)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Client-ID", c.clientID)
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get streams: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("streams request failed: %s, body: %s", resp.Status, string(body))
-	}
-
 	var streamsResp struct {
 		Data []TwitchStream `json:"data"`
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&streamsResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.get(ctx, "/streams", url.Values{"user_id": {userID}}, &streamsResp); err != nil {
+		return nil, err
 	}
 
 	return streamsResp.Data, nil
 }
-
@@ -0,0 +1,236 @@
+package twitch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeHelix はトークンの発行とHelixの /videos のスタンドイン
+// statuses の順にステータスを返し（使い切ったら200）、rejected のトークンには401を返す
+type fakeHelix struct {
+	tokenRequests atomic.Int32
+	apiRequests   atomic.Int32
+
+	mu       sync.Mutex
+	rejected map[string]bool
+	statuses []int
+	headers  http.Header // 各レスポンスに付けるヘッダー
+}
+
+// newFakeHelix はfakeHelixと、それを呼び出すClientを作成
+// Clientは実際には待たずに待ち時間を記録する
+func newFakeHelix(t *testing.T) (*fakeHelix, *Client, func() []time.Duration) {
+	t.Helper()
+	f := &fakeHelix{rejected: make(map[string]bool), headers: http.Header{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("grant_type") != "client_credentials" {
+			http.Error(w, "bad grant", http.StatusBadRequest)
+			return
+		}
+		// 同時に取得されたら重複して数えられるよう、少し待ってから発行する
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprintf(w, `{"access_token":"t%d","expires_in":3600}`, f.tokenRequests.Add(1))
+	})
+	mux.HandleFunc("/helix/videos", func(w http.ResponseWriter, r *http.Request) {
+		f.apiRequests.Add(1)
+		f.mu.Lock()
+		status := http.StatusOK
+		if len(f.statuses) > 0 {
+			status, f.statuses = f.statuses[0], f.statuses[1:]
+		}
+		if f.rejected[r.Header.Get("Authorization")[len("Bearer "):]] {
+			status = http.StatusUnauthorized
+		}
+		for k, v := range f.headers {
+			w.Header()[k] = v
+		}
+		f.mu.Unlock()
+
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			fmt.Fprint(w, `{"error":"error"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"data":[{"id":"v1","user_id":"%s"}]}`, r.URL.Query().Get("user_id"))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	c := newClient("id", "secret", srv.URL+"/helix", srv.URL+"/oauth2/token")
+	var mu sync.Mutex
+	var sleeps []time.Duration
+	c.sleep = func(ctx context.Context, d time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		sleeps = append(sleeps, d)
+		return ctx.Err()
+	}
+	// リセットまで待ったことにする
+	c.limiter.sleep = func(ctx context.Context, d time.Duration) error {
+		c.sleep(ctx, d)
+		c.limiter.mu.Lock()
+		defer c.limiter.mu.Unlock()
+		c.limiter.reset = c.limiter.now()
+		return nil
+	}
+	return f, c, func() []time.Duration {
+		mu.Lock()
+		defer mu.Unlock()
+		return sleeps
+	}
+}
+
+// TestClientConcurrentToken は複数のgoroutineから同時に呼び出してもトークンを1回だけ取得することのテスト
+func TestClientConcurrentToken(t *testing.T) {
+	f, c, _ := newFakeHelix(t)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			videos, err := c.GetVideos(context.Background(), strconv.Itoa(i), 20)
+			if err != nil || len(videos) != 1 || videos[0].UserID != strconv.Itoa(i) {
+				t.Errorf("GetVideos() = %v, %v", videos, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := f.tokenRequests.Load(); got != 1 {
+		t.Errorf("token requests = %d, want 1", got)
+	}
+}
+
+// TestClientRetry は401でのトークンの更新と429・5xxのリトライのテスト
+func TestClientRetry(t *testing.T) {
+	tests := []struct {
+		name              string
+		statuses          []int
+		rejected          []string
+		wantErr           bool
+		wantAPIRequests   int32
+		wantTokenRequests int32
+		wantSleeps        int
+	}{
+		{
+			name:              "refreshes token on 401",
+			rejected:          []string{"t1"},
+			wantAPIRequests:   2,
+			wantTokenRequests: 2,
+		},
+		{
+			name:              "gives up on repeated 401",
+			rejected:          []string{"t1", "t2"},
+			wantErr:           true,
+			wantAPIRequests:   2,
+			wantTokenRequests: 2,
+		},
+		{
+			name:              "retries 429 and 5xx",
+			statuses:          []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusBadGateway},
+			wantAPIRequests:   4,
+			wantTokenRequests: 1,
+			wantSleeps:        3,
+		},
+		{
+			name:              "gives up after max retries",
+			statuses:          []int{500, 500, 500, 500, 500},
+			wantErr:           true,
+			wantAPIRequests:   maxRetries + 1,
+			wantTokenRequests: 1,
+			wantSleeps:        maxRetries,
+		},
+		{
+			name:              "does not retry 400",
+			statuses:          []int{http.StatusBadRequest},
+			wantErr:           true,
+			wantAPIRequests:   1,
+			wantTokenRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, c, sleeps := newFakeHelix(t)
+			f.statuses = tt.statuses
+			for _, token := range tt.rejected {
+				f.rejected[token] = true
+			}
+
+			_, err := c.GetVideos(context.Background(), "u1", 20)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetVideos() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := f.apiRequests.Load(); got != tt.wantAPIRequests {
+				t.Errorf("API requests = %d, want %d", got, tt.wantAPIRequests)
+			}
+			if got := f.tokenRequests.Load(); got != tt.wantTokenRequests {
+				t.Errorf("token requests = %d, want %d", got, tt.wantTokenRequests)
+			}
+			if got := sleeps(); len(got) != tt.wantSleeps {
+				t.Errorf("sleeps = %v, want %d", got, tt.wantSleeps)
+			}
+		})
+	}
+}
+
+// TestClientRateLimit はRatelimit-Remainingを使い切ったらRatelimit-Resetまで待つことのテスト
+func TestClientRateLimit(t *testing.T) {
+	f, c, sleeps := newFakeHelix(t)
+	now := time.Unix(1_750_000_000, 0)
+	c.limiter.now = func() time.Time { return now }
+	f.headers.Set("Ratelimit-Remaining", "1")
+	f.headers.Set("Ratelimit-Reset", strconv.FormatInt(now.Add(30*time.Second).Unix(), 10))
+
+	// 1回目はヘッダーを受け取る前なので待たない
+	if _, err := c.GetVideos(context.Background(), "u1", 20); err != nil {
+		t.Fatalf("GetVideos() error = %v", err)
+	}
+	f.mu.Lock()
+	f.headers.Set("Ratelimit-Remaining", "0")
+	f.mu.Unlock()
+	// 2回目は1回目のヘッダーの残り1を使う
+	if _, err := c.GetVideos(context.Background(), "u1", 20); err != nil {
+		t.Fatalf("GetVideos() error = %v", err)
+	}
+	if got := sleeps(); len(got) != 0 {
+		t.Fatalf("sleeps = %v, want none while requests remain", got)
+	}
+
+	// 残りを使い切ったのでRatelimit-Resetまで待つ
+	if _, err := c.GetVideos(context.Background(), "u1", 20); err != nil {
+		t.Fatalf("GetVideos() error = %v", err)
+	}
+	if got := sleeps(); len(got) != 1 || got[0] != 30*time.Second {
+		t.Errorf("sleeps = %v, want [30s] until Ratelimit-Reset", got)
+	}
+}
+
+// TestRetryDelay はリトライの待ち時間のテスト
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempt int
+		jitter  float64
+		want    time.Duration
+	}{
+		{attempt: 1, jitter: 0.5, want: 500 * time.Millisecond},
+		{attempt: 2, jitter: 0.5, want: time.Second},
+		{attempt: 3, jitter: 0, want: 1600 * time.Millisecond},
+		{attempt: 10, jitter: 1, want: 12 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempt, tt.jitter); got != tt.want {
+			t.Errorf("retryDelay(%d, %v) = %v, want %v", tt.attempt, tt.jitter, got, tt.want)
+		}
+	}
+}
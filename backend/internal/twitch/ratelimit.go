@@ -0,0 +1,80 @@
+package twitch
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimitMaxWait はRatelimit-Resetまで待つ時間の上限（Helixのバケットは1分で回復する）
+const rateLimitMaxWait = time.Minute
+
+// rateLimiter はHelixのRatelimit-Remaining / Ratelimit-Reset ヘッダーに従ってリクエストを待たせる
+// レスポンスを待たずに送ったリクエストの分も残りから引き、残りがなくなったらリセットまで待つ
+type rateLimiter struct {
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu        sync.Mutex
+	known     bool // ヘッダーを受け取っているか（受け取るまでは待たない）
+	remaining int
+	reset     time.Time
+}
+
+// wait はリクエストを送れるまで待ち、残りを1つ使う
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := l.now()
+		if l.known && !now.Before(l.reset) {
+			// リセット後は次のレスポンスのヘッダーまで待たない
+			l.known = false
+		}
+		if !l.known || l.remaining > 0 {
+			l.remaining--
+			l.mu.Unlock()
+			return nil
+		}
+		d := min(l.reset.Sub(now), rateLimitMaxWait)
+		l.mu.Unlock()
+
+		if err := l.sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+// update はレスポンスのヘッダーで残りとリセット日時を更新
+func (l *rateLimiter) update(h http.Header) {
+	remaining, err := strconv.Atoi(h.Get("Ratelimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(h.Get("Ratelimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.known = true
+	l.remaining = remaining
+	l.reset = time.Unix(reset, 0)
+}
+
+// sleepContext はdだけ待つ（ctxがキャンセルされたらそのエラーを返す）
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
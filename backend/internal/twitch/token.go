@@ -0,0 +1,86 @@
+package twitch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// tokenExpiryMargin は期限切れの直前に使わないよう、期限より早めに更新する時間
+const tokenExpiryMargin = time.Minute
+
+// tokenSource はApp Access Token（client credentials）の取得と更新
+// 複数のgoroutineから同時に呼ばれても、トークンの取得は1回だけ行う
+type tokenSource struct {
+	clientID     string
+	clientSecret string
+	tokenURL     string
+	httpClient   *http.Client
+	now          func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// get は有効なトークンを返す（なければ取得する）
+func (ts *tokenSource) get(ctx context.Context) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.token != "" && ts.now().Before(ts.expiry) {
+		return ts.token, nil
+	}
+	return ts.fetch(ctx)
+}
+
+// invalidate はAPIに拒否された（401）トークンを破棄して次のgetで取得し直す
+// 他のgoroutineがすでに更新していた場合は何もしない
+func (ts *tokenSource) invalidate(rejected string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.token == rejected {
+		ts.token = ""
+	}
+}
+
+// fetch はトークンを取得（ts.mu を保持して呼ぶ）
+func (ts *tokenSource) fetch(ctx context.Context) (string, error) {
+	q := url.Values{}
+	q.Set("client_id", ts.clientID)
+	q.Set("client_secret", ts.clientSecret)
+	q.Set("grant_type", "client_credentials")
+	req, err := http.NewRequestWithContext(ctx, "POST", ts.tokenURL+"?"+q.Encode(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+
+	resp, err := ts.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("token request failed: %s, body: %s", resp.Status, string(body))
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	ts.token = tokenResp.AccessToken
+	ts.expiry = ts.now().Add(time.Duration(tokenResp.ExpiresIn)*time.Second - tokenExpiryMargin)
+	log.Printf("✅ Twitch access token acquired (expires in %d seconds)", tokenResp.ExpiresIn)
+	return ts.token, nil
+}
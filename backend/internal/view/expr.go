@@ -0,0 +1,600 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kinchoKayaba/pixicast/backend/internal/mute"
+	"github.com/kinchoKayaba/pixicast/backend/internal/search"
+)
+
+// MaxExpressionLength は式の最大文字数
+const MaxExpressionLength = 1000
+
+// Error は式の構文・型のエラー
+type Error struct {
+	Pos int // エラー箇所（1始まりの文字数）
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Pos, e.Msg)
+}
+
+// Event は式の評価に使うイベントと購読の情報
+type Event struct {
+	Platform    string
+	Type        string
+	Title       string
+	Description string
+	IsLive      bool
+	Watched     bool
+	Hidden      bool
+	Duration    time.Duration // 長さが不明な場合は0
+	ViewCount   int64
+	StartAt     time.Time // 開始時刻（未定の場合は公開日時）
+	Source      Source
+}
+
+// Source は式の評価に使う購読チャンネルの情報
+type Source struct {
+	ID         string
+	Name       string
+	Handle     string
+	IsFavorite bool
+}
+
+// valueType は式の値の型
+type valueType int
+
+const (
+	typeBool valueType = iota
+	typeString
+	typeNumber
+	typeDuration
+	typeTime
+)
+
+func (t valueType) String() string {
+	switch t {
+	case typeBool:
+		return "bool"
+	case typeString:
+		return "string"
+	case typeNumber:
+		return "number"
+	case typeDuration:
+		return "duration"
+	case typeTime:
+		return "time"
+	}
+	return "unknown"
+}
+
+// value は式の値
+type value struct {
+	typ valueType
+	b   bool
+	s   string
+	n   float64
+	d   time.Duration
+	t   time.Time
+}
+
+// field は式で参照できるフィールド
+type field struct {
+	typ valueType
+	get func(e *Event) value
+}
+
+// fields は式で参照できるフィールドの一覧
+var fields = map[string]field{
+	"platform":           {typeString, func(e *Event) value { return value{typ: typeString, s: e.Platform} }},
+	"type":               {typeString, func(e *Event) value { return value{typ: typeString, s: e.Type} }},
+	"title":              {typeString, func(e *Event) value { return value{typ: typeString, s: e.Title} }},
+	"description":        {typeString, func(e *Event) value { return value{typ: typeString, s: e.Description} }},
+	"is_live":            {typeBool, func(e *Event) value { return value{typ: typeBool, b: e.IsLive} }},
+	"watched":            {typeBool, func(e *Event) value { return value{typ: typeBool, b: e.Watched} }},
+	"hidden":             {typeBool, func(e *Event) value { return value{typ: typeBool, b: e.Hidden} }},
+	"duration":           {typeDuration, func(e *Event) value { return value{typ: typeDuration, d: e.Duration} }},
+	"view_count":         {typeNumber, func(e *Event) value { return value{typ: typeNumber, n: float64(e.ViewCount)} }},
+	"start_at":           {typeTime, func(e *Event) value { return value{typ: typeTime, t: e.StartAt} }},
+	"source.id":          {typeString, func(e *Event) value { return value{typ: typeString, s: e.Source.ID} }},
+	"source.name":        {typeString, func(e *Event) value { return value{typ: typeString, s: e.Source.Name} }},
+	"source.handle":      {typeString, func(e *Event) value { return value{typ: typeString, s: e.Source.Handle} }},
+	"source.is_favorite": {typeBool, func(e *Event) value { return value{typ: typeBool, b: e.Source.IsFavorite} }},
+}
+
+// Expr はコンパイル済みの式
+type Expr struct {
+	root node
+}
+
+// Compile は式を構文解析・型検査する
+// 構文:
+//
+//	式       = or
+//	or       = and { "||" and }
+//	and      = not { "&&" not }
+//	not      = "!" not | 比較
+//	比較     = 値 [ ("==" | "!=" | "<" | "<=" | ">" | ">=") 値 | "=~" 文字列 | "contains" 文字列 | "in" "[" 値 { "," 値 } "]" ]
+//	値       = フィールド | 文字列 | 数値 | true | false | "(" 式 ")"
+//
+// durationとの比較では文字列を長さ（"01:00:00"・"90m"など）、timeとの比較では日時（RFC3339・"2006-01-02"）として解釈する
+func Compile(src string) (*Expr, error) {
+	if strings.TrimSpace(src) == "" {
+		return nil, &Error{Pos: 1, Msg: "expression is empty"}
+	}
+	if len([]rune(src)) > MaxExpressionLength {
+		return nil, &Error{Pos: MaxExpressionLength + 1, Msg: fmt.Sprintf("expression is too long: max %d characters", MaxExpressionLength)}
+	}
+
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %s", tok.describe())}
+	}
+	if root.typ() != typeBool {
+		return nil, &Error{Pos: root.pos(), Msg: fmt.Sprintf("expression must be a bool, got %s", root.typ())}
+	}
+	return &Expr{root: root}, nil
+}
+
+// Match はイベントが式を満たすかどうかを返す
+func (x *Expr) Match(e *Event) bool {
+	return x.root.eval(e).b
+}
+
+// node は式の構文木のノード
+type node interface {
+	typ() valueType
+	pos() int
+	eval(e *Event) value
+}
+
+// literalNode は定数
+type literalNode struct {
+	at int
+	v  value
+}
+
+func (n *literalNode) typ() valueType      { return n.v.typ }
+func (n *literalNode) pos() int            { return n.at }
+func (n *literalNode) eval(e *Event) value { return n.v }
+
+// fieldNode はフィールドの参照
+type fieldNode struct {
+	at int
+	f  field
+}
+
+func (n *fieldNode) typ() valueType      { return n.f.typ }
+func (n *fieldNode) pos() int            { return n.at }
+func (n *fieldNode) eval(e *Event) value { return n.f.get(e) }
+
+// logicalNode は && と ||
+type logicalNode struct {
+	at   int
+	and  bool
+	l, r node
+}
+
+func (n *logicalNode) typ() valueType { return typeBool }
+func (n *logicalNode) pos() int       { return n.at }
+func (n *logicalNode) eval(e *Event) value {
+	l := n.l.eval(e).b
+	if n.and && !l || !n.and && l {
+		return value{typ: typeBool, b: l}
+	}
+	return n.r.eval(e)
+}
+
+// notNode は !
+type notNode struct {
+	at int
+	x  node
+}
+
+func (n *notNode) typ() valueType      { return typeBool }
+func (n *notNode) pos() int            { return n.at }
+func (n *notNode) eval(e *Event) value { return value{typ: typeBool, b: !n.x.eval(e).b} }
+
+// compareNode は比較演算
+type compareNode struct {
+	at   int
+	op   string
+	l, r node
+}
+
+func (n *compareNode) typ() valueType { return typeBool }
+func (n *compareNode) pos() int       { return n.at }
+func (n *compareNode) eval(e *Event) value {
+	c := compareValues(n.l.eval(e), n.r.eval(e))
+	var b bool
+	switch n.op {
+	case "==":
+		b = c == 0
+	case "!=":
+		b = c != 0
+	case "<":
+		b = c < 0
+	case "<=":
+		b = c <= 0
+	case ">":
+		b = c > 0
+	case ">=":
+		b = c >= 0
+	}
+	return value{typ: typeBool, b: b}
+}
+
+// regexNode は =~（正規表現）
+type regexNode struct {
+	at int
+	x  node
+	re *regexp.Regexp
+}
+
+func (n *regexNode) typ() valueType { return typeBool }
+func (n *regexNode) pos() int       { return n.at }
+func (n *regexNode) eval(e *Event) value {
+	return value{typ: typeBool, b: n.re.MatchString(n.x.eval(e).s)}
+}
+
+// containsNode は contains（全角半角・ひらがなカタカナ・大文字小文字を区別しない部分一致）
+type containsNode struct {
+	at      int
+	x       node
+	keyword string // 正規化したキーワード
+}
+
+func (n *containsNode) typ() valueType { return typeBool }
+func (n *containsNode) pos() int       { return n.at }
+func (n *containsNode) eval(e *Event) value {
+	return value{typ: typeBool, b: strings.Contains(search.Normalize(n.x.eval(e).s), n.keyword)}
+}
+
+// inNode は in [...]
+type inNode struct {
+	at     int
+	x      node
+	values []value
+}
+
+func (n *inNode) typ() valueType { return typeBool }
+func (n *inNode) pos() int       { return n.at }
+func (n *inNode) eval(e *Event) value {
+	x := n.x.eval(e)
+	for _, v := range n.values {
+		if compareValues(x, v) == 0 {
+			return value{typ: typeBool, b: true}
+		}
+	}
+	return value{typ: typeBool}
+}
+
+// compareValues は同じ型の値を比較する（-1, 0, 1）
+func compareValues(a, b value) int {
+	switch a.typ {
+	case typeBool:
+		switch {
+		case a.b == b.b:
+			return 0
+		case !a.b:
+			return -1
+		}
+		return 1
+	case typeString:
+		return strings.Compare(a.s, b.s)
+	case typeNumber:
+		return cmpOrdered(a.n, b.n)
+	case typeDuration:
+		return cmpOrdered(a.d, b.d)
+	case typeTime:
+		return a.t.Compare(b.t)
+	}
+	return 0
+}
+
+func cmpOrdered[T float64 | time.Duration](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// parser は再帰下降の構文解析器
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.i]
+	if tok.kind != tokenEOF {
+		p.i++
+	}
+	return tok
+}
+
+// isOp は次のトークンが指定した演算子かどうかを返す
+func (p *parser) isOp(op string) bool {
+	tok := p.peek()
+	return tok.kind == tokenOp && tok.text == op
+}
+
+// isKeyword は次のトークンが指定したキーワードかどうかを返す
+func (p *parser) isKeyword(keyword string) bool {
+	tok := p.peek()
+	return tok.kind == tokenIdent && tok.text == keyword
+}
+
+func (p *parser) expectOp(op string) error {
+	if !p.isOp(op) {
+		tok := p.peek()
+		return &Error{Pos: tok.pos, Msg: fmt.Sprintf("expected %q, got %s", op, tok.describe())}
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseLogical("||", p.parseAnd)
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseLogical("&&", p.parseNot)
+}
+
+// parseLogical は && または || で連結された式を解析する
+func (p *parser) parseLogical(op string, operand func() (node, error)) (node, error) {
+	l, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp(op) {
+		at := p.next().pos
+		r, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		if err := expectBool(l, op); err != nil {
+			return nil, err
+		}
+		if err := expectBool(r, op); err != nil {
+			return nil, err
+		}
+		l = &logicalNode{at: at, and: op == "&&", l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.isOp("!") {
+		at := p.next().pos
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if err := expectBool(x, "!"); err != nil {
+			return nil, err
+		}
+		return &notNode{at: at, x: x}, nil
+	}
+	return p.parseComparison()
+}
+
+// compareOps は比較演算子の一覧
+var compareOps = []string{"==", "!=", "<", "<=", ">", ">="}
+
+func (p *parser) parseComparison() (node, error) {
+	l, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	tok := p.peek()
+	switch {
+	case tok.kind == tokenOp && slices.Contains(compareOps, tok.text):
+		p.next()
+		r, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if err := unify(l, r, tok.pos, tok.text); err != nil {
+			return nil, err
+		}
+		if tok.text != "==" && tok.text != "!=" && (l.typ() == typeBool || l.typ() == typeString) {
+			return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("operator %q is not defined for %s", tok.text, l.typ())}
+		}
+		return &compareNode{at: tok.pos, op: tok.text, l: l, r: r}, nil
+
+	case tok.kind == tokenOp && tok.text == "=~":
+		p.next()
+		if err := expectString(l, tok.text, tok.pos); err != nil {
+			return nil, err
+		}
+		pattern, err := p.expectStringLiteral()
+		if err != nil {
+			return nil, err
+		}
+		re, err := regexp.Compile(pattern.text)
+		if err != nil {
+			return nil, &Error{Pos: pattern.pos, Msg: fmt.Sprintf("invalid regex: %v", err)}
+		}
+		return &regexNode{at: tok.pos, x: l, re: re}, nil
+
+	case p.isKeyword("contains"):
+		p.next()
+		if err := expectString(l, tok.text, tok.pos); err != nil {
+			return nil, err
+		}
+		keyword, err := p.expectStringLiteral()
+		if err != nil {
+			return nil, err
+		}
+		return &containsNode{at: tok.pos, x: l, keyword: search.Normalize(keyword.text)}, nil
+
+	case p.isKeyword("in"):
+		p.next()
+		if err := p.expectOp("["); err != nil {
+			return nil, err
+		}
+		n := &inNode{at: tok.pos, x: l}
+		for {
+			elem, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			lit, ok := elem.(*literalNode)
+			if !ok {
+				return nil, &Error{Pos: elem.pos(), Msg: "list elements must be literals"}
+			}
+			if err := unify(l, lit, lit.at, tok.text); err != nil {
+				return nil, err
+			}
+			n.values = append(n.values, lit.v)
+			if p.isOp("]") {
+				p.next()
+				break
+			}
+			if err := p.expectOp(","); err != nil {
+				return nil, err
+			}
+		}
+		return n, nil
+	}
+	return l, nil
+}
+
+func (p *parser) parseOperand() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenString:
+		return &literalNode{at: tok.pos, v: value{typ: typeString, s: tok.text}}, nil
+	case tokenNumber:
+		n, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("invalid number %q", tok.text)}
+		}
+		return &literalNode{at: tok.pos, v: value{typ: typeNumber, n: n}}, nil
+	case tokenIdent:
+		switch tok.text {
+		case "true", "false":
+			return &literalNode{at: tok.pos, v: value{typ: typeBool, b: tok.text == "true"}}, nil
+		case "in", "contains":
+			return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %s", tok.describe())}
+		}
+		f, ok := fields[tok.text]
+		if !ok {
+			return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("unknown field %q", tok.text)}
+		}
+		return &fieldNode{at: tok.pos, f: f}, nil
+	case tokenOp:
+		if tok.text == "(" {
+			x, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectOp(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+	}
+	return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("expected a value, got %s", tok.describe())}
+}
+
+// expectStringLiteral は次のトークンが文字列であることを確認して返す
+func (p *parser) expectStringLiteral() (token, error) {
+	tok := p.next()
+	if tok.kind != tokenString {
+		return tok, &Error{Pos: tok.pos, Msg: fmt.Sprintf("expected a string, got %s", tok.describe())}
+	}
+	return tok, nil
+}
+
+// expectBool は論理演算子のオペランドがboolであることを確認する
+func expectBool(n node, op string) error {
+	if n.typ() != typeBool {
+		return &Error{Pos: n.pos(), Msg: fmt.Sprintf("operand of %q must be a bool, got %s", op, n.typ())}
+	}
+	return nil
+}
+
+// expectString は文字列演算子の左辺がstringであることを確認する
+func expectString(n node, op string, pos int) error {
+	if n.typ() != typeString {
+		return &Error{Pos: pos, Msg: fmt.Sprintf("operator %q is not defined for %s", op, n.typ())}
+	}
+	return nil
+}
+
+// unify は比較する2つの値の型を揃える
+// 一方が定数の場合は他方の型（duration・time）に変換する
+func unify(l, r node, pos int, op string) error {
+	if l.typ() == r.typ() {
+		return nil
+	}
+	if lit, ok := r.(*literalNode); ok {
+		if err := lit.convert(l.typ()); err != errNoConversion {
+			return err
+		}
+	}
+	if lit, ok := l.(*literalNode); ok {
+		if err := lit.convert(r.typ()); err != errNoConversion {
+			return err
+		}
+	}
+	return &Error{Pos: pos, Msg: fmt.Sprintf("mismatched types %s and %s for %q", l.typ(), r.typ(), op)}
+}
+
+// errNoConversion は定数を指定した型に変換できない組み合わせであることを表す
+var errNoConversion = errors.New("no conversion")
+
+// convert は定数を指定した型に変換する
+// 変換できない組み合わせの場合は errNoConversion、値が不正な場合は *Error を返す
+func (n *literalNode) convert(to valueType) error {
+	switch {
+	case to == typeDuration && n.v.typ == typeString:
+		d := mute.ParseDuration(n.v.s)
+		if d == 0 && strings.Trim(n.v.s, "0:") != "" {
+			return &Error{Pos: n.at, Msg: fmt.Sprintf("invalid duration %q", n.v.s)}
+		}
+		n.v = value{typ: typeDuration, d: d}
+	case to == typeDuration && n.v.typ == typeNumber:
+		// 数値は秒数として扱う
+		n.v = value{typ: typeDuration, d: time.Duration(n.v.n * float64(time.Second))}
+	case to == typeTime && n.v.typ == typeString:
+		t, err := time.Parse(time.RFC3339, n.v.s)
+		if err != nil {
+			t, err = time.Parse(time.DateOnly, n.v.s)
+		}
+		if err != nil {
+			return &Error{Pos: n.at, Msg: fmt.Sprintf("invalid time %q (use RFC3339 or YYYY-MM-DD)", n.v.s)}
+		}
+		n.v = value{typ: typeTime, t: t}
+	default:
+		return errNoConversion
+	}
+	return nil
+}
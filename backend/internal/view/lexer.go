@@ -0,0 +1,121 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// tokenKind はトークンの種類
+type tokenKind int
+
+const (
+	tokenEOF    tokenKind = iota
+	tokenIdent            // フィールド名・キーワード（true / false / in / contains）
+	tokenString           // "..." の文字列
+	tokenNumber           // 数値
+	tokenOp               // 演算子・括弧・カンマ
+)
+
+// token は式のトークン
+type token struct {
+	kind tokenKind
+	text string // 元の文字列（文字列リテラルは展開後の値）
+	pos  int    // 先頭の位置（1始まりの文字数）
+}
+
+// operators は演算子の一覧（長いものから順にマッチさせる）
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "=~", "<", ">", "!", "(", ")", "[", "]", ","}
+
+// tokenize は式をトークンに分割する
+func tokenize(src string) ([]token, error) {
+	runes := []rune(src)
+	var tokens []token
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '"':
+			var sb strings.Builder
+			j := i + 1
+			for {
+				if j >= len(runes) {
+					return nil, &Error{Pos: pos, Msg: "unterminated string"}
+				}
+				if runes[j] == '"' {
+					break
+				}
+				if runes[j] == '\\' {
+					if j+1 >= len(runes) {
+						return nil, &Error{Pos: pos, Msg: "unterminated string"}
+					}
+					switch runes[j+1] {
+					case '"', '\\':
+						sb.WriteRune(runes[j+1])
+					case 'n':
+						sb.WriteRune('\n')
+					case 't':
+						sb.WriteRune('\t')
+					default:
+						return nil, &Error{Pos: j + 1, Msg: fmt.Sprintf("invalid escape sequence \\%c", runes[j+1])}
+					}
+					j += 2
+					continue
+				}
+				sb.WriteRune(runes[j])
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenString, text: sb.String(), pos: pos})
+			i = j + 1
+
+		case unicode.IsDigit(r):
+			j := i
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(runes[i:j]), pos: pos})
+			i = j
+
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' || runes[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[i:j]), pos: pos})
+			i = j
+
+		default:
+			matched := false
+			for _, op := range operators {
+				n := len([]rune(op))
+				if i+n <= len(runes) && string(runes[i:i+n]) == op {
+					tokens = append(tokens, token{kind: tokenOp, text: op, pos: pos})
+					i += n
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, &Error{Pos: pos, Msg: fmt.Sprintf("unexpected character %q", r)}
+			}
+		}
+	}
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(runes) + 1})
+	return tokens, nil
+}
+
+// describe はエラーメッセージ用にトークンを表す
+func (t token) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return fmt.Sprintf("string %q", t.text)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
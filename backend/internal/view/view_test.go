@@ -0,0 +1,106 @@
+package view
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestMatch は式の評価のテスト
+func TestMatch(t *testing.T) {
+	stream := &Event{
+		Platform: "twitch",
+		Type:     "live",
+		Title:    "【歌枠】まったり歌います",
+		IsLive:   true,
+		StartAt:  time.Date(2026, 1, 10, 20, 0, 0, 0, time.UTC),
+		Source:   Source{ID: "src-1", Name: "Alice", IsFavorite: true},
+	}
+	video := &Event{
+		Platform:  "youtube",
+		Type:      "video",
+		Title:     "Minecraft hardcore day 5",
+		Duration:  90 * time.Minute,
+		ViewCount: 12000,
+		Watched:   true,
+		StartAt:   time.Date(2025, 12, 31, 12, 0, 0, 0, time.UTC),
+		Source:    Source{ID: "src-2", Name: "Bob", Handle: "@bob"},
+	}
+
+	tests := []struct {
+		name      string
+		expr      string
+		wantLive  bool // streamに対する結果
+		wantVideo bool // videoに対する結果
+	}{
+		{name: "Platform and live", expr: `platform == "twitch" && is_live`, wantLive: true},
+		{name: "Duration string", expr: `duration > "01:00:00" && !source.is_favorite`, wantVideo: true},
+		{name: "Duration seconds", expr: `duration >= 5400`, wantVideo: true},
+		{name: "Duration Go style", expr: `duration < "2h"`, wantLive: true, wantVideo: true},
+		{name: "Favorite source", expr: `source.is_favorite`, wantLive: true},
+		{name: "Or", expr: `is_live || view_count > 10000`, wantLive: true, wantVideo: true},
+		{name: "Not with parentheses", expr: `!(platform == "youtube" || watched)`, wantLive: true},
+		{name: "In list", expr: `type in ["live", "premiere"]`, wantLive: true},
+		{name: "Contains ignores width and kana", expr: `title contains "マッタリ"`, wantLive: true},
+		{name: "Regex", expr: `title =~ "(?i)^minecraft"`, wantVideo: true},
+		{name: "Start date", expr: `start_at >= "2026-01-01"`, wantLive: true},
+		{name: "Start time", expr: `start_at < "2026-01-01T00:00:00+09:00"`, wantVideo: true},
+		{name: "Source handle", expr: `source.handle != ""`, wantVideo: true},
+		{name: "Bool literal", expr: `watched == false`, wantLive: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, err := Compile(tt.expr)
+			if err != nil {
+				t.Fatalf("Compile(%q) error = %v", tt.expr, err)
+			}
+			if got := x.Match(stream); got != tt.wantLive {
+				t.Errorf("Match(stream) = %v, want %v", got, tt.wantLive)
+			}
+			if got := x.Match(video); got != tt.wantVideo {
+				t.Errorf("Match(video) = %v, want %v", got, tt.wantVideo)
+			}
+		})
+	}
+}
+
+// TestCompileError は式のエラーと位置のテスト
+func TestCompileError(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantPos int
+	}{
+		{name: "Empty", expr: "  ", wantPos: 1},
+		{name: "Unknown field", expr: `platform == "twitch" && is_livee`, wantPos: 25},
+		{name: "Missing operand", expr: `is_live &&`, wantPos: 11},
+		{name: "Unterminated string", expr: `title == "abc`, wantPos: 10},
+		{name: "Unexpected character", expr: `is_live & watched`, wantPos: 9},
+		{name: "Mismatched types", expr: `view_count == "many"`, wantPos: 12},
+		{name: "Invalid duration", expr: `duration > "long"`, wantPos: 12},
+		{name: "Invalid time", expr: `start_at > "yesterday"`, wantPos: 12},
+		{name: "Not a bool", expr: `title`, wantPos: 1},
+		{name: "Non-bool operand", expr: `is_live && title`, wantPos: 12},
+		{name: "Ordering strings", expr: `title < "b"`, wantPos: 7},
+		{name: "Invalid regex", expr: `title =~ "(unclosed"`, wantPos: 10},
+		{name: "Regex on number", expr: `view_count =~ "1"`, wantPos: 12},
+		{name: "Unclosed parenthesis", expr: `(is_live || watched`, wantPos: 20},
+		{name: "Trailing token", expr: `is_live watched`, wantPos: 9},
+		{name: "List element type", expr: `type in ["live", 1]`, wantPos: 18},
+		{name: "Position counts characters", expr: `title contains "歌枠" && 配信`, wantPos: 24},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.expr)
+			var exprErr *Error
+			if !errors.As(err, &exprErr) {
+				t.Fatalf("Compile(%q) error = %v, want *Error", tt.expr, err)
+			}
+			if exprErr.Pos != tt.wantPos {
+				t.Errorf("Compile(%q) error position = %d, want %d (%v)", tt.expr, exprErr.Pos, tt.wantPos, err)
+			}
+		})
+	}
+}
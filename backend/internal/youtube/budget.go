@@ -0,0 +1,363 @@
+package youtube
+
+import (
+	"context"
+	"log"
+	"maps"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// 予算の枠（api_quota_usage.bucket）
+const (
+	BucketHigh      = "high"     // 高優先度のソースの定期更新
+	BucketMedium    = "medium"   // 中優先度のソースの定期更新
+	BucketLow       = "low"      // 低優先度のソースの定期更新
+	BucketSearch    = "search"   // チャンネル検索（search.list）
+	BucketBackfill  = "backfill" // 購読時の過去分の取り込み
+	BucketUnplanned = ""         // 枠外（購読時のチャンネル情報の取得など）
+)
+
+// 1回の処理で使うQuotaの見積もり
+const (
+	RefreshCost  = 3   // 定期更新: channels.list + playlistItems.list + videos.list
+	SearchCost   = 101 // 検索: search.list + channels.list
+	BackfillCost = 5   // 過去分の取り込み: channels.list + playlistItems.list・videos.list 2ページ分
+)
+
+// bucketKey はctxに設定する予算の枠のキー
+type bucketKey struct{}
+
+// WithBucket はctxでのAPI呼び出しの使用量を記録する予算の枠を設定
+func WithBucket(ctx context.Context, bucket string) context.Context {
+	return context.WithValue(ctx, bucketKey{}, bucket)
+}
+
+// BucketFromContext はctxに設定された予算の枠（設定されていない場合は枠外）
+func BucketFromContext(ctx context.Context) string {
+	bucket, _ := ctx.Value(bucketKey{}).(string)
+	return bucket
+}
+
+// minPaceFraction は1日の経過割合の下限（日付が変わった直後に見込みが極端に大きくならないようにする）
+const minPaceFraction = 1.0 / 24
+
+// BudgetConfig はQuotaの予算の設定
+type BudgetConfig struct {
+	DailyLimit   int                // 1日のQuota（0の場合は10,000）
+	Reserve      int                // どの枠にも割り当てずに残すQuota（枠外の呼び出し用、0の場合はDailyLimitの10%）
+	Shares       map[string]float64 // 枠ごとの割合（DailyLimit - Reserve に対する、nilの場合はDefaultBudgetShares）
+	SyncInterval time.Duration      // 他のプロセスの使用量を読み込む間隔（0の場合は1分）
+}
+
+// DefaultBudgetShares はデフォルトの枠ごとの割合
+var DefaultBudgetShares = map[string]float64{
+	BucketHigh:     0.30,
+	BucketMedium:   0.20,
+	BucketLow:      0.15,
+	BucketSearch:   0.20,
+	BucketBackfill: 0.15,
+}
+
+// pacedBuckets は1日の経過に合わせて使う枠（使用量の見込みが予算を超える場合は見送る）
+var pacedBuckets = map[string]bool{
+	BucketMedium: true,
+	BucketLow:    true,
+}
+
+// budgetOrder は枠の表示順
+var budgetOrder = []string{BucketHigh, BucketMedium, BucketLow, BucketSearch, BucketBackfill}
+
+// BudgetStore は予算の枠・APIキーごとの使用量の取得（*db.Queries が実装する）
+type BudgetStore interface {
+	KeyUsageStore
+	GetDailyAPIQuotaUsageByBucket(ctx context.Context, arg db.GetDailyAPIQuotaUsageByBucketParams) ([]db.GetDailyAPIQuotaUsageByBucketRow, error)
+}
+
+// UsageRecorder は予算の枠ごとの使用量の記録（*QuotaTracker が実装する）
+type UsageRecorder interface {
+	RecordBucketUsage(ctx context.Context, bucket, endpoint string, cost int) error
+}
+
+// BudgetPlanner は1日のQuotaを枠（優先度ごとの定期更新・検索・過去分の取り込み）に割り当て、
+// 枠の予算を超える呼び出しと、1日の経過に対して使いすぎている低優先度の定期更新を見送る
+type BudgetPlanner struct {
+	store    BudgetStore
+	recorder UsageRecorder
+	cfg      BudgetConfig
+	budgets  map[string]int
+	now      func() time.Time
+
+	mu       sync.Mutex
+	day      string         // 使用量を集計している日付
+	spent    map[string]int // 枠ごとの使用量
+	keySpent map[string]int // APIキーごとの使用量（Syncで読み込んだ値）
+	keys     *keyPool       // Syncのたびに使用量を反映するAPIキー（Client.SetTracker で設定）
+	syncedAt time.Time
+}
+
+// NewBudgetPlanner は予算を計算し、当日の使用量を読み込んで作成
+func NewBudgetPlanner(store BudgetStore, recorder UsageRecorder, cfg BudgetConfig) *BudgetPlanner {
+	if cfg.DailyLimit <= 0 {
+		cfg.DailyLimit = 10000
+	}
+	if cfg.Reserve <= 0 {
+		cfg.Reserve = cfg.DailyLimit / 10
+	}
+	if cfg.Shares == nil {
+		cfg.Shares = DefaultBudgetShares
+	}
+	if cfg.SyncInterval <= 0 {
+		cfg.SyncInterval = time.Minute
+	}
+
+	b := &BudgetPlanner{
+		store:    store,
+		recorder: recorder,
+		cfg:      cfg,
+		budgets:  make(map[string]int),
+		now:      time.Now,
+		spent:    make(map[string]int),
+	}
+	for bucket, share := range cfg.Shares {
+		b.budgets[bucket] = int(math.Round(float64(cfg.DailyLimit-cfg.Reserve) * share))
+	}
+
+	if err := b.Sync(context.Background()); err != nil {
+		log.Printf("⚠️  Failed to load YouTube API quota usage by bucket: %v", err)
+	}
+	return b
+}
+
+// Sync は当日の枠・APIキーごとの使用量をDBから読み込む（他のプロセスの使用量を反映する）
+func (b *BudgetPlanner) Sync(ctx context.Context) error {
+	now := b.now()
+	rows, err := b.store.GetDailyAPIQuotaUsageByBucket(ctx, db.GetDailyAPIQuotaUsageByBucketParams{
+		Date:       quotaDate(now),
+		PlatformID: "youtube",
+	})
+	if err != nil {
+		return err
+	}
+
+	spent := make(map[string]int, len(rows))
+	for _, row := range rows {
+		spent[row.Bucket] = int(row.TotalQuotaUsed)
+	}
+	day, keySpent, err := loadKeyUsage(ctx, b.store, now)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.day = day
+	b.spent = spent
+	b.keySpent = keySpent
+	b.syncedAt = now
+	keys := b.keys
+	b.mu.Unlock()
+
+	if keys != nil {
+		keys.load(day, keySpent)
+	}
+	return nil
+}
+
+// watchKeys は読み込んだAPIキーごとの使用量をkeyPoolに反映し、以降のSyncでも反映する
+func (b *BudgetPlanner) watchKeys(p *keyPool) {
+	b.mu.Lock()
+	b.keys = p
+	day, keySpent := b.day, b.keySpent
+	b.mu.Unlock()
+	p.load(day, keySpent)
+}
+
+// rollover は日付が変わっていたら使用量をリセット（b.mu を保持して呼ぶ）
+func (b *BudgetPlanner) rollover(now time.Time) {
+	if day, _ := quotaDay(now); day != b.day {
+		b.day = day
+		b.spent = make(map[string]int)
+	}
+}
+
+// CanSpend は枠でcostのQuotaを使えるか
+func (b *BudgetPlanner) CanSpend(bucket string, cost int) bool {
+	ok, _ := b.Check(bucket, cost)
+	return ok
+}
+
+// Check は枠でcostのQuotaを使えるかと、使えない場合の理由
+func (b *BudgetPlanner) Check(bucket string, cost int) (bool, string) {
+	now := b.now()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rollover(now)
+	return b.decide(bucket, cost, dayElapsed(now))
+}
+
+// dayElapsed はQuotaの日付が変わってからの経過割合（0〜1）
+func dayElapsed(now time.Time) float64 {
+	_, start := quotaDay(now)
+	return min(1, float64(now.Sub(start))/float64(24*time.Hour))
+}
+
+// decide はbucketでcostのQuotaを使えるか判定（b.mu を保持して呼ぶ）
+//   - 枠外: 1日のQuotaを超えない
+//   - 枠: 予備（Reserve）を残し、枠の予算を超えない
+//   - 中・低優先度の定期更新: さらに、このペースで使い続けた場合の1日の使用量の見込みが枠の予算を超えない
+func (b *BudgetPlanner) decide(bucket string, cost int, elapsed float64) (bool, string) {
+	total := 0
+	for _, spent := range b.spent {
+		total += spent
+	}
+
+	budget, planned := b.budgets[bucket]
+	if !planned {
+		if total+cost > b.cfg.DailyLimit {
+			return false, "daily quota exhausted"
+		}
+		return true, ""
+	}
+	if total+cost > b.cfg.DailyLimit-b.cfg.Reserve {
+		return false, "only reserved quota left"
+	}
+	spent := b.spent[bucket] + cost
+	if spent > budget {
+		return false, "bucket budget exhausted"
+	}
+	if pacedBuckets[bucket] && projectSpend(spent, elapsed) > budget {
+		return false, "projected spend exceeds bucket budget"
+	}
+	return true, ""
+}
+
+// projectSpend は経過割合elapsedまでにspentを使ったペースで1日使い続けた場合の使用量
+func projectSpend(spent int, elapsed float64) int {
+	return int(math.Ceil(float64(spent) / max(elapsed, minPaceFraction)))
+}
+
+// Record はAPI使用量を枠ごとに記録し、SyncIntervalごとに他のプロセスの使用量を読み込む
+func (b *BudgetPlanner) Record(ctx context.Context, bucket, endpoint string, cost int) error {
+	err := b.recorder.RecordBucketUsage(ctx, bucket, endpoint, cost)
+
+	now := b.now()
+	b.mu.Lock()
+	b.rollover(now)
+	b.spent[bucket] += cost
+	stale := now.Sub(b.syncedAt) >= b.cfg.SyncInterval
+	b.mu.Unlock()
+
+	if stale {
+		if syncErr := b.Sync(ctx); syncErr != nil {
+			log.Printf("⚠️  Failed to load YouTube API quota usage by bucket: %v", syncErr)
+		}
+	}
+	return err
+}
+
+// CanUse は1日のQuotaの残りでcostのAPI呼び出しができるか（Client の Tracker として使う）
+func (b *BudgetPlanner) CanUse(cost int) bool {
+	return b.CanSpend(BucketUnplanned, cost)
+}
+
+// RecordUsage はAPI使用量をctxの予算の枠に記録（Client の Tracker として使う）
+func (b *BudgetPlanner) RecordUsage(ctx context.Context, endpoint string, cost int) error {
+	return b.Record(ctx, BucketFromContext(ctx), endpoint, cost)
+}
+
+// ResetsAt は次にQuotaがリセットされる日時（太平洋時間の0時）
+func (b *BudgetPlanner) ResetsAt() time.Time {
+	_, start := quotaDay(b.now())
+	return start.AddDate(0, 0, 1)
+}
+
+// BudgetStatus はQuotaの予算と使用量
+type BudgetStatus struct {
+	Date       string         `json:"date"`
+	DailyLimit int            `json:"daily_limit"`
+	Reserve    int            `json:"reserve"`
+	Spent      int            `json:"spent"`
+	Remaining  int            `json:"remaining"`
+	DayElapsed float64        `json:"day_elapsed"` // 日付が変わってからの経過割合（0〜1）
+	ResetsAt   string         `json:"resets_at"`
+	Buckets    []BucketStatus `json:"buckets"`
+}
+
+// BucketStatus は枠ごとの予算と使用量
+type BucketStatus struct {
+	Name      string `json:"name"`
+	Budget    int    `json:"budget"` // 枠外の場合は0
+	Spent     int    `json:"spent"`
+	Remaining int    `json:"remaining"`
+	Projected int    `json:"projected"` // このペースで使い続けた場合の1日の使用量
+	Paced     bool   `json:"paced"`     // 1日の経過に合わせて使う枠か
+	Deferring bool   `json:"deferring"` // 予算・ペースを超えるため見送っているか（1回の定期更新分を使えない）
+	Reason    string `json:"reason,omitempty"`
+}
+
+// Status は当日の予算と枠ごとの使用量
+func (b *BudgetPlanner) Status() BudgetStatus {
+	now := b.now()
+	elapsed := dayElapsed(now)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rollover(now)
+
+	status := BudgetStatus{
+		Date:       b.day,
+		DailyLimit: b.cfg.DailyLimit,
+		Reserve:    b.cfg.Reserve,
+		DayElapsed: elapsed,
+		ResetsAt:   b.ResetsAt().Format(time.RFC3339),
+	}
+	for _, spent := range b.spent {
+		status.Spent += spent
+	}
+	status.Remaining = max(0, b.cfg.DailyLimit-status.Spent)
+
+	names := append([]string{}, budgetOrder...)
+	for _, bucket := range slices.Sorted(maps.Keys(b.budgets)) {
+		if !slices.Contains(names, bucket) {
+			names = append(names, bucket)
+		}
+	}
+	for _, name := range append(names, BucketUnplanned) {
+		budget := b.budgets[name]
+		spent := b.spent[name]
+		ok, reason := b.decide(name, bucketUnitCost(name), elapsed)
+		bs := BucketStatus{
+			Name:      name,
+			Budget:    budget,
+			Spent:     spent,
+			Remaining: max(0, budget-spent),
+			Projected: projectSpend(spent, elapsed),
+			Paced:     pacedBuckets[name],
+			Deferring: !ok,
+			Reason:    reason,
+		}
+		if name == BucketUnplanned {
+			bs.Name = "unplanned"
+			bs.Remaining = status.Remaining
+		}
+		status.Buckets = append(status.Buckets, bs)
+	}
+	return status
+}
+
+// bucketUnitCost は枠の1回の処理で使うQuotaの見積もり
+func bucketUnitCost(bucket string) int {
+	switch bucket {
+	case BucketSearch:
+		return SearchCost
+	case BucketBackfill:
+		return BackfillCost
+	case BucketUnplanned:
+		return 1
+	default:
+		return RefreshCost
+	}
+}
@@ -0,0 +1,256 @@
+package youtube
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// fakeBudgetStore は枠ごとの使用量のインメモリ実装（RecordBucketUsageで加算する）
+type fakeBudgetStore struct {
+	spent    map[string]int
+	keySpent map[string]int
+	recorded int
+}
+
+func (s *fakeBudgetStore) GetDailyAPIQuotaUsageByBucket(ctx context.Context, arg db.GetDailyAPIQuotaUsageByBucketParams) ([]db.GetDailyAPIQuotaUsageByBucketRow, error) {
+	var rows []db.GetDailyAPIQuotaUsageByBucketRow
+	for bucket, spent := range s.spent {
+		rows = append(rows, db.GetDailyAPIQuotaUsageByBucketRow{Bucket: bucket, TotalQuotaUsed: int32(spent)})
+	}
+	return rows, nil
+}
+
+func (s *fakeBudgetStore) GetDailyAPIQuotaUsageByKey(ctx context.Context, arg db.GetDailyAPIQuotaUsageByKeyParams) ([]db.GetDailyAPIQuotaUsageByKeyRow, error) {
+	var rows []db.GetDailyAPIQuotaUsageByKeyRow
+	for id, spent := range s.keySpent {
+		rows = append(rows, db.GetDailyAPIQuotaUsageByKeyRow{KeyID: id, TotalQuotaUsed: int32(spent)})
+	}
+	return rows, nil
+}
+
+func (s *fakeBudgetStore) RecordBucketUsage(ctx context.Context, bucket, endpoint string, cost int) error {
+	s.spent[bucket] += cost
+	s.recorded++
+	return nil
+}
+
+// newTestPlanner は1日1,000（予備100、枠ごとの予算 high=270 medium=180 low=135 search=180 backfill=135）の予算でnowの時点のプランナーを作成
+func newTestPlanner(spent map[string]int, now time.Time) (*BudgetPlanner, *fakeBudgetStore) {
+	store := &fakeBudgetStore{spent: spent}
+	b := NewBudgetPlanner(store, store, BudgetConfig{DailyLimit: 1000, Reserve: 100})
+	b.now = func() time.Time { return now }
+	b.Sync(context.Background())
+	return b, store
+}
+
+// TestBudgetPlannerCheck は枠の予算・予備・ペースによる判定のテスト
+func TestBudgetPlannerCheck(t *testing.T) {
+	noon := time.Date(2025, 6, 1, 12, 0, 0, 0, quotaLocation)
+
+	tests := []struct {
+		name       string
+		spent      map[string]int
+		now        time.Time
+		bucket     string
+		cost       int
+		want       bool
+		wantReason string
+	}{
+		{
+			name:   "within bucket budget",
+			spent:  map[string]int{BucketHigh: 100},
+			now:    noon,
+			bucket: BucketHigh,
+			cost:   RefreshCost,
+			want:   true,
+		},
+		{
+			name:       "bucket budget exhausted",
+			spent:      map[string]int{BucketSearch: 100},
+			now:        noon,
+			bucket:     BucketSearch,
+			cost:       SearchCost,
+			wantReason: "bucket budget exhausted",
+		},
+		{
+			name:   "other buckets do not affect budget",
+			spent:  map[string]int{BucketSearch: 180, BucketBackfill: 135},
+			now:    noon,
+			bucket: BucketHigh,
+			cost:   RefreshCost,
+			want:   true,
+		},
+		{
+			name:       "only reserve left",
+			spent:      map[string]int{BucketHigh: 250, BucketUnplanned: 650},
+			now:        noon,
+			bucket:     BucketHigh,
+			cost:       RefreshCost,
+			wantReason: "only reserved quota left",
+		},
+		{
+			name:   "unplanned may use reserve",
+			spent:  map[string]int{BucketHigh: 250, BucketUnplanned: 650},
+			now:    noon,
+			bucket: BucketUnplanned,
+			cost:   1,
+			want:   true,
+		},
+		{
+			name:       "unplanned stops at daily limit",
+			spent:      map[string]int{BucketUnplanned: 1000},
+			now:        noon,
+			bucket:     BucketUnplanned,
+			cost:       1,
+			wantReason: "daily quota exhausted",
+		},
+		{
+			name:   "low priority on pace",
+			spent:  map[string]int{BucketLow: 60},
+			now:    noon,
+			bucket: BucketLow,
+			cost:   RefreshCost,
+			want:   true,
+		},
+		{
+			name:       "low priority ahead of pace is deferred",
+			spent:      map[string]int{BucketLow: 70},
+			now:        noon,
+			bucket:     BucketLow,
+			cost:       RefreshCost,
+			wantReason: "projected spend exceeds bucket budget",
+		},
+		{
+			name:   "high priority is not paced",
+			spent:  map[string]int{BucketHigh: 200},
+			now:    time.Date(2025, 6, 1, 1, 0, 0, 0, quotaLocation),
+			bucket: BucketHigh,
+			cost:   RefreshCost,
+			want:   true,
+		},
+		{
+			name:   "pace is not extreme right after reset",
+			spent:  map[string]int{},
+			now:    time.Date(2025, 6, 1, 0, 1, 0, 0, quotaLocation),
+			bucket: BucketMedium,
+			cost:   RefreshCost,
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := newTestPlanner(tt.spent, tt.now)
+			got, reason := b.Check(tt.bucket, tt.cost)
+			if got != tt.want || reason != tt.wantReason {
+				t.Errorf("Check(%q, %d) = %v, %q, want %v, %q", tt.bucket, tt.cost, got, reason, tt.want, tt.wantReason)
+			}
+		})
+	}
+}
+
+// TestBudgetPlannerRecord は使用量の記録・他のプロセスの使用量の読み込み・日付が変わったときのリセットのテスト
+func TestBudgetPlannerRecord(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, quotaLocation)
+	b, store := newTestPlanner(map[string]int{}, now)
+	b.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for range 67 {
+		b.Record(ctx, BucketHigh, "videos.list", 4)
+	}
+	if store.recorded != 67 {
+		t.Errorf("recorded = %d, want 67", store.recorded)
+	}
+	if b.CanSpend(BucketHigh, RefreshCost) {
+		t.Error("CanSpend() = true after spending the bucket budget")
+	}
+
+	// 他のプロセスの使用量はSyncInterval後に反映する
+	store.spent[BucketSearch] = 180
+	if !b.CanSpend(BucketSearch, 1) {
+		t.Error("usage of other processes applied before sync")
+	}
+	now = now.Add(time.Minute)
+	b.Record(ctx, BucketUnplanned, "channels.list", 1)
+	if b.CanSpend(BucketSearch, 1) {
+		t.Error("usage of other processes not applied after sync")
+	}
+
+	// 日付が変わったらリセット
+	now = time.Date(2025, 6, 2, 6, 0, 0, 0, quotaLocation)
+	if !b.CanSpend(BucketHigh, RefreshCost) {
+		t.Error("CanSpend() = false after the quota reset")
+	}
+	if got := b.ResetsAt(); !got.Equal(time.Date(2025, 6, 3, 0, 0, 0, 0, quotaLocation)) {
+		t.Errorf("ResetsAt() = %s, want 2025-06-03 00:00", got)
+	}
+}
+
+// TestBudgetPlannerStatus は管理APIで返す予算と使用量のテスト
+func TestBudgetPlannerStatus(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, quotaLocation)
+	b, _ := newTestPlanner(map[string]int{
+		BucketHigh:      90,
+		BucketLow:       100,
+		BucketSearch:    101,
+		BucketUnplanned: 9,
+	}, now)
+
+	status := b.Status()
+	if status.Date != "2025-06-01" || status.Spent != 300 || status.Remaining != 700 || status.DayElapsed != 0.5 {
+		t.Errorf("status = %+v", status)
+	}
+
+	want := []BucketStatus{
+		{Name: BucketHigh, Budget: 270, Spent: 90, Remaining: 180, Projected: 180},
+		{Name: BucketMedium, Budget: 180, Remaining: 180, Paced: true},
+		{Name: BucketLow, Budget: 135, Spent: 100, Remaining: 35, Projected: 200, Paced: true, Deferring: true, Reason: "projected spend exceeds bucket budget"},
+		{Name: BucketSearch, Budget: 180, Spent: 101, Remaining: 79, Projected: 202, Deferring: true, Reason: "bucket budget exhausted"},
+		{Name: BucketBackfill, Budget: 135, Remaining: 135},
+		{Name: "unplanned", Spent: 9, Remaining: 700, Projected: 18},
+	}
+	if len(status.Buckets) != len(want) {
+		t.Fatalf("buckets = %+v, want %d buckets", status.Buckets, len(want))
+	}
+	for i, got := range status.Buckets {
+		if got != want[i] {
+			t.Errorf("bucket %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
+// TestBudgetPlannerKeyUsage はDBのAPIキーごとの使用量を起動時とSyncのたびにキーの選択に反映することのテスト
+func TestBudgetPlannerKeyUsage(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, quotaLocation)
+	b, store := newTestPlanner(map[string]int{}, now)
+	p := newKeyPool([]string{"k1", "k2"})
+	p.limit = 2
+	p.now = func() time.Time { return now }
+
+	// 再起動前にk1を使い切っていた
+	store.keySpent = map[string]int{KeyID("k1"): 2}
+	b.Sync(context.Background())
+	b.watchKeys(p)
+	if k := p.pick(1, nil); k == nil || k.value != "k2" {
+		t.Fatalf("pick() after start = %v, want k2", k)
+	}
+
+	// 他のプロセスがk2を使い切った
+	store.keySpent[KeyID("k2")] = 2
+	b.Sync(context.Background())
+	if k := p.pick(1, nil); k != nil {
+		t.Errorf("pick() after sync = %s, want no key", k.value)
+	}
+
+	// 前日の使用量は反映しない
+	p = newKeyPool([]string{"k1"})
+	p.now = func() time.Time { return now.AddDate(0, 0, 1) }
+	b.watchKeys(p)
+	if p.keys[0].used != 0 {
+		t.Errorf("used after day change = %d, want 0", p.keys[0].used)
+	}
+}
@@ -2,30 +2,81 @@ package youtube
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"google.golang.org/api/option"
 	"google.golang.org/api/youtube/v3"
 )
 
+// ErrQuotaExhausted は1日のAPI Quotaを使い切ったため呼び出さなかったエラー
+var ErrQuotaExhausted = errors.New("youtube: daily API quota exhausted")
+
+// QuotaExhaustedError はQuotaが足りずに呼び出さなかったAPI（errors.Is(err, ErrQuotaExhausted) で判定できる）
+type QuotaExhaustedError struct {
+	Endpoint string
+	Cost     int
+}
+
+func (e *QuotaExhaustedError) Error() string {
+	return fmt.Sprintf("%v: %s needs %d units", ErrQuotaExhausted, e.Endpoint, e.Cost)
+}
+
+func (e *QuotaExhaustedError) Is(target error) bool { return target == ErrQuotaExhausted }
+
+// Tracker はAPI呼び出しごとのQuotaの確認と使用量の記録（*QuotaTracker・*BudgetPlanner が実装する）
+type Tracker interface {
+	CanUse(cost int) bool
+	RecordUsage(ctx context.Context, endpoint string, cost int) error
+}
+
 // Client は YouTube Data API v3 のクライアント
 type Client struct {
 	service *youtube.Service
+	keys    *keyPool
+	tracker Tracker
 }
 
 // NewClient は YouTube クライアントを作成
-func NewClient(apiKey string) (*Client, error) {
+// APIキーを複数指定した場合は、指定した順に当日のQuotaが残っているキーを使い、
+// Quotaを使い切った（quotaExceeded）キーはQuotaがリセットされるまで使わずに次のキーで再試行する
+func NewClient(apiKeys ...string) (*Client, error) {
+	return newClient(apiKeys)
+}
+
+func newClient(apiKeys []string, opts ...option.ClientOption) (*Client, error) {
+	if len(apiKeys) == 0 {
+		return nil, fmt.Errorf("failed to create YouTube client: no API key")
+	}
+
+	// APIキーはリクエストごとに選ぶ（keyPool）
 	ctx := context.Background()
-	service, err := youtube.NewService(ctx, option.WithAPIKey(apiKey))
+	service, err := youtube.NewService(ctx, append([]option.ClientOption{option.WithoutAuthentication()}, opts...)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create YouTube client: %v", err)
 	}
 
 	return &Client{
 		service: service,
+		keys:    newKeyPool(apiKeys),
 	}, nil
 }
 
+// SetTracker はAPI呼び出しごとにQuotaを確認・記録するTrackerを設定
+// 設定した場合、Quotaを使い切ったらAPIを呼び出さずに ErrQuotaExhausted を返す
+func (c *Client) SetTracker(tracker Tracker) {
+	c.tracker = tracker
+	// 再起動や他のプロセスで使った分を含めて、当日のQuotaが残っているキーを選ぶ
+	if w, ok := tracker.(keyWatcher); ok {
+		w.watchKeys(c.keys)
+	}
+}
+
+// DailyQuota はすべてのAPIキーを合わせた1日のQuota
+func (c *Client) DailyQuota() int32 {
+	return int32(len(c.keys.keys) * DailyQuotaPerKey)
+}
+
 // SearchLiveStreams はライブ配信を検索
 // query: 検索キーワード
 // maxResults: 取得する最大件数（最大50）
@@ -36,9 +87,9 @@ func (c *Client) SearchLiveStreams(ctx context.Context, query string, maxResults
 	call = call.EventType("live") // ライブ配信のみ
 	call = call.MaxResults(maxResults)
 
-	response, err := call.Do()
+	response, err := do(ctx, c, "search.list", call.Do)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search live streams: %v", err)
+		return nil, fmt.Errorf("failed to search live streams: %w", err)
 	}
 
 	return response.Items, nil
@@ -49,9 +100,9 @@ func (c *Client) GetVideoDetails(ctx context.Context, videoID string) (*youtube.
 	call := c.service.Videos.List([]string{"snippet", "liveStreamingDetails", "statistics", "contentDetails"})
 	call = call.Id(videoID)
 
-	response, err := call.Do()
+	response, err := do(ctx, c, "videos.list", call.Do)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get video details: %v", err)
+		return nil, fmt.Errorf("failed to get video details: %w", err)
 	}
 
 	if len(response.Items) == 0 {
@@ -81,9 +132,9 @@ func (c *Client) GetVideosDetails(ctx context.Context, videoIDs []string) ([]*yo
 		call := c.service.Videos.List([]string{"snippet", "contentDetails", "liveStreamingDetails", "statistics"})
 		call = call.Id(batch...)
 
-		response, err := call.Do()
+		response, err := do(ctx, c, "videos.list", call.Do)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get videos details: %v", err)
+			return nil, fmt.Errorf("failed to get videos details: %w", err)
 		}
 
 		allVideos = append(allVideos, response.Items...)
@@ -97,9 +148,9 @@ func (c *Client) GetChannelInfo(ctx context.Context, channelID string) (*youtube
 	call := c.service.Channels.List([]string{"snippet", "statistics"})
 	call = call.Id(channelID)
 
-	response, err := call.Do()
+	response, err := do(ctx, c, "channels.list", call.Do)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get channel info: %v", err)
+		return nil, fmt.Errorf("failed to get channel info: %w", err)
 	}
 
 	if len(response.Items) == 0 {
@@ -117,9 +168,9 @@ func (c *Client) SearchUpcomingStreams(ctx context.Context, query string, maxRes
 	call = call.EventType("upcoming") // 今後予定されている配信
 	call = call.MaxResults(maxResults)
 
-	response, err := call.Do()
+	response, err := do(ctx, c, "search.list", call.Do)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search upcoming streams: %v", err)
+		return nil, fmt.Errorf("failed to search upcoming streams: %w", err)
 	}
 
 	return response.Items, nil
@@ -137,9 +188,9 @@ func (c *Client) GetChannelVideosSince(ctx context.Context, channelID string, ma
 	channelCall := c.service.Channels.List([]string{"contentDetails"})
 	channelCall = channelCall.Id(channelID)
 	
-	channelResponse, err := channelCall.Do()
+	channelResponse, err := do(ctx, c, "channels.list", channelCall.Do)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get channel info: %v", err)
+		return nil, fmt.Errorf("failed to get channel info: %w", err)
 	}
 	
 	if len(channelResponse.Items) == 0 {
@@ -162,9 +213,9 @@ func (c *Client) GetChannelVideosSince(ctx context.Context, channelID string, ma
 			playlistCall = playlistCall.PageToken(pageToken)
 		}
 		
-		playlistResponse, err := playlistCall.Do()
+		playlistResponse, err := do(ctx, c, "playlistItems.list", playlistCall.Do)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get playlist items: %v", err)
+			return nil, fmt.Errorf("failed to get playlist items: %w", err)
 		}
 		
 		// PlaylistItemをSearchResult形式に変換
@@ -231,9 +282,9 @@ func (c *Client) SearchChannels(ctx context.Context, query string, maxResults in
 	call = call.MaxResults(maxResults)
 	call = call.RegionCode("JP")
 
-	response, err := call.Do()
+	response, err := do(ctx, c, "search.list", call.Do)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search channels: %v", err)
+		return nil, fmt.Errorf("failed to search channels: %w", err)
 	}
 
 	if len(response.Items) == 0 {
@@ -251,7 +302,7 @@ func (c *Client) SearchChannels(ctx context.Context, query string, maxResults in
 	// channels.list で subscriberCount, handle を取得
 	enrichCall := c.service.Channels.List([]string{"snippet", "statistics"})
 	enrichCall = enrichCall.Id(channelIDs...)
-	enrichResp, err := enrichCall.Do()
+	enrichResp, err := do(ctx, c, "channels.list", enrichCall.Do)
 	if err != nil {
 		// enrichment 失敗時は search.list の結果のみで返す
 		var results []ChannelSearchResult
@@ -332,9 +383,9 @@ func (c *Client) ResolveHandle(ctx context.Context, handle string) (string, erro
 	call := c.service.Channels.List([]string{"id"})
 	call = call.ForHandle(handle)
 	
-	response, err := call.Do()
+	response, err := do(ctx, c, "channels.list", call.Do)
 	if err != nil {
-		return "", fmt.Errorf("failed to resolve handle: %v", err)
+		return "", fmt.Errorf("failed to resolve handle: %w", err)
 	}
 	
 	if len(response.Items) == 0 {
@@ -350,9 +401,9 @@ func (c *Client) GetChannelDetails(ctx context.Context, channelID string) (*Chan
 	call := c.service.Channels.List([]string{"id", "snippet", "contentDetails"})
 	call = call.Id(channelID)
 	
-	response, err := call.Do()
+	response, err := do(ctx, c, "channels.list", call.Do)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get channel details: %v", err)
+		return nil, fmt.Errorf("failed to get channel details: %w", err)
 	}
 	
 	if len(response.Items) == 0 {
@@ -0,0 +1,121 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/option"
+)
+
+// fakeTracker は1日のQuotaを固定値としてエンドポイント・枠ごとの使用量を記録する
+type fakeTracker struct {
+	mu      sync.Mutex
+	limit   int
+	used    int
+	calls   map[string]int // エンドポイントごとの記録数
+	buckets map[string]int // 枠ごとの使用量
+}
+
+func newFakeTracker(limit int) *fakeTracker {
+	return &fakeTracker{limit: limit, calls: make(map[string]int), buckets: make(map[string]int)}
+}
+
+func (t *fakeTracker) CanUse(cost int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.used+cost <= t.limit
+}
+
+func (t *fakeTracker) RecordUsage(ctx context.Context, endpoint string, cost int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.used += cost
+	t.calls[endpoint]++
+	t.buckets[BucketFromContext(ctx)] += cost
+	return nil
+}
+
+// newFakeAPI はチャンネル UC1 のアップロード動画を2ページで返すYouTube Data APIのスタンドイン
+func newFakeAPI(t *testing.T) (*httptest.Server, *int) {
+	t.Helper()
+	var requests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/youtube/v3/channels", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"items":[{"id":"UC1","contentDetails":{"relatedPlaylists":{"uploads":"UU1"}}}]}`)
+	})
+	mux.HandleFunc("/youtube/v3/playlistItems", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("pageToken") == "" {
+			fmt.Fprint(w, `{"nextPageToken":"p2","items":[{"snippet":{"publishedAt":"2025-06-02T00:00:00Z"},"contentDetails":{"videoId":"v2"}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"items":[{"snippet":{"publishedAt":"2025-06-01T00:00:00Z"},"contentDetails":{"videoId":"v1"}}]}`)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+// TestClientQuotaAccounting はページングを含むすべてのAPI呼び出しの使用量をctxの枠に記録することのテスト
+func TestClientQuotaAccounting(t *testing.T) {
+	tests := []struct {
+		name         string
+		limit        int
+		wantVideos   int
+		wantErr      error
+		wantCalls    map[string]int
+		wantRequests int
+	}{
+		{
+			name:         "records every page",
+			limit:        100,
+			wantVideos:   2,
+			wantCalls:    map[string]int{"channels.list": 1, "playlistItems.list": 2},
+			wantRequests: 3,
+		},
+		{
+			name:         "fails fast when quota is exhausted",
+			limit:        2,
+			wantErr:      ErrQuotaExhausted,
+			wantCalls:    map[string]int{"channels.list": 1, "playlistItems.list": 1},
+			wantRequests: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := newFakeAPI(t)
+			c, err := newClient([]string{"k1"}, option.WithEndpoint(srv.URL+"/"))
+			if err != nil {
+				t.Fatalf("newClient() error = %v", err)
+			}
+			tracker := newFakeTracker(tt.limit)
+			c.SetTracker(tracker)
+
+			videos, err := c.GetChannelVideosSince(WithBucket(context.Background(), BucketHigh), "UC1", 0, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetChannelVideosSince() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(videos) != tt.wantVideos {
+				t.Errorf("videos = %d, want %d", len(videos), tt.wantVideos)
+			}
+			for endpoint, want := range tt.wantCalls {
+				if tracker.calls[endpoint] != want {
+					t.Errorf("recorded %s = %d, want %d", endpoint, tracker.calls[endpoint], want)
+				}
+			}
+			if tracker.buckets[BucketHigh] != tracker.used {
+				t.Errorf("usage by bucket = %v, want all in %q", tracker.buckets, BucketHigh)
+			}
+			if *requests != tt.wantRequests {
+				t.Errorf("API requests = %d, want %d", *requests, tt.wantRequests)
+			}
+		})
+	}
+}
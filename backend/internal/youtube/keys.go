@@ -0,0 +1,221 @@
+package youtube
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kinchoKayaba/pixicast/backend/db"
+	"google.golang.org/api/googleapi"
+)
+
+// DailyQuotaPerKey はAPIキー1つあたりの1日のQuota
+const DailyQuotaPerKey = 10000
+
+// keyErrorReasons は別のキーで再試行する403のreason
+// trueの場合はQuotaがリセットされるまでそのキーを使わない（falseの場合はそのリクエストだけ別のキーで再試行する）
+var keyErrorReasons = map[string]bool{
+	"quotaExceeded":         true, // キーの1日のQuotaを使い切った
+	"dailyLimitExceeded":    true,
+	"accessNotConfigured":   true, // キーのプロジェクトでYouTube Data APIが有効になっていない
+	"ipRefererBlocked":      true, // キーの制限で使えない
+	"rateLimitExceeded":     false,
+	"userRateLimitExceeded": false,
+}
+
+// ParseAPIKeys はカンマ区切りのAPIキーを分割（空の要素は除く）
+func ParseAPIKeys(s string) []string {
+	var keys []string
+	for _, key := range strings.Split(s, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// KeyID はAPIキーの識別子（SHA-256の先頭8文字、ログ・api_quota_usage.key_id に使う）
+func KeyID(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])[:8]
+}
+
+// apiKey はキーごとの当日の使用量
+type apiKey struct {
+	value     string
+	id        string
+	used      int
+	exhausted bool // quotaExceededなどが返ったため、Quotaがリセットされるまで使わない
+}
+
+// keyPool はAPIキーの選択
+// 設定した順に、当日のQuotaが残っているキーを使う（同じ状態なら常に同じキーを選ぶ）
+type keyPool struct {
+	limit int // キーごとの1日のQuota
+	now   func() time.Time
+
+	mu   sync.Mutex
+	day  string // 使用量を集計している日付（太平洋時間）
+	keys []*apiKey
+}
+
+func newKeyPool(keys []string) *keyPool {
+	p := &keyPool{limit: DailyQuotaPerKey, now: time.Now}
+	for _, key := range keys {
+		p.keys = append(p.keys, &apiKey{value: key, id: KeyID(key)})
+	}
+	return p
+}
+
+// rollover は日付が変わっていたらキーの使用量をリセット（p.mu を保持して呼ぶ）
+func (p *keyPool) rollover() {
+	if day, _ := quotaDay(p.now()); day != p.day {
+		p.day = day
+		for _, k := range p.keys {
+			k.used = 0
+			k.exhausted = false
+		}
+	}
+}
+
+// pick はcostのQuotaが残っている最初のキーを選んで使用量を加算（skipのキーは除く）
+// 使えるキーがない場合はnilを返す
+func (p *keyPool) pick(cost int, skip map[string]bool) *apiKey {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rollover()
+	for _, k := range p.keys {
+		if k.exhausted || skip[k.id] || k.used+cost > p.limit {
+			continue
+		}
+		k.used += cost
+		return k
+	}
+	return nil
+}
+
+// load はday（太平洋時間）のDBのキーごとの使用量を反映（他のプロセスの使用量を含む）
+// このプロセスの使用量もDBに記録しているため、多い方を使う（日付が違う場合は反映しない）
+func (p *keyPool) load(day string, used map[string]int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rollover()
+	if day != p.day {
+		return
+	}
+	for _, k := range p.keys {
+		k.used = max(k.used, used[k.id])
+	}
+}
+
+// KeyUsageStore はAPIキーごとの使用量の取得（*db.Queries が実装する）
+type KeyUsageStore interface {
+	GetDailyAPIQuotaUsageByKey(ctx context.Context, arg db.GetDailyAPIQuotaUsageByKeyParams) ([]db.GetDailyAPIQuotaUsageByKeyRow, error)
+}
+
+// loadKeyUsage はnowの日付（太平洋時間）のAPIキーごとの使用量をDBから読み込む
+func loadKeyUsage(ctx context.Context, store KeyUsageStore, now time.Time) (string, map[string]int, error) {
+	rows, err := store.GetDailyAPIQuotaUsageByKey(ctx, db.GetDailyAPIQuotaUsageByKeyParams{
+		Date:       quotaDate(now),
+		PlatformID: "youtube",
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	used := make(map[string]int, len(rows))
+	for _, row := range rows {
+		used[row.KeyID] = int(row.TotalQuotaUsed)
+	}
+	day, _ := quotaDay(now)
+	return day, used, nil
+}
+
+// keyWatcher はDBから読み込んだAPIキーごとの使用量をkeyPoolに反映するTracker（*BudgetPlanner・*QuotaTracker が実装する）
+type keyWatcher interface {
+	watchKeys(p *keyPool)
+}
+
+// disable はキーをQuotaがリセットされるまで使わない
+func (p *keyPool) disable(k *apiKey) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	k.exhausted = true
+}
+
+// keyError はAPIキーが原因の403の場合にreasonと、キーをQuotaがリセットされるまで使わないかを返す
+// 動画・チャンネルの権限など、リソースが原因の403の場合はokがfalse
+func keyError(err error) (reason string, disable, ok bool) {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusForbidden {
+		return "", false, false
+	}
+	if len(apiErr.Errors) == 0 {
+		return "forbidden", false, true
+	}
+	for _, item := range apiErr.Errors {
+		if disable, ok := keyErrorReasons[item.Reason]; ok {
+			return item.Reason, disable, true
+		}
+	}
+	return "", false, false
+}
+
+// do はQuotaを確認してAPIキーを選び、APIを呼び出して使用量を記録
+// キーが原因の403（quotaExceededなど）の場合は次のキーで再試行する
+// 失敗したリクエストもQuotaを消費するため、呼び出しの結果にかかわらず記録する
+func do[T any](ctx context.Context, c *Client, endpoint string, call func(...googleapi.CallOption) (T, error)) (T, error) {
+	var zero T
+	cost := QuotaCost[endpoint]
+	skip := make(map[string]bool)
+	var lastErr error
+	for {
+		if c.tracker != nil && !c.tracker.CanUse(cost) {
+			return zero, &QuotaExhaustedError{Endpoint: endpoint, Cost: cost}
+		}
+		key := c.keys.pick(cost, skip)
+		if key == nil && lastErr != nil {
+			// すべてのキーが一時的に拒否した（rateLimitExceededなど）
+			return zero, lastErr
+		}
+		if key == nil {
+			return zero, &QuotaExhaustedError{Endpoint: endpoint, Cost: cost}
+		}
+		if c.tracker != nil {
+			c.tracker.RecordUsage(withKeyID(context.WithoutCancel(ctx), key.id), endpoint, cost)
+		}
+
+		res, err := call(googleapi.QueryParameter("key", key.value))
+		reason, disable, ok := keyError(err)
+		if !ok {
+			return res, err
+		}
+		skip[key.id] = true
+		if disable {
+			c.keys.disable(key)
+			log.Printf("⚠️ YouTube API key %s unavailable until quota reset (%s): failing over", key.id, reason)
+		} else {
+			lastErr = err
+			log.Printf("⚠️ YouTube API key %s rejected %s (%s): retrying with another key", key.id, endpoint, reason)
+		}
+	}
+}
+
+// keyIDKey はctxに設定するAPIキーの識別子のキー
+type keyIDKey struct{}
+
+// withKeyID はctxでのAPI呼び出しに使ったAPIキーの識別子を設定（Trackerがキーごとに記録するため）
+func withKeyID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, keyIDKey{}, id)
+}
+
+// keyIDFromContext はctxに設定されたAPIキーの識別子（設定されていない場合は空文字）
+func keyIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(keyIDKey{}).(string)
+	return id
+}
@@ -0,0 +1,168 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+// fakeKeyAPI はAPIキーごとに403のreasonを返すchannels.listのスタンドイン
+type fakeKeyAPI struct {
+	mu      sync.Mutex
+	reasons map[string]string // キーごとの403のreason（含まれないキーは成功する）
+	keys    []string          // リクエストに使われたキー
+}
+
+func (f *fakeKeyAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Query().Get("key")
+	f.mu.Lock()
+	f.keys = append(f.keys, key)
+	reason, rejected := f.reasons[key]
+	f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if rejected {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintf(w, `{"error":{"code":403,"message":"%s","errors":[{"reason":"%s"}]}}`, reason, reason)
+		return
+	}
+	fmt.Fprint(w, `{"items":[{"id":"UC1","snippet":{"title":"channel"},"contentDetails":{"relatedPlaylists":{"uploads":"UU1"}}}]}`)
+}
+
+// TestClientKeyFailover はAPIキーの選択とQuotaを使い切ったキーからのフェイルオーバーのテスト
+func TestClientKeyFailover(t *testing.T) {
+	tests := []struct {
+		name          string
+		reasons       map[string]string
+		wantErr       error
+		wantForbidden bool     // キーではなくリソースが原因の403がそのまま返る
+		wantKeys      []string // 2回の呼び出しでリクエストに使われたキー
+		wantRecords   map[string]int
+	}{
+		{
+			name:        "uses first key",
+			reasons:     map[string]string{},
+			wantKeys:    []string{"k1", "k1"},
+			wantRecords: map[string]int{KeyID("k1"): 2},
+		},
+		{
+			name:        "fails over on quotaExceeded and keeps using next key",
+			reasons:     map[string]string{"k1": "quotaExceeded"},
+			wantKeys:    []string{"k1", "k2", "k2"},
+			wantRecords: map[string]int{KeyID("k1"): 1, KeyID("k2"): 2},
+		},
+		{
+			name:        "retries rate limited key on next call",
+			reasons:     map[string]string{"k1": "rateLimitExceeded"},
+			wantKeys:    []string{"k1", "k2", "k1", "k2"},
+			wantRecords: map[string]int{KeyID("k1"): 2, KeyID("k2"): 2},
+		},
+		{
+			name:        "all keys exhausted",
+			reasons:     map[string]string{"k1": "quotaExceeded", "k2": "dailyLimitExceeded"},
+			wantErr:     ErrQuotaExhausted,
+			wantKeys:    []string{"k1", "k2"},
+			wantRecords: map[string]int{KeyID("k1"): 1, KeyID("k2"): 1},
+		},
+		{
+			name:          "resource forbidden does not fail over",
+			reasons:       map[string]string{"k1": "forbidden"},
+			wantForbidden: true,
+			wantKeys:      []string{"k1", "k1"},
+			wantRecords:   map[string]int{KeyID("k1"): 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeKeyAPI{reasons: tt.reasons}
+			srv := httptest.NewServer(api)
+			defer srv.Close()
+			c, err := newClient([]string{"k1", "k2"}, option.WithEndpoint(srv.URL+"/"))
+			if err != nil {
+				t.Fatalf("newClient() error = %v", err)
+			}
+			tracker := &keyRecorder{records: make(map[string]int)}
+			c.SetTracker(tracker)
+
+			for range 2 {
+				_, err = c.GetChannelDetails(context.Background(), "UC1")
+				if tt.wantForbidden {
+					var apiErr *googleapi.Error
+					if !errors.As(err, &apiErr) || apiErr.Code != http.StatusForbidden {
+						t.Errorf("GetChannelDetails() error = %v, want forbidden", err)
+					}
+				} else if !errors.Is(err, tt.wantErr) {
+					t.Errorf("GetChannelDetails() error = %v, want %v", err, tt.wantErr)
+				}
+			}
+			if !slices.Equal(api.keys, tt.wantKeys) {
+				t.Errorf("keys = %v, want %v", api.keys, tt.wantKeys)
+			}
+			for id, want := range tt.wantRecords {
+				if tracker.records[id] != want {
+					t.Errorf("records of key %s = %d, want %d (all: %v)", id, tracker.records[id], want, tracker.records)
+				}
+			}
+		})
+	}
+}
+
+// keyRecorder はAPIキーごとの記録数を数えるTracker
+type keyRecorder struct {
+	mu      sync.Mutex
+	records map[string]int
+}
+
+func (r *keyRecorder) CanUse(cost int) bool { return true }
+
+func (r *keyRecorder) RecordUsage(ctx context.Context, endpoint string, cost int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records[keyIDFromContext(ctx)]++
+	return nil
+}
+
+// TestKeyPoolPick はキーごとのQuotaによるキーの選択と太平洋時間の0時のリセットのテスト
+func TestKeyPoolPick(t *testing.T) {
+	p := newKeyPool([]string{"k1", "k2"})
+	p.limit = 2
+	// 2025-06-01 23:00 PDT
+	now := time.Date(2025, 6, 2, 6, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+
+	var picked []string
+	for range 5 {
+		if k := p.pick(1, nil); k != nil {
+			picked = append(picked, k.value)
+		} else {
+			picked = append(picked, "")
+		}
+	}
+	if want := []string{"k1", "k1", "k2", "k2", ""}; !slices.Equal(picked, want) {
+		t.Errorf("picked = %v, want %v", picked, want)
+	}
+
+	p.disable(p.keys[0])
+	now = time.Date(2025, 6, 2, 7, 0, 0, 0, time.UTC)
+	if k := p.pick(1, nil); k == nil || k.value != "k1" {
+		t.Errorf("pick() after midnight Pacific = %v, want k1", k)
+	}
+}
+
+// TestParseAPIKeys はカンマ区切りのAPIキーの分割のテスト
+func TestParseAPIKeys(t *testing.T) {
+	got := ParseAPIKeys(" k1, k2 ,,k3 ")
+	if want := []string{"k1", "k2", "k3"}; !slices.Equal(got, want) {
+		t.Errorf("ParseAPIKeys() = %v, want %v", got, want)
+	}
+}
@@ -3,8 +3,9 @@ package youtube
 import (
 	"context"
 	"log"
-	"sync/atomic"
+	"sync"
 	"time"
+	_ "time/tzdata" // alpineなどタイムゾーンDBがない実行環境向けに埋め込む
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/kinchoKayaba/pixicast/backend/db"
@@ -19,33 +20,66 @@ var QuotaCost = map[string]int{
 	"activities.list":    1,
 }
 
+// quotaLocation はQuotaがリセットされるタイムゾーン（Googleと同じ太平洋時間の0時にリセットされる）
+var quotaLocation = mustLoadLocation("America/Los_Angeles")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// quotaDay はQuotaの日付（太平洋時間）と、その日の開始日時
+func quotaDay(now time.Time) (string, time.Time) {
+	now = now.In(quotaLocation)
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, quotaLocation)
+	return start.Format("2006-01-02"), start
+}
+
+// quotaDate はapi_quota_usage.date に記録するQuotaの日付（太平洋時間）
+func quotaDate(now time.Time) pgtype.Date {
+	_, start := quotaDay(now)
+	return pgtype.Date{Time: start, Valid: true}
+}
+
+// QuotaStore はAPI使用量の記録と日次の合計・APIキーごとの使用量の取得（*db.Queries が実装する）
+type QuotaStore interface {
+	KeyUsageStore
+	GetDailyAPIQuotaUsage(ctx context.Context, arg db.GetDailyAPIQuotaUsageParams) (db.GetDailyAPIQuotaUsageRow, error)
+	RecordAPIQuotaUsage(ctx context.Context, arg db.RecordAPIQuotaUsageParams) error
+}
+
 // QuotaTracker はAPI Quota使用量を追跡
 type QuotaTracker struct {
-	queries      *db.Queries
-	dailyUsed    atomic.Int32
-	dailyLimit   int32
-	lastResetDate string
+	queries    QuotaStore
+	dailyLimit int32
+	now        func() time.Time
+
+	mu        sync.Mutex
+	day       string // 使用量を集計している日付（太平洋時間）
+	dailyUsed int32
 }
 
 // NewQuotaTracker は新しいQuotaTrackerを作成
-func NewQuotaTracker(queries *db.Queries, dailyLimit int32) *QuotaTracker {
+func NewQuotaTracker(queries QuotaStore, dailyLimit int32) *QuotaTracker {
 	tracker := &QuotaTracker{
 		queries:    queries,
 		dailyLimit: dailyLimit,
-		lastResetDate: time.Now().Format("2006-01-02"),
+		now:        time.Now,
 	}
 
 	// 起動時に当日の使用量を読み込み
-	ctx := context.Background()
-	now := time.Now()
-	todayDate := pgtype.Date{Time: now, Valid: true}
-	usage, err := queries.GetDailyAPIQuotaUsage(ctx, db.GetDailyAPIQuotaUsageParams{
-		Date:       todayDate,
+	now := tracker.now()
+	tracker.day, _ = quotaDay(now)
+	usage, err := queries.GetDailyAPIQuotaUsage(context.Background(), db.GetDailyAPIQuotaUsageParams{
+		Date:       quotaDate(now),
 		PlatformID: "youtube",
 	})
 	if err == nil {
 		totalUsed := toInt32(usage.TotalQuotaUsed)
-		tracker.dailyUsed.Store(totalUsed)
+		tracker.dailyUsed = totalUsed
 		log.Printf("📊 YouTube API Quota today: %d/%d (%.1f%%)",
 			totalUsed,
 			dailyLimit,
@@ -56,26 +90,48 @@ func NewQuotaTracker(queries *db.Queries, dailyLimit int32) *QuotaTracker {
 	return tracker
 }
 
-// RecordUsage はAPI使用量を記録
-func (qt *QuotaTracker) RecordUsage(ctx context.Context, endpoint string, cost int) error {
-	// 日付が変わったらリセット
-	today := time.Now().Format("2006-01-02")
-	if qt.lastResetDate != today {
-		qt.dailyUsed.Store(0)
-		qt.lastResetDate = today
+// watchKeys は当日のAPIキーごとの使用量をDBから読み込んでkeyPoolに反映
+func (qt *QuotaTracker) watchKeys(p *keyPool) {
+	day, used, err := loadKeyUsage(context.Background(), qt.queries, qt.now())
+	if err != nil {
+		log.Printf("⚠️  Failed to load YouTube API quota usage by key: %v", err)
+		return
+	}
+	p.load(day, used)
+}
+
+// rollover は日付が変わっていたら使用量をリセットし、当日の使用量を返す（qt.mu を保持して呼ぶ）
+func (qt *QuotaTracker) rollover() int32 {
+	if today, _ := quotaDay(qt.now()); qt.day != today {
+		qt.day = today
+		qt.dailyUsed = 0
 		log.Println("🔄 YouTube API Quota reset (new day)")
 	}
+	return qt.dailyUsed
+}
 
-	// 使用量を加算
-	newUsed := qt.dailyUsed.Add(int32(cost))
+// RecordUsage はAPI使用量をctxの予算の枠（WithBucket、指定がなければ枠外）に記録
+func (qt *QuotaTracker) RecordUsage(ctx context.Context, endpoint string, cost int) error {
+	return qt.RecordBucketUsage(ctx, BucketFromContext(ctx), endpoint, cost)
+}
+
+// RecordBucketUsage はAPI使用量を予算の枠ごとに記録（Client が呼び出した場合はAPIキーごとにも分ける）
+func (qt *QuotaTracker) RecordBucketUsage(ctx context.Context, bucket, endpoint string, cost int) error {
+	// 使用量を加算（日付が変わったらリセット）
+	qt.mu.Lock()
+	qt.rollover()
+	qt.dailyUsed += int32(cost)
+	newUsed := qt.dailyUsed
+	qt.mu.Unlock()
 
 	// データベースに記録
-	todayDate := pgtype.Date{Time: time.Now(), Valid: true}
 	err := qt.queries.RecordAPIQuotaUsage(ctx, db.RecordAPIQuotaUsageParams{
-		Date:       todayDate,
+		Date:       quotaDate(qt.now()),
 		PlatformID: "youtube",
 		Endpoint:   endpoint,
 		QuotaCost:  int32(cost),
+		Bucket:     bucket,
+		KeyID:      keyIDFromContext(ctx),
 	})
 
 	if err != nil {
@@ -97,29 +153,24 @@ func (qt *QuotaTracker) RecordUsage(ctx context.Context, endpoint string, cost i
 
 // GetUsage は現在の使用量を取得
 func (qt *QuotaTracker) GetUsage() int32 {
-	return qt.dailyUsed.Load()
+	qt.mu.Lock()
+	defer qt.mu.Unlock()
+	return qt.rollover()
 }
 
 // GetRemaining は残りのQuotaを取得
 func (qt *QuotaTracker) GetRemaining() int32 {
-	used := qt.dailyUsed.Load()
-	remaining := qt.dailyLimit - used
-	if remaining < 0 {
-		return 0
-	}
-	return remaining
+	return max(0, qt.dailyLimit-qt.GetUsage())
 }
 
 // GetUsagePercent は使用率を取得
 func (qt *QuotaTracker) GetUsagePercent() float64 {
-	used := qt.dailyUsed.Load()
-	return float64(used) / float64(qt.dailyLimit) * 100
+	return float64(qt.GetUsage()) / float64(qt.dailyLimit) * 100
 }
 
 // CanUse は指定されたコストのAPI呼び出しが可能かチェック
 func (qt *QuotaTracker) CanUse(cost int) bool {
-	used := qt.dailyUsed.Load()
-	return used+int32(cost) <= qt.dailyLimit
+	return qt.GetUsage()+int32(cost) <= qt.dailyLimit
 }
 
 // toInt32 はinterface{}からint32に変換
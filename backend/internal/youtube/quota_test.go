@@ -0,0 +1,86 @@
+package youtube
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kinchoKayaba/pixicast/backend/db"
+)
+
+// fakeQuotaStore はapi_quota_usageのインメモリ実装
+type fakeQuotaStore struct {
+	mu       sync.Mutex
+	used     int64
+	recorded []db.RecordAPIQuotaUsageParams
+}
+
+func (s *fakeQuotaStore) GetDailyAPIQuotaUsage(ctx context.Context, arg db.GetDailyAPIQuotaUsageParams) (db.GetDailyAPIQuotaUsageRow, error) {
+	return db.GetDailyAPIQuotaUsageRow{TotalQuotaUsed: s.used}, nil
+}
+
+func (s *fakeQuotaStore) GetDailyAPIQuotaUsageByKey(ctx context.Context, arg db.GetDailyAPIQuotaUsageByKeyParams) ([]db.GetDailyAPIQuotaUsageByKeyRow, error) {
+	return nil, nil
+}
+
+func (s *fakeQuotaStore) RecordAPIQuotaUsage(ctx context.Context, arg db.RecordAPIQuotaUsageParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recorded = append(s.recorded, arg)
+	return nil
+}
+
+// TestQuotaTrackerReset は太平洋時間の0時に使用量がリセットされることのテスト
+func TestQuotaTrackerReset(t *testing.T) {
+	store := &fakeQuotaStore{used: 9999}
+	qt := NewQuotaTracker(store, 10000)
+	// 2025-06-01 23:30 PDT（UTCでは6/2）
+	now := time.Date(2025, 6, 2, 6, 30, 0, 0, time.UTC)
+	qt.now = func() time.Time { return now }
+	qt.day = "2025-06-01"
+
+	if !qt.CanUse(1) || qt.CanUse(2) {
+		t.Errorf("CanUse(1), CanUse(2) = %v, %v, want true, false", qt.CanUse(1), qt.CanUse(2))
+	}
+	qt.RecordUsage(WithBucket(context.Background(), BucketSearch), "channels.list", 1)
+	if got := store.recorded[0]; got.Date.Time.Format("2006-01-02") != "2025-06-01" || got.Bucket != BucketSearch {
+		t.Errorf("recorded = %+v, want date 2025-06-01 and bucket %q", got, BucketSearch)
+	}
+	if qt.CanUse(1) {
+		t.Error("CanUse(1) = true after spending the daily limit")
+	}
+
+	// 太平洋時間の0時（UTC 7時）にリセット
+	now = time.Date(2025, 6, 2, 7, 0, 0, 0, time.UTC)
+	if got := qt.GetUsage(); got != 0 {
+		t.Errorf("GetUsage() after midnight Pacific = %d, want 0", got)
+	}
+	if !qt.CanUse(100) {
+		t.Error("CanUse(100) = false after the quota reset")
+	}
+}
+
+// TestQuotaTrackerConcurrent は複数のgoroutineから記録しても使用量が失われないことのテスト
+func TestQuotaTrackerConcurrent(t *testing.T) {
+	store := &fakeQuotaStore{}
+	qt := NewQuotaTracker(store, 10000)
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			qt.RecordUsage(context.Background(), "videos.list", 1)
+			qt.CanUse(1)
+		}()
+	}
+	wg.Wait()
+
+	if got := qt.GetUsage(); got != 50 {
+		t.Errorf("GetUsage() = %d, want 50", got)
+	}
+	if len(store.recorded) != 50 {
+		t.Errorf("recorded = %d, want 50", len(store.recorded))
+	}
+}
@@ -0,0 +1,29 @@
+-- Migration: 011_create_event_changes
+-- Description: Add event_changes table for WatchTimeline streaming
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- ============================================================================
+-- event_changes: タイムライン変更履歴テーブル
+-- ============================================================================
+-- events 削除後も removed を配信できるよう event_id に外部キーは張らない
+CREATE TABLE IF NOT EXISTS event_changes (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL,
+    source_id UUID NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    change_type TEXT NOT NULL,  -- inserted / updated / removed
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- インデックス: ソース別の差分取得用
+CREATE INDEX IF NOT EXISTS idx_event_changes_source ON event_changes(source_id, id);
+
+-- インデックス: 古い履歴の削除用
+CREATE INDEX IF NOT EXISTS idx_event_changes_created ON event_changes(created_at);
+
+-- ============================================================================
+-- コメント
+-- ============================================================================
+COMMENT ON TABLE event_changes IS 'タイムライン変更履歴（WatchTimeline配信用）';
+
+COMMENT ON COLUMN event_changes.id IS 'ストリーム再開用のカーソル';
+COMMENT ON COLUMN event_changes.change_type IS 'inserted=新規追加, updated=更新, removed=削除';
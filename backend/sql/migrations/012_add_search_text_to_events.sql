@@ -0,0 +1,9 @@
+-- Migration: 012_add_search_text_to_events
+-- Description: Add normalized search_text column for SearchTimeline
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- 検索用に正規化したタイトル＋説明文（NFKC・小文字・カタカナ→ひらがな）
+-- 取り込み時にアプリケーション側で設定する。既存の行は backfill_search_text バッチで設定する
+ALTER TABLE events ADD COLUMN IF NOT EXISTS search_text TEXT;
+
+COMMENT ON COLUMN events.search_text IS 'Normalized title and description for search (NFKC, lowercase, katakana to hiragana)';
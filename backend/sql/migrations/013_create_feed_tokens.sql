@@ -0,0 +1,28 @@
+-- Migration: 013_create_feed_tokens
+-- Description: Add feed_tokens table for secret-token calendar feeds
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- ============================================================================
+-- feed_tokens: カレンダー購読用のシークレットトークン
+-- ============================================================================
+-- カレンダーアプリはFirebaseのIDトークンを送れないため、URLに含めるシークレットで認証する
+-- シークレット本体は保存せずSHA-256ハッシュのみを保持する
+CREATE TABLE IF NOT EXISTS feed_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id BIGINT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+-- インデックス: ユーザーごとの有効なトークンは1つだけ
+CREATE UNIQUE INDEX IF NOT EXISTS idx_feed_tokens_active_user ON feed_tokens(user_id) WHERE revoked_at IS NULL;
+
+-- ============================================================================
+-- コメント
+-- ============================================================================
+COMMENT ON TABLE feed_tokens IS 'カレンダー購読用のシークレットトークン';
+
+COMMENT ON COLUMN feed_tokens.token_hash IS 'シークレットのSHA-256ハッシュ（16進数）';
+COMMENT ON COLUMN feed_tokens.revoked_at IS '無効化日時（NULLの場合は有効）';
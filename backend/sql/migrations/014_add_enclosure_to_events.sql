@@ -0,0 +1,13 @@
+-- Migration: 014_add_enclosure_to_events
+-- Description: Add podcast enclosure columns for RSS/Atom/JSON Feed export
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- Podcastエピソードの音声ファイル（RSSのenclosure）
+-- events.url はApple Podcastsの番組ページになることがあるため、フィード出力用に別途保持する
+ALTER TABLE events ADD COLUMN IF NOT EXISTS enclosure_url TEXT;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS enclosure_type TEXT;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS enclosure_length BIGINT;
+
+COMMENT ON COLUMN events.enclosure_url IS 'Audio file URL of podcast episodes (RSS enclosure)';
+COMMENT ON COLUMN events.enclosure_type IS 'MIME type of the enclosure (e.g. audio/mpeg)';
+COMMENT ON COLUMN events.enclosure_length IS 'Size of the enclosure in bytes';
@@ -0,0 +1,30 @@
+-- Migration: 015_create_user_event_states
+-- Description: Add user_event_states table for watched / hidden / dismissed state per user per event
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- ============================================================================
+-- user_event_states: ユーザーごとのイベントの状態（視聴済み・非表示・却下）
+-- ============================================================================
+-- 各状態は独立しており、設定した日時を保持する（NULLの場合は未設定）
+CREATE TABLE IF NOT EXISTS user_event_states (
+    user_id BIGINT NOT NULL,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    watched_at TIMESTAMPTZ,
+    hidden_at TIMESTAMPTZ,
+    dismissed_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (user_id, event_id)
+);
+
+-- インデックス: イベント削除時の参照用
+CREATE INDEX IF NOT EXISTS idx_user_event_states_event_id ON user_event_states(event_id);
+
+-- ============================================================================
+-- コメント
+-- ============================================================================
+COMMENT ON TABLE user_event_states IS 'ユーザーごとのイベントの状態（視聴済み・非表示・却下）';
+
+COMMENT ON COLUMN user_event_states.watched_at IS '視聴済みにした日時';
+COMMENT ON COLUMN user_event_states.hidden_at IS 'タイムラインから非表示にした日時';
+COMMENT ON COLUMN user_event_states.dismissed_at IS '却下（通知・おすすめ対象外）にした日時';
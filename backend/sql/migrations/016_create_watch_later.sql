@@ -0,0 +1,40 @@
+-- Migration: 016_create_watch_later
+-- Description: Add watch_later queue and plan_limits.max_watch_later
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- ============================================================================
+-- plan_limits: 「あとで見る」の最大件数
+-- ============================================================================
+ALTER TABLE plan_limits ADD COLUMN IF NOT EXISTS max_watch_later INT NOT NULL DEFAULT 10;
+
+UPDATE plan_limits SET max_watch_later = 10 WHERE plan_type = 'free_anonymous';
+UPDATE plan_limits SET max_watch_later = 50 WHERE plan_type = 'free_login';
+UPDATE plan_limits SET max_watch_later = 999999 WHERE plan_type = 'plus';
+
+COMMENT ON COLUMN plan_limits.max_watch_later IS '「あとで見る」に追加できる最大件数';
+
+-- ============================================================================
+-- watch_later: ユーザーの「あとで見る」キュー
+-- ============================================================================
+-- イベントが削除（DeleteOldEvents・配信元での削除）されると自動的にキューからも消える
+CREATE TABLE IF NOT EXISTS watch_later (
+    user_id BIGINT NOT NULL,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    position INT NOT NULL,  -- 並び順（小さいほど先頭）
+    added_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (user_id, event_id)
+);
+
+-- インデックス: ユーザーのキューを並び順で取得
+CREATE INDEX IF NOT EXISTS idx_watch_later_user_position ON watch_later(user_id, position);
+
+-- インデックス: イベント削除時の参照用
+CREATE INDEX IF NOT EXISTS idx_watch_later_event_id ON watch_later(event_id);
+
+-- ============================================================================
+-- コメント
+-- ============================================================================
+COMMENT ON TABLE watch_later IS 'ユーザーの「あとで見る」キュー';
+
+COMMENT ON COLUMN watch_later.position IS '並び順（小さいほど先頭）';
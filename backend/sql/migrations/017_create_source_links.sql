@@ -0,0 +1,26 @@
+-- Migration: 017_create_source_links
+-- Description: Add source_links table for linking sources of the same creator (simulcast deduplication)
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- ============================================================================
+-- source_links: 同じクリエイターとしてリンクしたソースの組（ユーザーごと）
+-- ============================================================================
+-- リンクしたソースの同時配信（開始時刻が近くタイトルが似ているイベント）はタイムラインで1つの番組にまとめる
+-- 同じ組を重複して持たないよう source_id < linked_source_id で保存する
+CREATE TABLE IF NOT EXISTS source_links (
+    user_id BIGINT NOT NULL,
+    source_id UUID NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    linked_source_id UUID NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (user_id, source_id, linked_source_id),
+    CHECK (source_id < linked_source_id)
+);
+
+-- インデックス: ソース削除時の参照用
+CREATE INDEX IF NOT EXISTS idx_source_links_linked_source_id ON source_links(linked_source_id);
+
+-- ============================================================================
+-- コメント
+-- ============================================================================
+COMMENT ON TABLE source_links IS '同じクリエイターとしてリンクしたソースの組（ユーザーごと）';
@@ -0,0 +1,37 @@
+-- Migration: 018_create_mute_rules
+-- Description: Add mute_rules table for per-user timeline mute rules
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- ============================================================================
+-- mute_rules: タイムラインのミュートルール（ユーザーごと）
+-- ============================================================================
+-- いずれかのルールにマッチしたイベントはタイムラインに表示しない
+-- source_id がNULLの場合はすべての購読チャンネルが対象
+CREATE TABLE IF NOT EXISTS mute_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id BIGINT NOT NULL,
+    source_id UUID REFERENCES sources(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('keyword', 'regex', 'game_name', 'min_duration', 'max_duration', 'event_type')),
+    pattern TEXT NOT NULL DEFAULT '',
+    field TEXT NOT NULL DEFAULT 'all' CHECK (field IN ('all', 'title', 'description')),
+    duration_seconds INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- インデックス: ユーザーのルール取得用
+CREATE INDEX IF NOT EXISTS idx_mute_rules_user_id ON mute_rules(user_id);
+
+-- インデックス: ソース削除時の参照用
+CREATE INDEX IF NOT EXISTS idx_mute_rules_source_id ON mute_rules(source_id);
+
+-- ============================================================================
+-- コメント
+-- ============================================================================
+COMMENT ON TABLE mute_rules IS 'タイムラインのミュートルール（ユーザーごと）';
+
+COMMENT ON COLUMN mute_rules.source_id IS '対象のソース（NULLの場合はすべての購読チャンネル）';
+COMMENT ON COLUMN mute_rules.kind IS 'keyword=キーワード, regex=正規表現, game_name=Twitchのカテゴリ, min_duration=指定秒数より短い, max_duration=指定秒数より長い, event_type=イベント種別';
+COMMENT ON COLUMN mute_rules.pattern IS 'キーワード・正規表現・ゲーム名・イベント種別';
+COMMENT ON COLUMN mute_rules.field IS 'キーワード・正規表現の対象（all=タイトルと説明文, title, description）';
+COMMENT ON COLUMN mute_rules.duration_seconds IS 'min_duration・max_durationの秒数';
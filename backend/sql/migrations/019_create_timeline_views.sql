@@ -0,0 +1,26 @@
+-- Migration: 019_create_timeline_views
+-- Description: Add timeline_views table for saved smart views (filter expressions)
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- ============================================================================
+-- timeline_views: 保存したタイムラインのビュー（ユーザーごと）
+-- ============================================================================
+-- expression は platform == "twitch" && is_live のような絞り込みの式（internal/view で評価）
+CREATE TABLE IF NOT EXISTS timeline_views (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    expression TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    UNIQUE (user_id, name)
+);
+
+-- ============================================================================
+-- コメント
+-- ============================================================================
+COMMENT ON TABLE timeline_views IS '保存したタイムラインのビュー（ユーザーごと）';
+
+COMMENT ON COLUMN timeline_views.name IS 'ビューの名前（ユーザーごとに一意）';
+COMMENT ON COLUMN timeline_views.expression IS '絞り込みの式';
@@ -0,0 +1,42 @@
+-- Migration: 020_create_subscription_tags
+-- Description: Add subscription_tags and subscription_tag_assignments tables for user-defined folders/tags
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- ============================================================================
+-- subscription_tags: 購読チャンネルのタグ・フォルダ（ユーザーごと）
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS subscription_tags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    UNIQUE (user_id, name)
+);
+
+-- ============================================================================
+-- subscription_tag_assignments: 購読へのタグの割り当て
+-- ============================================================================
+-- タグを削除すると割り当てのみ削除され、購読は残る
+-- 購読を削除すると割り当ても削除される
+CREATE TABLE IF NOT EXISTS subscription_tag_assignments (
+    tag_id UUID NOT NULL REFERENCES subscription_tags(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    source_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (tag_id, source_id),
+    FOREIGN KEY (user_id, source_id) REFERENCES user_subscriptions(user_id, source_id) ON DELETE CASCADE
+);
+
+-- インデックス: 購読ごとのタグ取得・タイムラインのタグ絞り込み用
+CREATE INDEX IF NOT EXISTS idx_subscription_tag_assignments_user_source ON subscription_tag_assignments(user_id, source_id);
+
+-- ============================================================================
+-- コメント
+-- ============================================================================
+COMMENT ON TABLE subscription_tags IS '購読チャンネルのタグ・フォルダ（ユーザーごと）';
+COMMENT ON TABLE subscription_tag_assignments IS '購読へのタグの割り当て';
+
+COMMENT ON COLUMN subscription_tags.name IS 'タグの名前（ユーザーごとに一意）';
@@ -0,0 +1,33 @@
+-- Migration: 021_add_update_schedule_queue
+-- Description: Use update_schedule as a durable ingest job queue (leases, retries, dead letters, idempotent enqueue)
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- ジョブの種類と内容
+ALTER TABLE update_schedule ADD COLUMN IF NOT EXISTS job_type TEXT NOT NULL DEFAULT 'backfill';
+ALTER TABLE update_schedule ADD COLUMN IF NOT EXISTS payload JSONB NOT NULL DEFAULT '{}'::JSONB;
+
+-- 冪等キー: 同じキーの実行待ち・実行中のジョブは1件だけ
+ALTER TABLE update_schedule ADD COLUMN IF NOT EXISTS dedupe_key TEXT;
+
+-- リトライ: 失敗したジョブはバックオフして実行待ちに戻し、max_attempts回失敗したらfailed（デッドレター）にする
+ALTER TABLE update_schedule ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE update_schedule ADD COLUMN IF NOT EXISTS max_attempts INT NOT NULL DEFAULT 5;
+
+-- リース: 実行中のワーカーが期限までに完了・延長しない場合は他のワーカーが取得し直す
+ALTER TABLE update_schedule ADD COLUMN IF NOT EXISTS lease_owner TEXT;
+ALTER TABLE update_schedule ADD COLUMN IF NOT EXISTS lease_expires_at TIMESTAMPTZ;
+
+-- ユニーク制約: 実行待ち・実行中のジョブの冪等キーの重複防止
+CREATE UNIQUE INDEX IF NOT EXISTS idx_update_schedule_dedupe_active ON update_schedule(dedupe_key) WHERE status IN ('pending', 'running');
+
+-- インデックス: リースが切れたジョブの検索用
+CREATE INDEX IF NOT EXISTS idx_update_schedule_lease ON update_schedule(lease_expires_at) WHERE status = 'running';
+
+COMMENT ON COLUMN update_schedule.status IS 'pending=待機中, running=実行中, completed=完了, failed=失敗（リトライ上限に達したデッドレター）';
+COMMENT ON COLUMN update_schedule.job_type IS 'ジョブの種類（backfill=購読時の過去分の取り込み）';
+COMMENT ON COLUMN update_schedule.payload IS 'ジョブの内容（job_typeごとのJSON）';
+COMMENT ON COLUMN update_schedule.dedupe_key IS '冪等キー（同じキーの実行待ち・実行中のジョブは作成しない）';
+COMMENT ON COLUMN update_schedule.attempts IS '実行回数（リースを取得するたびに増える）';
+COMMENT ON COLUMN update_schedule.max_attempts IS 'failedにするまでの最大実行回数';
+COMMENT ON COLUMN update_schedule.lease_owner IS 'ジョブを実行中のワーカー';
+COMMENT ON COLUMN update_schedule.lease_expires_at IS 'リースの期限（過ぎると他のワーカーが取得できる）';
@@ -0,0 +1,25 @@
+-- Migration: 022_add_source_posting_patterns
+-- Description: Learn each source's posting pattern (weekday x hour) and store the predicted next fetch time
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- 投稿パターン: 曜日×時刻（日本時間、日曜0時=0〜土曜23時=167）ごとの投稿・配信数（新しいものほど重い）
+ALTER TABLE source_priority ADD COLUMN IF NOT EXISTS posting_histogram FLOAT8[];
+ALTER TABLE source_priority ADD COLUMN IF NOT EXISTS posting_live_ratio FLOAT8 NOT NULL DEFAULT 0;
+ALTER TABLE source_priority ADD COLUMN IF NOT EXISTS posting_sample_count INT NOT NULL DEFAULT 0;
+ALTER TABLE source_priority ADD COLUMN IF NOT EXISTS posting_confidence FLOAT8 NOT NULL DEFAULT 0;
+ALTER TABLE source_priority ADD COLUMN IF NOT EXISTS pattern_learned_at TIMESTAMPTZ;
+
+-- 予測: 次に投稿されそうな時間帯と、次に取得する時刻
+ALTER TABLE source_priority ADD COLUMN IF NOT EXISTS next_expected_at TIMESTAMPTZ;
+ALTER TABLE source_priority ADD COLUMN IF NOT EXISTS next_fetch_at TIMESTAMPTZ;
+
+-- インデックス: 次に取得する時刻順
+CREATE INDEX IF NOT EXISTS idx_source_priority_next_fetch ON source_priority(next_fetch_at);
+
+COMMENT ON COLUMN source_priority.posting_histogram IS '曜日×時刻（日本時間）ごとの投稿・配信数（168要素、日曜0時=0、新しいものほど重い）';
+COMMENT ON COLUMN source_priority.posting_live_ratio IS 'ライブ・予定・プレミア・ラジオの割合（0.5以上は開始前に取得）';
+COMMENT ON COLUMN source_priority.posting_sample_count IS '学習に使ったイベント数';
+COMMENT ON COLUMN source_priority.posting_confidence IS '投稿パターンの確度（0=ばらばら〜1=毎回同じ時間帯）';
+COMMENT ON COLUMN source_priority.pattern_learned_at IS '投稿パターンを学習した日時';
+COMMENT ON COLUMN source_priority.next_expected_at IS '次に投稿されそうな時間帯の開始日時';
+COMMENT ON COLUMN source_priority.next_fetch_at IS '次に取得する時刻（NULLの場合は last_fetched_at + update_interval_minutes）';
@@ -0,0 +1,12 @@
+-- Migration: 023_add_bucket_to_api_quota_usage
+-- Description: Record YouTube API quota usage per budget bucket (priority tiers, search, backfill)
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- 予算の枠（high / medium / low / search / backfill、空文字は枠外）
+ALTER TABLE api_quota_usage ADD COLUMN IF NOT EXISTS bucket TEXT NOT NULL DEFAULT '';
+
+-- ユニーク制約: 同じ日・プラットフォーム・エンドポイント・枠の重複防止（枠ごとに集計するため張り替える）
+DROP INDEX IF EXISTS idx_api_quota_unique CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_quota_unique_bucket ON api_quota_usage(date, platform_id, endpoint, bucket);
+
+COMMENT ON COLUMN api_quota_usage.bucket IS '予算の枠（high / medium / low=優先度ごとの定期更新, search=検索, backfill=購読時の取り込み, 空文字=枠外）';
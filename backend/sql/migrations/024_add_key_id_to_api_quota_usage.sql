@@ -0,0 +1,12 @@
+-- Migration: 024_add_key_id_to_api_quota_usage
+-- Description: Record YouTube API quota usage per API key (each key has its own daily quota)
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- APIキーの識別子（キーのSHA-256の先頭8文字。キーそのものは保存しない、空文字は不明）
+ALTER TABLE api_quota_usage ADD COLUMN IF NOT EXISTS key_id TEXT NOT NULL DEFAULT '';
+
+-- ユニーク制約: 同じ日・プラットフォーム・エンドポイント・枠・APIキーの重複防止（キーごとに集計するため張り替える）
+DROP INDEX IF EXISTS idx_api_quota_unique_bucket CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_quota_unique_key ON api_quota_usage(date, platform_id, endpoint, bucket, key_id);
+
+COMMENT ON COLUMN api_quota_usage.key_id IS 'APIキーの識別子（キーのSHA-256の先頭8文字、空文字=不明）';
@@ -0,0 +1,13 @@
+-- Migration: 025_page_event_changes_by_time
+-- Description: Page WatchTimeline changes on (created_at, id) instead of id alone
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- idは採番順とコミット順が一致しない（後に採番した行が先にコミットされる）ため、
+-- 作成日時とidの組で、一定時間前までにコミットされた変更履歴を順に配信する
+CREATE INDEX IF NOT EXISTS idx_event_changes_source_created ON event_changes(source_id, created_at, id);
+DROP INDEX IF EXISTS idx_event_changes_source;
+
+-- 開始・終了時刻を過ぎた番組の変更履歴の記録用
+CREATE INDEX IF NOT EXISTS idx_event_changes_event ON event_changes(event_id, created_at);
+
+COMMENT ON COLUMN event_changes.id IS '同じ作成日時の変更履歴の順序（ストリーム再開用のカーソルは作成日時とidの組）';
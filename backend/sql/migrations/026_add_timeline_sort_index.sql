@@ -0,0 +1,14 @@
+-- Migration: 026_add_timeline_sort_index
+-- Description: Index the timeline keyset (sort time, live first, id) used by ListTimeline
+-- Compatible with: PostgreSQL 12+ / CockroachDB 21+
+
+-- タイムラインの絞り込み・並び順・カーソルはすべて同じ式
+-- (COALESCE(start_at, published_at, created_at), type = 'live', id) を使う
+-- 降順のインデックスは昇順（番組表）のページでも逆方向に走査して使える
+CREATE INDEX IF NOT EXISTS idx_events_timeline_sort ON events(
+    source_id,
+    COALESCE(start_at, published_at, created_at) DESC,
+    (type = 'live') DESC,
+    id DESC
+);
+DROP INDEX IF EXISTS idx_events_timeline;
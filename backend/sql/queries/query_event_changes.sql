@@ -0,0 +1,116 @@
+-- query_event_changes.sql
+-- タイムライン変更履歴（WatchTimeline配信用）に関するクエリ
+
+-- ============================================================================
+-- InsertEventChange: 変更履歴を記録
+-- ============================================================================
+-- name: InsertEventChange :exec
+INSERT INTO event_changes (
+    event_id,
+    source_id,
+    change_type
+) VALUES (
+    $1, $2, $3
+);
+
+-- ============================================================================
+-- GetEventChangeWatermark: 配信できる変更履歴の作成日時の上限
+-- ============================================================================
+-- name: GetEventChangeWatermark :one
+-- 採番順とコミット順は一致しないため、コミット待ちの変更を飛ばさないよう5秒前までの変更履歴だけを配信する
+SELECT (now() - INTERVAL '5 seconds')::TIMESTAMPTZ AS watermark;
+
+-- ============================================================================
+-- GetOldestEventChangeTime: 保持している最も古い変更履歴の作成日時（変更履歴がない場合はNULL）
+-- ============================================================================
+-- name: GetOldestEventChangeTime :one
+SELECT MIN(created_at)::TIMESTAMPTZ AS oldest
+FROM event_changes;
+
+-- ============================================================================
+-- ListEventChangesForUser: ユーザーの購読ソースの変更履歴を取得
+-- ============================================================================
+-- name: ListEventChangesForUser :many
+-- (作成日時, id) がカーソルより後で、GetEventChangeWatermark より前の変更履歴を古い順に返す
+SELECT ec.*
+FROM event_changes ec
+JOIN user_subscriptions us ON ec.source_id = us.source_id
+WHERE 
+    us.user_id = sqlc.arg('user_id')
+    AND us.enabled = true
+    AND (ec.created_at, ec.id) > (sqlc.arg('after_created_at')::timestamptz, sqlc.arg('after_id')::bigint)
+    AND ec.created_at < now() - INTERVAL '5 seconds'
+ORDER BY ec.created_at ASC, ec.id ASC
+LIMIT sqlc.arg('limit');
+
+-- ============================================================================
+-- RecordEventTransitions: 開始・終了時刻を過ぎた番組の変更履歴を記録
+-- ============================================================================
+-- name: RecordEventTransitions :execrows
+-- 配信予定→配信中、配信中→終了はイベント自体が更新されないため、時刻を過ぎた後に
+-- 変更履歴がまだ記録されていない番組を更新として記録する（1日以上前に過ぎたものは対象外）
+INSERT INTO event_changes (
+    event_id,
+    source_id,
+    change_type
+)
+SELECT t.id, t.source_id, 'updated'
+FROM (
+    SELECT
+        e.id,
+        e.source_id,
+        CASE WHEN e.end_at <= now() THEN e.end_at ELSE e.start_at END AS changed_at
+    FROM events e
+    WHERE
+        e.type IN ('live', 'scheduled', 'premiere', 'radio')
+        AND e.start_at <= now()
+        AND COALESCE(e.end_at, e.start_at) > now() - INTERVAL '1 day'
+) t
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM event_changes ec
+    WHERE ec.event_id = t.id AND ec.created_at >= t.changed_at
+);
+
+-- ============================================================================
+-- GetTimelineEvent: タイムライン表示用にイベントを1件取得
+-- ============================================================================
+-- name: GetTimelineEvent :one
+SELECT 
+    e.id,
+    e.platform_id,
+    e.source_id,
+    e.external_event_id,
+    e.type,
+    e.title,
+    e.description,
+    e.start_at,
+    e.end_at,
+    e.published_at,
+    e.url,
+    e.image_url,
+    e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
+    e.created_at,
+    e.updated_at,
+    s.display_name as source_display_name,
+    s.thumbnail_url as source_thumbnail_url,
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
+FROM events e
+JOIN sources s ON e.source_id = s.id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = $2
+WHERE e.id = $1;
+
+-- ============================================================================
+-- DeleteOldEventChanges: 古い変更履歴を削除
+-- ============================================================================
+-- name: DeleteOldEventChanges :exec
+DELETE FROM event_changes
+WHERE created_at < now() - INTERVAL '7 days';
@@ -0,0 +1,97 @@
+-- query_event_states.sql
+-- ユーザーごとのイベントの状態（視聴済み・非表示・却下）に関するクエリ
+
+-- ============================================================================
+-- DeleteClearedEventStates: すべての状態を解除した行を削除
+-- event_ids がNULLの場合はユーザーのすべての行が対象
+-- ============================================================================
+-- name: DeleteClearedEventStates :execrows
+DELETE FROM user_event_states
+WHERE
+    user_id = sqlc.arg('user_id')
+    AND (
+        sqlc.narg('event_ids')::uuid[] IS NULL
+        OR event_id = ANY(sqlc.narg('event_ids')::uuid[])
+    )
+    AND watched_at IS NULL
+    AND hidden_at IS NULL
+    AND dismissed_at IS NULL;
+
+-- ============================================================================
+-- SetEventStates: 指定したイベントの状態を設定・解除
+-- state は watched / hidden / dismissed、value=false で解除（設定済みの場合は最初に設定した日時を保持）
+-- 解除は既存の行のみ対象（すべての状態を解除した行は DeleteClearedEventStates で削除する）
+-- 購読していないソースのイベントは対象外
+-- ============================================================================
+-- name: SetEventStates :execrows
+INSERT INTO user_event_states (
+    user_id,
+    event_id,
+    watched_at,
+    hidden_at,
+    dismissed_at
+)
+SELECT
+    us.user_id,
+    e.id,
+    CASE WHEN sqlc.arg('state')::text = 'watched' AND sqlc.arg('value')::bool THEN now() END,
+    CASE WHEN sqlc.arg('state')::text = 'hidden' AND sqlc.arg('value')::bool THEN now() END,
+    CASE WHEN sqlc.arg('state')::text = 'dismissed' AND sqlc.arg('value')::bool THEN now() END
+FROM events e
+JOIN user_subscriptions us ON e.source_id = us.source_id
+LEFT JOIN user_event_states cur ON cur.user_id = us.user_id AND cur.event_id = e.id
+WHERE
+    us.user_id = sqlc.arg('user_id')
+    AND (sqlc.arg('value')::bool OR cur.event_id IS NOT NULL)
+    AND e.id = ANY(sqlc.arg('event_ids')::uuid[])
+ON CONFLICT (user_id, event_id) DO UPDATE SET
+    watched_at = CASE WHEN sqlc.arg('state')::text <> 'watched' THEN user_event_states.watched_at WHEN sqlc.arg('value')::bool THEN COALESCE(user_event_states.watched_at, EXCLUDED.watched_at) END,
+    hidden_at = CASE WHEN sqlc.arg('state')::text <> 'hidden' THEN user_event_states.hidden_at WHEN sqlc.arg('value')::bool THEN COALESCE(user_event_states.hidden_at, EXCLUDED.hidden_at) END,
+    dismissed_at = CASE WHEN sqlc.arg('state')::text <> 'dismissed' THEN user_event_states.dismissed_at WHEN sqlc.arg('value')::bool THEN COALESCE(user_event_states.dismissed_at, EXCLUDED.dismissed_at) END,
+    updated_at = now();
+
+-- ============================================================================
+-- SetEventStatesBefore: 指定日時より前のイベントの状態を一括で設定・解除
+-- 日時はタイムラインの並び順と同じ COALESCE(start_at, published_at, created_at)
+-- 解除は SetEventStates と同じく既存の行のみ対象
+-- platform_ids/event_types/source_ids はNULLの場合は絞り込まない
+-- ============================================================================
+-- name: SetEventStatesBefore :execrows
+INSERT INTO user_event_states (
+    user_id,
+    event_id,
+    watched_at,
+    hidden_at,
+    dismissed_at
+)
+SELECT
+    us.user_id,
+    e.id,
+    CASE WHEN sqlc.arg('state')::text = 'watched' AND sqlc.arg('value')::bool THEN now() END,
+    CASE WHEN sqlc.arg('state')::text = 'hidden' AND sqlc.arg('value')::bool THEN now() END,
+    CASE WHEN sqlc.arg('state')::text = 'dismissed' AND sqlc.arg('value')::bool THEN now() END
+FROM events e
+JOIN user_subscriptions us ON e.source_id = us.source_id
+LEFT JOIN user_event_states cur ON cur.user_id = us.user_id AND cur.event_id = e.id
+WHERE
+    us.user_id = sqlc.arg('user_id')
+    AND (sqlc.arg('value')::bool OR cur.event_id IS NOT NULL)
+    AND us.enabled = true
+    AND COALESCE(e.start_at, e.published_at, e.created_at) < sqlc.arg('before_time')::timestamptz
+    AND (
+        sqlc.narg('platform_ids')::text[] IS NULL
+        OR e.platform_id = ANY(sqlc.narg('platform_ids')::text[])
+    )
+    AND (
+        sqlc.narg('event_types')::text[] IS NULL
+        OR e.type = ANY(sqlc.narg('event_types')::text[])
+    )
+    AND (
+        sqlc.narg('source_ids')::uuid[] IS NULL
+        OR e.source_id = ANY(sqlc.narg('source_ids')::uuid[])
+    )
+ON CONFLICT (user_id, event_id) DO UPDATE SET
+    watched_at = CASE WHEN sqlc.arg('state')::text <> 'watched' THEN user_event_states.watched_at WHEN sqlc.arg('value')::bool THEN COALESCE(user_event_states.watched_at, EXCLUDED.watched_at) END,
+    hidden_at = CASE WHEN sqlc.arg('state')::text <> 'hidden' THEN user_event_states.hidden_at WHEN sqlc.arg('value')::bool THEN COALESCE(user_event_states.hidden_at, EXCLUDED.hidden_at) END,
+    dismissed_at = CASE WHEN sqlc.arg('state')::text <> 'dismissed' THEN user_event_states.dismissed_at WHEN sqlc.arg('value')::bool THEN COALESCE(user_event_states.dismissed_at, EXCLUDED.dismissed_at) END,
+    updated_at = now();
@@ -0,0 +1,44 @@
+-- query_feed_tokens.sql
+-- カレンダー購読用のシークレットトークンに関するクエリ
+
+-- ============================================================================
+-- CreateFeedToken: トークンを発行
+-- ============================================================================
+-- name: CreateFeedToken :one
+INSERT INTO feed_tokens (
+    user_id,
+    token_hash
+) VALUES (
+    $1, $2
+)
+RETURNING *;
+
+-- ============================================================================
+-- GetActiveFeedToken: ユーザーの有効なトークンを取得
+-- ============================================================================
+-- name: GetActiveFeedToken :one
+SELECT * FROM feed_tokens
+WHERE user_id = $1 AND revoked_at IS NULL;
+
+-- ============================================================================
+-- GetFeedTokenByHash: シークレットのハッシュから有効なトークンを取得
+-- ============================================================================
+-- name: GetFeedTokenByHash :one
+SELECT * FROM feed_tokens
+WHERE token_hash = $1 AND revoked_at IS NULL;
+
+-- ============================================================================
+-- RevokeFeedTokens: ユーザーの有効なトークンをすべて無効化
+-- ============================================================================
+-- name: RevokeFeedTokens :execrows
+UPDATE feed_tokens
+SET revoked_at = now()
+WHERE user_id = $1 AND revoked_at IS NULL;
+
+-- ============================================================================
+-- TouchFeedToken: トークンの最終利用日時を更新
+-- ============================================================================
+-- name: TouchFeedToken :exec
+UPDATE feed_tokens
+SET last_used_at = now()
+WHERE id = $1;
@@ -0,0 +1,76 @@
+-- query_mute_rules.sql
+-- タイムラインのミュートルールに関するクエリ
+
+-- ============================================================================
+-- CountMuteRules: ユーザーのルール数を取得
+-- ============================================================================
+-- name: CountMuteRules :one
+SELECT COUNT(*) FROM mute_rules
+WHERE user_id = $1;
+
+-- ============================================================================
+-- CreateMuteRule: ルールを作成
+-- source_id を指定する場合は購読中のソースのみ（購読していない場合は行を返さない）
+-- ============================================================================
+-- name: CreateMuteRule :one
+INSERT INTO mute_rules (
+    user_id,
+    source_id,
+    kind,
+    pattern,
+    field,
+    duration_seconds
+)
+SELECT
+    sqlc.arg('user_id')::bigint,
+    sqlc.narg('source_id')::uuid,
+    sqlc.arg('kind')::text,
+    sqlc.arg('pattern')::text,
+    sqlc.arg('field')::text,
+    sqlc.arg('duration_seconds')::int
+WHERE
+    sqlc.narg('source_id')::uuid IS NULL
+    OR EXISTS (
+        SELECT 1 FROM user_subscriptions us
+        WHERE us.user_id = sqlc.arg('user_id')::bigint AND us.source_id = sqlc.narg('source_id')::uuid
+    )
+RETURNING *;
+
+-- ============================================================================
+-- DeleteMuteRule: ルールを削除
+-- ============================================================================
+-- name: DeleteMuteRule :execrows
+DELETE FROM mute_rules
+WHERE id = $1 AND user_id = $2;
+
+-- ============================================================================
+-- ListMuteRules: ユーザーのルールを作成順で取得
+-- ============================================================================
+-- name: ListMuteRules :many
+SELECT * FROM mute_rules
+WHERE user_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- ============================================================================
+-- UpdateMuteRule: ルールを更新
+-- source_id を指定する場合は購読中のソースのみ（購読していない場合は行を返さない）
+-- ============================================================================
+-- name: UpdateMuteRule :one
+UPDATE mute_rules SET
+    source_id = sqlc.narg('source_id')::uuid,
+    kind = sqlc.arg('kind')::text,
+    pattern = sqlc.arg('pattern')::text,
+    field = sqlc.arg('field')::text,
+    duration_seconds = sqlc.arg('duration_seconds')::int,
+    updated_at = now()
+WHERE
+    id = sqlc.arg('id')
+    AND user_id = sqlc.arg('user_id')::bigint
+    AND (
+        sqlc.narg('source_id')::uuid IS NULL
+        OR EXISTS (
+            SELECT 1 FROM user_subscriptions us
+            WHERE us.user_id = sqlc.arg('user_id')::bigint AND us.source_id = sqlc.narg('source_id')::uuid
+        )
+    )
+RETURNING *;
@@ -1,5 +1,5 @@
 -- name: CalculateSourcePriority :exec
--- 全チャンネルの優先度を計算してsource_priorityテーブルを更新
+-- 全ソース（全プラットフォーム）の優先度を計算してsource_priorityテーブルを更新
 WITH channel_popularity AS (
     SELECT
         us.source_id,
@@ -37,8 +37,7 @@ calculated_priority AS (
     FROM sources s
     CROSS JOIN total_users tu
     LEFT JOIN channel_popularity cp ON s.id = cp.source_id
-    WHERE s.platform_id = 'youtube'
-        AND s.fetch_status = 'ok'
+    WHERE s.fetch_status = 'ok'
 )
 INSERT INTO source_priority (
     source_id,
@@ -67,7 +66,9 @@ ON CONFLICT (source_id) DO UPDATE SET
     updated_at = now();
 
 -- name: GetSourcesByPriority :many
--- 優先度別にソースを取得
+-- 更新間隔を過ぎたソースを優先度の高い順に取得（スケジューラ用）
+-- 優先度が未計算のソース（calculate_priority の実行前に購読されたソースなど）は low（6時間ごと）として扱い、有効な購読がないソースは取得しない
+-- 投稿パターンから次に取得する時刻（next_fetch_at）を予測済みのソースは、更新間隔の代わりにその時刻を過ぎたら取得する
 SELECT
     s.id,
     s.platform_id,
@@ -75,23 +76,94 @@ SELECT
     s.display_name,
     s.uploads_playlist_id,
     s.last_fetched_at,
-    sp.priority_level,
-    sp.subscriber_count,
+    COALESCE(sp.priority_level, 'low')::text AS priority_level,
+    COALESCE(sp.subscriber_count, 0)::int AS subscriber_count,
     sp.popularity_ratio,
-    sp.update_interval_minutes
+    COALESCE(sp.update_interval_minutes, 360)::int AS update_interval_minutes,
+    sp.posting_histogram,
+    COALESCE(sp.posting_live_ratio, 0)::float8 AS posting_live_ratio,
+    COALESCE(sp.posting_sample_count, 0)::int AS posting_sample_count
 FROM sources s
-JOIN source_priority sp ON s.id = sp.source_id
+LEFT JOIN source_priority sp ON s.id = sp.source_id
 WHERE s.platform_id = $1
     AND s.fetch_status = 'ok'
+    AND EXISTS (
+        SELECT 1 FROM user_subscriptions us
+        WHERE us.source_id = s.id AND us.enabled = true
+    )
     AND (
         s.last_fetched_at IS NULL
-        OR s.last_fetched_at < now() - (sp.update_interval_minutes || ' minutes')::interval
+        OR COALESCE(
+            sp.next_fetch_at,
+            s.last_fetched_at + (COALESCE(sp.update_interval_minutes, 360) || ' minutes')::interval
+        ) <= now()
     )
 ORDER BY
-    sp.priority_level DESC,
+    CASE COALESCE(sp.priority_level, 'low') WHEN 'high' THEN 1 WHEN 'medium' THEN 2 ELSE 3 END,
     s.last_fetched_at ASC NULLS FIRST
 LIMIT $2;
 
+-- name: ListSourcesForPatternLearning :many
+-- 投稿パターンを学習するソース（有効な購読があるソース）を取得
+SELECT
+    s.id,
+    s.platform_id,
+    s.external_id,
+    s.last_fetched_at,
+    COALESCE(sp.update_interval_minutes, 360)::int AS update_interval_minutes
+FROM sources s
+LEFT JOIN source_priority sp ON s.id = sp.source_id
+WHERE s.fetch_status = 'ok'
+    AND EXISTS (
+        SELECT 1 FROM user_subscriptions us
+        WHERE us.source_id = s.id AND us.enabled = true
+    )
+ORDER BY s.id;
+
+-- name: ListEventTimesBySource :many
+-- ソースの指定日時以降のイベントの配信開始・公開日時を取得（投稿パターンの学習用、予定は開始日時を過ぎたもののみ）
+SELECT
+    type,
+    COALESCE(start_at, published_at)::timestamptz AS occurred_at
+FROM events
+WHERE source_id = sqlc.arg('source_id')
+    AND COALESCE(start_at, published_at) >= sqlc.arg('since')::timestamptz
+    AND COALESCE(start_at, published_at) <= now()
+ORDER BY occurred_at DESC;
+
+-- name: UpsertSourcePostingPattern :exec
+-- 学習した投稿パターンと予測をsource_priorityテーブルに保存（優先度の列は変更しない）
+INSERT INTO source_priority (
+    source_id,
+    posting_histogram,
+    posting_live_ratio,
+    posting_sample_count,
+    posting_confidence,
+    next_expected_at,
+    next_fetch_at,
+    pattern_learned_at,
+    updated_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, now(), now())
+ON CONFLICT (source_id) DO UPDATE SET
+    posting_histogram = EXCLUDED.posting_histogram,
+    posting_live_ratio = EXCLUDED.posting_live_ratio,
+    posting_sample_count = EXCLUDED.posting_sample_count,
+    posting_confidence = EXCLUDED.posting_confidence,
+    next_expected_at = EXCLUDED.next_expected_at,
+    next_fetch_at = EXCLUDED.next_fetch_at,
+    pattern_learned_at = now(),
+    updated_at = now();
+
+-- name: UpdateSourceNextFetch :exec
+-- 取得後に次に投稿されそうな時間帯と次に取得する時刻を更新
+UPDATE source_priority
+SET
+    next_expected_at = $2,
+    next_fetch_at = $3,
+    updated_at = now()
+WHERE source_id = $1;
+
 -- name: GetHighPrioritySources :many
 -- 高優先度チャンネルのみ取得
 SELECT
@@ -113,17 +185,19 @@ ORDER BY s.last_fetched_at ASC NULLS FIRST
 LIMIT $1;
 
 -- name: RecordAPIQuotaUsage :exec
--- API使用量を記録
+-- API使用量を記録（予算の枠・APIキーごと）
 INSERT INTO api_quota_usage (
     date,
     platform_id,
     endpoint,
     quota_cost,
+    bucket,
+    key_id,
     request_count,
     created_at
 )
-VALUES ($1, $2, $3, $4, 1, now())
-ON CONFLICT (date, platform_id, endpoint) DO UPDATE SET
+VALUES ($1, $2, $3, $4, $5, $6, 1, now())
+ON CONFLICT (date, platform_id, endpoint, bucket, key_id) DO UPDATE SET
     quota_cost = api_quota_usage.quota_cost + EXCLUDED.quota_cost,
     request_count = api_quota_usage.request_count + 1;
 
@@ -136,6 +210,29 @@ FROM api_quota_usage
 WHERE date = $1
     AND platform_id = $2;
 
+-- name: GetDailyAPIQuotaUsageByBucket :many
+-- 予算の枠ごとの日次API使用量を取得
+SELECT
+    bucket,
+    COALESCE(SUM(quota_cost), 0)::int AS total_quota_used
+FROM api_quota_usage
+WHERE date = $1
+    AND platform_id = $2
+GROUP BY bucket
+ORDER BY bucket;
+
+-- name: GetDailyAPIQuotaUsageByKey :many
+-- APIキーごとの日次API使用量を取得（キーが不明な行は除く）
+SELECT
+    key_id,
+    COALESCE(SUM(quota_cost), 0)::int AS total_quota_used
+FROM api_quota_usage
+WHERE date = $1
+    AND platform_id = $2
+    AND key_id <> ''
+GROUP BY key_id
+ORDER BY key_id;
+
 -- name: GetAPIQuotaUsageByEndpoint :many
 -- エンドポイント別のAPI使用量を取得
 SELECT
@@ -149,18 +246,117 @@ GROUP BY endpoint
 ORDER BY total_quota_cost DESC;
 
 -- name: CreateUpdateSchedule :one
--- 更新スケジュールを作成
+-- 更新スケジュール（ジョブ）を作成
+-- dedupe_key が同じ実行待ち・実行中のジョブがある場合は作成しない（行を返さない）
 INSERT INTO update_schedule (
     source_id,
     scheduled_at,
     priority_level,
     status,
+    job_type,
+    payload,
+    dedupe_key,
+    max_attempts,
     created_at,
     updated_at
 )
-VALUES ($1, $2, $3, 'pending', now(), now())
+VALUES ($1, $2, $3, 'pending', $4, $5, $6, $7, now(), now())
+ON CONFLICT DO NOTHING
 RETURNING *;
 
+-- name: GetActiveScheduleByDedupeKey :one
+-- 冪等キーが同じ実行待ち・実行中のジョブを取得
+SELECT * FROM update_schedule
+WHERE dedupe_key = $1
+    AND status IN ('pending', 'running')
+LIMIT 1;
+
+-- name: GetLatestScheduleBySource :one
+-- ソースの最新のジョブを取得（取り込み状況の表示用）
+SELECT * FROM update_schedule
+WHERE source_id = $1
+    AND job_type = $2
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ClaimPendingSchedules :many
+-- 実行時刻を過ぎた実行待ちのジョブと、リースが切れた実行中のジョブをリースして取得
+-- 複数のワーカーが同時に実行しても、UPDATEの条件で再チェックするため同じジョブを二重に取得しない
+WITH claimed AS (
+    UPDATE update_schedule
+    SET
+        status = 'running',
+        lease_owner = sqlc.arg('lease_owner'),
+        lease_expires_at = now() + sqlc.arg('lease_seconds')::int * INTERVAL '1 second',
+        attempts = attempts + 1,
+        started_at = now(),
+        updated_at = now()
+    WHERE
+        id IN (
+            SELECT id FROM update_schedule
+            WHERE
+                (status = 'pending' AND scheduled_at <= now())
+                OR (status = 'running' AND lease_expires_at < now())
+            ORDER BY
+                CASE priority_level WHEN 'high' THEN 1 WHEN 'medium' THEN 2 ELSE 3 END,
+                scheduled_at ASC
+            LIMIT sqlc.arg('batch_size')
+        )
+        AND (
+            (status = 'pending' AND scheduled_at <= now())
+            OR (status = 'running' AND lease_expires_at < now())
+        )
+    RETURNING *
+)
+SELECT
+    c.*,
+    s.external_id,
+    s.platform_id
+FROM claimed c
+JOIN sources s ON c.source_id = s.id
+ORDER BY
+    CASE c.priority_level WHEN 'high' THEN 1 WHEN 'medium' THEN 2 ELSE 3 END,
+    c.scheduled_at ASC;
+
+-- name: ExtendScheduleLease :execrows
+-- 実行中のジョブのリースを延長（リースを持っているワーカーのみ。他のワーカーに取得された場合は0行）
+UPDATE update_schedule
+SET
+    lease_expires_at = now() + sqlc.arg('lease_seconds')::int * INTERVAL '1 second',
+    updated_at = now()
+WHERE
+    id = sqlc.arg('id')
+    AND status = 'running'
+    AND lease_owner = sqlc.arg('lease_owner');
+
+-- name: DeferSchedule :execrows
+-- 実行中のジョブを実行回数に数えずに実行待ちに戻し、retry_at に再実行する（Quotaの予算が足りないなど、ジョブの失敗ではない場合）
+-- リースを持っているワーカーのみ更新できる（他のワーカーに取得された場合は0行）
+UPDATE update_schedule
+SET
+    status = 'pending',
+    attempts = GREATEST(attempts - 1, 0),
+    error_message = sqlc.narg('error_message'),
+    scheduled_at = sqlc.arg('retry_at')::timestamptz,
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = now()
+WHERE
+    id = sqlc.arg('id')
+    AND lease_owner = sqlc.arg('lease_owner');
+
+-- name: ListFailedSchedules :many
+-- 失敗（デッドレター）したジョブを新しい順に取得
+SELECT
+    us.*,
+    s.external_id,
+    s.platform_id
+FROM update_schedule us
+JOIN sources s ON us.source_id = s.id
+WHERE us.status = 'failed'
+ORDER BY us.completed_at DESC
+LIMIT $1;
+
 -- name: GetPendingSchedules :many
 -- 実行待ちのスケジュールを取得
 SELECT
@@ -174,16 +370,23 @@ WHERE us.status = 'pending'
 ORDER BY us.priority_level DESC, us.scheduled_at ASC
 LIMIT $1;
 
--- name: UpdateScheduleStatus :exec
--- スケジュールステータスを更新
+-- name: UpdateScheduleStatus :execrows
+-- スケジュールステータスを更新し、リースを解放
+-- lease_owner を指定した場合はリースを持っているワーカーのみ更新できる（他のワーカーに取得された場合は0行）
+-- retry_at を指定した場合は次の実行時刻（実行待ちに戻してリトライする場合）
 UPDATE update_schedule
 SET
-    status = $2,
-    started_at = CASE WHEN $2 = 'running' THEN now() ELSE started_at END,
-    completed_at = CASE WHEN $2 IN ('completed', 'failed') THEN now() ELSE completed_at END,
-    error_message = $3,
+    status = sqlc.arg('status')::text,
+    started_at = CASE WHEN sqlc.arg('status')::text = 'running' THEN now() ELSE started_at END,
+    completed_at = CASE WHEN sqlc.arg('status')::text IN ('completed', 'failed') THEN now() ELSE completed_at END,
+    error_message = sqlc.narg('error_message'),
+    scheduled_at = COALESCE(sqlc.narg('retry_at')::timestamptz, scheduled_at),
+    lease_owner = NULL,
+    lease_expires_at = NULL,
     updated_at = now()
-WHERE id = $1;
+WHERE
+    id = sqlc.arg('id')
+    AND (sqlc.narg('lease_owner')::text IS NULL OR lease_owner = sqlc.narg('lease_owner')::text);
 
 -- name: GetSourcePriorityStats :many
 -- 優先度別の統計情報を取得
@@ -0,0 +1,53 @@
+-- query_source_links.sql
+-- 同じクリエイターとしてリンクしたソース（同時配信のまとめ）に関するクエリ
+
+-- ============================================================================
+-- LinkSources: 2つのソースをリンク（リンク済みの場合も1件として返す）
+-- 両方のソースを購読している場合のみリンクできる
+-- ============================================================================
+-- name: LinkSources :execrows
+INSERT INTO source_links (user_id, source_id, linked_source_id)
+SELECT
+    sqlc.arg('user_id')::bigint,
+    LEAST(sqlc.arg('source_id')::uuid, sqlc.arg('linked_source_id')::uuid),
+    GREATEST(sqlc.arg('source_id')::uuid, sqlc.arg('linked_source_id')::uuid)
+WHERE
+    sqlc.arg('source_id')::uuid <> sqlc.arg('linked_source_id')::uuid
+    AND (
+        SELECT COUNT(*) FROM user_subscriptions us
+        WHERE
+            us.user_id = sqlc.arg('user_id')
+            AND us.source_id IN (sqlc.arg('source_id')::uuid, sqlc.arg('linked_source_id')::uuid)
+    ) = 2
+ON CONFLICT (user_id, source_id, linked_source_id) DO UPDATE SET
+    created_at = source_links.created_at;
+
+-- ============================================================================
+-- ListSourceLinks: リンクしたソースの組を取得（購読中のソースのみ）
+-- ============================================================================
+-- name: ListSourceLinks :many
+SELECT
+    sl.source_id,
+    sl.linked_source_id,
+    s.platform_id as source_platform_id,
+    s.display_name as source_display_name,
+    ls.platform_id as linked_platform_id,
+    ls.display_name as linked_display_name,
+    sl.created_at
+FROM source_links sl
+JOIN sources s ON sl.source_id = s.id
+JOIN sources ls ON sl.linked_source_id = ls.id
+JOIN user_subscriptions us ON us.source_id = sl.source_id AND us.user_id = sl.user_id
+JOIN user_subscriptions lus ON lus.source_id = sl.linked_source_id AND lus.user_id = sl.user_id
+WHERE sl.user_id = $1
+ORDER BY sl.created_at ASC;
+
+-- ============================================================================
+-- UnlinkSources: 2つのソースのリンクを解除
+-- ============================================================================
+-- name: UnlinkSources :execrows
+DELETE FROM source_links
+WHERE
+    user_id = sqlc.arg('user_id')
+    AND source_id = LEAST(sqlc.arg('source_id')::uuid, sqlc.arg('linked_source_id')::uuid)
+    AND linked_source_id = GREATEST(sqlc.arg('source_id')::uuid, sqlc.arg('linked_source_id')::uuid);
@@ -0,0 +1,80 @@
+-- query_subscription_tags.sql
+-- 購読チャンネルのタグ・フォルダに関するクエリ
+
+-- ============================================================================
+-- CountSubscriptionTags: ユーザーのタグ数を取得
+-- ============================================================================
+-- name: CountSubscriptionTags :one
+SELECT COUNT(*) FROM subscription_tags
+WHERE user_id = $1;
+
+-- ============================================================================
+-- CreateSubscriptionTag: タグを作成
+-- ============================================================================
+-- name: CreateSubscriptionTag :one
+INSERT INTO subscription_tags (
+    user_id,
+    name
+) VALUES (
+    $1, $2
+)
+RETURNING *;
+
+-- ============================================================================
+-- DeleteSubscriptionTag: タグを削除（割り当ても削除され、購読は残る）
+-- ============================================================================
+-- name: DeleteSubscriptionTag :execrows
+DELETE FROM subscription_tags
+WHERE id = $1 AND user_id = $2;
+
+-- ============================================================================
+-- ListSubscriptionTagAssignments: ユーザーの購読へのタグの割り当てを取得
+-- ============================================================================
+-- name: ListSubscriptionTagAssignments :many
+SELECT source_id, tag_id FROM subscription_tag_assignments
+WHERE user_id = $1
+ORDER BY source_id, created_at ASC;
+
+-- ============================================================================
+-- ListSubscriptionTags: ユーザーのタグを作成順で取得（割り当てた購読数付き）
+-- ============================================================================
+-- name: ListSubscriptionTags :many
+SELECT
+    t.id,
+    t.user_id,
+    t.name,
+    t.created_at,
+    t.updated_at,
+    COUNT(a.source_id) AS subscription_count
+FROM subscription_tags t
+LEFT JOIN subscription_tag_assignments a ON a.tag_id = t.id
+WHERE t.user_id = $1
+GROUP BY t.id, t.user_id, t.name, t.created_at, t.updated_at
+ORDER BY t.created_at ASC, t.id ASC;
+
+-- ============================================================================
+-- SetSubscriptionTags: 購読に割り当てるタグを指定したタグに置き換え
+-- 他のユーザーのタグは割り当てない
+-- ============================================================================
+-- name: SetSubscriptionTags :exec
+WITH removed AS (
+    DELETE FROM subscription_tag_assignments
+    WHERE
+        user_id = sqlc.arg('user_id')
+        AND source_id = sqlc.arg('source_id')
+        AND NOT (tag_id = ANY(sqlc.arg('tag_ids')::uuid[]))
+)
+INSERT INTO subscription_tag_assignments (tag_id, user_id, source_id)
+SELECT t.id, t.user_id, sqlc.arg('source_id')::uuid
+FROM subscription_tags t
+WHERE t.user_id = sqlc.arg('user_id') AND t.id = ANY(sqlc.arg('tag_ids')::uuid[])
+ON CONFLICT (tag_id, source_id) DO NOTHING;
+
+-- ============================================================================
+-- UpdateSubscriptionTag: タグの名前を変更
+-- ============================================================================
+-- name: UpdateSubscriptionTag :one
+UPDATE subscription_tags
+SET name = $3, updated_at = now()
+WHERE id = $1 AND user_id = $2
+RETURNING *;
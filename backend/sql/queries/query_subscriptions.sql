@@ -3,6 +3,7 @@
 
 -- ============================================================================
 -- UpsertUserSubscription: 購読情報のupsert
+-- 既に購読している場合は有効・無効（一時停止）と優先度を変更せず、既存の購読を返す
 -- ============================================================================
 -- name: UpsertUserSubscription :one
 INSERT INTO user_subscriptions (
@@ -16,8 +17,6 @@ INSERT INTO user_subscriptions (
 )
 ON CONFLICT (user_id, source_id)
 DO UPDATE SET
-    enabled = EXCLUDED.enabled,
-    priority = EXCLUDED.priority,
     updated_at = now()
 RETURNING *;
 
@@ -45,6 +44,7 @@ SELECT
     s.created_at,
     s.updated_at,
     us.enabled,
+    us.is_favorite,
     us.priority,
     us.created_at as subscribed_at
 FROM user_subscriptions us
@@ -99,6 +99,20 @@ SET
 WHERE user_id = $1 AND source_id = $2
 RETURNING *;
 
+-- ============================================================================
+-- ReorderSubscriptions: 指定した順序（source_idsの並び）で優先度を振り直す
+-- 先頭が最も高い優先度（件数）、末尾が1
+-- ============================================================================
+-- name: ReorderSubscriptions :exec
+UPDATE user_subscriptions AS us
+SET
+    priority = (cardinality(sqlc.arg('source_ids')::uuid[]) - o.ord + 1)::int,
+    updated_at = now()
+FROM unnest(sqlc.arg('source_ids')::uuid[]) WITH ORDINALITY AS o(source_id, ord)
+WHERE
+    us.user_id = sqlc.arg('user_id')
+    AND us.source_id = o.source_id;
+
 -- ============================================================================
 -- DeleteUserSubscription: 購読を削除
 -- ============================================================================
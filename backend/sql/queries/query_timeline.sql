@@ -19,9 +19,13 @@ INSERT INTO events (
     image_url,
     metrics,
     duration,
+    search_text,
+    enclosure_url,
+    enclosure_type,
+    enclosure_length,
     updated_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, now()
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, now()
 )
 ON CONFLICT (platform_id, external_event_id)
 DO UPDATE SET
@@ -35,6 +39,10 @@ DO UPDATE SET
     image_url = EXCLUDED.image_url,
     metrics = EXCLUDED.metrics,
     duration = EXCLUDED.duration,
+    search_text = EXCLUDED.search_text,
+    enclosure_url = EXCLUDED.enclosure_url,
+    enclosure_type = EXCLUDED.enclosure_type,
+    enclosure_length = EXCLUDED.enclosure_length,
     updated_at = now()
 RETURNING *;
 
@@ -53,7 +61,13 @@ SELECT * FROM events
 WHERE platform_id = $1 AND external_event_id = $2;
 
 -- ============================================================================
--- ListTimeline: ユーザーのタイムラインを取得
+-- ListTimeline: ユーザーのタイムラインを新着順に取得
+-- 並び順は (COALESCE(start_at, published_at, created_at), type = 'live', id) の降順のキーセット
+-- （同じ時刻なら配信中を先にする。idx_events_timeline_sort と同じ式でインデックスを使う）
+-- before_time・day_start/day_end も同じ並び順の時刻で絞り込む
+-- day_start/day_end 指定時はその範囲にかかる番組のみ（日付をまたぐ番組を含む）
+-- channel_ids/platform_ids/event_types/source_ids/tag_ids はNULLの場合は絞り込まない
+-- exclude_watched/exclude_hidden で視聴済み・非表示のイベントを除外
 -- ============================================================================
 -- name: ListTimeline :many
 SELECT 
@@ -71,30 +85,370 @@ SELECT
     e.image_url,
     e.metrics,
     e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
     e.created_at,
     e.updated_at,
     s.display_name as source_display_name,
     s.thumbnail_url as source_thumbnail_url,
     s.handle as source_handle,
-    s.external_id as source_external_id
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
 FROM events e
 JOIN sources s ON e.source_id = s.id
 JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
 WHERE 
-    us.user_id = $1
+    us.user_id = sqlc.arg('user_id')
     AND us.enabled = true
     AND (
-        $2::timestamptz IS NULL
-        OR COALESCE(e.start_at, e.published_at) < $2
+        sqlc.narg('before_time')::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < sqlc.narg('before_time')::timestamptz
+    )
+    AND (
+        sqlc.narg('day_start')::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) >= sqlc.narg('day_start')::timestamptz
+        OR e.end_at > sqlc.narg('day_start')::timestamptz
+    )
+    AND (
+        sqlc.narg('day_end')::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < sqlc.narg('day_end')::timestamptz
     )
     AND (
         sqlc.narg('channel_ids')::text[] IS NULL
         OR s.external_id = ANY(sqlc.narg('channel_ids')::text[])
     )
+    AND (
+        sqlc.narg('platform_ids')::text[] IS NULL
+        OR e.platform_id = ANY(sqlc.narg('platform_ids')::text[])
+    )
+    AND (
+        sqlc.narg('event_types')::text[] IS NULL
+        OR e.type = ANY(sqlc.narg('event_types')::text[])
+    )
+    AND (
+        sqlc.narg('source_ids')::uuid[] IS NULL
+        OR s.id = ANY(sqlc.narg('source_ids')::uuid[])
+    )
+    AND (
+        sqlc.narg('tag_ids')::uuid[] IS NULL
+        OR EXISTS (
+            SELECT 1 FROM subscription_tag_assignments sta
+            WHERE
+                sta.user_id = us.user_id
+                AND sta.source_id = us.source_id
+                AND sta.tag_id = ANY(sqlc.narg('tag_ids')::uuid[])
+        )
+    )
+    AND (
+        NOT sqlc.arg('favorites_only')::bool
+        OR us.is_favorite = true
+    )
+    AND (
+        NOT sqlc.arg('exclude_watched')::bool
+        OR ues.watched_at IS NULL
+    )
+    AND (
+        NOT sqlc.arg('exclude_hidden')::bool
+        OR ues.hidden_at IS NULL
+    )
+    AND (
+        sqlc.narg('cursor_time')::timestamptz IS NULL
+        OR (COALESCE(e.start_at, e.published_at, e.created_at), (e.type = 'live'), e.id) < (sqlc.narg('cursor_time')::timestamptz, sqlc.narg('cursor_live')::bool, sqlc.narg('cursor_id')::uuid)
+    )
 ORDER BY 
-    COALESCE(e.start_at, e.published_at) DESC NULLS LAST,
-    CASE WHEN e.type = 'live' THEN 0 ELSE 1 END ASC
-LIMIT $3;
+    COALESCE(e.start_at, e.published_at, e.created_at) DESC,
+    (e.type = 'live') DESC,
+    e.id DESC
+LIMIT sqlc.arg('page_limit');
+
+-- ============================================================================
+-- ListTimelineAscending: ユーザーのタイムラインを開始時刻順に取得（番組表・新着方向のページ）
+-- ListTimeline と同じ条件で、並び順はそのちょうど逆順（昇順のキーセット）
+-- ============================================================================
+-- name: ListTimelineAscending :many
+SELECT 
+    e.id,
+    e.platform_id,
+    e.source_id,
+    e.external_event_id,
+    e.type,
+    e.title,
+    e.description,
+    e.start_at,
+    e.end_at,
+    e.published_at,
+    e.url,
+    e.image_url,
+    e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
+    e.created_at,
+    e.updated_at,
+    s.display_name as source_display_name,
+    s.thumbnail_url as source_thumbnail_url,
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
+FROM events e
+JOIN sources s ON e.source_id = s.id
+JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
+WHERE 
+    us.user_id = sqlc.arg('user_id')
+    AND us.enabled = true
+    AND (
+        sqlc.narg('before_time')::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < sqlc.narg('before_time')::timestamptz
+    )
+    AND (
+        sqlc.narg('day_start')::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) >= sqlc.narg('day_start')::timestamptz
+        OR e.end_at > sqlc.narg('day_start')::timestamptz
+    )
+    AND (
+        sqlc.narg('day_end')::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < sqlc.narg('day_end')::timestamptz
+    )
+    AND (
+        sqlc.narg('channel_ids')::text[] IS NULL
+        OR s.external_id = ANY(sqlc.narg('channel_ids')::text[])
+    )
+    AND (
+        sqlc.narg('platform_ids')::text[] IS NULL
+        OR e.platform_id = ANY(sqlc.narg('platform_ids')::text[])
+    )
+    AND (
+        sqlc.narg('event_types')::text[] IS NULL
+        OR e.type = ANY(sqlc.narg('event_types')::text[])
+    )
+    AND (
+        sqlc.narg('source_ids')::uuid[] IS NULL
+        OR s.id = ANY(sqlc.narg('source_ids')::uuid[])
+    )
+    AND (
+        sqlc.narg('tag_ids')::uuid[] IS NULL
+        OR EXISTS (
+            SELECT 1 FROM subscription_tag_assignments sta
+            WHERE
+                sta.user_id = us.user_id
+                AND sta.source_id = us.source_id
+                AND sta.tag_id = ANY(sqlc.narg('tag_ids')::uuid[])
+        )
+    )
+    AND (
+        NOT sqlc.arg('favorites_only')::bool
+        OR us.is_favorite = true
+    )
+    AND (
+        NOT sqlc.arg('exclude_watched')::bool
+        OR ues.watched_at IS NULL
+    )
+    AND (
+        NOT sqlc.arg('exclude_hidden')::bool
+        OR ues.hidden_at IS NULL
+    )
+    AND (
+        sqlc.narg('cursor_time')::timestamptz IS NULL
+        OR (COALESCE(e.start_at, e.published_at, e.created_at), (e.type = 'live'), e.id) > (sqlc.narg('cursor_time')::timestamptz, sqlc.narg('cursor_live')::bool, sqlc.narg('cursor_id')::uuid)
+    )
+ORDER BY 
+    COALESCE(e.start_at, e.published_at, e.created_at) ASC,
+    (e.type = 'live') ASC,
+    e.id ASC
+LIMIT sqlc.arg('page_limit');
+
+-- ============================================================================
+-- ListTimelineByPriority: ユーザーのタイムラインを購読の優先度順に取得
+-- 並び順は (us.priority, ListTimeline の並び順) のキーセット、cursor_priority を併用
+-- ascending=false: 優先度の高い購読から新着順, ascending=true: その逆順（手前のページ）
+-- 条件は ListTimeline と同じ
+-- ============================================================================
+-- name: ListTimelineByPriority :many
+SELECT 
+    e.id,
+    e.platform_id,
+    e.source_id,
+    e.external_event_id,
+    e.type,
+    e.title,
+    e.description,
+    e.start_at,
+    e.end_at,
+    e.published_at,
+    e.url,
+    e.image_url,
+    e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
+    e.created_at,
+    e.updated_at,
+    s.display_name as source_display_name,
+    s.thumbnail_url as source_thumbnail_url,
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
+FROM events e
+JOIN sources s ON e.source_id = s.id
+JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
+WHERE 
+    us.user_id = sqlc.arg('user_id')
+    AND us.enabled = true
+    AND (
+        sqlc.narg('before_time')::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < sqlc.narg('before_time')::timestamptz
+    )
+    AND (
+        sqlc.narg('day_start')::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) >= sqlc.narg('day_start')::timestamptz
+        OR e.end_at > sqlc.narg('day_start')::timestamptz
+    )
+    AND (
+        sqlc.narg('day_end')::timestamptz IS NULL
+        OR COALESCE(e.start_at, e.published_at, e.created_at) < sqlc.narg('day_end')::timestamptz
+    )
+    AND (
+        sqlc.narg('channel_ids')::text[] IS NULL
+        OR s.external_id = ANY(sqlc.narg('channel_ids')::text[])
+    )
+    AND (
+        sqlc.narg('platform_ids')::text[] IS NULL
+        OR e.platform_id = ANY(sqlc.narg('platform_ids')::text[])
+    )
+    AND (
+        sqlc.narg('event_types')::text[] IS NULL
+        OR e.type = ANY(sqlc.narg('event_types')::text[])
+    )
+    AND (
+        sqlc.narg('source_ids')::uuid[] IS NULL
+        OR s.id = ANY(sqlc.narg('source_ids')::uuid[])
+    )
+    AND (
+        sqlc.narg('tag_ids')::uuid[] IS NULL
+        OR EXISTS (
+            SELECT 1 FROM subscription_tag_assignments sta
+            WHERE
+                sta.user_id = us.user_id
+                AND sta.source_id = us.source_id
+                AND sta.tag_id = ANY(sqlc.narg('tag_ids')::uuid[])
+        )
+    )
+    AND (
+        NOT sqlc.arg('favorites_only')::bool
+        OR us.is_favorite = true
+    )
+    AND (
+        NOT sqlc.arg('exclude_watched')::bool
+        OR ues.watched_at IS NULL
+    )
+    AND (
+        NOT sqlc.arg('exclude_hidden')::bool
+        OR ues.hidden_at IS NULL
+    )
+    AND (
+        sqlc.narg('cursor_time')::timestamptz IS NULL
+        OR (
+            sqlc.arg('ascending')::bool
+            AND (us.priority, COALESCE(e.start_at, e.published_at, e.created_at), (e.type = 'live'), e.id) > (sqlc.narg('cursor_priority')::int, sqlc.narg('cursor_time')::timestamptz, sqlc.narg('cursor_live')::bool, sqlc.narg('cursor_id')::uuid)
+        )
+        OR (
+            NOT sqlc.arg('ascending')::bool
+            AND (us.priority, COALESCE(e.start_at, e.published_at, e.created_at), (e.type = 'live'), e.id) < (sqlc.narg('cursor_priority')::int, sqlc.narg('cursor_time')::timestamptz, sqlc.narg('cursor_live')::bool, sqlc.narg('cursor_id')::uuid)
+        )
+    )
+ORDER BY 
+    CASE WHEN sqlc.arg('ascending')::bool THEN us.priority END ASC,
+    CASE WHEN sqlc.arg('ascending')::bool THEN COALESCE(e.start_at, e.published_at, e.created_at) END ASC,
+    CASE WHEN sqlc.arg('ascending')::bool THEN (e.type = 'live') END ASC,
+    CASE WHEN sqlc.arg('ascending')::bool THEN e.id END ASC,
+    CASE WHEN NOT sqlc.arg('ascending')::bool THEN us.priority END DESC,
+    CASE WHEN NOT sqlc.arg('ascending')::bool THEN COALESCE(e.start_at, e.published_at, e.created_at) END DESC,
+    CASE WHEN NOT sqlc.arg('ascending')::bool THEN (e.type = 'live') END DESC,
+    CASE WHEN NOT sqlc.arg('ascending')::bool THEN e.id END DESC
+LIMIT sqlc.arg('page_limit');
+
+-- ============================================================================
+-- SearchTimeline: 購読ソースのイベントをタイトル・説明文で検索
+-- terms は正規化済みの検索語（すべてを含むイベントを新着順で返す）
+-- search_text は取り込み時と backfill_search_text バッチで search.Normalize により設定する
+-- ============================================================================
+-- name: SearchTimeline :many
+SELECT 
+    e.id,
+    e.platform_id,
+    e.source_id,
+    e.external_event_id,
+    e.type,
+    e.title,
+    e.description,
+    e.start_at,
+    e.end_at,
+    e.published_at,
+    e.url,
+    e.image_url,
+    e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
+    e.created_at,
+    e.updated_at,
+    s.display_name as source_display_name,
+    s.thumbnail_url as source_thumbnail_url,
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
+FROM events e
+JOIN sources s ON e.source_id = s.id
+JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
+WHERE 
+    us.user_id = sqlc.arg('user_id')
+    AND us.enabled = true
+    AND e.search_text IS NOT NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM unnest(sqlc.arg('terms')::text[]) AS term
+        WHERE strpos(e.search_text, term) = 0
+    )
+    AND (
+        sqlc.narg('platform_ids')::text[] IS NULL
+        OR e.platform_id = ANY(sqlc.narg('platform_ids')::text[])
+    )
+    AND (
+        sqlc.narg('event_types')::text[] IS NULL
+        OR e.type = ANY(sqlc.narg('event_types')::text[])
+    )
+    AND (
+        sqlc.narg('source_ids')::uuid[] IS NULL
+        OR s.id = ANY(sqlc.narg('source_ids')::uuid[])
+    )
+    AND (
+        NOT sqlc.arg('favorites_only')::bool
+        OR us.is_favorite = true
+    )
+    AND (
+        sqlc.narg('cursor_time')::timestamptz IS NULL
+        OR (COALESCE(e.start_at, e.published_at, e.created_at), (e.type = 'live'), e.id) < (sqlc.narg('cursor_time')::timestamptz, sqlc.narg('cursor_live')::bool, sqlc.narg('cursor_id')::uuid)
+    )
+ORDER BY 
+    COALESCE(e.start_at, e.published_at, e.created_at) DESC,
+    (e.type = 'live') DESC,
+    e.id DESC
+LIMIT sqlc.arg('page_limit');
 
 -- ============================================================================
 -- ListTimelineBySource: 特定ソースのタイムラインを取得
@@ -102,11 +456,12 @@ LIMIT $3;
 -- name: ListTimelineBySource :many
 SELECT * FROM events
 WHERE source_id = $1
-ORDER BY COALESCE(start_at, published_at) DESC NULLS LAST
+ORDER BY COALESCE(start_at, published_at, created_at) DESC, (type = 'live') DESC, id DESC
 LIMIT $2;
 
 -- ============================================================================
--- ListLiveEvents: 配信中のイベントを取得
+-- ListLiveEvents: 配信中・放送中のイベントを取得
+-- event_types は timeline.OnAirTypes（ライブ配信・プレミア公開・ラジオ番組）
 -- ============================================================================
 -- name: ListLiveEvents :many
 SELECT 
@@ -123,26 +478,36 @@ SELECT
     e.url,
     e.image_url,
     e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
     e.created_at,
     e.updated_at,
     s.display_name as source_display_name,
     s.thumbnail_url as source_thumbnail_url,
-    s.handle as source_handle
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
 FROM events e
 JOIN sources s ON e.source_id = s.id
 JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
 WHERE 
-    us.user_id = $1
+    us.user_id = sqlc.arg('user_id')
     AND us.enabled = true
-    AND e.type = 'live'
+    AND e.type = ANY(sqlc.arg('event_types')::text[])
     AND e.start_at IS NOT NULL
     AND e.start_at <= now()
     AND (e.end_at IS NULL OR e.end_at > now())
 ORDER BY e.start_at DESC
-LIMIT $2;
+LIMIT sqlc.arg('limit');
 
 -- ============================================================================
 -- ListUpcomingEvents: 今後予定されているイベントを取得
+-- event_types は timeline.UpcomingTypes（配信予定・プレミア公開・ラジオ番組など）
 -- ============================================================================
 -- name: ListUpcomingEvents :many
 SELECT 
@@ -159,22 +524,31 @@ SELECT
     e.url,
     e.image_url,
     e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
     e.created_at,
     e.updated_at,
     s.display_name as source_display_name,
     s.thumbnail_url as source_thumbnail_url,
-    s.handle as source_handle
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
 FROM events e
 JOIN sources s ON e.source_id = s.id
 JOIN user_subscriptions us ON s.id = us.source_id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = us.user_id
 WHERE 
-    us.user_id = $1
+    us.user_id = sqlc.arg('user_id')
     AND us.enabled = true
-    AND e.type IN ('scheduled', 'premiere')
+    AND e.type = ANY(sqlc.arg('event_types')::text[])
     AND e.start_at IS NOT NULL
     AND e.start_at > now()
 ORDER BY e.start_at ASC
-LIMIT $2;
+LIMIT sqlc.arg('limit');
 
 -- ============================================================================
 -- ListEventsByType: タイプ別にイベントを取得
@@ -210,13 +584,18 @@ ORDER BY COALESCE(e.start_at, e.published_at) DESC NULLS LAST
 LIMIT $3;
 
 -- ============================================================================
--- DeleteOldEvents: 古いイベントを削除（アーカイブ）
+-- DeleteOldEvents: 古いイベントを削除（アーカイブ）し、削除を変更履歴に記録
 -- ============================================================================
 -- name: DeleteOldEvents :exec
-DELETE FROM events
-WHERE 
-    type = 'video'
-    AND published_at < now() - INTERVAL '90 days';
+WITH deleted AS (
+    DELETE FROM events
+    WHERE 
+        type = 'video'
+        AND published_at < now() - INTERVAL '90 days'
+    RETURNING id, source_id
+)
+INSERT INTO event_changes (event_id, source_id, change_type)
+SELECT id, source_id, 'removed' FROM deleted;
 
 -- ============================================================================
 -- CountEventsBySource: ソース別のイベント数をカウント
@@ -225,3 +604,33 @@ WHERE
 SELECT COUNT(*) FROM events
 WHERE source_id = $1;
 
+
+-- ============================================================================
+-- DeleteEventsByIDs: 配信元で削除されたイベントを削除し、削除を変更履歴に記録
+-- ============================================================================
+-- name: DeleteEventsByIDs :execrows
+WITH deleted AS (
+    DELETE FROM events
+    WHERE id = ANY(sqlc.arg('ids')::uuid[])
+    RETURNING id, source_id
+)
+INSERT INTO event_changes (event_id, source_id, change_type)
+SELECT id, source_id, 'removed' FROM deleted;
+
+-- ============================================================================
+-- ListEventsWithoutSearchText: search_text 未設定のイベントをid順に取得（バックフィル用）
+-- ============================================================================
+-- name: ListEventsWithoutSearchText :many
+SELECT id, title, description
+FROM events
+WHERE search_text IS NULL AND id > sqlc.arg('after_id')
+ORDER BY id
+LIMIT sqlc.arg('batch_size');
+
+-- ============================================================================
+-- UpdateEventSearchText: イベントの検索用テキストを設定（updated_at は変更しない）
+-- ============================================================================
+-- name: UpdateEventSearchText :exec
+UPDATE events
+SET search_text = sqlc.arg('search_text')
+WHERE id = sqlc.arg('id');
@@ -0,0 +1,53 @@
+-- query_timeline_views.sql
+-- 保存したタイムラインのビューに関するクエリ
+
+-- ============================================================================
+-- CountTimelineViews: ユーザーのビュー数を取得
+-- ============================================================================
+-- name: CountTimelineViews :one
+SELECT COUNT(*) FROM timeline_views
+WHERE user_id = $1;
+
+-- ============================================================================
+-- CreateTimelineView: ビューを作成
+-- ============================================================================
+-- name: CreateTimelineView :one
+INSERT INTO timeline_views (
+    user_id,
+    name,
+    expression
+) VALUES (
+    $1, $2, $3
+)
+RETURNING *;
+
+-- ============================================================================
+-- DeleteTimelineView: ビューを削除
+-- ============================================================================
+-- name: DeleteTimelineView :execrows
+DELETE FROM timeline_views
+WHERE id = $1 AND user_id = $2;
+
+-- ============================================================================
+-- GetTimelineView: ビューを取得
+-- ============================================================================
+-- name: GetTimelineView :one
+SELECT * FROM timeline_views
+WHERE id = $1 AND user_id = $2;
+
+-- ============================================================================
+-- ListTimelineViews: ユーザーのビューを作成順で取得
+-- ============================================================================
+-- name: ListTimelineViews :many
+SELECT * FROM timeline_views
+WHERE user_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- ============================================================================
+-- UpdateTimelineView: ビューの名前と式を更新
+-- ============================================================================
+-- name: UpdateTimelineView :one
+UPDATE timeline_views
+SET name = $3, expression = $4, updated_at = now()
+WHERE id = $1 AND user_id = $2
+RETURNING *;
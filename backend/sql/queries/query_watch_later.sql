@@ -0,0 +1,117 @@
+-- query_watch_later.sql
+-- 「あとで見る」キューに関するクエリ
+
+-- ============================================================================
+-- AddWatchLater: キューの末尾に追加（追加済みの場合は何もしない）
+-- 購読していないソースのイベント、キューが max_items 件に達している場合は追加しない
+-- 件数の確認と追加は1つの文で行う（件数を取得してから追加するまでの間に他のリクエストが追加しないように）
+-- ============================================================================
+-- name: AddWatchLater :execrows
+INSERT INTO watch_later (user_id, event_id, position)
+SELECT
+    us.user_id,
+    e.id,
+    COALESCE((SELECT MAX(wl.position) FROM watch_later wl WHERE wl.user_id = us.user_id), 0) + 1
+FROM events e
+JOIN user_subscriptions us ON e.source_id = us.source_id
+WHERE
+    us.user_id = sqlc.arg('user_id')
+    AND e.id = sqlc.arg('event_id')
+    AND (SELECT COUNT(*) FROM watch_later wl WHERE wl.user_id = us.user_id) < sqlc.arg('max_items')::int
+ON CONFLICT (user_id, event_id) DO NOTHING;
+
+-- ============================================================================
+-- CountWatchLater: キューの件数を取得
+-- ============================================================================
+-- name: CountWatchLater :one
+SELECT COUNT(*) FROM watch_later
+WHERE user_id = $1;
+
+-- ============================================================================
+-- ListWatchLater: キューを並び順で取得
+-- ============================================================================
+-- name: ListWatchLater :many
+SELECT 
+    e.id,
+    e.platform_id,
+    e.source_id,
+    e.external_event_id,
+    e.type,
+    e.title,
+    e.description,
+    e.start_at,
+    e.end_at,
+    e.published_at,
+    e.url,
+    e.image_url,
+    e.metrics,
+    e.duration,
+    e.enclosure_url,
+    e.enclosure_type,
+    e.enclosure_length,
+    e.created_at,
+    e.updated_at,
+    s.display_name as source_display_name,
+    s.thumbnail_url as source_thumbnail_url,
+    s.handle as source_handle,
+    s.external_id as source_external_id,
+    ues.watched_at,
+    ues.hidden_at,
+    ues.dismissed_at
+FROM watch_later wl
+JOIN events e ON wl.event_id = e.id
+JOIN sources s ON e.source_id = s.id
+LEFT JOIN user_event_states ues ON ues.event_id = e.id AND ues.user_id = wl.user_id
+WHERE wl.user_id = $1
+ORDER BY wl.position ASC, wl.added_at ASC;
+
+-- ============================================================================
+-- ListWatchLaterEventIDs: キューのイベントIDを並び順で取得
+-- ============================================================================
+-- name: ListWatchLaterEventIDs :many
+SELECT event_id FROM watch_later
+WHERE user_id = $1
+ORDER BY position ASC, added_at ASC;
+
+-- ============================================================================
+-- ReorderWatchLater: 指定した順序（event_idsの並び）で並び替え
+-- ============================================================================
+-- name: ReorderWatchLater :exec
+UPDATE watch_later AS wl
+SET position = o.ord
+FROM unnest(sqlc.arg('event_ids')::uuid[]) WITH ORDINALITY AS o(event_id, ord)
+WHERE
+    wl.user_id = sqlc.arg('user_id')
+    AND wl.event_id = o.event_id;
+
+-- ============================================================================
+-- RemoveWatchLater: キューから削除
+-- ============================================================================
+-- name: RemoveWatchLater :execrows
+DELETE FROM watch_later
+WHERE user_id = sqlc.arg('user_id') AND event_id = ANY(sqlc.arg('event_ids')::uuid[]);
+
+-- ============================================================================
+-- DeleteEndedWatchLater: 見られなくなった番組をすべてのユーザーのキューから削除
+-- 終了したTwitchの配信（アーカイブは別のイベント）と、タイムフリーの期間（7日）を過ぎたラジオ番組
+-- イベント自体はタイムラインに残す
+-- ============================================================================
+-- name: DeleteEndedWatchLater :execrows
+DELETE FROM watch_later wl
+USING events e
+WHERE
+    wl.event_id = e.id
+    AND (
+        (e.platform_id = 'twitch' AND e.type IN ('live', 'scheduled') AND e.end_at < now())
+        OR (e.type = 'radio' AND e.end_at < now() - INTERVAL '7 days')
+    );
+
+-- ============================================================================
+-- ListWatchLaterYouTubeEvents: キューに入っているYouTubeのイベントを取得
+-- 配信元で削除・非公開になった動画の検出用
+-- ============================================================================
+-- name: ListWatchLaterYouTubeEvents :many
+SELECT DISTINCT e.id, e.external_event_id
+FROM watch_later wl
+JOIN events e ON wl.event_id = e.id
+WHERE e.platform_id = 'youtube';
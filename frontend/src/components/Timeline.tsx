@@ -95,13 +95,12 @@ export default function Timeline() {
       console.log("📺 表示チャンネル:", channelIds);
 
       // 2. タイムラインを取得（初回は50件）
+      // date を指定すると番組表（1日分）表示になるため、新着順タイムラインでは指定しない
       const today = new Date();
-      const dateStr = today.toISOString().split("T")[0];
 
       const res = await client.getTimeline({
-        date: dateStr,
         youtubeChannelIds: channelIds,
-        beforeTime: "",
+        cursor: "",
         limit: 50,
       });
 
@@ -182,13 +181,9 @@ export default function Timeline() {
         channelIds = [selectedChannelId];
       }
 
-      const today = new Date();
-      const dateStr = today.toISOString().split("T")[0];
-
       const res = await client.getTimeline({
-        date: dateStr,
         youtubeChannelIds: channelIds,
-        beforeTime: nextCursor,
+        cursor: nextCursor,
         limit: 50,
       });
 
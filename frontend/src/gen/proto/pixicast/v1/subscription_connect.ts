@@ -0,0 +1,193 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts,import_extension=none"
+// @generated from file proto/pixicast/v1/subscription.proto (package pixicast.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateSubscriptionRequest, CreateSubscriptionResponse, ListSubscriptionsRequest, ListSubscriptionsResponse, DeleteSubscriptionRequest, DeleteSubscriptionResponse, ToggleFavoriteRequest, ToggleFavoriteResponse, SetEnabledRequest, SetEnabledResponse, SetPriorityRequest, SetPriorityResponse, ReorderSubscriptionsRequest, ReorderSubscriptionsResponse, ListTagsRequest, ListTagsResponse, CreateTagRequest, CreateTagResponse, UpdateTagRequest, UpdateTagResponse, DeleteTagRequest, DeleteTagResponse, SetSubscriptionTagsRequest, SetSubscriptionTagsResponse, ImportSubscriptionsRequest, ImportSubscriptionsResponse, ExportSubscriptionsRequest, ExportSubscriptionsResponse, GetIngestStatusRequest, GetIngestStatusResponse, GetMeRequest, GetMeResponse } from "./subscription_pb";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service pixicast.v1.SubscriptionService
+ */
+export const SubscriptionService = {
+  typeName: "pixicast.v1.SubscriptionService",
+  methods: {
+    /**
+     * チャンネルを登録（プラン別のチャンネル数上限をチェック）
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.CreateSubscription
+     */
+    createSubscription: {
+      name: "CreateSubscription",
+      I: CreateSubscriptionRequest,
+      O: CreateSubscriptionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 購読一覧を取得
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.ListSubscriptions
+     */
+    listSubscriptions: {
+      name: "ListSubscriptions",
+      I: ListSubscriptionsRequest,
+      O: ListSubscriptionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 購読を解除
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.DeleteSubscription
+     */
+    deleteSubscription: {
+      name: "DeleteSubscription",
+      I: DeleteSubscriptionRequest,
+      O: DeleteSubscriptionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * お気に入り状態を設定（Basicプラン以上）
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.ToggleFavorite
+     */
+    toggleFavorite: {
+      name: "ToggleFavorite",
+      I: ToggleFavoriteRequest,
+      O: ToggleFavoriteResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 購読の一時停止・再開（一時停止中はタイムラインに表示されず、お気に入り・優先度は保持）
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.SetEnabled
+     */
+    setEnabled: {
+      name: "SetEnabled",
+      I: SetEnabledRequest,
+      O: SetEnabledResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 購読の優先度を設定
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.SetPriority
+     */
+    setPriority: {
+      name: "SetPriority",
+      I: SetPriorityRequest,
+      O: SetPriorityResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 購読を指定した順に並び替え（先頭ほど優先度が高くなる）
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.ReorderSubscriptions
+     */
+    reorderSubscriptions: {
+      name: "ReorderSubscriptions",
+      I: ReorderSubscriptionsRequest,
+      O: ReorderSubscriptionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * タグの一覧を取得（タグごとの購読数付き）
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.ListTags
+     */
+    listTags: {
+      name: "ListTags",
+      I: ListTagsRequest,
+      O: ListTagsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * タグを作成
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.CreateTag
+     */
+    createTag: {
+      name: "CreateTag",
+      I: CreateTagRequest,
+      O: CreateTagResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * タグの名前を変更
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.UpdateTag
+     */
+    updateTag: {
+      name: "UpdateTag",
+      I: UpdateTagRequest,
+      O: UpdateTagResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * タグを削除（タグの割り当てだけが削除され、購読は残る）
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.DeleteTag
+     */
+    deleteTag: {
+      name: "DeleteTag",
+      I: DeleteTagRequest,
+      O: DeleteTagResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 購読に割り当てるタグを置き換え
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.SetSubscriptionTags
+     */
+    setSubscriptionTags: {
+      name: "SetSubscriptionTags",
+      I: SetSubscriptionTagsRequest,
+      O: SetSubscriptionTagsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ファイル（OPML・YouTube TakeoutのCSV・Pixicast JSON）から購読を一括登録
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.ImportSubscriptions
+     */
+    importSubscriptions: {
+      name: "ImportSubscriptions",
+      I: ImportSubscriptionsRequest,
+      O: ImportSubscriptionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 購読をファイル（OPML・YouTube TakeoutのCSV・Pixicast JSON）に書き出す
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.ExportSubscriptions
+     */
+    exportSubscriptions: {
+      name: "ExportSubscriptions",
+      I: ExportSubscriptionsRequest,
+      O: ExportSubscriptionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 購読したチャンネルの過去分の取り込み状況を取得
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.GetIngestStatus
+     */
+    getIngestStatus: {
+      name: "GetIngestStatus",
+      I: GetIngestStatusRequest,
+      O: GetIngestStatusResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ユーザー情報とプラン情報を取得
+     *
+     * @generated from rpc pixicast.v1.SubscriptionService.GetMe
+     */
+    getMe: {
+      name: "GetMe",
+      I: GetMeRequest,
+      O: GetMeResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
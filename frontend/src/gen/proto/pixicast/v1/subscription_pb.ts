@@ -0,0 +1,1886 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts,import_extension=none"
+// @generated from file proto/pixicast/v1/subscription.proto (package pixicast.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * 購読情報
+ *
+ * @generated from message pixicast.v1.Subscription
+ */
+export class Subscription extends Message<Subscription> {
+  /**
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  /**
+   * youtube / twitch / podcast / radiko
+   *
+   * @generated from field: string platform = 2;
+   */
+  platform = "";
+
+  /**
+   * プラットフォーム上のID（Podcastの場合はフィードURL）
+   *
+   * @generated from field: string channel_id = 3;
+   */
+  channelId = "";
+
+  /**
+   * @generated from field: string handle = 4;
+   */
+  handle = "";
+
+  /**
+   * @generated from field: string display_name = 5;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string thumbnail_url = 6;
+   */
+  thumbnailUrl = "";
+
+  /**
+   * @generated from field: bool enabled = 7;
+   */
+  enabled = false;
+
+  /**
+   * @generated from field: bool is_favorite = 8;
+   */
+  isFavorite = false;
+
+  /**
+   * @generated from field: int32 priority = 9;
+   */
+  priority = 0;
+
+  /**
+   * 割り当てたタグのID
+   *
+   * @generated from field: repeated string tag_ids = 10;
+   */
+  tagIds: string[] = [];
+
+  constructor(data?: PartialMessage<Subscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.Subscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "platform", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "channel_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "handle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "thumbnail_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "is_favorite", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "tag_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Subscription {
+    return new Subscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Subscription {
+    return new Subscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Subscription {
+    return new Subscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Subscription | PlainMessage<Subscription> | undefined, b: Subscription | PlainMessage<Subscription> | undefined): boolean {
+    return proto3.util.equals(Subscription, a, b);
+  }
+}
+
+/**
+ * 購読のタグ・フォルダ
+ *
+ * @generated from message pixicast.v1.SubscriptionTag
+ */
+export class SubscriptionTag extends Message<SubscriptionTag> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * タグを割り当てた購読の数（無効にした購読を含む）
+   *
+   * @generated from field: int64 subscription_count = 3;
+   */
+  subscriptionCount = protoInt64.zero;
+
+  /**
+   * RFC3339
+   *
+   * @generated from field: string created_at = 4;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<SubscriptionTag>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SubscriptionTag";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "subscription_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubscriptionTag {
+    return new SubscriptionTag().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubscriptionTag {
+    return new SubscriptionTag().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubscriptionTag {
+    return new SubscriptionTag().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubscriptionTag | PlainMessage<SubscriptionTag> | undefined, b: SubscriptionTag | PlainMessage<SubscriptionTag> | undefined): boolean {
+    return proto3.util.equals(SubscriptionTag, a, b);
+  }
+}
+
+/**
+ * 購読登録リクエスト
+ *
+ * @generated from message pixicast.v1.CreateSubscriptionRequest
+ */
+export class CreateSubscriptionRequest extends Message<CreateSubscriptionRequest> {
+  /**
+   * youtube / twitch / podcast / radiko
+   *
+   * @generated from field: string platform = 1;
+   */
+  platform = "";
+
+  /**
+   * URL・@handle・チャンネルID・フィードURL・ステーションID（"TBS" または "TBS:JP13"）
+   *
+   * @generated from field: string input = 2;
+   */
+  input = "";
+
+  constructor(data?: PartialMessage<CreateSubscriptionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.CreateSubscriptionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "platform", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "input", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSubscriptionRequest {
+    return new CreateSubscriptionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSubscriptionRequest {
+    return new CreateSubscriptionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSubscriptionRequest {
+    return new CreateSubscriptionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSubscriptionRequest | PlainMessage<CreateSubscriptionRequest> | undefined, b: CreateSubscriptionRequest | PlainMessage<CreateSubscriptionRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSubscriptionRequest, a, b);
+  }
+}
+
+/**
+ * 購読登録レスポンス
+ *
+ * @generated from message pixicast.v1.CreateSubscriptionResponse
+ */
+export class CreateSubscriptionResponse extends Message<CreateSubscriptionResponse> {
+  /**
+   * @generated from field: pixicast.v1.Subscription subscription = 1;
+   */
+  subscription?: Subscription;
+
+  constructor(data?: PartialMessage<CreateSubscriptionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.CreateSubscriptionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscription", kind: "message", T: Subscription },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSubscriptionResponse {
+    return new CreateSubscriptionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSubscriptionResponse {
+    return new CreateSubscriptionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSubscriptionResponse {
+    return new CreateSubscriptionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSubscriptionResponse | PlainMessage<CreateSubscriptionResponse> | undefined, b: CreateSubscriptionResponse | PlainMessage<CreateSubscriptionResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSubscriptionResponse, a, b);
+  }
+}
+
+/**
+ * 購読一覧取得リクエスト
+ *
+ * @generated from message pixicast.v1.ListSubscriptionsRequest
+ */
+export class ListSubscriptionsRequest extends Message<ListSubscriptionsRequest> {
+  /**
+   * 無効にした購読も含める
+   *
+   * @generated from field: bool include_disabled = 1;
+   */
+  includeDisabled = false;
+
+  constructor(data?: PartialMessage<ListSubscriptionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListSubscriptionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "include_disabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSubscriptionsRequest {
+    return new ListSubscriptionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSubscriptionsRequest {
+    return new ListSubscriptionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSubscriptionsRequest {
+    return new ListSubscriptionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSubscriptionsRequest | PlainMessage<ListSubscriptionsRequest> | undefined, b: ListSubscriptionsRequest | PlainMessage<ListSubscriptionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSubscriptionsRequest, a, b);
+  }
+}
+
+/**
+ * 購読一覧取得レスポンス
+ *
+ * @generated from message pixicast.v1.ListSubscriptionsResponse
+ */
+export class ListSubscriptionsResponse extends Message<ListSubscriptionsResponse> {
+  /**
+   * 優先度の高い順（同じ優先度の中は登録が新しい順）
+   *
+   * @generated from field: repeated pixicast.v1.Subscription subscriptions = 1;
+   */
+  subscriptions: Subscription[] = [];
+
+  /**
+   * タグの一覧（作成順、タグごとの購読数付き）
+   *
+   * @generated from field: repeated pixicast.v1.SubscriptionTag tags = 2;
+   */
+  tags: SubscriptionTag[] = [];
+
+  constructor(data?: PartialMessage<ListSubscriptionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListSubscriptionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscriptions", kind: "message", T: Subscription, repeated: true },
+    { no: 2, name: "tags", kind: "message", T: SubscriptionTag, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSubscriptionsResponse {
+    return new ListSubscriptionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSubscriptionsResponse {
+    return new ListSubscriptionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSubscriptionsResponse {
+    return new ListSubscriptionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSubscriptionsResponse | PlainMessage<ListSubscriptionsResponse> | undefined, b: ListSubscriptionsResponse | PlainMessage<ListSubscriptionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSubscriptionsResponse, a, b);
+  }
+}
+
+/**
+ * 購読解除リクエスト
+ *
+ * @generated from message pixicast.v1.DeleteSubscriptionRequest
+ */
+export class DeleteSubscriptionRequest extends Message<DeleteSubscriptionRequest> {
+  /**
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  constructor(data?: PartialMessage<DeleteSubscriptionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.DeleteSubscriptionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSubscriptionRequest {
+    return new DeleteSubscriptionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSubscriptionRequest {
+    return new DeleteSubscriptionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSubscriptionRequest {
+    return new DeleteSubscriptionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSubscriptionRequest | PlainMessage<DeleteSubscriptionRequest> | undefined, b: DeleteSubscriptionRequest | PlainMessage<DeleteSubscriptionRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSubscriptionRequest, a, b);
+  }
+}
+
+/**
+ * 購読解除レスポンス
+ *
+ * @generated from message pixicast.v1.DeleteSubscriptionResponse
+ */
+export class DeleteSubscriptionResponse extends Message<DeleteSubscriptionResponse> {
+  constructor(data?: PartialMessage<DeleteSubscriptionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.DeleteSubscriptionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSubscriptionResponse {
+    return new DeleteSubscriptionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSubscriptionResponse {
+    return new DeleteSubscriptionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSubscriptionResponse {
+    return new DeleteSubscriptionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSubscriptionResponse | PlainMessage<DeleteSubscriptionResponse> | undefined, b: DeleteSubscriptionResponse | PlainMessage<DeleteSubscriptionResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteSubscriptionResponse, a, b);
+  }
+}
+
+/**
+ * お気に入り設定リクエスト
+ *
+ * @generated from message pixicast.v1.ToggleFavoriteRequest
+ */
+export class ToggleFavoriteRequest extends Message<ToggleFavoriteRequest> {
+  /**
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  /**
+   * @generated from field: bool is_favorite = 2;
+   */
+  isFavorite = false;
+
+  constructor(data?: PartialMessage<ToggleFavoriteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ToggleFavoriteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "is_favorite", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ToggleFavoriteRequest {
+    return new ToggleFavoriteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ToggleFavoriteRequest {
+    return new ToggleFavoriteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ToggleFavoriteRequest {
+    return new ToggleFavoriteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ToggleFavoriteRequest | PlainMessage<ToggleFavoriteRequest> | undefined, b: ToggleFavoriteRequest | PlainMessage<ToggleFavoriteRequest> | undefined): boolean {
+    return proto3.util.equals(ToggleFavoriteRequest, a, b);
+  }
+}
+
+/**
+ * お気に入り設定レスポンス
+ *
+ * @generated from message pixicast.v1.ToggleFavoriteResponse
+ */
+export class ToggleFavoriteResponse extends Message<ToggleFavoriteResponse> {
+  /**
+   * @generated from field: pixicast.v1.Subscription subscription = 1;
+   */
+  subscription?: Subscription;
+
+  constructor(data?: PartialMessage<ToggleFavoriteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ToggleFavoriteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscription", kind: "message", T: Subscription },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ToggleFavoriteResponse {
+    return new ToggleFavoriteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ToggleFavoriteResponse {
+    return new ToggleFavoriteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ToggleFavoriteResponse {
+    return new ToggleFavoriteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ToggleFavoriteResponse | PlainMessage<ToggleFavoriteResponse> | undefined, b: ToggleFavoriteResponse | PlainMessage<ToggleFavoriteResponse> | undefined): boolean {
+    return proto3.util.equals(ToggleFavoriteResponse, a, b);
+  }
+}
+
+/**
+ * 購読の有効・無効切り替えリクエスト
+ *
+ * @generated from message pixicast.v1.SetEnabledRequest
+ */
+export class SetEnabledRequest extends Message<SetEnabledRequest> {
+  /**
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<SetEnabledRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SetEnabledRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetEnabledRequest {
+    return new SetEnabledRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetEnabledRequest {
+    return new SetEnabledRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetEnabledRequest {
+    return new SetEnabledRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetEnabledRequest | PlainMessage<SetEnabledRequest> | undefined, b: SetEnabledRequest | PlainMessage<SetEnabledRequest> | undefined): boolean {
+    return proto3.util.equals(SetEnabledRequest, a, b);
+  }
+}
+
+/**
+ * 購読の有効・無効切り替えレスポンス
+ *
+ * @generated from message pixicast.v1.SetEnabledResponse
+ */
+export class SetEnabledResponse extends Message<SetEnabledResponse> {
+  /**
+   * @generated from field: pixicast.v1.Subscription subscription = 1;
+   */
+  subscription?: Subscription;
+
+  constructor(data?: PartialMessage<SetEnabledResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SetEnabledResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscription", kind: "message", T: Subscription },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetEnabledResponse {
+    return new SetEnabledResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetEnabledResponse {
+    return new SetEnabledResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetEnabledResponse {
+    return new SetEnabledResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetEnabledResponse | PlainMessage<SetEnabledResponse> | undefined, b: SetEnabledResponse | PlainMessage<SetEnabledResponse> | undefined): boolean {
+    return proto3.util.equals(SetEnabledResponse, a, b);
+  }
+}
+
+/**
+ * 優先度設定リクエスト
+ *
+ * @generated from message pixicast.v1.SetPriorityRequest
+ */
+export class SetPriorityRequest extends Message<SetPriorityRequest> {
+  /**
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  /**
+   * 大きいほど上に表示
+   *
+   * @generated from field: int32 priority = 2;
+   */
+  priority = 0;
+
+  constructor(data?: PartialMessage<SetPriorityRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SetPriorityRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetPriorityRequest {
+    return new SetPriorityRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetPriorityRequest {
+    return new SetPriorityRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetPriorityRequest {
+    return new SetPriorityRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetPriorityRequest | PlainMessage<SetPriorityRequest> | undefined, b: SetPriorityRequest | PlainMessage<SetPriorityRequest> | undefined): boolean {
+    return proto3.util.equals(SetPriorityRequest, a, b);
+  }
+}
+
+/**
+ * 優先度設定レスポンス
+ *
+ * @generated from message pixicast.v1.SetPriorityResponse
+ */
+export class SetPriorityResponse extends Message<SetPriorityResponse> {
+  /**
+   * @generated from field: pixicast.v1.Subscription subscription = 1;
+   */
+  subscription?: Subscription;
+
+  constructor(data?: PartialMessage<SetPriorityResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SetPriorityResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscription", kind: "message", T: Subscription },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetPriorityResponse {
+    return new SetPriorityResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetPriorityResponse {
+    return new SetPriorityResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetPriorityResponse {
+    return new SetPriorityResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetPriorityResponse | PlainMessage<SetPriorityResponse> | undefined, b: SetPriorityResponse | PlainMessage<SetPriorityResponse> | undefined): boolean {
+    return proto3.util.equals(SetPriorityResponse, a, b);
+  }
+}
+
+/**
+ * 購読の並び替えリクエスト
+ *
+ * @generated from message pixicast.v1.ReorderSubscriptionsRequest
+ */
+export class ReorderSubscriptionsRequest extends Message<ReorderSubscriptionsRequest> {
+  /**
+   * 無効にした購読を含むすべての購読を新しい順序で指定
+   *
+   * @generated from field: repeated string source_ids = 1;
+   */
+  sourceIds: string[] = [];
+
+  constructor(data?: PartialMessage<ReorderSubscriptionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ReorderSubscriptionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReorderSubscriptionsRequest {
+    return new ReorderSubscriptionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReorderSubscriptionsRequest {
+    return new ReorderSubscriptionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReorderSubscriptionsRequest {
+    return new ReorderSubscriptionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReorderSubscriptionsRequest | PlainMessage<ReorderSubscriptionsRequest> | undefined, b: ReorderSubscriptionsRequest | PlainMessage<ReorderSubscriptionsRequest> | undefined): boolean {
+    return proto3.util.equals(ReorderSubscriptionsRequest, a, b);
+  }
+}
+
+/**
+ * 購読の並び替えレスポンス
+ *
+ * @generated from message pixicast.v1.ReorderSubscriptionsResponse
+ */
+export class ReorderSubscriptionsResponse extends Message<ReorderSubscriptionsResponse> {
+  /**
+   * 並び替え後の購読一覧（無効にした購読を含む）
+   *
+   * @generated from field: repeated pixicast.v1.Subscription subscriptions = 1;
+   */
+  subscriptions: Subscription[] = [];
+
+  constructor(data?: PartialMessage<ReorderSubscriptionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ReorderSubscriptionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscriptions", kind: "message", T: Subscription, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReorderSubscriptionsResponse {
+    return new ReorderSubscriptionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReorderSubscriptionsResponse {
+    return new ReorderSubscriptionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReorderSubscriptionsResponse {
+    return new ReorderSubscriptionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReorderSubscriptionsResponse | PlainMessage<ReorderSubscriptionsResponse> | undefined, b: ReorderSubscriptionsResponse | PlainMessage<ReorderSubscriptionsResponse> | undefined): boolean {
+    return proto3.util.equals(ReorderSubscriptionsResponse, a, b);
+  }
+}
+
+/**
+ * タグ一覧取得リクエスト
+ *
+ * @generated from message pixicast.v1.ListTagsRequest
+ */
+export class ListTagsRequest extends Message<ListTagsRequest> {
+  constructor(data?: PartialMessage<ListTagsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListTagsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTagsRequest {
+    return new ListTagsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTagsRequest {
+    return new ListTagsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTagsRequest {
+    return new ListTagsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTagsRequest | PlainMessage<ListTagsRequest> | undefined, b: ListTagsRequest | PlainMessage<ListTagsRequest> | undefined): boolean {
+    return proto3.util.equals(ListTagsRequest, a, b);
+  }
+}
+
+/**
+ * タグ一覧取得レスポンス
+ *
+ * @generated from message pixicast.v1.ListTagsResponse
+ */
+export class ListTagsResponse extends Message<ListTagsResponse> {
+  /**
+   * 作成順
+   *
+   * @generated from field: repeated pixicast.v1.SubscriptionTag tags = 1;
+   */
+  tags: SubscriptionTag[] = [];
+
+  constructor(data?: PartialMessage<ListTagsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListTagsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tags", kind: "message", T: SubscriptionTag, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTagsResponse {
+    return new ListTagsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTagsResponse {
+    return new ListTagsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTagsResponse {
+    return new ListTagsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTagsResponse | PlainMessage<ListTagsResponse> | undefined, b: ListTagsResponse | PlainMessage<ListTagsResponse> | undefined): boolean {
+    return proto3.util.equals(ListTagsResponse, a, b);
+  }
+}
+
+/**
+ * タグ作成リクエスト
+ *
+ * @generated from message pixicast.v1.CreateTagRequest
+ */
+export class CreateTagRequest extends Message<CreateTagRequest> {
+  /**
+   * 最大50文字（ユーザーごとに一意）
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateTagRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.CreateTagRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTagRequest {
+    return new CreateTagRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTagRequest {
+    return new CreateTagRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTagRequest {
+    return new CreateTagRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTagRequest | PlainMessage<CreateTagRequest> | undefined, b: CreateTagRequest | PlainMessage<CreateTagRequest> | undefined): boolean {
+    return proto3.util.equals(CreateTagRequest, a, b);
+  }
+}
+
+/**
+ * タグ作成レスポンス
+ *
+ * @generated from message pixicast.v1.CreateTagResponse
+ */
+export class CreateTagResponse extends Message<CreateTagResponse> {
+  /**
+   * @generated from field: pixicast.v1.SubscriptionTag tag = 1;
+   */
+  tag?: SubscriptionTag;
+
+  constructor(data?: PartialMessage<CreateTagResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.CreateTagResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tag", kind: "message", T: SubscriptionTag },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTagResponse {
+    return new CreateTagResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTagResponse {
+    return new CreateTagResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTagResponse {
+    return new CreateTagResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTagResponse | PlainMessage<CreateTagResponse> | undefined, b: CreateTagResponse | PlainMessage<CreateTagResponse> | undefined): boolean {
+    return proto3.util.equals(CreateTagResponse, a, b);
+  }
+}
+
+/**
+ * タグ更新リクエスト
+ *
+ * @generated from message pixicast.v1.UpdateTagRequest
+ */
+export class UpdateTagRequest extends Message<UpdateTagRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<UpdateTagRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.UpdateTagRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTagRequest {
+    return new UpdateTagRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTagRequest {
+    return new UpdateTagRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTagRequest {
+    return new UpdateTagRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTagRequest | PlainMessage<UpdateTagRequest> | undefined, b: UpdateTagRequest | PlainMessage<UpdateTagRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateTagRequest, a, b);
+  }
+}
+
+/**
+ * タグ更新レスポンス
+ *
+ * @generated from message pixicast.v1.UpdateTagResponse
+ */
+export class UpdateTagResponse extends Message<UpdateTagResponse> {
+  /**
+   * @generated from field: pixicast.v1.SubscriptionTag tag = 1;
+   */
+  tag?: SubscriptionTag;
+
+  constructor(data?: PartialMessage<UpdateTagResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.UpdateTagResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tag", kind: "message", T: SubscriptionTag },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTagResponse {
+    return new UpdateTagResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTagResponse {
+    return new UpdateTagResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTagResponse {
+    return new UpdateTagResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTagResponse | PlainMessage<UpdateTagResponse> | undefined, b: UpdateTagResponse | PlainMessage<UpdateTagResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateTagResponse, a, b);
+  }
+}
+
+/**
+ * タグ削除リクエスト
+ *
+ * @generated from message pixicast.v1.DeleteTagRequest
+ */
+export class DeleteTagRequest extends Message<DeleteTagRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteTagRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.DeleteTagRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTagRequest {
+    return new DeleteTagRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTagRequest {
+    return new DeleteTagRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTagRequest {
+    return new DeleteTagRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteTagRequest | PlainMessage<DeleteTagRequest> | undefined, b: DeleteTagRequest | PlainMessage<DeleteTagRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteTagRequest, a, b);
+  }
+}
+
+/**
+ * タグ削除レスポンス
+ *
+ * @generated from message pixicast.v1.DeleteTagResponse
+ */
+export class DeleteTagResponse extends Message<DeleteTagResponse> {
+  constructor(data?: PartialMessage<DeleteTagResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.DeleteTagResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTagResponse {
+    return new DeleteTagResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTagResponse {
+    return new DeleteTagResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTagResponse {
+    return new DeleteTagResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteTagResponse | PlainMessage<DeleteTagResponse> | undefined, b: DeleteTagResponse | PlainMessage<DeleteTagResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteTagResponse, a, b);
+  }
+}
+
+/**
+ * 購読のタグ設定リクエスト
+ *
+ * @generated from message pixicast.v1.SetSubscriptionTagsRequest
+ */
+export class SetSubscriptionTagsRequest extends Message<SetSubscriptionTagsRequest> {
+  /**
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  /**
+   * 空の場合はすべてのタグを外す
+   *
+   * @generated from field: repeated string tag_ids = 2;
+   */
+  tagIds: string[] = [];
+
+  constructor(data?: PartialMessage<SetSubscriptionTagsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SetSubscriptionTagsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tag_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSubscriptionTagsRequest {
+    return new SetSubscriptionTagsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSubscriptionTagsRequest {
+    return new SetSubscriptionTagsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSubscriptionTagsRequest {
+    return new SetSubscriptionTagsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSubscriptionTagsRequest | PlainMessage<SetSubscriptionTagsRequest> | undefined, b: SetSubscriptionTagsRequest | PlainMessage<SetSubscriptionTagsRequest> | undefined): boolean {
+    return proto3.util.equals(SetSubscriptionTagsRequest, a, b);
+  }
+}
+
+/**
+ * 購読のタグ設定レスポンス
+ *
+ * @generated from message pixicast.v1.SetSubscriptionTagsResponse
+ */
+export class SetSubscriptionTagsResponse extends Message<SetSubscriptionTagsResponse> {
+  /**
+   * @generated from field: pixicast.v1.Subscription subscription = 1;
+   */
+  subscription?: Subscription;
+
+  constructor(data?: PartialMessage<SetSubscriptionTagsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SetSubscriptionTagsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscription", kind: "message", T: Subscription },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSubscriptionTagsResponse {
+    return new SetSubscriptionTagsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSubscriptionTagsResponse {
+    return new SetSubscriptionTagsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSubscriptionTagsResponse {
+    return new SetSubscriptionTagsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSubscriptionTagsResponse | PlainMessage<SetSubscriptionTagsResponse> | undefined, b: SetSubscriptionTagsResponse | PlainMessage<SetSubscriptionTagsResponse> | undefined): boolean {
+    return proto3.util.equals(SetSubscriptionTagsResponse, a, b);
+  }
+}
+
+/**
+ * 一括登録リクエスト
+ *
+ * @generated from message pixicast.v1.ImportSubscriptionsRequest
+ */
+export class ImportSubscriptionsRequest extends Message<ImportSubscriptionsRequest> {
+  /**
+   * opml / takeout / json（省略時は内容から推定）
+   *
+   * @generated from field: string format = 1;
+   */
+  format = "";
+
+  /**
+   * ファイルの内容（最大5MB、500件まで。1回のリクエストで登録を試みるのは50件まで）
+   *
+   * @generated from field: bytes content = 2;
+   */
+  content = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<ImportSubscriptionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ImportSubscriptionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "format", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "content", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportSubscriptionsRequest {
+    return new ImportSubscriptionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportSubscriptionsRequest {
+    return new ImportSubscriptionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportSubscriptionsRequest {
+    return new ImportSubscriptionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportSubscriptionsRequest | PlainMessage<ImportSubscriptionsRequest> | undefined, b: ImportSubscriptionsRequest | PlainMessage<ImportSubscriptionsRequest> | undefined): boolean {
+    return proto3.util.equals(ImportSubscriptionsRequest, a, b);
+  }
+}
+
+/**
+ * 一括登録の1行の結果
+ *
+ * @generated from message pixicast.v1.ImportResult
+ */
+export class ImportResult extends Message<ImportResult> {
+  /**
+   * ファイル内の購読の番号（1始まり）
+   *
+   * @generated from field: int32 row = 1;
+   */
+  row = 0;
+
+  /**
+   * @generated from field: string platform = 2;
+   */
+  platform = "";
+
+  /**
+   * @generated from field: string input = 3;
+   */
+  input = "";
+
+  /**
+   * ファイルに書かれていた名前
+   *
+   * @generated from field: string title = 4;
+   */
+  title = "";
+
+  /**
+   * created / exists / limit_exceeded / failed / pending
+   *
+   * @generated from field: string status = 5;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string source_id = 6;
+   */
+  sourceId = "";
+
+  /**
+   * @generated from field: string display_name = 7;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string error = 8;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ImportResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ImportResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "row", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "platform", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "input", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportResult {
+    return new ImportResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportResult {
+    return new ImportResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportResult {
+    return new ImportResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportResult | PlainMessage<ImportResult> | undefined, b: ImportResult | PlainMessage<ImportResult> | undefined): boolean {
+    return proto3.util.equals(ImportResult, a, b);
+  }
+}
+
+/**
+ * 一括登録レスポンス
+ *
+ * @generated from message pixicast.v1.ImportSubscriptionsResponse
+ */
+export class ImportSubscriptionsResponse extends Message<ImportSubscriptionsResponse> {
+  /**
+   * @generated from field: string format = 1;
+   */
+  format = "";
+
+  /**
+   * @generated from field: int32 created = 2;
+   */
+  created = 0;
+
+  /**
+   * @generated from field: int32 existing = 3;
+   */
+  existing = 0;
+
+  /**
+   * プランのチャンネル数上限のため登録しなかった件数
+   *
+   * @generated from field: int32 limit_exceeded = 4;
+   */
+  limitExceeded = 0;
+
+  /**
+   * @generated from field: int32 failed = 5;
+   */
+  failed = 0;
+
+  /**
+   * ファイル内の順
+   *
+   * @generated from field: repeated pixicast.v1.ImportResult results = 6;
+   */
+  results: ImportResult[] = [];
+
+  /**
+   * 1回のリクエストで登録する件数の上限のため未処理の件数（同じファイルを再送すると続きを登録する）
+   *
+   * @generated from field: int32 pending = 7;
+   */
+  pending = 0;
+
+  constructor(data?: PartialMessage<ImportSubscriptionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ImportSubscriptionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "format", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "created", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "existing", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "limit_exceeded", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "failed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "results", kind: "message", T: ImportResult, repeated: true },
+    { no: 7, name: "pending", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportSubscriptionsResponse {
+    return new ImportSubscriptionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportSubscriptionsResponse {
+    return new ImportSubscriptionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportSubscriptionsResponse {
+    return new ImportSubscriptionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportSubscriptionsResponse | PlainMessage<ImportSubscriptionsResponse> | undefined, b: ImportSubscriptionsResponse | PlainMessage<ImportSubscriptionsResponse> | undefined): boolean {
+    return proto3.util.equals(ImportSubscriptionsResponse, a, b);
+  }
+}
+
+/**
+ * 書き出しリクエスト
+ *
+ * @generated from message pixicast.v1.ExportSubscriptionsRequest
+ */
+export class ExportSubscriptionsRequest extends Message<ExportSubscriptionsRequest> {
+  /**
+   * opml / takeout / json（デフォルト: json）。OPMLはYouTubeとPodcast、takeoutはYouTubeのみ
+   *
+   * @generated from field: string format = 1;
+   */
+  format = "";
+
+  constructor(data?: PartialMessage<ExportSubscriptionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ExportSubscriptionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "format", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportSubscriptionsRequest {
+    return new ExportSubscriptionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportSubscriptionsRequest {
+    return new ExportSubscriptionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportSubscriptionsRequest {
+    return new ExportSubscriptionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportSubscriptionsRequest | PlainMessage<ExportSubscriptionsRequest> | undefined, b: ExportSubscriptionsRequest | PlainMessage<ExportSubscriptionsRequest> | undefined): boolean {
+    return proto3.util.equals(ExportSubscriptionsRequest, a, b);
+  }
+}
+
+/**
+ * 書き出しレスポンス
+ *
+ * @generated from message pixicast.v1.ExportSubscriptionsResponse
+ */
+export class ExportSubscriptionsResponse extends Message<ExportSubscriptionsResponse> {
+  /**
+   * @generated from field: bytes content = 1;
+   */
+  content = new Uint8Array(0);
+
+  /**
+   * @generated from field: string content_type = 2;
+   */
+  contentType = "";
+
+  /**
+   * @generated from field: string file_name = 3;
+   */
+  fileName = "";
+
+  constructor(data?: PartialMessage<ExportSubscriptionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ExportSubscriptionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "content", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "file_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportSubscriptionsResponse {
+    return new ExportSubscriptionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportSubscriptionsResponse {
+    return new ExportSubscriptionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportSubscriptionsResponse {
+    return new ExportSubscriptionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportSubscriptionsResponse | PlainMessage<ExportSubscriptionsResponse> | undefined, b: ExportSubscriptionsResponse | PlainMessage<ExportSubscriptionsResponse> | undefined): boolean {
+    return proto3.util.equals(ExportSubscriptionsResponse, a, b);
+  }
+}
+
+/**
+ * 取り込み状況取得リクエスト
+ *
+ * @generated from message pixicast.v1.GetIngestStatusRequest
+ */
+export class GetIngestStatusRequest extends Message<GetIngestStatusRequest> {
+  /**
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  constructor(data?: PartialMessage<GetIngestStatusRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.GetIngestStatusRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetIngestStatusRequest {
+    return new GetIngestStatusRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetIngestStatusRequest {
+    return new GetIngestStatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetIngestStatusRequest {
+    return new GetIngestStatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetIngestStatusRequest | PlainMessage<GetIngestStatusRequest> | undefined, b: GetIngestStatusRequest | PlainMessage<GetIngestStatusRequest> | undefined): boolean {
+    return proto3.util.equals(GetIngestStatusRequest, a, b);
+  }
+}
+
+/**
+ * 過去分の取り込み状況
+ *
+ * @generated from message pixicast.v1.IngestStatus
+ */
+export class IngestStatus extends Message<IngestStatus> {
+  /**
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  /**
+   * queued / running / done / failed
+   *
+   * @generated from field: string state = 2;
+   */
+  state = "";
+
+  /**
+   * 保存済みのイベント数
+   *
+   * @generated from field: int64 event_count = 3;
+   */
+  eventCount = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 attempts = 4;
+   */
+  attempts = 0;
+
+  /**
+   * @generated from field: int32 max_attempts = 5;
+   */
+  maxAttempts = 0;
+
+  /**
+   * @generated from field: string last_error = 6;
+   */
+  lastError = "";
+
+  /**
+   * ok / not_found / suspended / error
+   *
+   * @generated from field: string fetch_status = 7;
+   */
+  fetchStatus = "";
+
+  /**
+   * @generated from field: string queued_at = 8;
+   */
+  queuedAt = "";
+
+  /**
+   * @generated from field: string started_at = 9;
+   */
+  startedAt = "";
+
+  /**
+   * @generated from field: string completed_at = 10;
+   */
+  completedAt = "";
+
+  /**
+   * 取り込み待ちの場合の実行予定日時
+   *
+   * @generated from field: string next_attempt_at = 11;
+   */
+  nextAttemptAt = "";
+
+  /**
+   * @generated from field: string last_fetched_at = 12;
+   */
+  lastFetchedAt = "";
+
+  constructor(data?: PartialMessage<IngestStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.IngestStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "max_attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "fetch_status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "queued_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "started_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "completed_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "next_attempt_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "last_fetched_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngestStatus {
+    return new IngestStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngestStatus {
+    return new IngestStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngestStatus {
+    return new IngestStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IngestStatus | PlainMessage<IngestStatus> | undefined, b: IngestStatus | PlainMessage<IngestStatus> | undefined): boolean {
+    return proto3.util.equals(IngestStatus, a, b);
+  }
+}
+
+/**
+ * 取り込み状況取得レスポンス
+ *
+ * @generated from message pixicast.v1.GetIngestStatusResponse
+ */
+export class GetIngestStatusResponse extends Message<GetIngestStatusResponse> {
+  /**
+   * @generated from field: pixicast.v1.IngestStatus status = 1;
+   */
+  status?: IngestStatus;
+
+  constructor(data?: PartialMessage<GetIngestStatusResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.GetIngestStatusResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status", kind: "message", T: IngestStatus },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetIngestStatusResponse {
+    return new GetIngestStatusResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetIngestStatusResponse {
+    return new GetIngestStatusResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetIngestStatusResponse {
+    return new GetIngestStatusResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetIngestStatusResponse | PlainMessage<GetIngestStatusResponse> | undefined, b: GetIngestStatusResponse | PlainMessage<GetIngestStatusResponse> | undefined): boolean {
+    return proto3.util.equals(GetIngestStatusResponse, a, b);
+  }
+}
+
+/**
+ * ユーザー情報取得リクエスト
+ *
+ * @generated from message pixicast.v1.GetMeRequest
+ */
+export class GetMeRequest extends Message<GetMeRequest> {
+  constructor(data?: PartialMessage<GetMeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.GetMeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMeRequest {
+    return new GetMeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMeRequest {
+    return new GetMeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMeRequest {
+    return new GetMeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMeRequest | PlainMessage<GetMeRequest> | undefined, b: GetMeRequest | PlainMessage<GetMeRequest> | undefined): boolean {
+    return proto3.util.equals(GetMeRequest, a, b);
+  }
+}
+
+/**
+ * ユーザー情報
+ *
+ * @generated from message pixicast.v1.User
+ */
+export class User extends Message<User> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string firebase_uid = 2;
+   */
+  firebaseUid = "";
+
+  /**
+   * @generated from field: string plan_type = 3;
+   */
+  planType = "";
+
+  /**
+   * @generated from field: string email = 4;
+   */
+  email = "";
+
+  /**
+   * @generated from field: string display_name = 5;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string photo_url = 6;
+   */
+  photoUrl = "";
+
+  /**
+   * @generated from field: bool is_anonymous = 7;
+   */
+  isAnonymous = false;
+
+  constructor(data?: PartialMessage<User>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.User";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "firebase_uid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "plan_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "photo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "is_anonymous", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): User {
+    return new User().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): User {
+    return new User().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): User {
+    return new User().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: User | PlainMessage<User> | undefined, b: User | PlainMessage<User> | undefined): boolean {
+    return proto3.util.equals(User, a, b);
+  }
+}
+
+/**
+ * プラン情報
+ *
+ * @generated from message pixicast.v1.Plan
+ */
+export class Plan extends Message<Plan> {
+  /**
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: int32 max_channels = 3;
+   */
+  maxChannels = 0;
+
+  /**
+   * 無料プランの場合は0
+   *
+   * @generated from field: int32 price_monthly = 4;
+   */
+  priceMonthly = 0;
+
+  /**
+   * @generated from field: bool has_favorites = 5;
+   */
+  hasFavorites = false;
+
+  /**
+   * @generated from field: bool has_device_sync = 6;
+   */
+  hasDeviceSync = false;
+
+  /**
+   * @generated from field: string description = 7;
+   */
+  description = "";
+
+  constructor(data?: PartialMessage<Plan>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.Plan";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "max_channels", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "price_monthly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "has_favorites", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "has_device_sync", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Plan {
+    return new Plan().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Plan {
+    return new Plan().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Plan {
+    return new Plan().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Plan | PlainMessage<Plan> | undefined, b: Plan | PlainMessage<Plan> | undefined): boolean {
+    return proto3.util.equals(Plan, a, b);
+  }
+}
+
+/**
+ * ユーザー情報取得レスポンス
+ *
+ * @generated from message pixicast.v1.GetMeResponse
+ */
+export class GetMeResponse extends Message<GetMeResponse> {
+  /**
+   * @generated from field: pixicast.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: pixicast.v1.Plan plan = 2;
+   */
+  plan?: Plan;
+
+  /**
+   * @generated from field: int64 current_channels = 3;
+   */
+  currentChannels = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetMeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.GetMeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user", kind: "message", T: User },
+    { no: 2, name: "plan", kind: "message", T: Plan },
+    { no: 3, name: "current_channels", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMeResponse {
+    return new GetMeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMeResponse {
+    return new GetMeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMeResponse {
+    return new GetMeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMeResponse | PlainMessage<GetMeResponse> | undefined, b: GetMeResponse | PlainMessage<GetMeResponse> | undefined): boolean {
+    return proto3.util.equals(GetMeResponse, a, b);
+  }
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetTimelineRequest, GetTimelineResponse, SearchYouTubeLiveRequest, SearchYouTubeLiveResponse } from "./timeline_pb";
+import { GetTimelineRequest, GetTimelineResponse, SearchYouTubeLiveRequest, SearchYouTubeLiveResponse, WatchTimelineRequest, WatchTimelineResponse, ListLiveNowRequest, ListLiveNowResponse, ListUpcomingRequest, ListUpcomingResponse, SearchTimelineRequest, SearchTimelineResponse, SetEventStateRequest, SetEventStateResponse, MarkEventsBeforeRequest, MarkEventsBeforeResponse, ListWatchLaterRequest, ListWatchLaterResponse, AddWatchLaterRequest, AddWatchLaterResponse, ReorderWatchLaterRequest, ReorderWatchLaterResponse, RemoveWatchLaterRequest, RemoveWatchLaterResponse, ListSourceLinksRequest, ListSourceLinksResponse, LinkSourcesRequest, LinkSourcesResponse, UnlinkSourcesRequest, UnlinkSourcesResponse, ListMuteRulesRequest, ListMuteRulesResponse, CreateMuteRuleRequest, CreateMuteRuleResponse, UpdateMuteRuleRequest, UpdateMuteRuleResponse, DeleteMuteRuleRequest, DeleteMuteRuleResponse, ListTimelineViewsRequest, ListTimelineViewsResponse, CreateTimelineViewRequest, CreateTimelineViewResponse, UpdateTimelineViewRequest, UpdateTimelineViewResponse, DeleteTimelineViewRequest, DeleteTimelineViewResponse } from "./timeline_pb";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -30,6 +30,237 @@ export const TimelineService = {
       O: SearchYouTubeLiveResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * タイムラインの変更（追加・更新・削除）をストリーミング配信
+     *
+     * @generated from rpc pixicast.v1.TimelineService.WatchTimeline
+     */
+    watchTimeline: {
+      name: "WatchTimeline",
+      I: WatchTimelineRequest,
+      O: WatchTimelineResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * 購読チャンネルの配信中・放送中の番組を取得（ライブ配信・プレミア公開・ラジオ番組）
+     *
+     * @generated from rpc pixicast.v1.TimelineService.ListLiveNow
+     */
+    listLiveNow: {
+      name: "ListLiveNow",
+      I: ListLiveNowRequest,
+      O: ListLiveNowResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 購読チャンネルの配信予定・放送予定の番組を取得（ライブ配信・プレミア公開・ラジオ番組）
+     *
+     * @generated from rpc pixicast.v1.TimelineService.ListUpcoming
+     */
+    listUpcoming: {
+      name: "ListUpcoming",
+      I: ListUpcomingRequest,
+      O: ListUpcomingResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 購読チャンネルの番組をタイトル・説明文で検索
+     *
+     * @generated from rpc pixicast.v1.TimelineService.SearchTimeline
+     */
+    searchTimeline: {
+      name: "SearchTimeline",
+      I: SearchTimelineRequest,
+      O: SearchTimelineResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 番組の状態（視聴済み・非表示・却下）を設定・解除
+     *
+     * @generated from rpc pixicast.v1.TimelineService.SetEventState
+     */
+    setEventState: {
+      name: "SetEventState",
+      I: SetEventStateRequest,
+      O: SetEventStateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 指定日時より前の番組の状態を一括で設定・解除
+     *
+     * @generated from rpc pixicast.v1.TimelineService.MarkEventsBefore
+     */
+    markEventsBefore: {
+      name: "MarkEventsBefore",
+      I: MarkEventsBeforeRequest,
+      O: MarkEventsBeforeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 「あとで見る」キューを並び順で取得
+     *
+     * @generated from rpc pixicast.v1.TimelineService.ListWatchLater
+     */
+    listWatchLater: {
+      name: "ListWatchLater",
+      I: ListWatchLaterRequest,
+      O: ListWatchLaterResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 「あとで見る」キューの末尾に番組を追加
+     *
+     * @generated from rpc pixicast.v1.TimelineService.AddWatchLater
+     */
+    addWatchLater: {
+      name: "AddWatchLater",
+      I: AddWatchLaterRequest,
+      O: AddWatchLaterResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 「あとで見る」キューを並び替え
+     *
+     * @generated from rpc pixicast.v1.TimelineService.ReorderWatchLater
+     */
+    reorderWatchLater: {
+      name: "ReorderWatchLater",
+      I: ReorderWatchLaterRequest,
+      O: ReorderWatchLaterResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 「あとで見る」キューから番組を削除
+     *
+     * @generated from rpc pixicast.v1.TimelineService.RemoveWatchLater
+     */
+    removeWatchLater: {
+      name: "RemoveWatchLater",
+      I: RemoveWatchLaterRequest,
+      O: RemoveWatchLaterResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 同じクリエイターとしてリンクしたソースの一覧を取得
+     *
+     * @generated from rpc pixicast.v1.TimelineService.ListSourceLinks
+     */
+    listSourceLinks: {
+      name: "ListSourceLinks",
+      I: ListSourceLinksRequest,
+      O: ListSourceLinksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 2つのソースを同じクリエイターとしてリンク（同時配信をタイムラインで1つにまとめる）
+     *
+     * @generated from rpc pixicast.v1.TimelineService.LinkSources
+     */
+    linkSources: {
+      name: "LinkSources",
+      I: LinkSourcesRequest,
+      O: LinkSourcesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ソースのリンクを解除
+     *
+     * @generated from rpc pixicast.v1.TimelineService.UnlinkSources
+     */
+    unlinkSources: {
+      name: "UnlinkSources",
+      I: UnlinkSourcesRequest,
+      O: UnlinkSourcesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * タイムラインのミュートルールの一覧を取得
+     *
+     * @generated from rpc pixicast.v1.TimelineService.ListMuteRules
+     */
+    listMuteRules: {
+      name: "ListMuteRules",
+      I: ListMuteRulesRequest,
+      O: ListMuteRulesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ミュートルールを作成
+     *
+     * @generated from rpc pixicast.v1.TimelineService.CreateMuteRule
+     */
+    createMuteRule: {
+      name: "CreateMuteRule",
+      I: CreateMuteRuleRequest,
+      O: CreateMuteRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ミュートルールを更新
+     *
+     * @generated from rpc pixicast.v1.TimelineService.UpdateMuteRule
+     */
+    updateMuteRule: {
+      name: "UpdateMuteRule",
+      I: UpdateMuteRuleRequest,
+      O: UpdateMuteRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ミュートルールを削除
+     *
+     * @generated from rpc pixicast.v1.TimelineService.DeleteMuteRule
+     */
+    deleteMuteRule: {
+      name: "DeleteMuteRule",
+      I: DeleteMuteRuleRequest,
+      O: DeleteMuteRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 保存したタイムラインのビューの一覧を取得
+     *
+     * @generated from rpc pixicast.v1.TimelineService.ListTimelineViews
+     */
+    listTimelineViews: {
+      name: "ListTimelineViews",
+      I: ListTimelineViewsRequest,
+      O: ListTimelineViewsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * タイムラインのビューを保存
+     *
+     * @generated from rpc pixicast.v1.TimelineService.CreateTimelineView
+     */
+    createTimelineView: {
+      name: "CreateTimelineView",
+      I: CreateTimelineViewRequest,
+      O: CreateTimelineViewResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * タイムラインのビューを更新
+     *
+     * @generated from rpc pixicast.v1.TimelineService.UpdateTimelineView
+     */
+    updateTimelineView: {
+      name: "UpdateTimelineView",
+      I: UpdateTimelineViewRequest,
+      O: UpdateTimelineViewResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * タイムラインのビューを削除
+     *
+     * @generated from rpc pixicast.v1.TimelineService.DeleteTimelineView
+     */
+    deleteTimelineView: {
+      name: "DeleteTimelineView",
+      I: DeleteTimelineViewRequest,
+      O: DeleteTimelineViewResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
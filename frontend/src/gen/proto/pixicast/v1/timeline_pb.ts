@@ -6,6 +6,262 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 
+/**
+ * 番組表の1日の区切り方
+ *
+ * @generated from enum pixicast.v1.DayBoundary
+ */
+export enum DayBoundary {
+  /**
+   * 暦日（0:00〜24:00）
+   *
+   * @generated from enum value: DAY_BOUNDARY_CALENDAR = 0;
+   */
+  CALENDAR = 0,
+
+  /**
+   * 放送日（5:00〜29:00、radiko準拠）
+   *
+   * @generated from enum value: DAY_BOUNDARY_BROADCAST = 1;
+   */
+  BROADCAST = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DayBoundary)
+proto3.util.setEnumType(DayBoundary, "pixicast.v1.DayBoundary", [
+  { no: 0, name: "DAY_BOUNDARY_CALENDAR" },
+  { no: 1, name: "DAY_BOUNDARY_BROADCAST" },
+]);
+
+/**
+ * ページング方向（表示順に対する向き）
+ *
+ * @generated from enum pixicast.v1.PageDirection
+ */
+export enum PageDirection {
+  /**
+   * 続きを取得（タイムラインは古い方向、番組表は遅い時刻方向）。next_cursorと組み合わせる
+   *
+   * @generated from enum value: PAGE_DIRECTION_FORWARD = 0;
+   */
+  FORWARD = 0,
+
+  /**
+   * 手前を取得（タイムラインは新しい方向、番組表は早い時刻方向）。prev_cursorと組み合わせる
+   *
+   * @generated from enum value: PAGE_DIRECTION_BACKWARD = 1;
+   */
+  BACKWARD = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PageDirection)
+proto3.util.setEnumType(PageDirection, "pixicast.v1.PageDirection", [
+  { no: 0, name: "PAGE_DIRECTION_FORWARD" },
+  { no: 1, name: "PAGE_DIRECTION_BACKWARD" },
+]);
+
+/**
+ * タイムラインの並び順
+ *
+ * @generated from enum pixicast.v1.TimelineSort
+ */
+export enum TimelineSort {
+  /**
+   * 新着順
+   *
+   * @generated from enum value: TIMELINE_SORT_NEWEST = 0;
+   */
+  NEWEST = 0,
+
+  /**
+   * 購読の優先度が高いチャンネル順（同じ優先度の中は新着順）
+   *
+   * @generated from enum value: TIMELINE_SORT_PRIORITY = 1;
+   */
+  PRIORITY = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TimelineSort)
+proto3.util.setEnumType(TimelineSort, "pixicast.v1.TimelineSort", [
+  { no: 0, name: "TIMELINE_SORT_NEWEST" },
+  { no: 1, name: "TIMELINE_SORT_PRIORITY" },
+]);
+
+/**
+ * タイムライン変更の種類
+ *
+ * @generated from enum pixicast.v1.TimelineChangeType
+ */
+export enum TimelineChangeType {
+  /**
+   * @generated from enum value: TIMELINE_CHANGE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 新規追加
+   *
+   * @generated from enum value: TIMELINE_CHANGE_TYPE_INSERTED = 1;
+   */
+  INSERTED = 1,
+
+  /**
+   * 更新（タイトル・配信状態・再生回数など）
+   *
+   * @generated from enum value: TIMELINE_CHANGE_TYPE_UPDATED = 2;
+   */
+  UPDATED = 2,
+
+  /**
+   * 削除
+   *
+   * @generated from enum value: TIMELINE_CHANGE_TYPE_REMOVED = 3;
+   */
+  REMOVED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TimelineChangeType)
+proto3.util.setEnumType(TimelineChangeType, "pixicast.v1.TimelineChangeType", [
+  { no: 0, name: "TIMELINE_CHANGE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "TIMELINE_CHANGE_TYPE_INSERTED" },
+  { no: 2, name: "TIMELINE_CHANGE_TYPE_UPDATED" },
+  { no: 3, name: "TIMELINE_CHANGE_TYPE_REMOVED" },
+]);
+
+/**
+ * 番組の状態（ユーザーごと）
+ *
+ * @generated from enum pixicast.v1.EventState
+ */
+export enum EventState {
+  /**
+   * @generated from enum value: EVENT_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 視聴済み
+   *
+   * @generated from enum value: EVENT_STATE_WATCHED = 1;
+   */
+  WATCHED = 1,
+
+  /**
+   * 非表示
+   *
+   * @generated from enum value: EVENT_STATE_HIDDEN = 2;
+   */
+  HIDDEN = 2,
+
+  /**
+   * 却下
+   *
+   * @generated from enum value: EVENT_STATE_DISMISSED = 3;
+   */
+  DISMISSED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(EventState)
+proto3.util.setEnumType(EventState, "pixicast.v1.EventState", [
+  { no: 0, name: "EVENT_STATE_UNSPECIFIED" },
+  { no: 1, name: "EVENT_STATE_WATCHED" },
+  { no: 2, name: "EVENT_STATE_HIDDEN" },
+  { no: 3, name: "EVENT_STATE_DISMISSED" },
+]);
+
+/**
+ * ミュートルールの種類
+ *
+ * @generated from enum pixicast.v1.MuteRuleKind
+ */
+export enum MuteRuleKind {
+  /**
+   * @generated from enum value: MUTE_RULE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * タイトル・説明文にキーワードを含む（全角半角・ひらがなカタカナ・大文字小文字は区別しない）
+   *
+   * @generated from enum value: MUTE_RULE_KIND_KEYWORD = 1;
+   */
+  KEYWORD = 1,
+
+  /**
+   * タイトル・説明文が正規表現（RE2）にマッチする
+   *
+   * @generated from enum value: MUTE_RULE_KIND_REGEX = 2;
+   */
+  REGEX = 2,
+
+  /**
+   * Twitchの配信カテゴリ（ゲーム名）が一致する
+   *
+   * @generated from enum value: MUTE_RULE_KIND_GAME_NAME = 3;
+   */
+  GAME_NAME = 3,
+
+  /**
+   * 長さがduration_secondsより短い
+   *
+   * @generated from enum value: MUTE_RULE_KIND_MIN_DURATION = 4;
+   */
+  MIN_DURATION = 4,
+
+  /**
+   * 長さがduration_secondsより長い
+   *
+   * @generated from enum value: MUTE_RULE_KIND_MAX_DURATION = 5;
+   */
+  MAX_DURATION = 5,
+
+  /**
+   * 種別が一致する（live / scheduled / video / premiere / radio / episode）
+   *
+   * @generated from enum value: MUTE_RULE_KIND_EVENT_TYPE = 6;
+   */
+  EVENT_TYPE = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MuteRuleKind)
+proto3.util.setEnumType(MuteRuleKind, "pixicast.v1.MuteRuleKind", [
+  { no: 0, name: "MUTE_RULE_KIND_UNSPECIFIED" },
+  { no: 1, name: "MUTE_RULE_KIND_KEYWORD" },
+  { no: 2, name: "MUTE_RULE_KIND_REGEX" },
+  { no: 3, name: "MUTE_RULE_KIND_GAME_NAME" },
+  { no: 4, name: "MUTE_RULE_KIND_MIN_DURATION" },
+  { no: 5, name: "MUTE_RULE_KIND_MAX_DURATION" },
+  { no: 6, name: "MUTE_RULE_KIND_EVENT_TYPE" },
+]);
+
+/**
+ * キーワード・正規表現の対象
+ *
+ * @generated from enum pixicast.v1.MuteRuleField
+ */
+export enum MuteRuleField {
+  /**
+   * タイトルと説明文
+   *
+   * @generated from enum value: MUTE_RULE_FIELD_ALL = 0;
+   */
+  ALL = 0,
+
+  /**
+   * タイトルのみ
+   *
+   * @generated from enum value: MUTE_RULE_FIELD_TITLE = 1;
+   */
+  TITLE = 1,
+
+  /**
+   * 説明文のみ
+   *
+   * @generated from enum value: MUTE_RULE_FIELD_DESCRIPTION = 2;
+   */
+  DESCRIPTION = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MuteRuleField)
+proto3.util.setEnumType(MuteRuleField, "pixicast.v1.MuteRuleField", [
+  { no: 0, name: "MUTE_RULE_FIELD_ALL" },
+  { no: 1, name: "MUTE_RULE_FIELD_TITLE" },
+  { no: 2, name: "MUTE_RULE_FIELD_DESCRIPTION" },
+]);
+
 /**
  * リクエストの定義
  *
@@ -13,31 +269,131 @@ import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
  */
 export class GetTimelineRequest extends Message<GetTimelineRequest> {
   /**
+   * 番組表表示用：指定日（YYYY-MM-DD）の番組を開始時刻の昇順で取得（空の場合は新着順のタイムライン）
+   *
    * @generated from field: string date = 1;
    */
   date = "";
 
   /**
-   * YouTubeチャンネルIDのリスト
+   * チャンネルの外部IDのリスト（YouTube以外のプラットフォームも可）
    *
    * @generated from field: repeated string youtube_channel_ids = 2;
    */
   youtubeChannelIds: string[] = [];
 
   /**
-   * ページング用：この時刻より前のイベントを取得（RFC3339形式）
+   * 非推奨（cursorを使用）：この時刻より前のイベントを取得（RFC3339形式）
    *
    * @generated from field: string before_time = 3;
    */
   beforeTime = "";
 
   /**
-   * 取得件数（デフォルト50、最大100）
+   * 取得件数（デフォルト50、最大100。date指定時はデフォルト500、最大1000）
    *
    * @generated from field: int32 limit = 4;
    */
   limit = 0;
 
+  /**
+   * date指定時の日付の区切りに使うIANAタイムゾーン（デフォルト: Asia/Tokyo）
+   *
+   * @generated from field: string timezone = 5;
+   */
+  timezone = "";
+
+  /**
+   * date指定時の1日の区切り方
+   *
+   * @generated from field: pixicast.v1.DayBoundary day_boundary = 6;
+   */
+  dayBoundary = DayBoundary.CALENDAR;
+
+  /**
+   * ページング用：前回レスポンスのnext_cursorまたはprev_cursor
+   *
+   * @generated from field: string cursor = 7;
+   */
+  cursor = "";
+
+  /**
+   * cursorからどちら方向のページを取得するか
+   *
+   * @generated from field: pixicast.v1.PageDirection direction = 8;
+   */
+  direction = PageDirection.FORWARD;
+
+  /**
+   * プラットフォームで絞り込み（youtube / twitch / podcast / radiko）
+   *
+   * @generated from field: repeated string platform_ids = 9;
+   */
+  platformIds: string[] = [];
+
+  /**
+   * 種別で絞り込み（live / scheduled / video / premiere / radio / episode）
+   *
+   * @generated from field: repeated string event_types = 10;
+   */
+  eventTypes: string[] = [];
+
+  /**
+   * お気に入りのチャンネルのみ
+   *
+   * @generated from field: bool favorites_only = 11;
+   */
+  favoritesOnly = false;
+
+  /**
+   * ソースID（購読一覧のsource_id）で絞り込み
+   *
+   * @generated from field: repeated string source_ids = 12;
+   */
+  sourceIds: string[] = [];
+
+  /**
+   * 視聴済みの番組を除外
+   *
+   * @generated from field: bool exclude_watched = 13;
+   */
+  excludeWatched = false;
+
+  /**
+   * 非表示にした番組を除外
+   *
+   * @generated from field: bool exclude_hidden = 14;
+   */
+  excludeHidden = false;
+
+  /**
+   * ミュートルールにマッチする番組も含める
+   *
+   * @generated from field: bool include_muted = 15;
+   */
+  includeMuted = false;
+
+  /**
+   * 保存したビューのIDを指定すると、ビューの式を満たす番組のみ取得
+   *
+   * @generated from field: string view_id = 16;
+   */
+  viewId = "";
+
+  /**
+   * タイムラインの並び順（date指定時は指定不可）
+   *
+   * @generated from field: pixicast.v1.TimelineSort sort = 17;
+   */
+  sort = TimelineSort.NEWEST;
+
+  /**
+   * タグIDを指定すると、いずれかのタグを割り当てた購読の番組のみ取得
+   *
+   * @generated from field: repeated string tag_ids = 18;
+   */
+  tagIds: string[] = [];
+
   constructor(data?: PartialMessage<GetTimelineRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -50,6 +406,20 @@ export class GetTimelineRequest extends Message<GetTimelineRequest> {
     { no: 2, name: "youtube_channel_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "before_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "day_boundary", kind: "enum", T: proto3.getEnumType(DayBoundary) },
+    { no: 7, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "direction", kind: "enum", T: proto3.getEnumType(PageDirection) },
+    { no: 9, name: "platform_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 10, name: "event_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 11, name: "favorites_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 12, name: "source_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 13, name: "exclude_watched", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 14, name: "exclude_hidden", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 15, name: "include_muted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 16, name: "view_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 17, name: "sort", kind: "enum", T: proto3.getEnumType(TimelineSort) },
+    { no: 18, name: "tag_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTimelineRequest {
@@ -81,19 +451,26 @@ export class GetTimelineResponse extends Message<GetTimelineResponse> {
   programs: Program[] = [];
 
   /**
-   * 次のページがあるかどうか
+   * 指定方向に次のページがあるかどうか
    *
    * @generated from field: bool has_more = 2;
    */
   hasMore = false;
 
   /**
-   * 次のページ取得用のカーソル（最後のイベントの時刻）
+   * 続きのページ取得用のカーソル（最後のイベントの位置）
    *
    * @generated from field: string next_cursor = 3;
    */
   nextCursor = "";
 
+  /**
+   * 手前のページ取得用のカーソル（先頭のイベントの位置）
+   *
+   * @generated from field: string prev_cursor = 4;
+   */
+  prevCursor = "";
+
   constructor(data?: PartialMessage<GetTimelineResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -105,6 +482,7 @@ export class GetTimelineResponse extends Message<GetTimelineResponse> {
     { no: 1, name: "programs", kind: "message", T: Program, repeated: true },
     { no: 2, name: "has_more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 3, name: "next_cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "prev_cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTimelineResponse {
@@ -212,6 +590,41 @@ export class Program extends Message<Program> {
    */
   channelThumbnailUrl = "";
 
+  /**
+   * 開始までの秒数（カウントダウン表示用。開始済みの場合は負数、start_atがない場合は0）
+   *
+   * @generated from field: int64 starts_in_seconds = 15;
+   */
+  startsInSeconds = protoInt64.zero;
+
+  /**
+   * 視聴済み
+   *
+   * @generated from field: bool watched = 16;
+   */
+  watched = false;
+
+  /**
+   * 非表示
+   *
+   * @generated from field: bool hidden = 17;
+   */
+  hidden = false;
+
+  /**
+   * 却下
+   *
+   * @generated from field: bool dismissed = 18;
+   */
+  dismissed = false;
+
+  /**
+   * 同時配信している他のプラットフォームの番組（リンクしたソースのみ）
+   *
+   * @generated from field: repeated pixicast.v1.AlternateLink alternate_links = 19;
+   */
+  alternateLinks: AlternateLink[] = [];
+
   constructor(data?: PartialMessage<Program>) {
     super();
     proto3.util.initPartial(data, this);
@@ -234,6 +647,11 @@ export class Program extends Message<Program> {
     { no: 12, name: "published_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 13, name: "view_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 14, name: "channel_thumbnail_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "starts_in_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 16, name: "watched", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 17, name: "hidden", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 18, name: "dismissed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 19, name: "alternate_links", kind: "message", T: AlternateLink, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Program {
@@ -253,6 +671,63 @@ export class Program extends Message<Program> {
   }
 }
 
+/**
+ * 同時配信している他のプラットフォームの番組
+ *
+ * @generated from message pixicast.v1.AlternateLink
+ */
+export class AlternateLink extends Message<AlternateLink> {
+  /**
+   * @generated from field: string program_id = 1;
+   */
+  programId = "";
+
+  /**
+   * @generated from field: string platform_name = 2;
+   */
+  platformName = "";
+
+  /**
+   * @generated from field: string link_url = 3;
+   */
+  linkUrl = "";
+
+  /**
+   * @generated from field: string channel_title = 4;
+   */
+  channelTitle = "";
+
+  constructor(data?: PartialMessage<AlternateLink>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.AlternateLink";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "program_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "platform_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "link_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "channel_title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlternateLink {
+    return new AlternateLink().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlternateLink {
+    return new AlternateLink().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlternateLink {
+    return new AlternateLink().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlternateLink | PlainMessage<AlternateLink> | undefined, b: AlternateLink | PlainMessage<AlternateLink> | undefined): boolean {
+    return proto3.util.equals(AlternateLink, a, b);
+  }
+}
+
 /**
  * YouTubeライブ配信検索リクエスト
  *
@@ -410,3 +885,2142 @@ export class YouTubeLiveStream extends Message<YouTubeLiveStream> {
   }
 }
 
+/**
+ * タイムライン変更監視リクエスト
+ *
+ * @generated from message pixicast.v1.WatchTimelineRequest
+ */
+export class WatchTimelineRequest extends Message<WatchTimelineRequest> {
+  /**
+   * 再接続用：最後に受信したレスポンスのcursor（空の場合は接続時点以降の変更のみ。保持期間を過ぎたカーソルはFAILED_PRECONDITIONを返すため、タイムラインを再取得してcursorなしで接続し直す）
+   *
+   * @generated from field: string cursor = 1;
+   */
+  cursor = "";
+
+  constructor(data?: PartialMessage<WatchTimelineRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.WatchTimelineRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchTimelineRequest {
+    return new WatchTimelineRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchTimelineRequest {
+    return new WatchTimelineRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchTimelineRequest {
+    return new WatchTimelineRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchTimelineRequest | PlainMessage<WatchTimelineRequest> | undefined, b: WatchTimelineRequest | PlainMessage<WatchTimelineRequest> | undefined): boolean {
+    return proto3.util.equals(WatchTimelineRequest, a, b);
+  }
+}
+
+/**
+ * タイムライン変更監視レスポンス
+ *
+ * @generated from message pixicast.v1.WatchTimelineResponse
+ */
+export class WatchTimelineResponse extends Message<WatchTimelineResponse> {
+  /**
+   * @generated from field: pixicast.v1.TimelineChangeType type = 1;
+   */
+  type = TimelineChangeType.UNSPECIFIED;
+
+  /**
+   * 変更された番組ID
+   *
+   * @generated from field: string program_id = 2;
+   */
+  programId = "";
+
+  /**
+   * 変更後の番組データ（削除時は空）
+   *
+   * @generated from field: pixicast.v1.Program program = 3;
+   */
+  program?: Program;
+
+  /**
+   * 再接続時に指定するカーソル
+   *
+   * @generated from field: string cursor = 4;
+   */
+  cursor = "";
+
+  constructor(data?: PartialMessage<WatchTimelineResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.WatchTimelineResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(TimelineChangeType) },
+    { no: 2, name: "program_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "program", kind: "message", T: Program },
+    { no: 4, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchTimelineResponse {
+    return new WatchTimelineResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchTimelineResponse {
+    return new WatchTimelineResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchTimelineResponse {
+    return new WatchTimelineResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchTimelineResponse | PlainMessage<WatchTimelineResponse> | undefined, b: WatchTimelineResponse | PlainMessage<WatchTimelineResponse> | undefined): boolean {
+    return proto3.util.equals(WatchTimelineResponse, a, b);
+  }
+}
+
+/**
+ * 配信中番組取得リクエスト
+ *
+ * @generated from message pixicast.v1.ListLiveNowRequest
+ */
+export class ListLiveNowRequest extends Message<ListLiveNowRequest> {
+  /**
+   * 取得件数（デフォルト20、最大100）
+   *
+   * @generated from field: int32 limit = 1;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListLiveNowRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListLiveNowRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListLiveNowRequest {
+    return new ListLiveNowRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListLiveNowRequest {
+    return new ListLiveNowRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListLiveNowRequest {
+    return new ListLiveNowRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListLiveNowRequest | PlainMessage<ListLiveNowRequest> | undefined, b: ListLiveNowRequest | PlainMessage<ListLiveNowRequest> | undefined): boolean {
+    return proto3.util.equals(ListLiveNowRequest, a, b);
+  }
+}
+
+/**
+ * 配信中番組取得レスポンス
+ *
+ * @generated from message pixicast.v1.ListLiveNowResponse
+ */
+export class ListLiveNowResponse extends Message<ListLiveNowResponse> {
+  /**
+   * 開始時刻の新しい順
+   *
+   * @generated from field: repeated pixicast.v1.Program programs = 1;
+   */
+  programs: Program[] = [];
+
+  constructor(data?: PartialMessage<ListLiveNowResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListLiveNowResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "programs", kind: "message", T: Program, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListLiveNowResponse {
+    return new ListLiveNowResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListLiveNowResponse {
+    return new ListLiveNowResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListLiveNowResponse {
+    return new ListLiveNowResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListLiveNowResponse | PlainMessage<ListLiveNowResponse> | undefined, b: ListLiveNowResponse | PlainMessage<ListLiveNowResponse> | undefined): boolean {
+    return proto3.util.equals(ListLiveNowResponse, a, b);
+  }
+}
+
+/**
+ * 配信予定番組取得リクエスト
+ *
+ * @generated from message pixicast.v1.ListUpcomingRequest
+ */
+export class ListUpcomingRequest extends Message<ListUpcomingRequest> {
+  /**
+   * 取得件数（デフォルト20、最大100）
+   *
+   * @generated from field: int32 limit = 1;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListUpcomingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListUpcomingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUpcomingRequest {
+    return new ListUpcomingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUpcomingRequest {
+    return new ListUpcomingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUpcomingRequest {
+    return new ListUpcomingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUpcomingRequest | PlainMessage<ListUpcomingRequest> | undefined, b: ListUpcomingRequest | PlainMessage<ListUpcomingRequest> | undefined): boolean {
+    return proto3.util.equals(ListUpcomingRequest, a, b);
+  }
+}
+
+/**
+ * 配信予定番組取得レスポンス
+ *
+ * @generated from message pixicast.v1.ListUpcomingResponse
+ */
+export class ListUpcomingResponse extends Message<ListUpcomingResponse> {
+  /**
+   * 開始時刻の早い順
+   *
+   * @generated from field: repeated pixicast.v1.Program programs = 1;
+   */
+  programs: Program[] = [];
+
+  constructor(data?: PartialMessage<ListUpcomingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListUpcomingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "programs", kind: "message", T: Program, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUpcomingResponse {
+    return new ListUpcomingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUpcomingResponse {
+    return new ListUpcomingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUpcomingResponse {
+    return new ListUpcomingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUpcomingResponse | PlainMessage<ListUpcomingResponse> | undefined, b: ListUpcomingResponse | PlainMessage<ListUpcomingResponse> | undefined): boolean {
+    return proto3.util.equals(ListUpcomingResponse, a, b);
+  }
+}
+
+/**
+ * タイムライン検索リクエスト
+ *
+ * @generated from message pixicast.v1.SearchTimelineRequest
+ */
+export class SearchTimelineRequest extends Message<SearchTimelineRequest> {
+  /**
+   * 検索キーワード（空白区切りですべてを含む番組を検索。全角半角・ひらがなカタカナ・大文字小文字は区別しない）
+   *
+   * @generated from field: string query = 1;
+   */
+  query = "";
+
+  /**
+   * プラットフォームで絞り込み（youtube / twitch / podcast / radiko）
+   *
+   * @generated from field: repeated string platform_ids = 2;
+   */
+  platformIds: string[] = [];
+
+  /**
+   * 種別で絞り込み（live / scheduled / video / premiere / radio / episode）
+   *
+   * @generated from field: repeated string event_types = 3;
+   */
+  eventTypes: string[] = [];
+
+  /**
+   * お気に入りのチャンネルのみ
+   *
+   * @generated from field: bool favorites_only = 4;
+   */
+  favoritesOnly = false;
+
+  /**
+   * ソースID（購読一覧のsource_id）で絞り込み
+   *
+   * @generated from field: repeated string source_ids = 5;
+   */
+  sourceIds: string[] = [];
+
+  /**
+   * ページング用：前回レスポンスのnext_cursor
+   *
+   * @generated from field: string cursor = 6;
+   */
+  cursor = "";
+
+  /**
+   * 取得件数（デフォルト20、最大50）
+   *
+   * @generated from field: int32 limit = 7;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<SearchTimelineRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SearchTimelineRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "platform_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "event_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "favorites_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "source_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchTimelineRequest {
+    return new SearchTimelineRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchTimelineRequest {
+    return new SearchTimelineRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchTimelineRequest {
+    return new SearchTimelineRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchTimelineRequest | PlainMessage<SearchTimelineRequest> | undefined, b: SearchTimelineRequest | PlainMessage<SearchTimelineRequest> | undefined): boolean {
+    return proto3.util.equals(SearchTimelineRequest, a, b);
+  }
+}
+
+/**
+ * タイムライン検索レスポンス
+ *
+ * @generated from message pixicast.v1.SearchTimelineResponse
+ */
+export class SearchTimelineResponse extends Message<SearchTimelineResponse> {
+  /**
+   * 新着順
+   *
+   * @generated from field: repeated pixicast.v1.SearchTimelineResult results = 1;
+   */
+  results: SearchTimelineResult[] = [];
+
+  /**
+   * 次のページがあるかどうか
+   *
+   * @generated from field: bool has_more = 2;
+   */
+  hasMore = false;
+
+  /**
+   * 次のページ取得用のカーソル
+   *
+   * @generated from field: string next_cursor = 3;
+   */
+  nextCursor = "";
+
+  constructor(data?: PartialMessage<SearchTimelineResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SearchTimelineResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "results", kind: "message", T: SearchTimelineResult, repeated: true },
+    { no: 2, name: "has_more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "next_cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchTimelineResponse {
+    return new SearchTimelineResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchTimelineResponse {
+    return new SearchTimelineResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchTimelineResponse {
+    return new SearchTimelineResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchTimelineResponse | PlainMessage<SearchTimelineResponse> | undefined, b: SearchTimelineResponse | PlainMessage<SearchTimelineResponse> | undefined): boolean {
+    return proto3.util.equals(SearchTimelineResponse, a, b);
+  }
+}
+
+/**
+ * タイムライン検索結果
+ *
+ * @generated from message pixicast.v1.SearchTimelineResult
+ */
+export class SearchTimelineResult extends Message<SearchTimelineResult> {
+  /**
+   * @generated from field: pixicast.v1.Program program = 1;
+   */
+  program?: Program;
+
+  /**
+   * ハイライト付きのタイトル
+   *
+   * @generated from field: repeated pixicast.v1.SnippetSegment title_snippet = 2;
+   */
+  titleSnippet: SnippetSegment[] = [];
+
+  /**
+   * マッチ箇所周辺の説明文の抜粋
+   *
+   * @generated from field: repeated pixicast.v1.SnippetSegment description_snippet = 3;
+   */
+  descriptionSnippet: SnippetSegment[] = [];
+
+  constructor(data?: PartialMessage<SearchTimelineResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SearchTimelineResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "program", kind: "message", T: Program },
+    { no: 2, name: "title_snippet", kind: "message", T: SnippetSegment, repeated: true },
+    { no: 3, name: "description_snippet", kind: "message", T: SnippetSegment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchTimelineResult {
+    return new SearchTimelineResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchTimelineResult {
+    return new SearchTimelineResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchTimelineResult {
+    return new SearchTimelineResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchTimelineResult | PlainMessage<SearchTimelineResult> | undefined, b: SearchTimelineResult | PlainMessage<SearchTimelineResult> | undefined): boolean {
+    return proto3.util.equals(SearchTimelineResult, a, b);
+  }
+}
+
+/**
+ * スニペットの一部分
+ *
+ * @generated from message pixicast.v1.SnippetSegment
+ */
+export class SnippetSegment extends Message<SnippetSegment> {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text = "";
+
+  /**
+   * 検索語にマッチした部分かどうか
+   *
+   * @generated from field: bool highlighted = 2;
+   */
+  highlighted = false;
+
+  constructor(data?: PartialMessage<SnippetSegment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SnippetSegment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "highlighted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SnippetSegment {
+    return new SnippetSegment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SnippetSegment {
+    return new SnippetSegment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SnippetSegment {
+    return new SnippetSegment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SnippetSegment | PlainMessage<SnippetSegment> | undefined, b: SnippetSegment | PlainMessage<SnippetSegment> | undefined): boolean {
+    return proto3.util.equals(SnippetSegment, a, b);
+  }
+}
+
+/**
+ * 番組状態の設定リクエスト
+ *
+ * @generated from message pixicast.v1.SetEventStateRequest
+ */
+export class SetEventStateRequest extends Message<SetEventStateRequest> {
+  /**
+   * 対象の番組ID（最大500件）
+   *
+   * @generated from field: repeated string program_ids = 1;
+   */
+  programIds: string[] = [];
+
+  /**
+   * @generated from field: pixicast.v1.EventState state = 2;
+   */
+  state = EventState.UNSPECIFIED;
+
+  /**
+   * trueで設定、falseで解除
+   *
+   * @generated from field: bool value = 3;
+   */
+  value = false;
+
+  constructor(data?: PartialMessage<SetEventStateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SetEventStateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "program_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "state", kind: "enum", T: proto3.getEnumType(EventState) },
+    { no: 3, name: "value", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetEventStateRequest {
+    return new SetEventStateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetEventStateRequest {
+    return new SetEventStateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetEventStateRequest {
+    return new SetEventStateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetEventStateRequest | PlainMessage<SetEventStateRequest> | undefined, b: SetEventStateRequest | PlainMessage<SetEventStateRequest> | undefined): boolean {
+    return proto3.util.equals(SetEventStateRequest, a, b);
+  }
+}
+
+/**
+ * 番組状態の設定レスポンス
+ *
+ * @generated from message pixicast.v1.SetEventStateResponse
+ */
+export class SetEventStateResponse extends Message<SetEventStateResponse> {
+  /**
+   * 更新した番組数（購読していないチャンネルの番組は対象外）
+   *
+   * @generated from field: int64 updated_count = 1;
+   */
+  updatedCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SetEventStateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SetEventStateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "updated_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetEventStateResponse {
+    return new SetEventStateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetEventStateResponse {
+    return new SetEventStateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetEventStateResponse {
+    return new SetEventStateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetEventStateResponse | PlainMessage<SetEventStateResponse> | undefined, b: SetEventStateResponse | PlainMessage<SetEventStateResponse> | undefined): boolean {
+    return proto3.util.equals(SetEventStateResponse, a, b);
+  }
+}
+
+/**
+ * 番組状態の一括設定リクエスト
+ *
+ * @generated from message pixicast.v1.MarkEventsBeforeRequest
+ */
+export class MarkEventsBeforeRequest extends Message<MarkEventsBeforeRequest> {
+  /**
+   * この日時より前の番組が対象（RFC3339形式、番組の開始・公開日時で判定）
+   *
+   * @generated from field: string before_time = 1;
+   */
+  beforeTime = "";
+
+  /**
+   * @generated from field: pixicast.v1.EventState state = 2;
+   */
+  state = EventState.UNSPECIFIED;
+
+  /**
+   * trueで設定、falseで解除
+   *
+   * @generated from field: bool value = 3;
+   */
+  value = false;
+
+  /**
+   * プラットフォームで絞り込み（youtube / twitch / podcast / radiko）
+   *
+   * @generated from field: repeated string platform_ids = 4;
+   */
+  platformIds: string[] = [];
+
+  /**
+   * 種別で絞り込み（live / scheduled / video / premiere / radio / episode）
+   *
+   * @generated from field: repeated string event_types = 5;
+   */
+  eventTypes: string[] = [];
+
+  /**
+   * ソースID（購読一覧のsource_id）で絞り込み
+   *
+   * @generated from field: repeated string source_ids = 6;
+   */
+  sourceIds: string[] = [];
+
+  constructor(data?: PartialMessage<MarkEventsBeforeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.MarkEventsBeforeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "before_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "state", kind: "enum", T: proto3.getEnumType(EventState) },
+    { no: 3, name: "value", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "platform_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "event_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "source_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkEventsBeforeRequest {
+    return new MarkEventsBeforeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkEventsBeforeRequest {
+    return new MarkEventsBeforeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkEventsBeforeRequest {
+    return new MarkEventsBeforeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MarkEventsBeforeRequest | PlainMessage<MarkEventsBeforeRequest> | undefined, b: MarkEventsBeforeRequest | PlainMessage<MarkEventsBeforeRequest> | undefined): boolean {
+    return proto3.util.equals(MarkEventsBeforeRequest, a, b);
+  }
+}
+
+/**
+ * 番組状態の一括設定レスポンス
+ *
+ * @generated from message pixicast.v1.MarkEventsBeforeResponse
+ */
+export class MarkEventsBeforeResponse extends Message<MarkEventsBeforeResponse> {
+  /**
+   * 更新した番組数
+   *
+   * @generated from field: int64 updated_count = 1;
+   */
+  updatedCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MarkEventsBeforeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.MarkEventsBeforeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "updated_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkEventsBeforeResponse {
+    return new MarkEventsBeforeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkEventsBeforeResponse {
+    return new MarkEventsBeforeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkEventsBeforeResponse {
+    return new MarkEventsBeforeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MarkEventsBeforeResponse | PlainMessage<MarkEventsBeforeResponse> | undefined, b: MarkEventsBeforeResponse | PlainMessage<MarkEventsBeforeResponse> | undefined): boolean {
+    return proto3.util.equals(MarkEventsBeforeResponse, a, b);
+  }
+}
+
+/**
+ * 「あとで見る」キュー取得リクエスト
+ *
+ * @generated from message pixicast.v1.ListWatchLaterRequest
+ */
+export class ListWatchLaterRequest extends Message<ListWatchLaterRequest> {
+  constructor(data?: PartialMessage<ListWatchLaterRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListWatchLaterRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWatchLaterRequest {
+    return new ListWatchLaterRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWatchLaterRequest {
+    return new ListWatchLaterRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWatchLaterRequest {
+    return new ListWatchLaterRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWatchLaterRequest | PlainMessage<ListWatchLaterRequest> | undefined, b: ListWatchLaterRequest | PlainMessage<ListWatchLaterRequest> | undefined): boolean {
+    return proto3.util.equals(ListWatchLaterRequest, a, b);
+  }
+}
+
+/**
+ * 「あとで見る」キュー取得レスポンス
+ *
+ * @generated from message pixicast.v1.ListWatchLaterResponse
+ */
+export class ListWatchLaterResponse extends Message<ListWatchLaterResponse> {
+  /**
+   * キューの並び順
+   *
+   * @generated from field: repeated pixicast.v1.Program programs = 1;
+   */
+  programs: Program[] = [];
+
+  /**
+   * プランごとの最大件数
+   *
+   * @generated from field: int32 max_items = 2;
+   */
+  maxItems = 0;
+
+  constructor(data?: PartialMessage<ListWatchLaterResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListWatchLaterResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "programs", kind: "message", T: Program, repeated: true },
+    { no: 2, name: "max_items", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWatchLaterResponse {
+    return new ListWatchLaterResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWatchLaterResponse {
+    return new ListWatchLaterResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWatchLaterResponse {
+    return new ListWatchLaterResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWatchLaterResponse | PlainMessage<ListWatchLaterResponse> | undefined, b: ListWatchLaterResponse | PlainMessage<ListWatchLaterResponse> | undefined): boolean {
+    return proto3.util.equals(ListWatchLaterResponse, a, b);
+  }
+}
+
+/**
+ * 「あとで見る」追加リクエスト
+ *
+ * @generated from message pixicast.v1.AddWatchLaterRequest
+ */
+export class AddWatchLaterRequest extends Message<AddWatchLaterRequest> {
+  /**
+   * @generated from field: string program_id = 1;
+   */
+  programId = "";
+
+  constructor(data?: PartialMessage<AddWatchLaterRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.AddWatchLaterRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "program_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddWatchLaterRequest {
+    return new AddWatchLaterRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddWatchLaterRequest {
+    return new AddWatchLaterRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddWatchLaterRequest {
+    return new AddWatchLaterRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddWatchLaterRequest | PlainMessage<AddWatchLaterRequest> | undefined, b: AddWatchLaterRequest | PlainMessage<AddWatchLaterRequest> | undefined): boolean {
+    return proto3.util.equals(AddWatchLaterRequest, a, b);
+  }
+}
+
+/**
+ * 「あとで見る」追加レスポンス
+ *
+ * @generated from message pixicast.v1.AddWatchLaterResponse
+ */
+export class AddWatchLaterResponse extends Message<AddWatchLaterResponse> {
+  /**
+   * 新しく追加したかどうか（追加済みの場合はfalse）
+   *
+   * @generated from field: bool added = 1;
+   */
+  added = false;
+
+  /**
+   * 追加後のキューの件数
+   *
+   * @generated from field: int32 count = 2;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<AddWatchLaterResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.AddWatchLaterResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "added", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddWatchLaterResponse {
+    return new AddWatchLaterResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddWatchLaterResponse {
+    return new AddWatchLaterResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddWatchLaterResponse {
+    return new AddWatchLaterResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddWatchLaterResponse | PlainMessage<AddWatchLaterResponse> | undefined, b: AddWatchLaterResponse | PlainMessage<AddWatchLaterResponse> | undefined): boolean {
+    return proto3.util.equals(AddWatchLaterResponse, a, b);
+  }
+}
+
+/**
+ * 「あとで見る」並び替えリクエスト
+ *
+ * @generated from message pixicast.v1.ReorderWatchLaterRequest
+ */
+export class ReorderWatchLaterRequest extends Message<ReorderWatchLaterRequest> {
+  /**
+   * 並び替え後の番組IDの順序（キューの全件を指定）
+   *
+   * @generated from field: repeated string program_ids = 1;
+   */
+  programIds: string[] = [];
+
+  constructor(data?: PartialMessage<ReorderWatchLaterRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ReorderWatchLaterRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "program_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReorderWatchLaterRequest {
+    return new ReorderWatchLaterRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReorderWatchLaterRequest {
+    return new ReorderWatchLaterRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReorderWatchLaterRequest {
+    return new ReorderWatchLaterRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReorderWatchLaterRequest | PlainMessage<ReorderWatchLaterRequest> | undefined, b: ReorderWatchLaterRequest | PlainMessage<ReorderWatchLaterRequest> | undefined): boolean {
+    return proto3.util.equals(ReorderWatchLaterRequest, a, b);
+  }
+}
+
+/**
+ * 「あとで見る」並び替えレスポンス
+ *
+ * @generated from message pixicast.v1.ReorderWatchLaterResponse
+ */
+export class ReorderWatchLaterResponse extends Message<ReorderWatchLaterResponse> {
+  constructor(data?: PartialMessage<ReorderWatchLaterResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ReorderWatchLaterResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReorderWatchLaterResponse {
+    return new ReorderWatchLaterResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReorderWatchLaterResponse {
+    return new ReorderWatchLaterResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReorderWatchLaterResponse {
+    return new ReorderWatchLaterResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReorderWatchLaterResponse | PlainMessage<ReorderWatchLaterResponse> | undefined, b: ReorderWatchLaterResponse | PlainMessage<ReorderWatchLaterResponse> | undefined): boolean {
+    return proto3.util.equals(ReorderWatchLaterResponse, a, b);
+  }
+}
+
+/**
+ * 「あとで見る」削除リクエスト
+ *
+ * @generated from message pixicast.v1.RemoveWatchLaterRequest
+ */
+export class RemoveWatchLaterRequest extends Message<RemoveWatchLaterRequest> {
+  /**
+   * 削除する番組ID
+   *
+   * @generated from field: repeated string program_ids = 1;
+   */
+  programIds: string[] = [];
+
+  constructor(data?: PartialMessage<RemoveWatchLaterRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.RemoveWatchLaterRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "program_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveWatchLaterRequest {
+    return new RemoveWatchLaterRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveWatchLaterRequest {
+    return new RemoveWatchLaterRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveWatchLaterRequest {
+    return new RemoveWatchLaterRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveWatchLaterRequest | PlainMessage<RemoveWatchLaterRequest> | undefined, b: RemoveWatchLaterRequest | PlainMessage<RemoveWatchLaterRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveWatchLaterRequest, a, b);
+  }
+}
+
+/**
+ * 「あとで見る」削除レスポンス
+ *
+ * @generated from message pixicast.v1.RemoveWatchLaterResponse
+ */
+export class RemoveWatchLaterResponse extends Message<RemoveWatchLaterResponse> {
+  /**
+   * 削除した件数
+   *
+   * @generated from field: int64 removed_count = 1;
+   */
+  removedCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RemoveWatchLaterResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.RemoveWatchLaterResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "removed_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveWatchLaterResponse {
+    return new RemoveWatchLaterResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveWatchLaterResponse {
+    return new RemoveWatchLaterResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveWatchLaterResponse {
+    return new RemoveWatchLaterResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveWatchLaterResponse | PlainMessage<RemoveWatchLaterResponse> | undefined, b: RemoveWatchLaterResponse | PlainMessage<RemoveWatchLaterResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveWatchLaterResponse, a, b);
+  }
+}
+
+/**
+ * ソースのリンク
+ *
+ * @generated from message pixicast.v1.SourceLink
+ */
+export class SourceLink extends Message<SourceLink> {
+  /**
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  /**
+   * @generated from field: string source_platform_id = 2;
+   */
+  sourcePlatformId = "";
+
+  /**
+   * @generated from field: string source_display_name = 3;
+   */
+  sourceDisplayName = "";
+
+  /**
+   * @generated from field: string linked_source_id = 4;
+   */
+  linkedSourceId = "";
+
+  /**
+   * @generated from field: string linked_platform_id = 5;
+   */
+  linkedPlatformId = "";
+
+  /**
+   * @generated from field: string linked_display_name = 6;
+   */
+  linkedDisplayName = "";
+
+  /**
+   * @generated from field: string created_at = 7;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<SourceLink>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.SourceLink";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "source_platform_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "source_display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "linked_source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "linked_platform_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "linked_display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SourceLink {
+    return new SourceLink().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SourceLink {
+    return new SourceLink().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SourceLink {
+    return new SourceLink().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SourceLink | PlainMessage<SourceLink> | undefined, b: SourceLink | PlainMessage<SourceLink> | undefined): boolean {
+    return proto3.util.equals(SourceLink, a, b);
+  }
+}
+
+/**
+ * ソースのリンク一覧取得リクエスト
+ *
+ * @generated from message pixicast.v1.ListSourceLinksRequest
+ */
+export class ListSourceLinksRequest extends Message<ListSourceLinksRequest> {
+  constructor(data?: PartialMessage<ListSourceLinksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListSourceLinksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSourceLinksRequest {
+    return new ListSourceLinksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSourceLinksRequest {
+    return new ListSourceLinksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSourceLinksRequest {
+    return new ListSourceLinksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSourceLinksRequest | PlainMessage<ListSourceLinksRequest> | undefined, b: ListSourceLinksRequest | PlainMessage<ListSourceLinksRequest> | undefined): boolean {
+    return proto3.util.equals(ListSourceLinksRequest, a, b);
+  }
+}
+
+/**
+ * ソースのリンク一覧取得レスポンス
+ *
+ * @generated from message pixicast.v1.ListSourceLinksResponse
+ */
+export class ListSourceLinksResponse extends Message<ListSourceLinksResponse> {
+  /**
+   * リンクした順
+   *
+   * @generated from field: repeated pixicast.v1.SourceLink links = 1;
+   */
+  links: SourceLink[] = [];
+
+  constructor(data?: PartialMessage<ListSourceLinksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListSourceLinksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "links", kind: "message", T: SourceLink, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSourceLinksResponse {
+    return new ListSourceLinksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSourceLinksResponse {
+    return new ListSourceLinksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSourceLinksResponse {
+    return new ListSourceLinksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSourceLinksResponse | PlainMessage<ListSourceLinksResponse> | undefined, b: ListSourceLinksResponse | PlainMessage<ListSourceLinksResponse> | undefined): boolean {
+    return proto3.util.equals(ListSourceLinksResponse, a, b);
+  }
+}
+
+/**
+ * ソースのリンクリクエスト
+ *
+ * @generated from message pixicast.v1.LinkSourcesRequest
+ */
+export class LinkSourcesRequest extends Message<LinkSourcesRequest> {
+  /**
+   * ソースID（購読一覧のsource_id）
+   *
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  /**
+   * 同じクリエイターとしてリンクするソースID
+   *
+   * @generated from field: string linked_source_id = 2;
+   */
+  linkedSourceId = "";
+
+  constructor(data?: PartialMessage<LinkSourcesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.LinkSourcesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "linked_source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LinkSourcesRequest {
+    return new LinkSourcesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LinkSourcesRequest {
+    return new LinkSourcesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LinkSourcesRequest {
+    return new LinkSourcesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LinkSourcesRequest | PlainMessage<LinkSourcesRequest> | undefined, b: LinkSourcesRequest | PlainMessage<LinkSourcesRequest> | undefined): boolean {
+    return proto3.util.equals(LinkSourcesRequest, a, b);
+  }
+}
+
+/**
+ * ソースのリンクレスポンス
+ *
+ * @generated from message pixicast.v1.LinkSourcesResponse
+ */
+export class LinkSourcesResponse extends Message<LinkSourcesResponse> {
+  constructor(data?: PartialMessage<LinkSourcesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.LinkSourcesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LinkSourcesResponse {
+    return new LinkSourcesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LinkSourcesResponse {
+    return new LinkSourcesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LinkSourcesResponse {
+    return new LinkSourcesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LinkSourcesResponse | PlainMessage<LinkSourcesResponse> | undefined, b: LinkSourcesResponse | PlainMessage<LinkSourcesResponse> | undefined): boolean {
+    return proto3.util.equals(LinkSourcesResponse, a, b);
+  }
+}
+
+/**
+ * ソースのリンク解除リクエスト
+ *
+ * @generated from message pixicast.v1.UnlinkSourcesRequest
+ */
+export class UnlinkSourcesRequest extends Message<UnlinkSourcesRequest> {
+  /**
+   * @generated from field: string source_id = 1;
+   */
+  sourceId = "";
+
+  /**
+   * @generated from field: string linked_source_id = 2;
+   */
+  linkedSourceId = "";
+
+  constructor(data?: PartialMessage<UnlinkSourcesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.UnlinkSourcesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "linked_source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnlinkSourcesRequest {
+    return new UnlinkSourcesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnlinkSourcesRequest {
+    return new UnlinkSourcesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnlinkSourcesRequest {
+    return new UnlinkSourcesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnlinkSourcesRequest | PlainMessage<UnlinkSourcesRequest> | undefined, b: UnlinkSourcesRequest | PlainMessage<UnlinkSourcesRequest> | undefined): boolean {
+    return proto3.util.equals(UnlinkSourcesRequest, a, b);
+  }
+}
+
+/**
+ * ソースのリンク解除レスポンス
+ *
+ * @generated from message pixicast.v1.UnlinkSourcesResponse
+ */
+export class UnlinkSourcesResponse extends Message<UnlinkSourcesResponse> {
+  constructor(data?: PartialMessage<UnlinkSourcesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.UnlinkSourcesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnlinkSourcesResponse {
+    return new UnlinkSourcesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnlinkSourcesResponse {
+    return new UnlinkSourcesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnlinkSourcesResponse {
+    return new UnlinkSourcesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnlinkSourcesResponse | PlainMessage<UnlinkSourcesResponse> | undefined, b: UnlinkSourcesResponse | PlainMessage<UnlinkSourcesResponse> | undefined): boolean {
+    return proto3.util.equals(UnlinkSourcesResponse, a, b);
+  }
+}
+
+/**
+ * ミュートルール
+ *
+ * @generated from message pixicast.v1.MuteRule
+ */
+export class MuteRule extends Message<MuteRule> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: pixicast.v1.MuteRuleKind kind = 2;
+   */
+  kind = MuteRuleKind.UNSPECIFIED;
+
+  /**
+   * キーワード・正規表現・ゲーム名・種別
+   *
+   * @generated from field: string pattern = 3;
+   */
+  pattern = "";
+
+  /**
+   * キーワード・正規表現の対象
+   *
+   * @generated from field: pixicast.v1.MuteRuleField field = 4;
+   */
+  field = MuteRuleField.ALL;
+
+  /**
+   * MIN_DURATION・MAX_DURATIONの秒数
+   *
+   * @generated from field: int32 duration_seconds = 5;
+   */
+  durationSeconds = 0;
+
+  /**
+   * 対象のソースID（空の場合はすべての購読チャンネル）
+   *
+   * @generated from field: string source_id = 6;
+   */
+  sourceId = "";
+
+  /**
+   * @generated from field: string created_at = 7;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<MuteRule>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.MuteRule";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "enum", T: proto3.getEnumType(MuteRuleKind) },
+    { no: 3, name: "pattern", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "field", kind: "enum", T: proto3.getEnumType(MuteRuleField) },
+    { no: 5, name: "duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "source_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MuteRule {
+    return new MuteRule().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MuteRule {
+    return new MuteRule().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MuteRule {
+    return new MuteRule().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MuteRule | PlainMessage<MuteRule> | undefined, b: MuteRule | PlainMessage<MuteRule> | undefined): boolean {
+    return proto3.util.equals(MuteRule, a, b);
+  }
+}
+
+/**
+ * ミュートルール一覧取得リクエスト
+ *
+ * @generated from message pixicast.v1.ListMuteRulesRequest
+ */
+export class ListMuteRulesRequest extends Message<ListMuteRulesRequest> {
+  constructor(data?: PartialMessage<ListMuteRulesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListMuteRulesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMuteRulesRequest {
+    return new ListMuteRulesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMuteRulesRequest {
+    return new ListMuteRulesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMuteRulesRequest {
+    return new ListMuteRulesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListMuteRulesRequest | PlainMessage<ListMuteRulesRequest> | undefined, b: ListMuteRulesRequest | PlainMessage<ListMuteRulesRequest> | undefined): boolean {
+    return proto3.util.equals(ListMuteRulesRequest, a, b);
+  }
+}
+
+/**
+ * ミュートルール一覧取得レスポンス
+ *
+ * @generated from message pixicast.v1.ListMuteRulesResponse
+ */
+export class ListMuteRulesResponse extends Message<ListMuteRulesResponse> {
+  /**
+   * 作成順
+   *
+   * @generated from field: repeated pixicast.v1.MuteRule rules = 1;
+   */
+  rules: MuteRule[] = [];
+
+  constructor(data?: PartialMessage<ListMuteRulesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListMuteRulesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rules", kind: "message", T: MuteRule, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMuteRulesResponse {
+    return new ListMuteRulesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMuteRulesResponse {
+    return new ListMuteRulesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMuteRulesResponse {
+    return new ListMuteRulesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListMuteRulesResponse | PlainMessage<ListMuteRulesResponse> | undefined, b: ListMuteRulesResponse | PlainMessage<ListMuteRulesResponse> | undefined): boolean {
+    return proto3.util.equals(ListMuteRulesResponse, a, b);
+  }
+}
+
+/**
+ * ミュートルール作成リクエスト（idとcreated_atは無視）
+ *
+ * @generated from message pixicast.v1.CreateMuteRuleRequest
+ */
+export class CreateMuteRuleRequest extends Message<CreateMuteRuleRequest> {
+  /**
+   * @generated from field: pixicast.v1.MuteRule rule = 1;
+   */
+  rule?: MuteRule;
+
+  constructor(data?: PartialMessage<CreateMuteRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.CreateMuteRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: MuteRule },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateMuteRuleRequest {
+    return new CreateMuteRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateMuteRuleRequest {
+    return new CreateMuteRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateMuteRuleRequest {
+    return new CreateMuteRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateMuteRuleRequest | PlainMessage<CreateMuteRuleRequest> | undefined, b: CreateMuteRuleRequest | PlainMessage<CreateMuteRuleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateMuteRuleRequest, a, b);
+  }
+}
+
+/**
+ * ミュートルール作成レスポンス
+ *
+ * @generated from message pixicast.v1.CreateMuteRuleResponse
+ */
+export class CreateMuteRuleResponse extends Message<CreateMuteRuleResponse> {
+  /**
+   * @generated from field: pixicast.v1.MuteRule rule = 1;
+   */
+  rule?: MuteRule;
+
+  constructor(data?: PartialMessage<CreateMuteRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.CreateMuteRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: MuteRule },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateMuteRuleResponse {
+    return new CreateMuteRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateMuteRuleResponse {
+    return new CreateMuteRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateMuteRuleResponse {
+    return new CreateMuteRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateMuteRuleResponse | PlainMessage<CreateMuteRuleResponse> | undefined, b: CreateMuteRuleResponse | PlainMessage<CreateMuteRuleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateMuteRuleResponse, a, b);
+  }
+}
+
+/**
+ * ミュートルール更新リクエスト（idで指定したルールを置き換える）
+ *
+ * @generated from message pixicast.v1.UpdateMuteRuleRequest
+ */
+export class UpdateMuteRuleRequest extends Message<UpdateMuteRuleRequest> {
+  /**
+   * @generated from field: pixicast.v1.MuteRule rule = 1;
+   */
+  rule?: MuteRule;
+
+  constructor(data?: PartialMessage<UpdateMuteRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.UpdateMuteRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: MuteRule },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMuteRuleRequest {
+    return new UpdateMuteRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMuteRuleRequest {
+    return new UpdateMuteRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMuteRuleRequest {
+    return new UpdateMuteRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMuteRuleRequest | PlainMessage<UpdateMuteRuleRequest> | undefined, b: UpdateMuteRuleRequest | PlainMessage<UpdateMuteRuleRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateMuteRuleRequest, a, b);
+  }
+}
+
+/**
+ * ミュートルール更新レスポンス
+ *
+ * @generated from message pixicast.v1.UpdateMuteRuleResponse
+ */
+export class UpdateMuteRuleResponse extends Message<UpdateMuteRuleResponse> {
+  /**
+   * @generated from field: pixicast.v1.MuteRule rule = 1;
+   */
+  rule?: MuteRule;
+
+  constructor(data?: PartialMessage<UpdateMuteRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.UpdateMuteRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: MuteRule },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMuteRuleResponse {
+    return new UpdateMuteRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMuteRuleResponse {
+    return new UpdateMuteRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMuteRuleResponse {
+    return new UpdateMuteRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMuteRuleResponse | PlainMessage<UpdateMuteRuleResponse> | undefined, b: UpdateMuteRuleResponse | PlainMessage<UpdateMuteRuleResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateMuteRuleResponse, a, b);
+  }
+}
+
+/**
+ * ミュートルール削除リクエスト
+ *
+ * @generated from message pixicast.v1.DeleteMuteRuleRequest
+ */
+export class DeleteMuteRuleRequest extends Message<DeleteMuteRuleRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteMuteRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.DeleteMuteRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteMuteRuleRequest {
+    return new DeleteMuteRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteMuteRuleRequest {
+    return new DeleteMuteRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteMuteRuleRequest {
+    return new DeleteMuteRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteMuteRuleRequest | PlainMessage<DeleteMuteRuleRequest> | undefined, b: DeleteMuteRuleRequest | PlainMessage<DeleteMuteRuleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteMuteRuleRequest, a, b);
+  }
+}
+
+/**
+ * ミュートルール削除レスポンス
+ *
+ * @generated from message pixicast.v1.DeleteMuteRuleResponse
+ */
+export class DeleteMuteRuleResponse extends Message<DeleteMuteRuleResponse> {
+  constructor(data?: PartialMessage<DeleteMuteRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.DeleteMuteRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteMuteRuleResponse {
+    return new DeleteMuteRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteMuteRuleResponse {
+    return new DeleteMuteRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteMuteRuleResponse {
+    return new DeleteMuteRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteMuteRuleResponse | PlainMessage<DeleteMuteRuleResponse> | undefined, b: DeleteMuteRuleResponse | PlainMessage<DeleteMuteRuleResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteMuteRuleResponse, a, b);
+  }
+}
+
+/**
+ * 保存したタイムラインのビュー
+ * expressionの例: platform == "twitch" && is_live / duration > "01:00:00" && source.is_favorite
+ * 使えるフィールド: platform, type, title, description, is_live, watched, hidden, duration, view_count, start_at,
+ * source.id, source.name, source.handle, source.is_favorite
+ * 使える演算子: && || ! == != < <= > >= =~（正規表現） contains（部分一致） in [...]
+ *
+ * @generated from message pixicast.v1.TimelineView
+ */
+export class TimelineView extends Message<TimelineView> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string expression = 3;
+   */
+  expression = "";
+
+  /**
+   * @generated from field: string created_at = 4;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: string updated_at = 5;
+   */
+  updatedAt = "";
+
+  constructor(data?: PartialMessage<TimelineView>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.TimelineView";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "expression", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TimelineView {
+    return new TimelineView().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TimelineView {
+    return new TimelineView().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TimelineView {
+    return new TimelineView().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TimelineView | PlainMessage<TimelineView> | undefined, b: TimelineView | PlainMessage<TimelineView> | undefined): boolean {
+    return proto3.util.equals(TimelineView, a, b);
+  }
+}
+
+/**
+ * ビュー一覧取得リクエスト
+ *
+ * @generated from message pixicast.v1.ListTimelineViewsRequest
+ */
+export class ListTimelineViewsRequest extends Message<ListTimelineViewsRequest> {
+  constructor(data?: PartialMessage<ListTimelineViewsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListTimelineViewsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTimelineViewsRequest {
+    return new ListTimelineViewsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTimelineViewsRequest {
+    return new ListTimelineViewsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTimelineViewsRequest {
+    return new ListTimelineViewsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTimelineViewsRequest | PlainMessage<ListTimelineViewsRequest> | undefined, b: ListTimelineViewsRequest | PlainMessage<ListTimelineViewsRequest> | undefined): boolean {
+    return proto3.util.equals(ListTimelineViewsRequest, a, b);
+  }
+}
+
+/**
+ * ビュー一覧取得レスポンス
+ *
+ * @generated from message pixicast.v1.ListTimelineViewsResponse
+ */
+export class ListTimelineViewsResponse extends Message<ListTimelineViewsResponse> {
+  /**
+   * 作成順
+   *
+   * @generated from field: repeated pixicast.v1.TimelineView views = 1;
+   */
+  views: TimelineView[] = [];
+
+  constructor(data?: PartialMessage<ListTimelineViewsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.ListTimelineViewsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "views", kind: "message", T: TimelineView, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTimelineViewsResponse {
+    return new ListTimelineViewsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTimelineViewsResponse {
+    return new ListTimelineViewsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTimelineViewsResponse {
+    return new ListTimelineViewsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTimelineViewsResponse | PlainMessage<ListTimelineViewsResponse> | undefined, b: ListTimelineViewsResponse | PlainMessage<ListTimelineViewsResponse> | undefined): boolean {
+    return proto3.util.equals(ListTimelineViewsResponse, a, b);
+  }
+}
+
+/**
+ * ビュー保存リクエスト（式が不正な場合はINVALID_ARGUMENTで位置を返す）
+ *
+ * @generated from message pixicast.v1.CreateTimelineViewRequest
+ */
+export class CreateTimelineViewRequest extends Message<CreateTimelineViewRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string expression = 2;
+   */
+  expression = "";
+
+  constructor(data?: PartialMessage<CreateTimelineViewRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.CreateTimelineViewRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "expression", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTimelineViewRequest {
+    return new CreateTimelineViewRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTimelineViewRequest {
+    return new CreateTimelineViewRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTimelineViewRequest {
+    return new CreateTimelineViewRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTimelineViewRequest | PlainMessage<CreateTimelineViewRequest> | undefined, b: CreateTimelineViewRequest | PlainMessage<CreateTimelineViewRequest> | undefined): boolean {
+    return proto3.util.equals(CreateTimelineViewRequest, a, b);
+  }
+}
+
+/**
+ * ビュー保存レスポンス
+ *
+ * @generated from message pixicast.v1.CreateTimelineViewResponse
+ */
+export class CreateTimelineViewResponse extends Message<CreateTimelineViewResponse> {
+  /**
+   * @generated from field: pixicast.v1.TimelineView view = 1;
+   */
+  view?: TimelineView;
+
+  constructor(data?: PartialMessage<CreateTimelineViewResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.CreateTimelineViewResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "view", kind: "message", T: TimelineView },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTimelineViewResponse {
+    return new CreateTimelineViewResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTimelineViewResponse {
+    return new CreateTimelineViewResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTimelineViewResponse {
+    return new CreateTimelineViewResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTimelineViewResponse | PlainMessage<CreateTimelineViewResponse> | undefined, b: CreateTimelineViewResponse | PlainMessage<CreateTimelineViewResponse> | undefined): boolean {
+    return proto3.util.equals(CreateTimelineViewResponse, a, b);
+  }
+}
+
+/**
+ * ビュー更新リクエスト
+ *
+ * @generated from message pixicast.v1.UpdateTimelineViewRequest
+ */
+export class UpdateTimelineViewRequest extends Message<UpdateTimelineViewRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string expression = 3;
+   */
+  expression = "";
+
+  constructor(data?: PartialMessage<UpdateTimelineViewRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.UpdateTimelineViewRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "expression", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTimelineViewRequest {
+    return new UpdateTimelineViewRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTimelineViewRequest {
+    return new UpdateTimelineViewRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTimelineViewRequest {
+    return new UpdateTimelineViewRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTimelineViewRequest | PlainMessage<UpdateTimelineViewRequest> | undefined, b: UpdateTimelineViewRequest | PlainMessage<UpdateTimelineViewRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateTimelineViewRequest, a, b);
+  }
+}
+
+/**
+ * ビュー更新レスポンス
+ *
+ * @generated from message pixicast.v1.UpdateTimelineViewResponse
+ */
+export class UpdateTimelineViewResponse extends Message<UpdateTimelineViewResponse> {
+  /**
+   * @generated from field: pixicast.v1.TimelineView view = 1;
+   */
+  view?: TimelineView;
+
+  constructor(data?: PartialMessage<UpdateTimelineViewResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.UpdateTimelineViewResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "view", kind: "message", T: TimelineView },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTimelineViewResponse {
+    return new UpdateTimelineViewResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTimelineViewResponse {
+    return new UpdateTimelineViewResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTimelineViewResponse {
+    return new UpdateTimelineViewResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTimelineViewResponse | PlainMessage<UpdateTimelineViewResponse> | undefined, b: UpdateTimelineViewResponse | PlainMessage<UpdateTimelineViewResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateTimelineViewResponse, a, b);
+  }
+}
+
+/**
+ * ビュー削除リクエスト
+ *
+ * @generated from message pixicast.v1.DeleteTimelineViewRequest
+ */
+export class DeleteTimelineViewRequest extends Message<DeleteTimelineViewRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteTimelineViewRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.DeleteTimelineViewRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTimelineViewRequest {
+    return new DeleteTimelineViewRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTimelineViewRequest {
+    return new DeleteTimelineViewRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTimelineViewRequest {
+    return new DeleteTimelineViewRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteTimelineViewRequest | PlainMessage<DeleteTimelineViewRequest> | undefined, b: DeleteTimelineViewRequest | PlainMessage<DeleteTimelineViewRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteTimelineViewRequest, a, b);
+  }
+}
+
+/**
+ * ビュー削除レスポンス
+ *
+ * @generated from message pixicast.v1.DeleteTimelineViewResponse
+ */
+export class DeleteTimelineViewResponse extends Message<DeleteTimelineViewResponse> {
+  constructor(data?: PartialMessage<DeleteTimelineViewResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pixicast.v1.DeleteTimelineViewResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTimelineViewResponse {
+    return new DeleteTimelineViewResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTimelineViewResponse {
+    return new DeleteTimelineViewResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTimelineViewResponse {
+    return new DeleteTimelineViewResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteTimelineViewResponse | PlainMessage<DeleteTimelineViewResponse> | undefined, b: DeleteTimelineViewResponse | PlainMessage<DeleteTimelineViewResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteTimelineViewResponse, a, b);
+  }
+}
+